@@ -0,0 +1,1067 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetDeviceName returns DeviceName field of DataDisk or nil if DataDisk is nil.
+func (d *DataDisk) GetDeviceName() *string {
+	if d == nil {
+		return nil
+	}
+	return d.DeviceName
+}
+
+// GetDeviceNameV returns value of DeviceName field of DataDisk or zero value if either DataDisk or field is nil.
+func (d *DataDisk) GetDeviceNameV() string {
+	if d == nil || d.DeviceName == nil {
+		return ""
+	}
+	return *d.DeviceName
+}
+
+// GetDeviceNameOr returns value of DeviceName field of DataDisk or def if either DataDisk or field is nil.
+func (d *DataDisk) GetDeviceNameOr(def string) string {
+	if d == nil || d.DeviceName == nil {
+		return def
+	}
+	return *d.DeviceName
+}
+
+// GetGbSize returns GbSize field of DataDisk or nil if DataDisk is nil.
+func (d *DataDisk) GetGbSize() *int {
+	if d == nil {
+		return nil
+	}
+	return d.GbSize
+}
+
+// GetGbSizeV returns value of GbSize field of DataDisk or zero value if either DataDisk or field is nil.
+func (d *DataDisk) GetGbSizeV() int {
+	if d == nil || d.GbSize == nil {
+		return 0
+	}
+	return *d.GbSize
+}
+
+// GetGbSizeOr returns value of GbSize field of DataDisk or def if either DataDisk or field is nil.
+func (d *DataDisk) GetGbSizeOr(def int) int {
+	if d == nil || d.GbSize == nil {
+		return def
+	}
+	return *d.GbSize
+}
+
+// GetType returns Type field of DataDisk or nil if DataDisk is nil.
+func (d *DataDisk) GetType() *string {
+	if d == nil {
+		return nil
+	}
+	return d.Type
+}
+
+// GetTypeV returns value of Type field of DataDisk or zero value if either DataDisk or field is nil.
+func (d *DataDisk) GetTypeV() string {
+	if d == nil || d.Type == nil {
+		return ""
+	}
+	return *d.Type
+}
+
+// GetTypeOr returns value of Type field of DataDisk or def if either DataDisk or field is nil.
+func (d *DataDisk) GetTypeOr(def string) string {
+	if d == nil || d.Type == nil {
+		return def
+	}
+	return *d.Type
+}
+
+// GetAMI returns AMI field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetAMI() *string {
+	if v == nil {
+		return nil
+	}
+	return v.AMI
+}
+
+// GetAMIV returns value of AMI field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetAMIV() string {
+	if v == nil || v.AMI == nil {
+		return ""
+	}
+	return *v.AMI
+}
+
+// GetAMIOr returns value of AMI field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetAMIOr(def string) string {
+	if v == nil || v.AMI == nil {
+		return def
+	}
+	return *v.AMI
+}
+
+// GetOwner returns Owner field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetOwner() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Owner
+}
+
+// GetOwnerV returns value of Owner field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetOwnerV() string {
+	if v == nil || v.Owner == nil {
+		return ""
+	}
+	return *v.Owner
+}
+
+// GetOwnerOr returns value of Owner field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetOwnerOr(def string) string {
+	if v == nil || v.Owner == nil {
+		return def
+	}
+	return *v.Owner
+}
+
+// GetName returns Name field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Name
+}
+
+// GetNameV returns value of Name field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetNameV() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
+// GetNameOr returns value of Name field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetNameOr(def string) string {
+	if v == nil || v.Name == nil {
+		return def
+	}
+	return *v.Name
+}
+
+// GetVmCount returns VmCount field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmCount() *int {
+	if v == nil {
+		return nil
+	}
+	return v.VmCount
+}
+
+// GetVmCountV returns value of VmCount field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmCountV() int {
+	if v == nil || v.VmCount == nil {
+		return 0
+	}
+	return *v.VmCount
+}
+
+// GetVmCountOr returns value of VmCount field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmCountOr(def int) int {
+	if v == nil || v.VmCount == nil {
+		return def
+	}
+	return *v.VmCount
+}
+
+// GetVmSize returns VmSize field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmSize() *string {
+	if v == nil {
+		return nil
+	}
+	return v.VmSize
+}
+
+// GetVmSizeV returns value of VmSize field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmSizeV() string {
+	if v == nil || v.VmSize == nil {
+		return ""
+	}
+	return *v.VmSize
+}
+
+// GetVmSizeOr returns value of VmSize field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmSizeOr(def string) string {
+	if v == nil || v.VmSize == nil {
+		return def
+	}
+	return *v.VmSize
+}
+
+// GetUsePublicIp returns UsePublicIp field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetUsePublicIp() *bool {
+	if v == nil {
+		return nil
+	}
+	return v.UsePublicIp
+}
+
+// GetUsePublicIpV returns value of UsePublicIp field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetUsePublicIpV() bool {
+	if v == nil || v.UsePublicIp == nil {
+		return false
+	}
+	return *v.UsePublicIp
+}
+
+// GetUsePublicIpOr returns value of UsePublicIp field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetUsePublicIpOr(def bool) bool {
+	if v == nil || v.UsePublicIp == nil {
+		return def
+	}
+	return *v.UsePublicIp
+}
+
+// GetSubnetNames returns SubnetNames field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetSubnetNames() []string {
+	if v == nil {
+		return nil
+	}
+	if len(v.SubnetNames) == 0 {
+		return []string{}
+	}
+	return v.SubnetNames
+}
+
+// GetSecurityGroupNames returns SecurityGroupNames field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetSecurityGroupNames() []string {
+	if v == nil {
+		return nil
+	}
+	if len(v.SecurityGroupNames) == 0 {
+		return []string{}
+	}
+	return v.SecurityGroupNames
+}
+
+// GetVmImage returns VmImage field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmImage() *VmImage {
+	if v == nil {
+		return nil
+	}
+	return v.VmImage
+}
+
+// GetVmImageV returns value of VmImage field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmImageV() VmImage {
+	if v == nil || v.VmImage == nil {
+		return VmImage{}
+	}
+	return *v.VmImage
+}
+
+// GetVmImageOr returns value of VmImage field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmImageOr(def VmImage) VmImage {
+	if v == nil || v.VmImage == nil {
+		return def
+	}
+	return *v.VmImage
+}
+
+// GetRootVolumeGbSize returns RootVolumeGbSize field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetRootVolumeGbSize() *int {
+	if v == nil {
+		return nil
+	}
+	return v.RootVolumeGbSize
+}
+
+// GetRootVolumeGbSizeV returns value of RootVolumeGbSize field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetRootVolumeGbSizeV() int {
+	if v == nil || v.RootVolumeGbSize == nil {
+		return 0
+	}
+	return *v.RootVolumeGbSize
+}
+
+// GetRootVolumeGbSizeOr returns value of RootVolumeGbSize field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetRootVolumeGbSizeOr(def int) int {
+	if v == nil || v.RootVolumeGbSize == nil {
+		return def
+	}
+	return *v.RootVolumeGbSize
+}
+
+// GetDataDisks returns DataDisks field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetDataDisks() []DataDisk {
+	if v == nil {
+		return nil
+	}
+	if len(v.DataDisks) == 0 {
+		return []DataDisk{}
+	}
+	return v.DataDisks
+}
+
+// GetProtocol returns Protocol field of SecurityRule or nil if SecurityRule is nil.
+func (s *SecurityRule) GetProtocol() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Protocol
+}
+
+// GetProtocolV returns value of Protocol field of SecurityRule or zero value if either SecurityRule or field is nil.
+func (s *SecurityRule) GetProtocolV() string {
+	if s == nil || s.Protocol == nil {
+		return ""
+	}
+	return *s.Protocol
+}
+
+// GetProtocolOr returns value of Protocol field of SecurityRule or def if either SecurityRule or field is nil.
+func (s *SecurityRule) GetProtocolOr(def string) string {
+	if s == nil || s.Protocol == nil {
+		return def
+	}
+	return *s.Protocol
+}
+
+// GetFromPort returns FromPort field of SecurityRule or nil if SecurityRule is nil.
+func (s *SecurityRule) GetFromPort() *int {
+	if s == nil {
+		return nil
+	}
+	return s.FromPort
+}
+
+// GetFromPortV returns value of FromPort field of SecurityRule or zero value if either SecurityRule or field is nil.
+func (s *SecurityRule) GetFromPortV() int {
+	if s == nil || s.FromPort == nil {
+		return 0
+	}
+	return *s.FromPort
+}
+
+// GetFromPortOr returns value of FromPort field of SecurityRule or def if either SecurityRule or field is nil.
+func (s *SecurityRule) GetFromPortOr(def int) int {
+	if s == nil || s.FromPort == nil {
+		return def
+	}
+	return *s.FromPort
+}
+
+// GetToPort returns ToPort field of SecurityRule or nil if SecurityRule is nil.
+func (s *SecurityRule) GetToPort() *int {
+	if s == nil {
+		return nil
+	}
+	return s.ToPort
+}
+
+// GetToPortV returns value of ToPort field of SecurityRule or zero value if either SecurityRule or field is nil.
+func (s *SecurityRule) GetToPortV() int {
+	if s == nil || s.ToPort == nil {
+		return 0
+	}
+	return *s.ToPort
+}
+
+// GetToPortOr returns value of ToPort field of SecurityRule or def if either SecurityRule or field is nil.
+func (s *SecurityRule) GetToPortOr(def int) int {
+	if s == nil || s.ToPort == nil {
+		return def
+	}
+	return *s.ToPort
+}
+
+// GetCidrBlocks returns CidrBlocks field of SecurityRule, nil if SecurityRule is nil or empty slice if field is nil.
+func (s *SecurityRule) GetCidrBlocks() []string {
+	if s == nil {
+		return nil
+	}
+	if len(s.CidrBlocks) == 0 {
+		return []string{}
+	}
+	return s.CidrBlocks
+}
+
+// GetIngress returns Ingress field of Rules, nil if Rules is nil or empty slice if field is nil.
+func (r *Rules) GetIngress() []SecurityRule {
+	if r == nil {
+		return nil
+	}
+	if len(r.Ingress) == 0 {
+		return []SecurityRule{}
+	}
+	return r.Ingress
+}
+
+// GetEgress returns Egress field of Rules, nil if Rules is nil or empty slice if field is nil.
+func (r *Rules) GetEgress() []SecurityRule {
+	if r == nil {
+		return nil
+	}
+	if len(r.Egress) == 0 {
+		return []SecurityRule{}
+	}
+	return r.Egress
+}
+
+// GetName returns Name field of SecurityGroup or nil if SecurityGroup is nil.
+func (s *SecurityGroup) GetName() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Name
+}
+
+// GetNameV returns value of Name field of SecurityGroup or zero value if either SecurityGroup or field is nil.
+func (s *SecurityGroup) GetNameV() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOr returns value of Name field of SecurityGroup or def if either SecurityGroup or field is nil.
+func (s *SecurityGroup) GetNameOr(def string) string {
+	if s == nil || s.Name == nil {
+		return def
+	}
+	return *s.Name
+}
+
+// GetRules returns Rules field of SecurityGroup or nil if SecurityGroup is nil.
+func (s *SecurityGroup) GetRules() *Rules {
+	if s == nil {
+		return nil
+	}
+	return s.Rules
+}
+
+// GetRulesV returns value of Rules field of SecurityGroup or zero value if either SecurityGroup or field is nil.
+func (s *SecurityGroup) GetRulesV() Rules {
+	if s == nil || s.Rules == nil {
+		return Rules{}
+	}
+	return *s.Rules
+}
+
+// GetRulesOr returns value of Rules field of SecurityGroup or def if either SecurityGroup or field is nil.
+func (s *SecurityGroup) GetRulesOr(def Rules) Rules {
+	if s == nil || s.Rules == nil {
+		return def
+	}
+	return *s.Rules
+}
+
+// GetName returns Name field of Subnet or nil if Subnet is nil.
+func (s *Subnet) GetName() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Name
+}
+
+// GetNameV returns value of Name field of Subnet or zero value if either Subnet or field is nil.
+func (s *Subnet) GetNameV() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOr returns value of Name field of Subnet or def if either Subnet or field is nil.
+func (s *Subnet) GetNameOr(def string) string {
+	if s == nil || s.Name == nil {
+		return def
+	}
+	return *s.Name
+}
+
+// GetAvailabilityZone returns AvailabilityZone field of Subnet or nil if Subnet is nil.
+func (s *Subnet) GetAvailabilityZone() *string {
+	if s == nil {
+		return nil
+	}
+	return s.AvailabilityZone
+}
+
+// GetAvailabilityZoneV returns value of AvailabilityZone field of Subnet or zero value if either Subnet or field is nil.
+func (s *Subnet) GetAvailabilityZoneV() string {
+	if s == nil || s.AvailabilityZone == nil {
+		return ""
+	}
+	return *s.AvailabilityZone
+}
+
+// GetAvailabilityZoneOr returns value of AvailabilityZone field of Subnet or def if either Subnet or field is nil.
+func (s *Subnet) GetAvailabilityZoneOr(def string) string {
+	if s == nil || s.AvailabilityZone == nil {
+		return def
+	}
+	return *s.AvailabilityZone
+}
+
+// GetAddressPrefixes returns AddressPrefixes field of Subnet or nil if Subnet is nil.
+func (s *Subnet) GetAddressPrefixes() *string {
+	if s == nil {
+		return nil
+	}
+	return s.AddressPrefixes
+}
+
+// GetAddressPrefixesV returns value of AddressPrefixes field of Subnet or zero value if either Subnet or field is nil.
+func (s *Subnet) GetAddressPrefixesV() string {
+	if s == nil || s.AddressPrefixes == nil {
+		return ""
+	}
+	return *s.AddressPrefixes
+}
+
+// GetAddressPrefixesOr returns value of AddressPrefixes field of Subnet or def if either Subnet or field is nil.
+func (s *Subnet) GetAddressPrefixesOr(def string) string {
+	if s == nil || s.AddressPrefixes == nil {
+		return def
+	}
+	return *s.AddressPrefixes
+}
+
+// GetPrivate returns Private field of Subnets, nil if Subnets is nil or empty slice if field is nil.
+func (s *Subnets) GetPrivate() []Subnet {
+	if s == nil {
+		return nil
+	}
+	if len(s.Private) == 0 {
+		return []Subnet{}
+	}
+	return s.Private
+}
+
+// GetPublic returns Public field of Subnets, nil if Subnets is nil or empty slice if field is nil.
+func (s *Subnets) GetPublic() []Subnet {
+	if s == nil {
+		return nil
+	}
+	if len(s.Public) == 0 {
+		return []Subnet{}
+	}
+	return s.Public
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetRegion returns Region field of Params or nil if Params is nil.
+func (p *Params) GetRegion() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Region
+}
+
+// GetRegionV returns value of Region field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRegionV() string {
+	if p == nil || p.Region == nil {
+		return ""
+	}
+	return *p.Region
+}
+
+// GetRegionOr returns value of Region field of Params or def if either Params or field is nil.
+func (p *Params) GetRegionOr(def string) string {
+	if p == nil || p.Region == nil {
+		return def
+	}
+	return *p.Region
+}
+
+// GetNatGatewayCount returns NatGatewayCount field of Params or nil if Params is nil.
+func (p *Params) GetNatGatewayCount() *int {
+	if p == nil {
+		return nil
+	}
+	return p.NatGatewayCount
+}
+
+// GetNatGatewayCountV returns value of NatGatewayCount field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNatGatewayCountV() int {
+	if p == nil || p.NatGatewayCount == nil {
+		return 0
+	}
+	return *p.NatGatewayCount
+}
+
+// GetNatGatewayCountOr returns value of NatGatewayCount field of Params or def if either Params or field is nil.
+func (p *Params) GetNatGatewayCountOr(def int) int {
+	if p == nil || p.NatGatewayCount == nil {
+		return def
+	}
+	return *p.NatGatewayCount
+}
+
+// GetVirtualPrivateGateway returns VirtualPrivateGateway field of Params or nil if Params is nil.
+func (p *Params) GetVirtualPrivateGateway() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.VirtualPrivateGateway
+}
+
+// GetVirtualPrivateGatewayV returns value of VirtualPrivateGateway field of Params or zero value if either Params or field is nil.
+func (p *Params) GetVirtualPrivateGatewayV() bool {
+	if p == nil || p.VirtualPrivateGateway == nil {
+		return false
+	}
+	return *p.VirtualPrivateGateway
+}
+
+// GetVirtualPrivateGatewayOr returns value of VirtualPrivateGateway field of Params or def if either Params or field is nil.
+func (p *Params) GetVirtualPrivateGatewayOr(def bool) bool {
+	if p == nil || p.VirtualPrivateGateway == nil {
+		return def
+	}
+	return *p.VirtualPrivateGateway
+}
+
+// GetRsaPublicKeyPath returns RsaPublicKeyPath field of Params or nil if Params is nil.
+func (p *Params) GetRsaPublicKeyPath() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathV returns value of RsaPublicKeyPath field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathV() string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return ""
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathOr returns value of RsaPublicKeyPath field of Params or def if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathOr(def string) string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return def
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetVpcAddressSpace returns VpcAddressSpace field of Params or nil if Params is nil.
+func (p *Params) GetVpcAddressSpace() *string {
+	if p == nil {
+		return nil
+	}
+	return p.VpcAddressSpace
+}
+
+// GetVpcAddressSpaceV returns value of VpcAddressSpace field of Params or zero value if either Params or field is nil.
+func (p *Params) GetVpcAddressSpaceV() string {
+	if p == nil || p.VpcAddressSpace == nil {
+		return ""
+	}
+	return *p.VpcAddressSpace
+}
+
+// GetVpcAddressSpaceOr returns value of VpcAddressSpace field of Params or def if either Params or field is nil.
+func (p *Params) GetVpcAddressSpaceOr(def string) string {
+	if p == nil || p.VpcAddressSpace == nil {
+		return def
+	}
+	return *p.VpcAddressSpace
+}
+
+// GetSubnets returns Subnets field of Params or nil if Params is nil.
+func (p *Params) GetSubnets() *Subnets {
+	if p == nil {
+		return nil
+	}
+	return p.Subnets
+}
+
+// GetSubnetsV returns value of Subnets field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSubnetsV() Subnets {
+	if p == nil || p.Subnets == nil {
+		return Subnets{}
+	}
+	return *p.Subnets
+}
+
+// GetSubnetsOr returns value of Subnets field of Params or def if either Params or field is nil.
+func (p *Params) GetSubnetsOr(def Subnets) Subnets {
+	if p == nil || p.Subnets == nil {
+		return def
+	}
+	return *p.Subnets
+}
+
+// GetSecurityGroups returns SecurityGroups field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetSecurityGroups() []SecurityGroup {
+	if p == nil {
+		return nil
+	}
+	if len(p.SecurityGroups) == 0 {
+		return []SecurityGroup{}
+	}
+	return p.SecurityGroups
+}
+
+// GetVmGroups returns VmGroups field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetVmGroups() []VmGroup {
+	if p == nil {
+		return nil
+	}
+	if len(p.VmGroups) == 0 {
+		return []VmGroup{}
+	}
+	return p.VmGroups
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetSize returns Size field of OutputDataDisk or nil if OutputDataDisk is nil.
+func (o *OutputDataDisk) GetSize() *int {
+	if o == nil {
+		return nil
+	}
+	return o.Size
+}
+
+// GetSizeV returns value of Size field of OutputDataDisk or zero value if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetSizeV() int {
+	if o == nil || o.Size == nil {
+		return 0
+	}
+	return *o.Size
+}
+
+// GetSizeOr returns value of Size field of OutputDataDisk or def if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetSizeOr(def int) int {
+	if o == nil || o.Size == nil {
+		return def
+	}
+	return *o.Size
+}
+
+// GetDeviceName returns DeviceName field of OutputDataDisk or nil if OutputDataDisk is nil.
+func (o *OutputDataDisk) GetDeviceName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.DeviceName
+}
+
+// GetDeviceNameV returns value of DeviceName field of OutputDataDisk or zero value if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetDeviceNameV() string {
+	if o == nil || o.DeviceName == nil {
+		return ""
+	}
+	return *o.DeviceName
+}
+
+// GetDeviceNameOr returns value of DeviceName field of OutputDataDisk or def if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetDeviceNameOr(def string) string {
+	if o == nil || o.DeviceName == nil {
+		return def
+	}
+	return *o.DeviceName
+}
+
+// GetName returns Name field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetPublicIp returns PublicIp field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetPublicIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PublicIp
+}
+
+// GetPublicIpV returns value of PublicIp field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetPublicIpV() string {
+	if o == nil || o.PublicIp == nil {
+		return ""
+	}
+	return *o.PublicIp
+}
+
+// GetPublicIpOr returns value of PublicIp field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetPublicIpOr(def string) string {
+	if o == nil || o.PublicIp == nil {
+		return def
+	}
+	return *o.PublicIp
+}
+
+// GetPrivateIp returns PrivateIp field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetPrivateIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PrivateIp
+}
+
+// GetPrivateIpV returns value of PrivateIp field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetPrivateIpV() string {
+	if o == nil || o.PrivateIp == nil {
+		return ""
+	}
+	return *o.PrivateIp
+}
+
+// GetPrivateIpOr returns value of PrivateIp field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetPrivateIpOr(def string) string {
+	if o == nil || o.PrivateIp == nil {
+		return def
+	}
+	return *o.PrivateIp
+}
+
+// GetDataDisks returns DataDisks field of OutputVm, nil if OutputVm is nil or empty slice if field is nil.
+func (o *OutputVm) GetDataDisks() []OutputDataDisk {
+	if o == nil {
+		return nil
+	}
+	if len(o.DataDisks) == 0 {
+		return []OutputDataDisk{}
+	}
+	return o.DataDisks
+}
+
+// GetName returns Name field of OutputVmGroup or nil if OutputVmGroup is nil.
+func (o *OutputVmGroup) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputVmGroup or zero value if either OutputVmGroup or field is nil.
+func (o *OutputVmGroup) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputVmGroup or def if either OutputVmGroup or field is nil.
+func (o *OutputVmGroup) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetVms returns Vms field of OutputVmGroup, nil if OutputVmGroup is nil or empty slice if field is nil.
+func (o *OutputVmGroup) GetVms() []OutputVm {
+	if o == nil {
+		return nil
+	}
+	if len(o.Vms) == 0 {
+		return []OutputVm{}
+	}
+	return o.Vms
+}
+
+// GetVpcId returns VpcId field of Output or nil if Output is nil.
+func (o *Output) GetVpcId() *string {
+	if o == nil {
+		return nil
+	}
+	return o.VpcId
+}
+
+// GetVpcIdV returns value of VpcId field of Output or zero value if either Output or field is nil.
+func (o *Output) GetVpcIdV() string {
+	if o == nil || o.VpcId == nil {
+		return ""
+	}
+	return *o.VpcId
+}
+
+// GetVpcIdOr returns value of VpcId field of Output or def if either Output or field is nil.
+func (o *Output) GetVpcIdOr(def string) string {
+	if o == nil || o.VpcId == nil {
+		return def
+	}
+	return *o.VpcId
+}
+
+// GetPrivateSubnetIds returns PrivateSubnetIds field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetPrivateSubnetIds() []string {
+	if o == nil {
+		return nil
+	}
+	if len(o.PrivateSubnetIds) == 0 {
+		return []string{}
+	}
+	return o.PrivateSubnetIds
+}
+
+// GetPublicSubnetIds returns PublicSubnetIds field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetPublicSubnetIds() []string {
+	if o == nil {
+		return nil
+	}
+	if len(o.PublicSubnetIds) == 0 {
+		return []string{}
+	}
+	return o.PublicSubnetIds
+}
+
+// GetPrivateRouteTable returns PrivateRouteTable field of Output or nil if Output is nil.
+func (o *Output) GetPrivateRouteTable() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PrivateRouteTable
+}
+
+// GetPrivateRouteTableV returns value of PrivateRouteTable field of Output or zero value if either Output or field is nil.
+func (o *Output) GetPrivateRouteTableV() string {
+	if o == nil || o.PrivateRouteTable == nil {
+		return ""
+	}
+	return *o.PrivateRouteTable
+}
+
+// GetPrivateRouteTableOr returns value of PrivateRouteTable field of Output or def if either Output or field is nil.
+func (o *Output) GetPrivateRouteTableOr(def string) string {
+	if o == nil || o.PrivateRouteTable == nil {
+		return def
+	}
+	return *o.PrivateRouteTable
+}
+
+// GetVmGroups returns VmGroups field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetVmGroups() []OutputVmGroup {
+	if o == nil {
+		return nil
+	}
+	if len(o.VmGroups) == 0 {
+		return []OutputVmGroup{}
+	}
+	return o.VmGroups
+}
@@ -0,0 +1,1072 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDataDisk_Accessors(t *testing.T) {
+	var nilStruct *DataDisk
+	emptyStruct := &DataDisk{}
+	t.Run("DeviceName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &DataDisk{DeviceName: &v}
+		if nilStruct.GetDeviceName() != nil || emptyStruct.GetDeviceName() != nil {
+			t.Error("GetDeviceName() expected to return nil")
+		}
+		if fullStruct.GetDeviceName() != &v {
+			t.Error("GetDeviceName() expected to return field")
+		}
+		if nilStruct.GetDeviceNameV() != "" || emptyStruct.GetDeviceNameV() != "" {
+			t.Error("GetDeviceNameV() expected to return zero value")
+		}
+		if fullStruct.GetDeviceNameV() != v {
+			t.Error("GetDeviceNameV() expected to return field value")
+		}
+		if nilStruct.GetDeviceNameOr(v) != v || emptyStruct.GetDeviceNameOr(v) != v {
+			t.Error("GetDeviceNameOr() expected to return default value")
+		}
+		if fullStruct.GetDeviceNameOr("") != v {
+			t.Error("GetDeviceNameOr() expected to return field value")
+		}
+	})
+	t.Run("GbSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &DataDisk{GbSize: &v}
+		if nilStruct.GetGbSize() != nil || emptyStruct.GetGbSize() != nil {
+			t.Error("GetGbSize() expected to return nil")
+		}
+		if fullStruct.GetGbSize() != &v {
+			t.Error("GetGbSize() expected to return field")
+		}
+		if nilStruct.GetGbSizeV() != 0 || emptyStruct.GetGbSizeV() != 0 {
+			t.Error("GetGbSizeV() expected to return zero value")
+		}
+		if fullStruct.GetGbSizeV() != v {
+			t.Error("GetGbSizeV() expected to return field value")
+		}
+		if nilStruct.GetGbSizeOr(v) != v || emptyStruct.GetGbSizeOr(v) != v {
+			t.Error("GetGbSizeOr() expected to return default value")
+		}
+		if fullStruct.GetGbSizeOr(0) != v {
+			t.Error("GetGbSizeOr() expected to return field value")
+		}
+	})
+	t.Run("Type", func(t *testing.T) {
+		v := "value"
+		fullStruct := &DataDisk{Type: &v}
+		if nilStruct.GetType() != nil || emptyStruct.GetType() != nil {
+			t.Error("GetType() expected to return nil")
+		}
+		if fullStruct.GetType() != &v {
+			t.Error("GetType() expected to return field")
+		}
+		if nilStruct.GetTypeV() != "" || emptyStruct.GetTypeV() != "" {
+			t.Error("GetTypeV() expected to return zero value")
+		}
+		if fullStruct.GetTypeV() != v {
+			t.Error("GetTypeV() expected to return field value")
+		}
+		if nilStruct.GetTypeOr(v) != v || emptyStruct.GetTypeOr(v) != v {
+			t.Error("GetTypeOr() expected to return default value")
+		}
+		if fullStruct.GetTypeOr("") != v {
+			t.Error("GetTypeOr() expected to return field value")
+		}
+	})
+}
+
+func TestVmImage_Accessors(t *testing.T) {
+	var nilStruct *VmImage
+	emptyStruct := &VmImage{}
+	t.Run("AMI", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{AMI: &v}
+		if nilStruct.GetAMI() != nil || emptyStruct.GetAMI() != nil {
+			t.Error("GetAMI() expected to return nil")
+		}
+		if fullStruct.GetAMI() != &v {
+			t.Error("GetAMI() expected to return field")
+		}
+		if nilStruct.GetAMIV() != "" || emptyStruct.GetAMIV() != "" {
+			t.Error("GetAMIV() expected to return zero value")
+		}
+		if fullStruct.GetAMIV() != v {
+			t.Error("GetAMIV() expected to return field value")
+		}
+		if nilStruct.GetAMIOr(v) != v || emptyStruct.GetAMIOr(v) != v {
+			t.Error("GetAMIOr() expected to return default value")
+		}
+		if fullStruct.GetAMIOr("") != v {
+			t.Error("GetAMIOr() expected to return field value")
+		}
+	})
+	t.Run("Owner", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{Owner: &v}
+		if nilStruct.GetOwner() != nil || emptyStruct.GetOwner() != nil {
+			t.Error("GetOwner() expected to return nil")
+		}
+		if fullStruct.GetOwner() != &v {
+			t.Error("GetOwner() expected to return field")
+		}
+		if nilStruct.GetOwnerV() != "" || emptyStruct.GetOwnerV() != "" {
+			t.Error("GetOwnerV() expected to return zero value")
+		}
+		if fullStruct.GetOwnerV() != v {
+			t.Error("GetOwnerV() expected to return field value")
+		}
+		if nilStruct.GetOwnerOr(v) != v || emptyStruct.GetOwnerOr(v) != v {
+			t.Error("GetOwnerOr() expected to return default value")
+		}
+		if fullStruct.GetOwnerOr("") != v {
+			t.Error("GetOwnerOr() expected to return field value")
+		}
+	})
+}
+
+func TestVmGroup_Accessors(t *testing.T) {
+	var nilStruct *VmGroup
+	emptyStruct := &VmGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VmCount", func(t *testing.T) {
+		v := 1
+		fullStruct := &VmGroup{VmCount: &v}
+		if nilStruct.GetVmCount() != nil || emptyStruct.GetVmCount() != nil {
+			t.Error("GetVmCount() expected to return nil")
+		}
+		if fullStruct.GetVmCount() != &v {
+			t.Error("GetVmCount() expected to return field")
+		}
+		if nilStruct.GetVmCountV() != 0 || emptyStruct.GetVmCountV() != 0 {
+			t.Error("GetVmCountV() expected to return zero value")
+		}
+		if fullStruct.GetVmCountV() != v {
+			t.Error("GetVmCountV() expected to return field value")
+		}
+		if nilStruct.GetVmCountOr(v) != v || emptyStruct.GetVmCountOr(v) != v {
+			t.Error("GetVmCountOr() expected to return default value")
+		}
+		if fullStruct.GetVmCountOr(0) != v {
+			t.Error("GetVmCountOr() expected to return field value")
+		}
+	})
+	t.Run("VmSize", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{VmSize: &v}
+		if nilStruct.GetVmSize() != nil || emptyStruct.GetVmSize() != nil {
+			t.Error("GetVmSize() expected to return nil")
+		}
+		if fullStruct.GetVmSize() != &v {
+			t.Error("GetVmSize() expected to return field")
+		}
+		if nilStruct.GetVmSizeV() != "" || emptyStruct.GetVmSizeV() != "" {
+			t.Error("GetVmSizeV() expected to return zero value")
+		}
+		if fullStruct.GetVmSizeV() != v {
+			t.Error("GetVmSizeV() expected to return field value")
+		}
+		if nilStruct.GetVmSizeOr(v) != v || emptyStruct.GetVmSizeOr(v) != v {
+			t.Error("GetVmSizeOr() expected to return default value")
+		}
+		if fullStruct.GetVmSizeOr("") != v {
+			t.Error("GetVmSizeOr() expected to return field value")
+		}
+	})
+	t.Run("UsePublicIp", func(t *testing.T) {
+		v := true
+		fullStruct := &VmGroup{UsePublicIp: &v}
+		if nilStruct.GetUsePublicIp() != nil || emptyStruct.GetUsePublicIp() != nil {
+			t.Error("GetUsePublicIp() expected to return nil")
+		}
+		if fullStruct.GetUsePublicIp() != &v {
+			t.Error("GetUsePublicIp() expected to return field")
+		}
+		if nilStruct.GetUsePublicIpV() != false || emptyStruct.GetUsePublicIpV() != false {
+			t.Error("GetUsePublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetUsePublicIpV() != v {
+			t.Error("GetUsePublicIpV() expected to return field value")
+		}
+		if nilStruct.GetUsePublicIpOr(v) != v || emptyStruct.GetUsePublicIpOr(v) != v {
+			t.Error("GetUsePublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetUsePublicIpOr(false) != v {
+			t.Error("GetUsePublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetNames", func(t *testing.T) {
+		fullStruct := &VmGroup{SubnetNames: make([]string, 1)}
+		if nilStruct.GetSubnetNames() != nil {
+			t.Error("GetSubnetNames() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnetNames(); got == nil || len(got) != 0 {
+			t.Error("GetSubnetNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnetNames(); len(got) != 1 || &got[0] != &fullStruct.SubnetNames[0] {
+			t.Error("GetSubnetNames() expected to return field")
+		}
+	})
+	t.Run("SecurityGroupNames", func(t *testing.T) {
+		fullStruct := &VmGroup{SecurityGroupNames: make([]string, 1)}
+		if nilStruct.GetSecurityGroupNames() != nil {
+			t.Error("GetSecurityGroupNames() expected to return nil")
+		}
+		if got := emptyStruct.GetSecurityGroupNames(); got == nil || len(got) != 0 {
+			t.Error("GetSecurityGroupNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetSecurityGroupNames(); len(got) != 1 || &got[0] != &fullStruct.SecurityGroupNames[0] {
+			t.Error("GetSecurityGroupNames() expected to return field")
+		}
+	})
+	t.Run("VmImage", func(t *testing.T) {
+		v := VmImage{}
+		fullStruct := &VmGroup{VmImage: &v}
+		if nilStruct.GetVmImage() != nil || emptyStruct.GetVmImage() != nil {
+			t.Error("GetVmImage() expected to return nil")
+		}
+		if fullStruct.GetVmImage() != &v {
+			t.Error("GetVmImage() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetVmImageV(), VmImage{}) || !reflect.DeepEqual(emptyStruct.GetVmImageV(), VmImage{}) {
+			t.Error("GetVmImageV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetVmImageV(), v) {
+			t.Error("GetVmImageV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetVmImageOr(v), v) || !reflect.DeepEqual(emptyStruct.GetVmImageOr(v), v) {
+			t.Error("GetVmImageOr() expected to return default value")
+		}
+	})
+	t.Run("RootVolumeGbSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &VmGroup{RootVolumeGbSize: &v}
+		if nilStruct.GetRootVolumeGbSize() != nil || emptyStruct.GetRootVolumeGbSize() != nil {
+			t.Error("GetRootVolumeGbSize() expected to return nil")
+		}
+		if fullStruct.GetRootVolumeGbSize() != &v {
+			t.Error("GetRootVolumeGbSize() expected to return field")
+		}
+		if nilStruct.GetRootVolumeGbSizeV() != 0 || emptyStruct.GetRootVolumeGbSizeV() != 0 {
+			t.Error("GetRootVolumeGbSizeV() expected to return zero value")
+		}
+		if fullStruct.GetRootVolumeGbSizeV() != v {
+			t.Error("GetRootVolumeGbSizeV() expected to return field value")
+		}
+		if nilStruct.GetRootVolumeGbSizeOr(v) != v || emptyStruct.GetRootVolumeGbSizeOr(v) != v {
+			t.Error("GetRootVolumeGbSizeOr() expected to return default value")
+		}
+		if fullStruct.GetRootVolumeGbSizeOr(0) != v {
+			t.Error("GetRootVolumeGbSizeOr() expected to return field value")
+		}
+	})
+	t.Run("DataDisks", func(t *testing.T) {
+		fullStruct := &VmGroup{DataDisks: make([]DataDisk, 1)}
+		if nilStruct.GetDataDisks() != nil {
+			t.Error("GetDataDisks() expected to return nil")
+		}
+		if got := emptyStruct.GetDataDisks(); got == nil || len(got) != 0 {
+			t.Error("GetDataDisks() expected to return empty slice")
+		}
+		if got := fullStruct.GetDataDisks(); len(got) != 1 || &got[0] != &fullStruct.DataDisks[0] {
+			t.Error("GetDataDisks() expected to return field")
+		}
+	})
+}
+
+func TestSecurityRule_Accessors(t *testing.T) {
+	var nilStruct *SecurityRule
+	emptyStruct := &SecurityRule{}
+	t.Run("Protocol", func(t *testing.T) {
+		v := "value"
+		fullStruct := &SecurityRule{Protocol: &v}
+		if nilStruct.GetProtocol() != nil || emptyStruct.GetProtocol() != nil {
+			t.Error("GetProtocol() expected to return nil")
+		}
+		if fullStruct.GetProtocol() != &v {
+			t.Error("GetProtocol() expected to return field")
+		}
+		if nilStruct.GetProtocolV() != "" || emptyStruct.GetProtocolV() != "" {
+			t.Error("GetProtocolV() expected to return zero value")
+		}
+		if fullStruct.GetProtocolV() != v {
+			t.Error("GetProtocolV() expected to return field value")
+		}
+		if nilStruct.GetProtocolOr(v) != v || emptyStruct.GetProtocolOr(v) != v {
+			t.Error("GetProtocolOr() expected to return default value")
+		}
+		if fullStruct.GetProtocolOr("") != v {
+			t.Error("GetProtocolOr() expected to return field value")
+		}
+	})
+	t.Run("FromPort", func(t *testing.T) {
+		v := 1
+		fullStruct := &SecurityRule{FromPort: &v}
+		if nilStruct.GetFromPort() != nil || emptyStruct.GetFromPort() != nil {
+			t.Error("GetFromPort() expected to return nil")
+		}
+		if fullStruct.GetFromPort() != &v {
+			t.Error("GetFromPort() expected to return field")
+		}
+		if nilStruct.GetFromPortV() != 0 || emptyStruct.GetFromPortV() != 0 {
+			t.Error("GetFromPortV() expected to return zero value")
+		}
+		if fullStruct.GetFromPortV() != v {
+			t.Error("GetFromPortV() expected to return field value")
+		}
+		if nilStruct.GetFromPortOr(v) != v || emptyStruct.GetFromPortOr(v) != v {
+			t.Error("GetFromPortOr() expected to return default value")
+		}
+		if fullStruct.GetFromPortOr(0) != v {
+			t.Error("GetFromPortOr() expected to return field value")
+		}
+	})
+	t.Run("ToPort", func(t *testing.T) {
+		v := 1
+		fullStruct := &SecurityRule{ToPort: &v}
+		if nilStruct.GetToPort() != nil || emptyStruct.GetToPort() != nil {
+			t.Error("GetToPort() expected to return nil")
+		}
+		if fullStruct.GetToPort() != &v {
+			t.Error("GetToPort() expected to return field")
+		}
+		if nilStruct.GetToPortV() != 0 || emptyStruct.GetToPortV() != 0 {
+			t.Error("GetToPortV() expected to return zero value")
+		}
+		if fullStruct.GetToPortV() != v {
+			t.Error("GetToPortV() expected to return field value")
+		}
+		if nilStruct.GetToPortOr(v) != v || emptyStruct.GetToPortOr(v) != v {
+			t.Error("GetToPortOr() expected to return default value")
+		}
+		if fullStruct.GetToPortOr(0) != v {
+			t.Error("GetToPortOr() expected to return field value")
+		}
+	})
+	t.Run("CidrBlocks", func(t *testing.T) {
+		fullStruct := &SecurityRule{CidrBlocks: make([]string, 1)}
+		if nilStruct.GetCidrBlocks() != nil {
+			t.Error("GetCidrBlocks() expected to return nil")
+		}
+		if got := emptyStruct.GetCidrBlocks(); got == nil || len(got) != 0 {
+			t.Error("GetCidrBlocks() expected to return empty slice")
+		}
+		if got := fullStruct.GetCidrBlocks(); len(got) != 1 || &got[0] != &fullStruct.CidrBlocks[0] {
+			t.Error("GetCidrBlocks() expected to return field")
+		}
+	})
+}
+
+func TestRules_Accessors(t *testing.T) {
+	var nilStruct *Rules
+	emptyStruct := &Rules{}
+	t.Run("Ingress", func(t *testing.T) {
+		fullStruct := &Rules{Ingress: make([]SecurityRule, 1)}
+		if nilStruct.GetIngress() != nil {
+			t.Error("GetIngress() expected to return nil")
+		}
+		if got := emptyStruct.GetIngress(); got == nil || len(got) != 0 {
+			t.Error("GetIngress() expected to return empty slice")
+		}
+		if got := fullStruct.GetIngress(); len(got) != 1 || &got[0] != &fullStruct.Ingress[0] {
+			t.Error("GetIngress() expected to return field")
+		}
+	})
+	t.Run("Egress", func(t *testing.T) {
+		fullStruct := &Rules{Egress: make([]SecurityRule, 1)}
+		if nilStruct.GetEgress() != nil {
+			t.Error("GetEgress() expected to return nil")
+		}
+		if got := emptyStruct.GetEgress(); got == nil || len(got) != 0 {
+			t.Error("GetEgress() expected to return empty slice")
+		}
+		if got := fullStruct.GetEgress(); len(got) != 1 || &got[0] != &fullStruct.Egress[0] {
+			t.Error("GetEgress() expected to return field")
+		}
+	})
+}
+
+func TestSecurityGroup_Accessors(t *testing.T) {
+	var nilStruct *SecurityGroup
+	emptyStruct := &SecurityGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &SecurityGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Rules", func(t *testing.T) {
+		v := Rules{}
+		fullStruct := &SecurityGroup{Rules: &v}
+		if nilStruct.GetRules() != nil || emptyStruct.GetRules() != nil {
+			t.Error("GetRules() expected to return nil")
+		}
+		if fullStruct.GetRules() != &v {
+			t.Error("GetRules() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetRulesV(), Rules{}) || !reflect.DeepEqual(emptyStruct.GetRulesV(), Rules{}) {
+			t.Error("GetRulesV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetRulesV(), v) {
+			t.Error("GetRulesV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetRulesOr(v), v) || !reflect.DeepEqual(emptyStruct.GetRulesOr(v), v) {
+			t.Error("GetRulesOr() expected to return default value")
+		}
+	})
+}
+
+func TestSubnet_Accessors(t *testing.T) {
+	var nilStruct *Subnet
+	emptyStruct := &Subnet{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Subnet{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("AvailabilityZone", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Subnet{AvailabilityZone: &v}
+		if nilStruct.GetAvailabilityZone() != nil || emptyStruct.GetAvailabilityZone() != nil {
+			t.Error("GetAvailabilityZone() expected to return nil")
+		}
+		if fullStruct.GetAvailabilityZone() != &v {
+			t.Error("GetAvailabilityZone() expected to return field")
+		}
+		if nilStruct.GetAvailabilityZoneV() != "" || emptyStruct.GetAvailabilityZoneV() != "" {
+			t.Error("GetAvailabilityZoneV() expected to return zero value")
+		}
+		if fullStruct.GetAvailabilityZoneV() != v {
+			t.Error("GetAvailabilityZoneV() expected to return field value")
+		}
+		if nilStruct.GetAvailabilityZoneOr(v) != v || emptyStruct.GetAvailabilityZoneOr(v) != v {
+			t.Error("GetAvailabilityZoneOr() expected to return default value")
+		}
+		if fullStruct.GetAvailabilityZoneOr("") != v {
+			t.Error("GetAvailabilityZoneOr() expected to return field value")
+		}
+	})
+	t.Run("AddressPrefixes", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Subnet{AddressPrefixes: &v}
+		if nilStruct.GetAddressPrefixes() != nil || emptyStruct.GetAddressPrefixes() != nil {
+			t.Error("GetAddressPrefixes() expected to return nil")
+		}
+		if fullStruct.GetAddressPrefixes() != &v {
+			t.Error("GetAddressPrefixes() expected to return field")
+		}
+		if nilStruct.GetAddressPrefixesV() != "" || emptyStruct.GetAddressPrefixesV() != "" {
+			t.Error("GetAddressPrefixesV() expected to return zero value")
+		}
+		if fullStruct.GetAddressPrefixesV() != v {
+			t.Error("GetAddressPrefixesV() expected to return field value")
+		}
+		if nilStruct.GetAddressPrefixesOr(v) != v || emptyStruct.GetAddressPrefixesOr(v) != v {
+			t.Error("GetAddressPrefixesOr() expected to return default value")
+		}
+		if fullStruct.GetAddressPrefixesOr("") != v {
+			t.Error("GetAddressPrefixesOr() expected to return field value")
+		}
+	})
+}
+
+func TestSubnets_Accessors(t *testing.T) {
+	var nilStruct *Subnets
+	emptyStruct := &Subnets{}
+	t.Run("Private", func(t *testing.T) {
+		fullStruct := &Subnets{Private: make([]Subnet, 1)}
+		if nilStruct.GetPrivate() != nil {
+			t.Error("GetPrivate() expected to return nil")
+		}
+		if got := emptyStruct.GetPrivate(); got == nil || len(got) != 0 {
+			t.Error("GetPrivate() expected to return empty slice")
+		}
+		if got := fullStruct.GetPrivate(); len(got) != 1 || &got[0] != &fullStruct.Private[0] {
+			t.Error("GetPrivate() expected to return field")
+		}
+	})
+	t.Run("Public", func(t *testing.T) {
+		fullStruct := &Subnets{Public: make([]Subnet, 1)}
+		if nilStruct.GetPublic() != nil {
+			t.Error("GetPublic() expected to return nil")
+		}
+		if got := emptyStruct.GetPublic(); got == nil || len(got) != 0 {
+			t.Error("GetPublic() expected to return empty slice")
+		}
+		if got := fullStruct.GetPublic(); len(got) != 1 || &got[0] != &fullStruct.Public[0] {
+			t.Error("GetPublic() expected to return field")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Region", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Region: &v}
+		if nilStruct.GetRegion() != nil || emptyStruct.GetRegion() != nil {
+			t.Error("GetRegion() expected to return nil")
+		}
+		if fullStruct.GetRegion() != &v {
+			t.Error("GetRegion() expected to return field")
+		}
+		if nilStruct.GetRegionV() != "" || emptyStruct.GetRegionV() != "" {
+			t.Error("GetRegionV() expected to return zero value")
+		}
+		if fullStruct.GetRegionV() != v {
+			t.Error("GetRegionV() expected to return field value")
+		}
+		if nilStruct.GetRegionOr(v) != v || emptyStruct.GetRegionOr(v) != v {
+			t.Error("GetRegionOr() expected to return default value")
+		}
+		if fullStruct.GetRegionOr("") != v {
+			t.Error("GetRegionOr() expected to return field value")
+		}
+	})
+	t.Run("NatGatewayCount", func(t *testing.T) {
+		v := 1
+		fullStruct := &Params{NatGatewayCount: &v}
+		if nilStruct.GetNatGatewayCount() != nil || emptyStruct.GetNatGatewayCount() != nil {
+			t.Error("GetNatGatewayCount() expected to return nil")
+		}
+		if fullStruct.GetNatGatewayCount() != &v {
+			t.Error("GetNatGatewayCount() expected to return field")
+		}
+		if nilStruct.GetNatGatewayCountV() != 0 || emptyStruct.GetNatGatewayCountV() != 0 {
+			t.Error("GetNatGatewayCountV() expected to return zero value")
+		}
+		if fullStruct.GetNatGatewayCountV() != v {
+			t.Error("GetNatGatewayCountV() expected to return field value")
+		}
+		if nilStruct.GetNatGatewayCountOr(v) != v || emptyStruct.GetNatGatewayCountOr(v) != v {
+			t.Error("GetNatGatewayCountOr() expected to return default value")
+		}
+		if fullStruct.GetNatGatewayCountOr(0) != v {
+			t.Error("GetNatGatewayCountOr() expected to return field value")
+		}
+	})
+	t.Run("VirtualPrivateGateway", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{VirtualPrivateGateway: &v}
+		if nilStruct.GetVirtualPrivateGateway() != nil || emptyStruct.GetVirtualPrivateGateway() != nil {
+			t.Error("GetVirtualPrivateGateway() expected to return nil")
+		}
+		if fullStruct.GetVirtualPrivateGateway() != &v {
+			t.Error("GetVirtualPrivateGateway() expected to return field")
+		}
+		if nilStruct.GetVirtualPrivateGatewayV() != false || emptyStruct.GetVirtualPrivateGatewayV() != false {
+			t.Error("GetVirtualPrivateGatewayV() expected to return zero value")
+		}
+		if fullStruct.GetVirtualPrivateGatewayV() != v {
+			t.Error("GetVirtualPrivateGatewayV() expected to return field value")
+		}
+		if nilStruct.GetVirtualPrivateGatewayOr(v) != v || emptyStruct.GetVirtualPrivateGatewayOr(v) != v {
+			t.Error("GetVirtualPrivateGatewayOr() expected to return default value")
+		}
+		if fullStruct.GetVirtualPrivateGatewayOr(false) != v {
+			t.Error("GetVirtualPrivateGatewayOr() expected to return field value")
+		}
+	})
+	t.Run("RsaPublicKeyPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RsaPublicKeyPath: &v}
+		if nilStruct.GetRsaPublicKeyPath() != nil || emptyStruct.GetRsaPublicKeyPath() != nil {
+			t.Error("GetRsaPublicKeyPath() expected to return nil")
+		}
+		if fullStruct.GetRsaPublicKeyPath() != &v {
+			t.Error("GetRsaPublicKeyPath() expected to return field")
+		}
+		if nilStruct.GetRsaPublicKeyPathV() != "" || emptyStruct.GetRsaPublicKeyPathV() != "" {
+			t.Error("GetRsaPublicKeyPathV() expected to return zero value")
+		}
+		if fullStruct.GetRsaPublicKeyPathV() != v {
+			t.Error("GetRsaPublicKeyPathV() expected to return field value")
+		}
+		if nilStruct.GetRsaPublicKeyPathOr(v) != v || emptyStruct.GetRsaPublicKeyPathOr(v) != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return default value")
+		}
+		if fullStruct.GetRsaPublicKeyPathOr("") != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return field value")
+		}
+	})
+	t.Run("VpcAddressSpace", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{VpcAddressSpace: &v}
+		if nilStruct.GetVpcAddressSpace() != nil || emptyStruct.GetVpcAddressSpace() != nil {
+			t.Error("GetVpcAddressSpace() expected to return nil")
+		}
+		if fullStruct.GetVpcAddressSpace() != &v {
+			t.Error("GetVpcAddressSpace() expected to return field")
+		}
+		if nilStruct.GetVpcAddressSpaceV() != "" || emptyStruct.GetVpcAddressSpaceV() != "" {
+			t.Error("GetVpcAddressSpaceV() expected to return zero value")
+		}
+		if fullStruct.GetVpcAddressSpaceV() != v {
+			t.Error("GetVpcAddressSpaceV() expected to return field value")
+		}
+		if nilStruct.GetVpcAddressSpaceOr(v) != v || emptyStruct.GetVpcAddressSpaceOr(v) != v {
+			t.Error("GetVpcAddressSpaceOr() expected to return default value")
+		}
+		if fullStruct.GetVpcAddressSpaceOr("") != v {
+			t.Error("GetVpcAddressSpaceOr() expected to return field value")
+		}
+	})
+	t.Run("Subnets", func(t *testing.T) {
+		v := Subnets{}
+		fullStruct := &Params{Subnets: &v}
+		if nilStruct.GetSubnets() != nil || emptyStruct.GetSubnets() != nil {
+			t.Error("GetSubnets() expected to return nil")
+		}
+		if fullStruct.GetSubnets() != &v {
+			t.Error("GetSubnets() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetSubnetsV(), Subnets{}) || !reflect.DeepEqual(emptyStruct.GetSubnetsV(), Subnets{}) {
+			t.Error("GetSubnetsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetSubnetsV(), v) {
+			t.Error("GetSubnetsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetSubnetsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetSubnetsOr(v), v) {
+			t.Error("GetSubnetsOr() expected to return default value")
+		}
+	})
+	t.Run("SecurityGroups", func(t *testing.T) {
+		fullStruct := &Params{SecurityGroups: make([]SecurityGroup, 1)}
+		if nilStruct.GetSecurityGroups() != nil {
+			t.Error("GetSecurityGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetSecurityGroups(); got == nil || len(got) != 0 {
+			t.Error("GetSecurityGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetSecurityGroups(); len(got) != 1 || &got[0] != &fullStruct.SecurityGroups[0] {
+			t.Error("GetSecurityGroups() expected to return field")
+		}
+	})
+	t.Run("VmGroups", func(t *testing.T) {
+		fullStruct := &Params{VmGroups: make([]VmGroup, 1)}
+		if nilStruct.GetVmGroups() != nil {
+			t.Error("GetVmGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroups(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroups(); len(got) != 1 || &got[0] != &fullStruct.VmGroups[0] {
+			t.Error("GetVmGroups() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputDataDisk_Accessors(t *testing.T) {
+	var nilStruct *OutputDataDisk
+	emptyStruct := &OutputDataDisk{}
+	t.Run("Size", func(t *testing.T) {
+		v := 1
+		fullStruct := &OutputDataDisk{Size: &v}
+		if nilStruct.GetSize() != nil || emptyStruct.GetSize() != nil {
+			t.Error("GetSize() expected to return nil")
+		}
+		if fullStruct.GetSize() != &v {
+			t.Error("GetSize() expected to return field")
+		}
+		if nilStruct.GetSizeV() != 0 || emptyStruct.GetSizeV() != 0 {
+			t.Error("GetSizeV() expected to return zero value")
+		}
+		if fullStruct.GetSizeV() != v {
+			t.Error("GetSizeV() expected to return field value")
+		}
+		if nilStruct.GetSizeOr(v) != v || emptyStruct.GetSizeOr(v) != v {
+			t.Error("GetSizeOr() expected to return default value")
+		}
+		if fullStruct.GetSizeOr(0) != v {
+			t.Error("GetSizeOr() expected to return field value")
+		}
+	})
+	t.Run("DeviceName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputDataDisk{DeviceName: &v}
+		if nilStruct.GetDeviceName() != nil || emptyStruct.GetDeviceName() != nil {
+			t.Error("GetDeviceName() expected to return nil")
+		}
+		if fullStruct.GetDeviceName() != &v {
+			t.Error("GetDeviceName() expected to return field")
+		}
+		if nilStruct.GetDeviceNameV() != "" || emptyStruct.GetDeviceNameV() != "" {
+			t.Error("GetDeviceNameV() expected to return zero value")
+		}
+		if fullStruct.GetDeviceNameV() != v {
+			t.Error("GetDeviceNameV() expected to return field value")
+		}
+		if nilStruct.GetDeviceNameOr(v) != v || emptyStruct.GetDeviceNameOr(v) != v {
+			t.Error("GetDeviceNameOr() expected to return default value")
+		}
+		if fullStruct.GetDeviceNameOr("") != v {
+			t.Error("GetDeviceNameOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutputVm_Accessors(t *testing.T) {
+	var nilStruct *OutputVm
+	emptyStruct := &OutputVm{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("PublicIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{PublicIp: &v}
+		if nilStruct.GetPublicIp() != nil || emptyStruct.GetPublicIp() != nil {
+			t.Error("GetPublicIp() expected to return nil")
+		}
+		if fullStruct.GetPublicIp() != &v {
+			t.Error("GetPublicIp() expected to return field")
+		}
+		if nilStruct.GetPublicIpV() != "" || emptyStruct.GetPublicIpV() != "" {
+			t.Error("GetPublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetPublicIpV() != v {
+			t.Error("GetPublicIpV() expected to return field value")
+		}
+		if nilStruct.GetPublicIpOr(v) != v || emptyStruct.GetPublicIpOr(v) != v {
+			t.Error("GetPublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetPublicIpOr("") != v {
+			t.Error("GetPublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("PrivateIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{PrivateIp: &v}
+		if nilStruct.GetPrivateIp() != nil || emptyStruct.GetPrivateIp() != nil {
+			t.Error("GetPrivateIp() expected to return nil")
+		}
+		if fullStruct.GetPrivateIp() != &v {
+			t.Error("GetPrivateIp() expected to return field")
+		}
+		if nilStruct.GetPrivateIpV() != "" || emptyStruct.GetPrivateIpV() != "" {
+			t.Error("GetPrivateIpV() expected to return zero value")
+		}
+		if fullStruct.GetPrivateIpV() != v {
+			t.Error("GetPrivateIpV() expected to return field value")
+		}
+		if nilStruct.GetPrivateIpOr(v) != v || emptyStruct.GetPrivateIpOr(v) != v {
+			t.Error("GetPrivateIpOr() expected to return default value")
+		}
+		if fullStruct.GetPrivateIpOr("") != v {
+			t.Error("GetPrivateIpOr() expected to return field value")
+		}
+	})
+	t.Run("DataDisks", func(t *testing.T) {
+		fullStruct := &OutputVm{DataDisks: make([]OutputDataDisk, 1)}
+		if nilStruct.GetDataDisks() != nil {
+			t.Error("GetDataDisks() expected to return nil")
+		}
+		if got := emptyStruct.GetDataDisks(); got == nil || len(got) != 0 {
+			t.Error("GetDataDisks() expected to return empty slice")
+		}
+		if got := fullStruct.GetDataDisks(); len(got) != 1 || &got[0] != &fullStruct.DataDisks[0] {
+			t.Error("GetDataDisks() expected to return field")
+		}
+	})
+}
+
+func TestOutputVmGroup_Accessors(t *testing.T) {
+	var nilStruct *OutputVmGroup
+	emptyStruct := &OutputVmGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVmGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Vms", func(t *testing.T) {
+		fullStruct := &OutputVmGroup{Vms: make([]OutputVm, 1)}
+		if nilStruct.GetVms() != nil {
+			t.Error("GetVms() expected to return nil")
+		}
+		if got := emptyStruct.GetVms(); got == nil || len(got) != 0 {
+			t.Error("GetVms() expected to return empty slice")
+		}
+		if got := fullStruct.GetVms(); len(got) != 1 || &got[0] != &fullStruct.Vms[0] {
+			t.Error("GetVms() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("VpcId", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{VpcId: &v}
+		if nilStruct.GetVpcId() != nil || emptyStruct.GetVpcId() != nil {
+			t.Error("GetVpcId() expected to return nil")
+		}
+		if fullStruct.GetVpcId() != &v {
+			t.Error("GetVpcId() expected to return field")
+		}
+		if nilStruct.GetVpcIdV() != "" || emptyStruct.GetVpcIdV() != "" {
+			t.Error("GetVpcIdV() expected to return zero value")
+		}
+		if fullStruct.GetVpcIdV() != v {
+			t.Error("GetVpcIdV() expected to return field value")
+		}
+		if nilStruct.GetVpcIdOr(v) != v || emptyStruct.GetVpcIdOr(v) != v {
+			t.Error("GetVpcIdOr() expected to return default value")
+		}
+		if fullStruct.GetVpcIdOr("") != v {
+			t.Error("GetVpcIdOr() expected to return field value")
+		}
+	})
+	t.Run("PrivateSubnetIds", func(t *testing.T) {
+		fullStruct := &Output{PrivateSubnetIds: make([]string, 1)}
+		if nilStruct.GetPrivateSubnetIds() != nil {
+			t.Error("GetPrivateSubnetIds() expected to return nil")
+		}
+		if got := emptyStruct.GetPrivateSubnetIds(); got == nil || len(got) != 0 {
+			t.Error("GetPrivateSubnetIds() expected to return empty slice")
+		}
+		if got := fullStruct.GetPrivateSubnetIds(); len(got) != 1 || &got[0] != &fullStruct.PrivateSubnetIds[0] {
+			t.Error("GetPrivateSubnetIds() expected to return field")
+		}
+	})
+	t.Run("PublicSubnetIds", func(t *testing.T) {
+		fullStruct := &Output{PublicSubnetIds: make([]string, 1)}
+		if nilStruct.GetPublicSubnetIds() != nil {
+			t.Error("GetPublicSubnetIds() expected to return nil")
+		}
+		if got := emptyStruct.GetPublicSubnetIds(); got == nil || len(got) != 0 {
+			t.Error("GetPublicSubnetIds() expected to return empty slice")
+		}
+		if got := fullStruct.GetPublicSubnetIds(); len(got) != 1 || &got[0] != &fullStruct.PublicSubnetIds[0] {
+			t.Error("GetPublicSubnetIds() expected to return field")
+		}
+	})
+	t.Run("PrivateRouteTable", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{PrivateRouteTable: &v}
+		if nilStruct.GetPrivateRouteTable() != nil || emptyStruct.GetPrivateRouteTable() != nil {
+			t.Error("GetPrivateRouteTable() expected to return nil")
+		}
+		if fullStruct.GetPrivateRouteTable() != &v {
+			t.Error("GetPrivateRouteTable() expected to return field")
+		}
+		if nilStruct.GetPrivateRouteTableV() != "" || emptyStruct.GetPrivateRouteTableV() != "" {
+			t.Error("GetPrivateRouteTableV() expected to return zero value")
+		}
+		if fullStruct.GetPrivateRouteTableV() != v {
+			t.Error("GetPrivateRouteTableV() expected to return field value")
+		}
+		if nilStruct.GetPrivateRouteTableOr(v) != v || emptyStruct.GetPrivateRouteTableOr(v) != v {
+			t.Error("GetPrivateRouteTableOr() expected to return default value")
+		}
+		if fullStruct.GetPrivateRouteTableOr("") != v {
+			t.Error("GetPrivateRouteTableOr() expected to return field value")
+		}
+	})
+	t.Run("VmGroups", func(t *testing.T) {
+		fullStruct := &Output{VmGroups: make([]OutputVmGroup, 1)}
+		if nilStruct.GetVmGroups() != nil {
+			t.Error("GetVmGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroups(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroups(); len(got) != 1 || &got[0] != &fullStruct.VmGroups[0] {
+			t.Error("GetVmGroups() expected to return field")
+		}
+	})
+}
@@ -1,10 +1,16 @@
 package v0
 
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
 import (
 	"encoding/json"
 	"errors"
 	"fmt"
 
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
 	"github.com/epiphany-platform/e-structures/utils/to"
 	"github.com/epiphany-platform/e-structures/utils/validators"
 	"github.com/go-playground/validator/v10"
@@ -180,7 +186,40 @@ func (c *Config) Marshal() ([]byte, error) {
 	return json.MarshalIndent(c, "", "\t")
 }
 
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
 func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
 	var input map[string]interface{}
 	if err = json.Unmarshal(b, &input); err != nil {
 		return
@@ -199,7 +238,6 @@ func (c *Config) Unmarshal(b []byte) (err error) {
 		return
 	}
 	c.Unused = md.Unused
-	err = c.isValid()
 	return
 }
 
@@ -225,28 +263,33 @@ func (c *Config) isValid() error {
 }
 
 type OutputDataDisk struct {
-	Size       *int    `json:"size"`
-	DeviceName *string `json:"device_name"`
+	Size       *int    `json:"size" validate:"required,min=1"`
+	DeviceName *string `json:"device_name" validate:"required,min=1"`
 }
 
 type OutputVm struct {
-	Name      *string          `json:"name"`
-	PublicIp  *string          `json:"public_ip"`
-	PrivateIp *string          `json:"private_ip"`
-	DataDisks []OutputDataDisk `json:"data_disks"`
+	Name      *string          `json:"name" validate:"required,min=1"`
+	PublicIp  *string          `json:"public_ip" validate:"omitempty,eq=|ip"`
+	PrivateIp *string          `json:"private_ip" validate:"required,ip"`
+	DataDisks []OutputDataDisk `json:"data_disks" validate:"omitempty,dive"`
 }
 
 type OutputVmGroup struct {
-	Name *string    `json:"name"`
-	Vms  []OutputVm `json:"vms"`
+	Name *string    `json:"name" validate:"required,min=1"`
+	Vms  []OutputVm `json:"vms" validate:"omitempty,dive"`
 }
 
 type Output struct {
-	VpcId             *string         `json:"vpc_id"`
-	PrivateSubnetIds  []string        `json:"private_subnet_ids"`
-	PublicSubnetIds   []string        `json:"public_subnet_ids"`
-	PrivateRouteTable *string         `json:"private_route_table"`
-	VmGroups          []OutputVmGroup `json:"vm_groups"`
+	VpcId             *string         `json:"vpc_id" validate:"required,min=1"`
+	PrivateSubnetIds  []string        `json:"private_subnet_ids" validate:"omitempty,dive,required"`
+	PublicSubnetIds   []string        `json:"public_subnet_ids" validate:"omitempty,dive,required"`
+	PrivateRouteTable *string         `json:"private_route_table" validate:"omitempty,min=1"`
+	VmGroups          []OutputVmGroup `json:"vm_groups" validate:"omitempty,dive"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
 }
 
 func AwsBIParamsValidation(sl validator.StructLevel) {
@@ -0,0 +1,706 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of DataDisk or nil if DataDisk is nil.
+func (d *DataDisk) DeepCopy() *DataDisk {
+	if d == nil {
+		return nil
+	}
+	out := new(DataDisk)
+	if d.DeviceName != nil {
+		x := *d.DeviceName
+		out.DeviceName = &x
+	}
+	if d.GbSize != nil {
+		x := *d.GbSize
+		out.GbSize = &x
+	}
+	if d.Type != nil {
+		x := *d.Type
+		out.Type = &x
+	}
+	return out
+}
+
+// Equal reports whether DataDisk and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (d *DataDisk) Equal(other *DataDisk) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if (d.DeviceName == nil) != (other.DeviceName == nil) || d.DeviceName != nil && *d.DeviceName != *other.DeviceName {
+		return false
+	}
+	if (d.GbSize == nil) != (other.GbSize == nil) || d.GbSize != nil && *d.GbSize != *other.GbSize {
+		return false
+	}
+	if (d.Type == nil) != (other.Type == nil) || d.Type != nil && *d.Type != *other.Type {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmImage or nil if VmImage is nil.
+func (v *VmImage) DeepCopy() *VmImage {
+	if v == nil {
+		return nil
+	}
+	out := new(VmImage)
+	if v.AMI != nil {
+		x := *v.AMI
+		out.AMI = &x
+	}
+	if v.Owner != nil {
+		x := *v.Owner
+		out.Owner = &x
+	}
+	return out
+}
+
+// Equal reports whether VmImage and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmImage) Equal(other *VmImage) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.AMI == nil) != (other.AMI == nil) || v.AMI != nil && *v.AMI != *other.AMI {
+		return false
+	}
+	if (v.Owner == nil) != (other.Owner == nil) || v.Owner != nil && *v.Owner != *other.Owner {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) DeepCopy() *VmGroup {
+	if v == nil {
+		return nil
+	}
+	out := new(VmGroup)
+	if v.Name != nil {
+		x := *v.Name
+		out.Name = &x
+	}
+	if v.VmCount != nil {
+		x := *v.VmCount
+		out.VmCount = &x
+	}
+	if v.VmSize != nil {
+		x := *v.VmSize
+		out.VmSize = &x
+	}
+	if v.UsePublicIp != nil {
+		x := *v.UsePublicIp
+		out.UsePublicIp = &x
+	}
+	if v.SubnetNames != nil {
+		out.SubnetNames = make([]string, len(v.SubnetNames))
+		copy(out.SubnetNames, v.SubnetNames)
+	}
+	if v.SecurityGroupNames != nil {
+		out.SecurityGroupNames = make([]string, len(v.SecurityGroupNames))
+		copy(out.SecurityGroupNames, v.SecurityGroupNames)
+	}
+	out.VmImage = v.VmImage.DeepCopy()
+	if v.RootVolumeGbSize != nil {
+		x := *v.RootVolumeGbSize
+		out.RootVolumeGbSize = &x
+	}
+	if v.DataDisks != nil {
+		out.DataDisks = make([]DataDisk, len(v.DataDisks))
+		for i := range v.DataDisks {
+			out.DataDisks[i] = *v.DataDisks[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether VmGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmGroup) Equal(other *VmGroup) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Name == nil) != (other.Name == nil) || v.Name != nil && *v.Name != *other.Name {
+		return false
+	}
+	if (v.VmCount == nil) != (other.VmCount == nil) || v.VmCount != nil && *v.VmCount != *other.VmCount {
+		return false
+	}
+	if (v.VmSize == nil) != (other.VmSize == nil) || v.VmSize != nil && *v.VmSize != *other.VmSize {
+		return false
+	}
+	if (v.UsePublicIp == nil) != (other.UsePublicIp == nil) || v.UsePublicIp != nil && *v.UsePublicIp != *other.UsePublicIp {
+		return false
+	}
+	if (v.SubnetNames == nil) != (other.SubnetNames == nil) || len(v.SubnetNames) != len(other.SubnetNames) {
+		return false
+	}
+	for i := range v.SubnetNames {
+		if v.SubnetNames[i] != other.SubnetNames[i] {
+			return false
+		}
+	}
+	if (v.SecurityGroupNames == nil) != (other.SecurityGroupNames == nil) || len(v.SecurityGroupNames) != len(other.SecurityGroupNames) {
+		return false
+	}
+	for i := range v.SecurityGroupNames {
+		if v.SecurityGroupNames[i] != other.SecurityGroupNames[i] {
+			return false
+		}
+	}
+	if !v.VmImage.Equal(other.VmImage) {
+		return false
+	}
+	if (v.RootVolumeGbSize == nil) != (other.RootVolumeGbSize == nil) || v.RootVolumeGbSize != nil && *v.RootVolumeGbSize != *other.RootVolumeGbSize {
+		return false
+	}
+	if (v.DataDisks == nil) != (other.DataDisks == nil) || len(v.DataDisks) != len(other.DataDisks) {
+		return false
+	}
+	for i := range v.DataDisks {
+		if !v.DataDisks[i].Equal(&other.DataDisks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of SecurityRule or nil if SecurityRule is nil.
+func (s *SecurityRule) DeepCopy() *SecurityRule {
+	if s == nil {
+		return nil
+	}
+	out := new(SecurityRule)
+	if s.Protocol != nil {
+		x := *s.Protocol
+		out.Protocol = &x
+	}
+	if s.FromPort != nil {
+		x := *s.FromPort
+		out.FromPort = &x
+	}
+	if s.ToPort != nil {
+		x := *s.ToPort
+		out.ToPort = &x
+	}
+	if s.CidrBlocks != nil {
+		out.CidrBlocks = make([]string, len(s.CidrBlocks))
+		copy(out.CidrBlocks, s.CidrBlocks)
+	}
+	return out
+}
+
+// Equal reports whether SecurityRule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *SecurityRule) Equal(other *SecurityRule) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Protocol == nil) != (other.Protocol == nil) || s.Protocol != nil && *s.Protocol != *other.Protocol {
+		return false
+	}
+	if (s.FromPort == nil) != (other.FromPort == nil) || s.FromPort != nil && *s.FromPort != *other.FromPort {
+		return false
+	}
+	if (s.ToPort == nil) != (other.ToPort == nil) || s.ToPort != nil && *s.ToPort != *other.ToPort {
+		return false
+	}
+	if (s.CidrBlocks == nil) != (other.CidrBlocks == nil) || len(s.CidrBlocks) != len(other.CidrBlocks) {
+		return false
+	}
+	for i := range s.CidrBlocks {
+		if s.CidrBlocks[i] != other.CidrBlocks[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Rules or nil if Rules is nil.
+func (r *Rules) DeepCopy() *Rules {
+	if r == nil {
+		return nil
+	}
+	out := new(Rules)
+	if r.Ingress != nil {
+		out.Ingress = make([]SecurityRule, len(r.Ingress))
+		for i := range r.Ingress {
+			out.Ingress[i] = *r.Ingress[i].DeepCopy()
+		}
+	}
+	if r.Egress != nil {
+		out.Egress = make([]SecurityRule, len(r.Egress))
+		for i := range r.Egress {
+			out.Egress[i] = *r.Egress[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Rules and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (r *Rules) Equal(other *Rules) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	if (r.Ingress == nil) != (other.Ingress == nil) || len(r.Ingress) != len(other.Ingress) {
+		return false
+	}
+	for i := range r.Ingress {
+		if !r.Ingress[i].Equal(&other.Ingress[i]) {
+			return false
+		}
+	}
+	if (r.Egress == nil) != (other.Egress == nil) || len(r.Egress) != len(other.Egress) {
+		return false
+	}
+	for i := range r.Egress {
+		if !r.Egress[i].Equal(&other.Egress[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of SecurityGroup or nil if SecurityGroup is nil.
+func (s *SecurityGroup) DeepCopy() *SecurityGroup {
+	if s == nil {
+		return nil
+	}
+	out := new(SecurityGroup)
+	if s.Name != nil {
+		x := *s.Name
+		out.Name = &x
+	}
+	out.Rules = s.Rules.DeepCopy()
+	return out
+}
+
+// Equal reports whether SecurityGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *SecurityGroup) Equal(other *SecurityGroup) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Name == nil) != (other.Name == nil) || s.Name != nil && *s.Name != *other.Name {
+		return false
+	}
+	if !s.Rules.Equal(other.Rules) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Subnet or nil if Subnet is nil.
+func (s *Subnet) DeepCopy() *Subnet {
+	if s == nil {
+		return nil
+	}
+	out := new(Subnet)
+	if s.Name != nil {
+		x := *s.Name
+		out.Name = &x
+	}
+	if s.AvailabilityZone != nil {
+		x := *s.AvailabilityZone
+		out.AvailabilityZone = &x
+	}
+	if s.AddressPrefixes != nil {
+		x := *s.AddressPrefixes
+		out.AddressPrefixes = &x
+	}
+	return out
+}
+
+// Equal reports whether Subnet and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *Subnet) Equal(other *Subnet) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Name == nil) != (other.Name == nil) || s.Name != nil && *s.Name != *other.Name {
+		return false
+	}
+	if (s.AvailabilityZone == nil) != (other.AvailabilityZone == nil) || s.AvailabilityZone != nil && *s.AvailabilityZone != *other.AvailabilityZone {
+		return false
+	}
+	if (s.AddressPrefixes == nil) != (other.AddressPrefixes == nil) || s.AddressPrefixes != nil && *s.AddressPrefixes != *other.AddressPrefixes {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Subnets or nil if Subnets is nil.
+func (s *Subnets) DeepCopy() *Subnets {
+	if s == nil {
+		return nil
+	}
+	out := new(Subnets)
+	if s.Private != nil {
+		out.Private = make([]Subnet, len(s.Private))
+		for i := range s.Private {
+			out.Private[i] = *s.Private[i].DeepCopy()
+		}
+	}
+	if s.Public != nil {
+		out.Public = make([]Subnet, len(s.Public))
+		for i := range s.Public {
+			out.Public[i] = *s.Public[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Subnets and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *Subnets) Equal(other *Subnets) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Private == nil) != (other.Private == nil) || len(s.Private) != len(other.Private) {
+		return false
+	}
+	for i := range s.Private {
+		if !s.Private[i].Equal(&other.Private[i]) {
+			return false
+		}
+	}
+	if (s.Public == nil) != (other.Public == nil) || len(s.Public) != len(other.Public) {
+		return false
+	}
+	for i := range s.Public {
+		if !s.Public[i].Equal(&other.Public[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Region != nil {
+		x := *p.Region
+		out.Region = &x
+	}
+	if p.NatGatewayCount != nil {
+		x := *p.NatGatewayCount
+		out.NatGatewayCount = &x
+	}
+	if p.VirtualPrivateGateway != nil {
+		x := *p.VirtualPrivateGateway
+		out.VirtualPrivateGateway = &x
+	}
+	if p.RsaPublicKeyPath != nil {
+		x := *p.RsaPublicKeyPath
+		out.RsaPublicKeyPath = &x
+	}
+	if p.VpcAddressSpace != nil {
+		x := *p.VpcAddressSpace
+		out.VpcAddressSpace = &x
+	}
+	out.Subnets = p.Subnets.DeepCopy()
+	if p.SecurityGroups != nil {
+		out.SecurityGroups = make([]SecurityGroup, len(p.SecurityGroups))
+		for i := range p.SecurityGroups {
+			out.SecurityGroups[i] = *p.SecurityGroups[i].DeepCopy()
+		}
+	}
+	if p.VmGroups != nil {
+		out.VmGroups = make([]VmGroup, len(p.VmGroups))
+		for i := range p.VmGroups {
+			out.VmGroups[i] = *p.VmGroups[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Region == nil) != (other.Region == nil) || p.Region != nil && *p.Region != *other.Region {
+		return false
+	}
+	if (p.NatGatewayCount == nil) != (other.NatGatewayCount == nil) || p.NatGatewayCount != nil && *p.NatGatewayCount != *other.NatGatewayCount {
+		return false
+	}
+	if (p.VirtualPrivateGateway == nil) != (other.VirtualPrivateGateway == nil) || p.VirtualPrivateGateway != nil && *p.VirtualPrivateGateway != *other.VirtualPrivateGateway {
+		return false
+	}
+	if (p.RsaPublicKeyPath == nil) != (other.RsaPublicKeyPath == nil) || p.RsaPublicKeyPath != nil && *p.RsaPublicKeyPath != *other.RsaPublicKeyPath {
+		return false
+	}
+	if (p.VpcAddressSpace == nil) != (other.VpcAddressSpace == nil) || p.VpcAddressSpace != nil && *p.VpcAddressSpace != *other.VpcAddressSpace {
+		return false
+	}
+	if !p.Subnets.Equal(other.Subnets) {
+		return false
+	}
+	if (p.SecurityGroups == nil) != (other.SecurityGroups == nil) || len(p.SecurityGroups) != len(other.SecurityGroups) {
+		return false
+	}
+	for i := range p.SecurityGroups {
+		if !p.SecurityGroups[i].Equal(&other.SecurityGroups[i]) {
+			return false
+		}
+	}
+	if (p.VmGroups == nil) != (other.VmGroups == nil) || len(p.VmGroups) != len(other.VmGroups) {
+		return false
+	}
+	for i := range p.VmGroups {
+		if !p.VmGroups[i].Equal(&other.VmGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputDataDisk or nil if OutputDataDisk is nil.
+func (o *OutputDataDisk) DeepCopy() *OutputDataDisk {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputDataDisk)
+	if o.Size != nil {
+		x := *o.Size
+		out.Size = &x
+	}
+	if o.DeviceName != nil {
+		x := *o.DeviceName
+		out.DeviceName = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputDataDisk and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputDataDisk) Equal(other *OutputDataDisk) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Size == nil) != (other.Size == nil) || o.Size != nil && *o.Size != *other.Size {
+		return false
+	}
+	if (o.DeviceName == nil) != (other.DeviceName == nil) || o.DeviceName != nil && *o.DeviceName != *other.DeviceName {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) DeepCopy() *OutputVm {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputVm)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.PublicIp != nil {
+		x := *o.PublicIp
+		out.PublicIp = &x
+	}
+	if o.PrivateIp != nil {
+		x := *o.PrivateIp
+		out.PrivateIp = &x
+	}
+	if o.DataDisks != nil {
+		out.DataDisks = make([]OutputDataDisk, len(o.DataDisks))
+		for i := range o.DataDisks {
+			out.DataDisks[i] = *o.DataDisks[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether OutputVm and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputVm) Equal(other *OutputVm) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.PublicIp == nil) != (other.PublicIp == nil) || o.PublicIp != nil && *o.PublicIp != *other.PublicIp {
+		return false
+	}
+	if (o.PrivateIp == nil) != (other.PrivateIp == nil) || o.PrivateIp != nil && *o.PrivateIp != *other.PrivateIp {
+		return false
+	}
+	if (o.DataDisks == nil) != (other.DataDisks == nil) || len(o.DataDisks) != len(other.DataDisks) {
+		return false
+	}
+	for i := range o.DataDisks {
+		if !o.DataDisks[i].Equal(&other.DataDisks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputVmGroup or nil if OutputVmGroup is nil.
+func (o *OutputVmGroup) DeepCopy() *OutputVmGroup {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputVmGroup)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Vms != nil {
+		out.Vms = make([]OutputVm, len(o.Vms))
+		for i := range o.Vms {
+			out.Vms[i] = *o.Vms[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether OutputVmGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputVmGroup) Equal(other *OutputVmGroup) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Vms == nil) != (other.Vms == nil) || len(o.Vms) != len(other.Vms) {
+		return false
+	}
+	for i := range o.Vms {
+		if !o.Vms[i].Equal(&other.Vms[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.VpcId != nil {
+		x := *o.VpcId
+		out.VpcId = &x
+	}
+	if o.PrivateSubnetIds != nil {
+		out.PrivateSubnetIds = make([]string, len(o.PrivateSubnetIds))
+		copy(out.PrivateSubnetIds, o.PrivateSubnetIds)
+	}
+	if o.PublicSubnetIds != nil {
+		out.PublicSubnetIds = make([]string, len(o.PublicSubnetIds))
+		copy(out.PublicSubnetIds, o.PublicSubnetIds)
+	}
+	if o.PrivateRouteTable != nil {
+		x := *o.PrivateRouteTable
+		out.PrivateRouteTable = &x
+	}
+	if o.VmGroups != nil {
+		out.VmGroups = make([]OutputVmGroup, len(o.VmGroups))
+		for i := range o.VmGroups {
+			out.VmGroups[i] = *o.VmGroups[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.VpcId == nil) != (other.VpcId == nil) || o.VpcId != nil && *o.VpcId != *other.VpcId {
+		return false
+	}
+	if (o.PrivateSubnetIds == nil) != (other.PrivateSubnetIds == nil) || len(o.PrivateSubnetIds) != len(other.PrivateSubnetIds) {
+		return false
+	}
+	for i := range o.PrivateSubnetIds {
+		if o.PrivateSubnetIds[i] != other.PrivateSubnetIds[i] {
+			return false
+		}
+	}
+	if (o.PublicSubnetIds == nil) != (other.PublicSubnetIds == nil) || len(o.PublicSubnetIds) != len(other.PublicSubnetIds) {
+		return false
+	}
+	for i := range o.PublicSubnetIds {
+		if o.PublicSubnetIds[i] != other.PublicSubnetIds[i] {
+			return false
+		}
+	}
+	if (o.PrivateRouteTable == nil) != (other.PrivateRouteTable == nil) || o.PrivateRouteTable != nil && *o.PrivateRouteTable != *other.PrivateRouteTable {
+		return false
+	}
+	if (o.VmGroups == nil) != (other.VmGroups == nil) || len(o.VmGroups) != len(other.VmGroups) {
+		return false
+	}
+	for i := range o.VmGroups {
+		if !o.VmGroups[i].Equal(&other.VmGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package v0
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/epiphany-platform/e-structures/utils/to"
+)
+
+const (
+	// ProfileDefault is profile returned by NewConfig.
+	ProfileDefault = "default"
+	// ProfileMinimal is single small VM in public subnet without NAT gateway, suitable for
+	// development.
+	ProfileMinimal = "minimal"
+	// ProfileHA is three VMs spread over private subnets in three availability zones, each with
+	// its own public subnet and NAT gateway.
+	ProfileHA = "ha"
+	// ProfileProduction is ProfileHA with bigger VMs and disks.
+	ProfileProduction = "production"
+)
+
+var zones = []string{"a", "b", "c"}
+
+var profiles = map[string]func() *Config{
+	ProfileDefault: NewConfig,
+	ProfileMinimal: func() *Config {
+		c := NewConfig()
+		c.Params.NatGatewayCount = to.IntPtr(0)
+		c.Params.Subnets.Private = nil
+		g := &c.Params.VmGroups[0]
+		g.VmSize = to.StrPtr("t3.small")
+		g.UsePublicIp = to.BooPtr(true)
+		g.SubnetNames = []string{"first_public_subnet"}
+		g.DataDisks = nil
+		return c
+	},
+	ProfileHA: func() *Config {
+		return multiZoneConfig("t3.medium", 30, 16)
+	},
+	ProfileProduction: func() *Config {
+		return multiZoneConfig("t3.xlarge", 50, 128)
+	},
+}
+
+// multiZoneConfig returns config with private and public subnet in each of zones and single VM
+// group spread over private subnets.
+func multiZoneConfig(vmSize string, rootVolumeGbSize int, dataDiskGbSize int) *Config {
+	c := NewConfig()
+	region := c.Params.GetRegionV()
+	c.Params.NatGatewayCount = to.IntPtr(len(zones))
+	c.Params.Subnets = &Subnets{}
+	var private []string
+	for i, zone := range zones {
+		c.Params.Subnets.Private = append(c.Params.Subnets.Private, Subnet{
+			Name:             to.StrPtr("private_subnet_" + zone),
+			AvailabilityZone: to.StrPtr(region + zone),
+			AddressPrefixes:  to.StrPtr(fmt.Sprintf("10.1.%d.0/24", i+1)),
+		})
+		c.Params.Subnets.Public = append(c.Params.Subnets.Public, Subnet{
+			Name:             to.StrPtr("public_subnet_" + zone),
+			AvailabilityZone: to.StrPtr(region + zone),
+			AddressPrefixes:  to.StrPtr(fmt.Sprintf("10.1.%d.0/24", i+len(zones)+1)),
+		})
+		private = append(private, "private_subnet_"+zone)
+	}
+	g := &c.Params.VmGroups[0]
+	g.VmCount = to.IntPtr(len(zones))
+	g.VmSize = to.StrPtr(vmSize)
+	g.SubnetNames = private
+	g.RootVolumeGbSize = to.IntPtr(rootVolumeGbSize)
+	g.DataDisks = []DataDisk{
+		{
+			DeviceName: to.StrPtr("/dev/sdf"),
+			GbSize:     to.IntPtr(dataDiskGbSize),
+			Type:       to.StrPtr("gp3"),
+		},
+	}
+	return c
+}
+
+// Profiles returns sorted names of profiles accepted by NewConfigWithProfile.
+func Profiles() []string {
+	var result []string
+	for name := range profiles {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// NewConfigWithProfile returns new Config with defaults of named profile. Empty name means
+// ProfileDefault.
+func NewConfigWithProfile(name string) (*Config, error) {
+	if name == "" {
+		name = ProfileDefault
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown %s profile '%s', known profiles are: %s", kind, name, strings.Join(Profiles(), ", "))
+	}
+	return profile(), nil
+}
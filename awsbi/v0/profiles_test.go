@@ -0,0 +1,120 @@
+package v0
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewConfigWithProfile(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(t *testing.T, c *Config)
+	}{
+		{
+			name: "",
+			check: func(t *testing.T, c *Config) {
+				if diff := cmp.Diff(NewConfig(), c); diff != "" {
+					t.Errorf("NewConfigWithProfile() mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: ProfileDefault,
+			check: func(t *testing.T, c *Config) {
+				if diff := cmp.Diff(NewConfig(), c); diff != "" {
+					t.Errorf("NewConfigWithProfile() mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: ProfileMinimal,
+			check: func(t *testing.T, c *Config) {
+				if got := c.Params.GetNatGatewayCountV(); got != 0 {
+					t.Errorf("nat_gateway_count = %d, want 0", got)
+				}
+			},
+		},
+		{
+			name: ProfileHA,
+			check: func(t *testing.T, c *Config) {
+				checkZones(t, c)
+			},
+		},
+		{
+			name: ProfileProduction,
+			check: func(t *testing.T, c *Config) {
+				checkZones(t, c)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewConfigWithProfile(tt.name)
+			if err != nil {
+				t.Fatalf("NewConfigWithProfile() unexpected error occurred: %v", err)
+			}
+			b, err := c.Marshal()
+			if err != nil {
+				t.Fatalf("NewConfigWithProfile() returned invalid config: %v", err)
+			}
+			loaded := &Config{}
+			if err = loaded.UnmarshalStrict(b); err != nil {
+				t.Fatalf("NewConfigWithProfile() returned config that cannot be loaded: %v", err)
+			}
+			tt.check(t, c)
+			checkSubnetNames(t, c)
+		})
+	}
+
+	for _, name := range Profiles() {
+		if _, err := NewConfigWithProfile(name); err != nil {
+			t.Errorf("NewConfigWithProfile() of listed profile %s unexpected error occurred: %v", name, err)
+		}
+	}
+
+	_, err := NewConfigWithProfile("unknown")
+	want := "unknown awsbi profile 'unknown', known profiles are: default, ha, minimal, production"
+	if err == nil || err.Error() != want {
+		t.Errorf("NewConfigWithProfile() error = %v, want %s", err, want)
+	}
+}
+
+// checkZones checks that VMs are spread over private subnets in at least three availability zones.
+func checkZones(t *testing.T, c *Config) {
+	t.Helper()
+	zones := map[string]string{}
+	for _, s := range c.Params.Subnets.Private {
+		zones[s.GetNameV()] = s.GetAvailabilityZoneV()
+	}
+	for _, g := range c.Params.VmGroups {
+		used := map[string]bool{}
+		for _, name := range g.SubnetNames {
+			if zone, ok := zones[name]; ok {
+				used[zone] = true
+			}
+		}
+		if len(used) < 3 || g.GetVmCountV() < 3 {
+			t.Errorf("vm group %s uses %d availability zones with %d VMs, want at least 3", g.GetNameV(), len(used), g.GetVmCountV())
+		}
+	}
+	if got := c.Params.GetNatGatewayCountV(); got < 3 {
+		t.Errorf("nat_gateway_count = %d, want at least 3", got)
+	}
+}
+
+// checkSubnetNames checks that VM groups use only defined subnets.
+func checkSubnetNames(t *testing.T, c *Config) {
+	t.Helper()
+	names := map[string]bool{}
+	for _, s := range append(append([]Subnet{}, c.Params.Subnets.Private...), c.Params.Subnets.Public...) {
+		names[s.GetNameV()] = true
+	}
+	for _, g := range c.Params.VmGroups {
+		for _, name := range g.SubnetNames {
+			if !names[name] {
+				t.Errorf("vm group %s uses undefined subnet %s", g.GetNameV(), name)
+			}
+		}
+	}
+}
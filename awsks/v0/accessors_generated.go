@@ -0,0 +1,726 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetName returns Name field of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) GetName() *string {
+	if w == nil {
+		return nil
+	}
+	return w.Name
+}
+
+// GetNameV returns value of Name field of WorkerGroup or zero value if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetNameV() string {
+	if w == nil || w.Name == nil {
+		return ""
+	}
+	return *w.Name
+}
+
+// GetNameOr returns value of Name field of WorkerGroup or def if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetNameOr(def string) string {
+	if w == nil || w.Name == nil {
+		return def
+	}
+	return *w.Name
+}
+
+// GetInstanceType returns InstanceType field of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) GetInstanceType() *string {
+	if w == nil {
+		return nil
+	}
+	return w.InstanceType
+}
+
+// GetInstanceTypeV returns value of InstanceType field of WorkerGroup or zero value if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetInstanceTypeV() string {
+	if w == nil || w.InstanceType == nil {
+		return ""
+	}
+	return *w.InstanceType
+}
+
+// GetInstanceTypeOr returns value of InstanceType field of WorkerGroup or def if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetInstanceTypeOr(def string) string {
+	if w == nil || w.InstanceType == nil {
+		return def
+	}
+	return *w.InstanceType
+}
+
+// GetDesiredSize returns DesiredSize field of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) GetDesiredSize() *int {
+	if w == nil {
+		return nil
+	}
+	return w.DesiredSize
+}
+
+// GetDesiredSizeV returns value of DesiredSize field of WorkerGroup or zero value if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetDesiredSizeV() int {
+	if w == nil || w.DesiredSize == nil {
+		return 0
+	}
+	return *w.DesiredSize
+}
+
+// GetDesiredSizeOr returns value of DesiredSize field of WorkerGroup or def if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetDesiredSizeOr(def int) int {
+	if w == nil || w.DesiredSize == nil {
+		return def
+	}
+	return *w.DesiredSize
+}
+
+// GetMinSize returns MinSize field of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) GetMinSize() *int {
+	if w == nil {
+		return nil
+	}
+	return w.MinSize
+}
+
+// GetMinSizeV returns value of MinSize field of WorkerGroup or zero value if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetMinSizeV() int {
+	if w == nil || w.MinSize == nil {
+		return 0
+	}
+	return *w.MinSize
+}
+
+// GetMinSizeOr returns value of MinSize field of WorkerGroup or def if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetMinSizeOr(def int) int {
+	if w == nil || w.MinSize == nil {
+		return def
+	}
+	return *w.MinSize
+}
+
+// GetMaxSize returns MaxSize field of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) GetMaxSize() *int {
+	if w == nil {
+		return nil
+	}
+	return w.MaxSize
+}
+
+// GetMaxSizeV returns value of MaxSize field of WorkerGroup or zero value if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetMaxSizeV() int {
+	if w == nil || w.MaxSize == nil {
+		return 0
+	}
+	return *w.MaxSize
+}
+
+// GetMaxSizeOr returns value of MaxSize field of WorkerGroup or def if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetMaxSizeOr(def int) int {
+	if w == nil || w.MaxSize == nil {
+		return def
+	}
+	return *w.MaxSize
+}
+
+// GetRootVolumeGbSize returns RootVolumeGbSize field of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) GetRootVolumeGbSize() *int {
+	if w == nil {
+		return nil
+	}
+	return w.RootVolumeGbSize
+}
+
+// GetRootVolumeGbSizeV returns value of RootVolumeGbSize field of WorkerGroup or zero value if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetRootVolumeGbSizeV() int {
+	if w == nil || w.RootVolumeGbSize == nil {
+		return 0
+	}
+	return *w.RootVolumeGbSize
+}
+
+// GetRootVolumeGbSizeOr returns value of RootVolumeGbSize field of WorkerGroup or def if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetRootVolumeGbSizeOr(def int) int {
+	if w == nil || w.RootVolumeGbSize == nil {
+		return def
+	}
+	return *w.RootVolumeGbSize
+}
+
+// GetRootVolumeType returns RootVolumeType field of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) GetRootVolumeType() *string {
+	if w == nil {
+		return nil
+	}
+	return w.RootVolumeType
+}
+
+// GetRootVolumeTypeV returns value of RootVolumeType field of WorkerGroup or zero value if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetRootVolumeTypeV() string {
+	if w == nil || w.RootVolumeType == nil {
+		return ""
+	}
+	return *w.RootVolumeType
+}
+
+// GetRootVolumeTypeOr returns value of RootVolumeType field of WorkerGroup or def if either WorkerGroup or field is nil.
+func (w *WorkerGroup) GetRootVolumeTypeOr(def string) string {
+	if w == nil || w.RootVolumeType == nil {
+		return def
+	}
+	return *w.RootVolumeType
+}
+
+// GetRoleArn returns RoleArn field of RoleMapping or nil if RoleMapping is nil.
+func (r *RoleMapping) GetRoleArn() *string {
+	if r == nil {
+		return nil
+	}
+	return r.RoleArn
+}
+
+// GetRoleArnV returns value of RoleArn field of RoleMapping or zero value if either RoleMapping or field is nil.
+func (r *RoleMapping) GetRoleArnV() string {
+	if r == nil || r.RoleArn == nil {
+		return ""
+	}
+	return *r.RoleArn
+}
+
+// GetRoleArnOr returns value of RoleArn field of RoleMapping or def if either RoleMapping or field is nil.
+func (r *RoleMapping) GetRoleArnOr(def string) string {
+	if r == nil || r.RoleArn == nil {
+		return def
+	}
+	return *r.RoleArn
+}
+
+// GetUsername returns Username field of RoleMapping or nil if RoleMapping is nil.
+func (r *RoleMapping) GetUsername() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Username
+}
+
+// GetUsernameV returns value of Username field of RoleMapping or zero value if either RoleMapping or field is nil.
+func (r *RoleMapping) GetUsernameV() string {
+	if r == nil || r.Username == nil {
+		return ""
+	}
+	return *r.Username
+}
+
+// GetUsernameOr returns value of Username field of RoleMapping or def if either RoleMapping or field is nil.
+func (r *RoleMapping) GetUsernameOr(def string) string {
+	if r == nil || r.Username == nil {
+		return def
+	}
+	return *r.Username
+}
+
+// GetGroups returns Groups field of RoleMapping, nil if RoleMapping is nil or empty slice if field is nil.
+func (r *RoleMapping) GetGroups() []string {
+	if r == nil {
+		return nil
+	}
+	if len(r.Groups) == 0 {
+		return []string{}
+	}
+	return r.Groups
+}
+
+// GetUserArn returns UserArn field of UserMapping or nil if UserMapping is nil.
+func (u *UserMapping) GetUserArn() *string {
+	if u == nil {
+		return nil
+	}
+	return u.UserArn
+}
+
+// GetUserArnV returns value of UserArn field of UserMapping or zero value if either UserMapping or field is nil.
+func (u *UserMapping) GetUserArnV() string {
+	if u == nil || u.UserArn == nil {
+		return ""
+	}
+	return *u.UserArn
+}
+
+// GetUserArnOr returns value of UserArn field of UserMapping or def if either UserMapping or field is nil.
+func (u *UserMapping) GetUserArnOr(def string) string {
+	if u == nil || u.UserArn == nil {
+		return def
+	}
+	return *u.UserArn
+}
+
+// GetUsername returns Username field of UserMapping or nil if UserMapping is nil.
+func (u *UserMapping) GetUsername() *string {
+	if u == nil {
+		return nil
+	}
+	return u.Username
+}
+
+// GetUsernameV returns value of Username field of UserMapping or zero value if either UserMapping or field is nil.
+func (u *UserMapping) GetUsernameV() string {
+	if u == nil || u.Username == nil {
+		return ""
+	}
+	return *u.Username
+}
+
+// GetUsernameOr returns value of Username field of UserMapping or def if either UserMapping or field is nil.
+func (u *UserMapping) GetUsernameOr(def string) string {
+	if u == nil || u.Username == nil {
+		return def
+	}
+	return *u.Username
+}
+
+// GetGroups returns Groups field of UserMapping, nil if UserMapping is nil or empty slice if field is nil.
+func (u *UserMapping) GetGroups() []string {
+	if u == nil {
+		return nil
+	}
+	if len(u.Groups) == 0 {
+		return []string{}
+	}
+	return u.Groups
+}
+
+// GetClusterRoleArn returns ClusterRoleArn field of Auth or nil if Auth is nil.
+func (a *Auth) GetClusterRoleArn() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ClusterRoleArn
+}
+
+// GetClusterRoleArnV returns value of ClusterRoleArn field of Auth or zero value if either Auth or field is nil.
+func (a *Auth) GetClusterRoleArnV() string {
+	if a == nil || a.ClusterRoleArn == nil {
+		return ""
+	}
+	return *a.ClusterRoleArn
+}
+
+// GetClusterRoleArnOr returns value of ClusterRoleArn field of Auth or def if either Auth or field is nil.
+func (a *Auth) GetClusterRoleArnOr(def string) string {
+	if a == nil || a.ClusterRoleArn == nil {
+		return def
+	}
+	return *a.ClusterRoleArn
+}
+
+// GetWorkersRoleArn returns WorkersRoleArn field of Auth or nil if Auth is nil.
+func (a *Auth) GetWorkersRoleArn() *string {
+	if a == nil {
+		return nil
+	}
+	return a.WorkersRoleArn
+}
+
+// GetWorkersRoleArnV returns value of WorkersRoleArn field of Auth or zero value if either Auth or field is nil.
+func (a *Auth) GetWorkersRoleArnV() string {
+	if a == nil || a.WorkersRoleArn == nil {
+		return ""
+	}
+	return *a.WorkersRoleArn
+}
+
+// GetWorkersRoleArnOr returns value of WorkersRoleArn field of Auth or def if either Auth or field is nil.
+func (a *Auth) GetWorkersRoleArnOr(def string) string {
+	if a == nil || a.WorkersRoleArn == nil {
+		return def
+	}
+	return *a.WorkersRoleArn
+}
+
+// GetMapRoles returns MapRoles field of Auth, nil if Auth is nil or empty slice if field is nil.
+func (a *Auth) GetMapRoles() []RoleMapping {
+	if a == nil {
+		return nil
+	}
+	if len(a.MapRoles) == 0 {
+		return []RoleMapping{}
+	}
+	return a.MapRoles
+}
+
+// GetMapUsers returns MapUsers field of Auth, nil if Auth is nil or empty slice if field is nil.
+func (a *Auth) GetMapUsers() []UserMapping {
+	if a == nil {
+		return nil
+	}
+	if len(a.MapUsers) == 0 {
+		return []UserMapping{}
+	}
+	return a.MapUsers
+}
+
+// GetMapAccounts returns MapAccounts field of Auth, nil if Auth is nil or empty slice if field is nil.
+func (a *Auth) GetMapAccounts() []string {
+	if a == nil {
+		return nil
+	}
+	if len(a.MapAccounts) == 0 {
+		return []string{}
+	}
+	return a.MapAccounts
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetRegion returns Region field of Params or nil if Params is nil.
+func (p *Params) GetRegion() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Region
+}
+
+// GetRegionV returns value of Region field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRegionV() string {
+	if p == nil || p.Region == nil {
+		return ""
+	}
+	return *p.Region
+}
+
+// GetRegionOr returns value of Region field of Params or def if either Params or field is nil.
+func (p *Params) GetRegionOr(def string) string {
+	if p == nil || p.Region == nil {
+		return def
+	}
+	return *p.Region
+}
+
+// GetKubernetesVersion returns KubernetesVersion field of Params or nil if Params is nil.
+func (p *Params) GetKubernetesVersion() *string {
+	if p == nil {
+		return nil
+	}
+	return p.KubernetesVersion
+}
+
+// GetKubernetesVersionV returns value of KubernetesVersion field of Params or zero value if either Params or field is nil.
+func (p *Params) GetKubernetesVersionV() string {
+	if p == nil || p.KubernetesVersion == nil {
+		return ""
+	}
+	return *p.KubernetesVersion
+}
+
+// GetKubernetesVersionOr returns value of KubernetesVersion field of Params or def if either Params or field is nil.
+func (p *Params) GetKubernetesVersionOr(def string) string {
+	if p == nil || p.KubernetesVersion == nil {
+		return def
+	}
+	return *p.KubernetesVersion
+}
+
+// GetVpcId returns VpcId field of Params or nil if Params is nil.
+func (p *Params) GetVpcId() *string {
+	if p == nil {
+		return nil
+	}
+	return p.VpcId
+}
+
+// GetVpcIdV returns value of VpcId field of Params or zero value if either Params or field is nil.
+func (p *Params) GetVpcIdV() string {
+	if p == nil || p.VpcId == nil {
+		return ""
+	}
+	return *p.VpcId
+}
+
+// GetVpcIdOr returns value of VpcId field of Params or def if either Params or field is nil.
+func (p *Params) GetVpcIdOr(def string) string {
+	if p == nil || p.VpcId == nil {
+		return def
+	}
+	return *p.VpcId
+}
+
+// GetSubnetIds returns SubnetIds field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetSubnetIds() []string {
+	if p == nil {
+		return nil
+	}
+	if len(p.SubnetIds) == 0 {
+		return []string{}
+	}
+	return p.SubnetIds
+}
+
+// GetEndpointPrivateAccess returns EndpointPrivateAccess field of Params or nil if Params is nil.
+func (p *Params) GetEndpointPrivateAccess() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.EndpointPrivateAccess
+}
+
+// GetEndpointPrivateAccessV returns value of EndpointPrivateAccess field of Params or zero value if either Params or field is nil.
+func (p *Params) GetEndpointPrivateAccessV() bool {
+	if p == nil || p.EndpointPrivateAccess == nil {
+		return false
+	}
+	return *p.EndpointPrivateAccess
+}
+
+// GetEndpointPrivateAccessOr returns value of EndpointPrivateAccess field of Params or def if either Params or field is nil.
+func (p *Params) GetEndpointPrivateAccessOr(def bool) bool {
+	if p == nil || p.EndpointPrivateAccess == nil {
+		return def
+	}
+	return *p.EndpointPrivateAccess
+}
+
+// GetEndpointPublicAccess returns EndpointPublicAccess field of Params or nil if Params is nil.
+func (p *Params) GetEndpointPublicAccess() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.EndpointPublicAccess
+}
+
+// GetEndpointPublicAccessV returns value of EndpointPublicAccess field of Params or zero value if either Params or field is nil.
+func (p *Params) GetEndpointPublicAccessV() bool {
+	if p == nil || p.EndpointPublicAccess == nil {
+		return false
+	}
+	return *p.EndpointPublicAccess
+}
+
+// GetEndpointPublicAccessOr returns value of EndpointPublicAccess field of Params or def if either Params or field is nil.
+func (p *Params) GetEndpointPublicAccessOr(def bool) bool {
+	if p == nil || p.EndpointPublicAccess == nil {
+		return def
+	}
+	return *p.EndpointPublicAccess
+}
+
+// GetPublicAccessCidrs returns PublicAccessCidrs field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetPublicAccessCidrs() []string {
+	if p == nil {
+		return nil
+	}
+	if len(p.PublicAccessCidrs) == 0 {
+		return []string{}
+	}
+	return p.PublicAccessCidrs
+}
+
+// GetWorkerGroups returns WorkerGroups field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetWorkerGroups() []WorkerGroup {
+	if p == nil {
+		return nil
+	}
+	if len(p.WorkerGroups) == 0 {
+		return []WorkerGroup{}
+	}
+	return p.WorkerGroups
+}
+
+// GetAuth returns Auth field of Params or nil if Params is nil.
+func (p *Params) GetAuth() *Auth {
+	if p == nil {
+		return nil
+	}
+	return p.Auth
+}
+
+// GetAuthV returns value of Auth field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAuthV() Auth {
+	if p == nil || p.Auth == nil {
+		return Auth{}
+	}
+	return *p.Auth
+}
+
+// GetAuthOr returns value of Auth field of Params or def if either Params or field is nil.
+func (p *Params) GetAuthOr(def Auth) Auth {
+	if p == nil || p.Auth == nil {
+		return def
+	}
+	return *p.Auth
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetClusterName returns ClusterName field of Output or nil if Output is nil.
+func (o *Output) GetClusterName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.ClusterName
+}
+
+// GetClusterNameV returns value of ClusterName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetClusterNameV() string {
+	if o == nil || o.ClusterName == nil {
+		return ""
+	}
+	return *o.ClusterName
+}
+
+// GetClusterNameOr returns value of ClusterName field of Output or def if either Output or field is nil.
+func (o *Output) GetClusterNameOr(def string) string {
+	if o == nil || o.ClusterName == nil {
+		return def
+	}
+	return *o.ClusterName
+}
+
+// GetEndpoint returns Endpoint field of Output or nil if Output is nil.
+func (o *Output) GetEndpoint() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Endpoint
+}
+
+// GetEndpointV returns value of Endpoint field of Output or zero value if either Output or field is nil.
+func (o *Output) GetEndpointV() string {
+	if o == nil || o.Endpoint == nil {
+		return ""
+	}
+	return *o.Endpoint
+}
+
+// GetEndpointOr returns value of Endpoint field of Output or def if either Output or field is nil.
+func (o *Output) GetEndpointOr(def string) string {
+	if o == nil || o.Endpoint == nil {
+		return def
+	}
+	return *o.Endpoint
+}
+
+// GetKubeConfig returns KubeConfig field of Output or nil if Output is nil.
+func (o *Output) GetKubeConfig() *string {
+	if o == nil {
+		return nil
+	}
+	return o.KubeConfig
+}
+
+// GetKubeConfigV returns value of KubeConfig field of Output or zero value if either Output or field is nil.
+func (o *Output) GetKubeConfigV() string {
+	if o == nil || o.KubeConfig == nil {
+		return ""
+	}
+	return *o.KubeConfig
+}
+
+// GetKubeConfigOr returns value of KubeConfig field of Output or def if either Output or field is nil.
+func (o *Output) GetKubeConfigOr(def string) string {
+	if o == nil || o.KubeConfig == nil {
+		return def
+	}
+	return *o.KubeConfig
+}
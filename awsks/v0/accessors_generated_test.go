@@ -0,0 +1,717 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWorkerGroup_Accessors(t *testing.T) {
+	var nilStruct *WorkerGroup
+	emptyStruct := &WorkerGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &WorkerGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("InstanceType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &WorkerGroup{InstanceType: &v}
+		if nilStruct.GetInstanceType() != nil || emptyStruct.GetInstanceType() != nil {
+			t.Error("GetInstanceType() expected to return nil")
+		}
+		if fullStruct.GetInstanceType() != &v {
+			t.Error("GetInstanceType() expected to return field")
+		}
+		if nilStruct.GetInstanceTypeV() != "" || emptyStruct.GetInstanceTypeV() != "" {
+			t.Error("GetInstanceTypeV() expected to return zero value")
+		}
+		if fullStruct.GetInstanceTypeV() != v {
+			t.Error("GetInstanceTypeV() expected to return field value")
+		}
+		if nilStruct.GetInstanceTypeOr(v) != v || emptyStruct.GetInstanceTypeOr(v) != v {
+			t.Error("GetInstanceTypeOr() expected to return default value")
+		}
+		if fullStruct.GetInstanceTypeOr("") != v {
+			t.Error("GetInstanceTypeOr() expected to return field value")
+		}
+	})
+	t.Run("DesiredSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &WorkerGroup{DesiredSize: &v}
+		if nilStruct.GetDesiredSize() != nil || emptyStruct.GetDesiredSize() != nil {
+			t.Error("GetDesiredSize() expected to return nil")
+		}
+		if fullStruct.GetDesiredSize() != &v {
+			t.Error("GetDesiredSize() expected to return field")
+		}
+		if nilStruct.GetDesiredSizeV() != 0 || emptyStruct.GetDesiredSizeV() != 0 {
+			t.Error("GetDesiredSizeV() expected to return zero value")
+		}
+		if fullStruct.GetDesiredSizeV() != v {
+			t.Error("GetDesiredSizeV() expected to return field value")
+		}
+		if nilStruct.GetDesiredSizeOr(v) != v || emptyStruct.GetDesiredSizeOr(v) != v {
+			t.Error("GetDesiredSizeOr() expected to return default value")
+		}
+		if fullStruct.GetDesiredSizeOr(0) != v {
+			t.Error("GetDesiredSizeOr() expected to return field value")
+		}
+	})
+	t.Run("MinSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &WorkerGroup{MinSize: &v}
+		if nilStruct.GetMinSize() != nil || emptyStruct.GetMinSize() != nil {
+			t.Error("GetMinSize() expected to return nil")
+		}
+		if fullStruct.GetMinSize() != &v {
+			t.Error("GetMinSize() expected to return field")
+		}
+		if nilStruct.GetMinSizeV() != 0 || emptyStruct.GetMinSizeV() != 0 {
+			t.Error("GetMinSizeV() expected to return zero value")
+		}
+		if fullStruct.GetMinSizeV() != v {
+			t.Error("GetMinSizeV() expected to return field value")
+		}
+		if nilStruct.GetMinSizeOr(v) != v || emptyStruct.GetMinSizeOr(v) != v {
+			t.Error("GetMinSizeOr() expected to return default value")
+		}
+		if fullStruct.GetMinSizeOr(0) != v {
+			t.Error("GetMinSizeOr() expected to return field value")
+		}
+	})
+	t.Run("MaxSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &WorkerGroup{MaxSize: &v}
+		if nilStruct.GetMaxSize() != nil || emptyStruct.GetMaxSize() != nil {
+			t.Error("GetMaxSize() expected to return nil")
+		}
+		if fullStruct.GetMaxSize() != &v {
+			t.Error("GetMaxSize() expected to return field")
+		}
+		if nilStruct.GetMaxSizeV() != 0 || emptyStruct.GetMaxSizeV() != 0 {
+			t.Error("GetMaxSizeV() expected to return zero value")
+		}
+		if fullStruct.GetMaxSizeV() != v {
+			t.Error("GetMaxSizeV() expected to return field value")
+		}
+		if nilStruct.GetMaxSizeOr(v) != v || emptyStruct.GetMaxSizeOr(v) != v {
+			t.Error("GetMaxSizeOr() expected to return default value")
+		}
+		if fullStruct.GetMaxSizeOr(0) != v {
+			t.Error("GetMaxSizeOr() expected to return field value")
+		}
+	})
+	t.Run("RootVolumeGbSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &WorkerGroup{RootVolumeGbSize: &v}
+		if nilStruct.GetRootVolumeGbSize() != nil || emptyStruct.GetRootVolumeGbSize() != nil {
+			t.Error("GetRootVolumeGbSize() expected to return nil")
+		}
+		if fullStruct.GetRootVolumeGbSize() != &v {
+			t.Error("GetRootVolumeGbSize() expected to return field")
+		}
+		if nilStruct.GetRootVolumeGbSizeV() != 0 || emptyStruct.GetRootVolumeGbSizeV() != 0 {
+			t.Error("GetRootVolumeGbSizeV() expected to return zero value")
+		}
+		if fullStruct.GetRootVolumeGbSizeV() != v {
+			t.Error("GetRootVolumeGbSizeV() expected to return field value")
+		}
+		if nilStruct.GetRootVolumeGbSizeOr(v) != v || emptyStruct.GetRootVolumeGbSizeOr(v) != v {
+			t.Error("GetRootVolumeGbSizeOr() expected to return default value")
+		}
+		if fullStruct.GetRootVolumeGbSizeOr(0) != v {
+			t.Error("GetRootVolumeGbSizeOr() expected to return field value")
+		}
+	})
+	t.Run("RootVolumeType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &WorkerGroup{RootVolumeType: &v}
+		if nilStruct.GetRootVolumeType() != nil || emptyStruct.GetRootVolumeType() != nil {
+			t.Error("GetRootVolumeType() expected to return nil")
+		}
+		if fullStruct.GetRootVolumeType() != &v {
+			t.Error("GetRootVolumeType() expected to return field")
+		}
+		if nilStruct.GetRootVolumeTypeV() != "" || emptyStruct.GetRootVolumeTypeV() != "" {
+			t.Error("GetRootVolumeTypeV() expected to return zero value")
+		}
+		if fullStruct.GetRootVolumeTypeV() != v {
+			t.Error("GetRootVolumeTypeV() expected to return field value")
+		}
+		if nilStruct.GetRootVolumeTypeOr(v) != v || emptyStruct.GetRootVolumeTypeOr(v) != v {
+			t.Error("GetRootVolumeTypeOr() expected to return default value")
+		}
+		if fullStruct.GetRootVolumeTypeOr("") != v {
+			t.Error("GetRootVolumeTypeOr() expected to return field value")
+		}
+	})
+}
+
+func TestRoleMapping_Accessors(t *testing.T) {
+	var nilStruct *RoleMapping
+	emptyStruct := &RoleMapping{}
+	t.Run("RoleArn", func(t *testing.T) {
+		v := "value"
+		fullStruct := &RoleMapping{RoleArn: &v}
+		if nilStruct.GetRoleArn() != nil || emptyStruct.GetRoleArn() != nil {
+			t.Error("GetRoleArn() expected to return nil")
+		}
+		if fullStruct.GetRoleArn() != &v {
+			t.Error("GetRoleArn() expected to return field")
+		}
+		if nilStruct.GetRoleArnV() != "" || emptyStruct.GetRoleArnV() != "" {
+			t.Error("GetRoleArnV() expected to return zero value")
+		}
+		if fullStruct.GetRoleArnV() != v {
+			t.Error("GetRoleArnV() expected to return field value")
+		}
+		if nilStruct.GetRoleArnOr(v) != v || emptyStruct.GetRoleArnOr(v) != v {
+			t.Error("GetRoleArnOr() expected to return default value")
+		}
+		if fullStruct.GetRoleArnOr("") != v {
+			t.Error("GetRoleArnOr() expected to return field value")
+		}
+	})
+	t.Run("Username", func(t *testing.T) {
+		v := "value"
+		fullStruct := &RoleMapping{Username: &v}
+		if nilStruct.GetUsername() != nil || emptyStruct.GetUsername() != nil {
+			t.Error("GetUsername() expected to return nil")
+		}
+		if fullStruct.GetUsername() != &v {
+			t.Error("GetUsername() expected to return field")
+		}
+		if nilStruct.GetUsernameV() != "" || emptyStruct.GetUsernameV() != "" {
+			t.Error("GetUsernameV() expected to return zero value")
+		}
+		if fullStruct.GetUsernameV() != v {
+			t.Error("GetUsernameV() expected to return field value")
+		}
+		if nilStruct.GetUsernameOr(v) != v || emptyStruct.GetUsernameOr(v) != v {
+			t.Error("GetUsernameOr() expected to return default value")
+		}
+		if fullStruct.GetUsernameOr("") != v {
+			t.Error("GetUsernameOr() expected to return field value")
+		}
+	})
+	t.Run("Groups", func(t *testing.T) {
+		fullStruct := &RoleMapping{Groups: make([]string, 1)}
+		if nilStruct.GetGroups() != nil {
+			t.Error("GetGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetGroups(); got == nil || len(got) != 0 {
+			t.Error("GetGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetGroups(); len(got) != 1 || &got[0] != &fullStruct.Groups[0] {
+			t.Error("GetGroups() expected to return field")
+		}
+	})
+}
+
+func TestUserMapping_Accessors(t *testing.T) {
+	var nilStruct *UserMapping
+	emptyStruct := &UserMapping{}
+	t.Run("UserArn", func(t *testing.T) {
+		v := "value"
+		fullStruct := &UserMapping{UserArn: &v}
+		if nilStruct.GetUserArn() != nil || emptyStruct.GetUserArn() != nil {
+			t.Error("GetUserArn() expected to return nil")
+		}
+		if fullStruct.GetUserArn() != &v {
+			t.Error("GetUserArn() expected to return field")
+		}
+		if nilStruct.GetUserArnV() != "" || emptyStruct.GetUserArnV() != "" {
+			t.Error("GetUserArnV() expected to return zero value")
+		}
+		if fullStruct.GetUserArnV() != v {
+			t.Error("GetUserArnV() expected to return field value")
+		}
+		if nilStruct.GetUserArnOr(v) != v || emptyStruct.GetUserArnOr(v) != v {
+			t.Error("GetUserArnOr() expected to return default value")
+		}
+		if fullStruct.GetUserArnOr("") != v {
+			t.Error("GetUserArnOr() expected to return field value")
+		}
+	})
+	t.Run("Username", func(t *testing.T) {
+		v := "value"
+		fullStruct := &UserMapping{Username: &v}
+		if nilStruct.GetUsername() != nil || emptyStruct.GetUsername() != nil {
+			t.Error("GetUsername() expected to return nil")
+		}
+		if fullStruct.GetUsername() != &v {
+			t.Error("GetUsername() expected to return field")
+		}
+		if nilStruct.GetUsernameV() != "" || emptyStruct.GetUsernameV() != "" {
+			t.Error("GetUsernameV() expected to return zero value")
+		}
+		if fullStruct.GetUsernameV() != v {
+			t.Error("GetUsernameV() expected to return field value")
+		}
+		if nilStruct.GetUsernameOr(v) != v || emptyStruct.GetUsernameOr(v) != v {
+			t.Error("GetUsernameOr() expected to return default value")
+		}
+		if fullStruct.GetUsernameOr("") != v {
+			t.Error("GetUsernameOr() expected to return field value")
+		}
+	})
+	t.Run("Groups", func(t *testing.T) {
+		fullStruct := &UserMapping{Groups: make([]string, 1)}
+		if nilStruct.GetGroups() != nil {
+			t.Error("GetGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetGroups(); got == nil || len(got) != 0 {
+			t.Error("GetGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetGroups(); len(got) != 1 || &got[0] != &fullStruct.Groups[0] {
+			t.Error("GetGroups() expected to return field")
+		}
+	})
+}
+
+func TestAuth_Accessors(t *testing.T) {
+	var nilStruct *Auth
+	emptyStruct := &Auth{}
+	t.Run("ClusterRoleArn", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Auth{ClusterRoleArn: &v}
+		if nilStruct.GetClusterRoleArn() != nil || emptyStruct.GetClusterRoleArn() != nil {
+			t.Error("GetClusterRoleArn() expected to return nil")
+		}
+		if fullStruct.GetClusterRoleArn() != &v {
+			t.Error("GetClusterRoleArn() expected to return field")
+		}
+		if nilStruct.GetClusterRoleArnV() != "" || emptyStruct.GetClusterRoleArnV() != "" {
+			t.Error("GetClusterRoleArnV() expected to return zero value")
+		}
+		if fullStruct.GetClusterRoleArnV() != v {
+			t.Error("GetClusterRoleArnV() expected to return field value")
+		}
+		if nilStruct.GetClusterRoleArnOr(v) != v || emptyStruct.GetClusterRoleArnOr(v) != v {
+			t.Error("GetClusterRoleArnOr() expected to return default value")
+		}
+		if fullStruct.GetClusterRoleArnOr("") != v {
+			t.Error("GetClusterRoleArnOr() expected to return field value")
+		}
+	})
+	t.Run("WorkersRoleArn", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Auth{WorkersRoleArn: &v}
+		if nilStruct.GetWorkersRoleArn() != nil || emptyStruct.GetWorkersRoleArn() != nil {
+			t.Error("GetWorkersRoleArn() expected to return nil")
+		}
+		if fullStruct.GetWorkersRoleArn() != &v {
+			t.Error("GetWorkersRoleArn() expected to return field")
+		}
+		if nilStruct.GetWorkersRoleArnV() != "" || emptyStruct.GetWorkersRoleArnV() != "" {
+			t.Error("GetWorkersRoleArnV() expected to return zero value")
+		}
+		if fullStruct.GetWorkersRoleArnV() != v {
+			t.Error("GetWorkersRoleArnV() expected to return field value")
+		}
+		if nilStruct.GetWorkersRoleArnOr(v) != v || emptyStruct.GetWorkersRoleArnOr(v) != v {
+			t.Error("GetWorkersRoleArnOr() expected to return default value")
+		}
+		if fullStruct.GetWorkersRoleArnOr("") != v {
+			t.Error("GetWorkersRoleArnOr() expected to return field value")
+		}
+	})
+	t.Run("MapRoles", func(t *testing.T) {
+		fullStruct := &Auth{MapRoles: make([]RoleMapping, 1)}
+		if nilStruct.GetMapRoles() != nil {
+			t.Error("GetMapRoles() expected to return nil")
+		}
+		if got := emptyStruct.GetMapRoles(); got == nil || len(got) != 0 {
+			t.Error("GetMapRoles() expected to return empty slice")
+		}
+		if got := fullStruct.GetMapRoles(); len(got) != 1 || &got[0] != &fullStruct.MapRoles[0] {
+			t.Error("GetMapRoles() expected to return field")
+		}
+	})
+	t.Run("MapUsers", func(t *testing.T) {
+		fullStruct := &Auth{MapUsers: make([]UserMapping, 1)}
+		if nilStruct.GetMapUsers() != nil {
+			t.Error("GetMapUsers() expected to return nil")
+		}
+		if got := emptyStruct.GetMapUsers(); got == nil || len(got) != 0 {
+			t.Error("GetMapUsers() expected to return empty slice")
+		}
+		if got := fullStruct.GetMapUsers(); len(got) != 1 || &got[0] != &fullStruct.MapUsers[0] {
+			t.Error("GetMapUsers() expected to return field")
+		}
+	})
+	t.Run("MapAccounts", func(t *testing.T) {
+		fullStruct := &Auth{MapAccounts: make([]string, 1)}
+		if nilStruct.GetMapAccounts() != nil {
+			t.Error("GetMapAccounts() expected to return nil")
+		}
+		if got := emptyStruct.GetMapAccounts(); got == nil || len(got) != 0 {
+			t.Error("GetMapAccounts() expected to return empty slice")
+		}
+		if got := fullStruct.GetMapAccounts(); len(got) != 1 || &got[0] != &fullStruct.MapAccounts[0] {
+			t.Error("GetMapAccounts() expected to return field")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Region", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Region: &v}
+		if nilStruct.GetRegion() != nil || emptyStruct.GetRegion() != nil {
+			t.Error("GetRegion() expected to return nil")
+		}
+		if fullStruct.GetRegion() != &v {
+			t.Error("GetRegion() expected to return field")
+		}
+		if nilStruct.GetRegionV() != "" || emptyStruct.GetRegionV() != "" {
+			t.Error("GetRegionV() expected to return zero value")
+		}
+		if fullStruct.GetRegionV() != v {
+			t.Error("GetRegionV() expected to return field value")
+		}
+		if nilStruct.GetRegionOr(v) != v || emptyStruct.GetRegionOr(v) != v {
+			t.Error("GetRegionOr() expected to return default value")
+		}
+		if fullStruct.GetRegionOr("") != v {
+			t.Error("GetRegionOr() expected to return field value")
+		}
+	})
+	t.Run("KubernetesVersion", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{KubernetesVersion: &v}
+		if nilStruct.GetKubernetesVersion() != nil || emptyStruct.GetKubernetesVersion() != nil {
+			t.Error("GetKubernetesVersion() expected to return nil")
+		}
+		if fullStruct.GetKubernetesVersion() != &v {
+			t.Error("GetKubernetesVersion() expected to return field")
+		}
+		if nilStruct.GetKubernetesVersionV() != "" || emptyStruct.GetKubernetesVersionV() != "" {
+			t.Error("GetKubernetesVersionV() expected to return zero value")
+		}
+		if fullStruct.GetKubernetesVersionV() != v {
+			t.Error("GetKubernetesVersionV() expected to return field value")
+		}
+		if nilStruct.GetKubernetesVersionOr(v) != v || emptyStruct.GetKubernetesVersionOr(v) != v {
+			t.Error("GetKubernetesVersionOr() expected to return default value")
+		}
+		if fullStruct.GetKubernetesVersionOr("") != v {
+			t.Error("GetKubernetesVersionOr() expected to return field value")
+		}
+	})
+	t.Run("VpcId", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{VpcId: &v}
+		if nilStruct.GetVpcId() != nil || emptyStruct.GetVpcId() != nil {
+			t.Error("GetVpcId() expected to return nil")
+		}
+		if fullStruct.GetVpcId() != &v {
+			t.Error("GetVpcId() expected to return field")
+		}
+		if nilStruct.GetVpcIdV() != "" || emptyStruct.GetVpcIdV() != "" {
+			t.Error("GetVpcIdV() expected to return zero value")
+		}
+		if fullStruct.GetVpcIdV() != v {
+			t.Error("GetVpcIdV() expected to return field value")
+		}
+		if nilStruct.GetVpcIdOr(v) != v || emptyStruct.GetVpcIdOr(v) != v {
+			t.Error("GetVpcIdOr() expected to return default value")
+		}
+		if fullStruct.GetVpcIdOr("") != v {
+			t.Error("GetVpcIdOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetIds", func(t *testing.T) {
+		fullStruct := &Params{SubnetIds: make([]string, 1)}
+		if nilStruct.GetSubnetIds() != nil {
+			t.Error("GetSubnetIds() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnetIds(); got == nil || len(got) != 0 {
+			t.Error("GetSubnetIds() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnetIds(); len(got) != 1 || &got[0] != &fullStruct.SubnetIds[0] {
+			t.Error("GetSubnetIds() expected to return field")
+		}
+	})
+	t.Run("EndpointPrivateAccess", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{EndpointPrivateAccess: &v}
+		if nilStruct.GetEndpointPrivateAccess() != nil || emptyStruct.GetEndpointPrivateAccess() != nil {
+			t.Error("GetEndpointPrivateAccess() expected to return nil")
+		}
+		if fullStruct.GetEndpointPrivateAccess() != &v {
+			t.Error("GetEndpointPrivateAccess() expected to return field")
+		}
+		if nilStruct.GetEndpointPrivateAccessV() != false || emptyStruct.GetEndpointPrivateAccessV() != false {
+			t.Error("GetEndpointPrivateAccessV() expected to return zero value")
+		}
+		if fullStruct.GetEndpointPrivateAccessV() != v {
+			t.Error("GetEndpointPrivateAccessV() expected to return field value")
+		}
+		if nilStruct.GetEndpointPrivateAccessOr(v) != v || emptyStruct.GetEndpointPrivateAccessOr(v) != v {
+			t.Error("GetEndpointPrivateAccessOr() expected to return default value")
+		}
+		if fullStruct.GetEndpointPrivateAccessOr(false) != v {
+			t.Error("GetEndpointPrivateAccessOr() expected to return field value")
+		}
+	})
+	t.Run("EndpointPublicAccess", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{EndpointPublicAccess: &v}
+		if nilStruct.GetEndpointPublicAccess() != nil || emptyStruct.GetEndpointPublicAccess() != nil {
+			t.Error("GetEndpointPublicAccess() expected to return nil")
+		}
+		if fullStruct.GetEndpointPublicAccess() != &v {
+			t.Error("GetEndpointPublicAccess() expected to return field")
+		}
+		if nilStruct.GetEndpointPublicAccessV() != false || emptyStruct.GetEndpointPublicAccessV() != false {
+			t.Error("GetEndpointPublicAccessV() expected to return zero value")
+		}
+		if fullStruct.GetEndpointPublicAccessV() != v {
+			t.Error("GetEndpointPublicAccessV() expected to return field value")
+		}
+		if nilStruct.GetEndpointPublicAccessOr(v) != v || emptyStruct.GetEndpointPublicAccessOr(v) != v {
+			t.Error("GetEndpointPublicAccessOr() expected to return default value")
+		}
+		if fullStruct.GetEndpointPublicAccessOr(false) != v {
+			t.Error("GetEndpointPublicAccessOr() expected to return field value")
+		}
+	})
+	t.Run("PublicAccessCidrs", func(t *testing.T) {
+		fullStruct := &Params{PublicAccessCidrs: make([]string, 1)}
+		if nilStruct.GetPublicAccessCidrs() != nil {
+			t.Error("GetPublicAccessCidrs() expected to return nil")
+		}
+		if got := emptyStruct.GetPublicAccessCidrs(); got == nil || len(got) != 0 {
+			t.Error("GetPublicAccessCidrs() expected to return empty slice")
+		}
+		if got := fullStruct.GetPublicAccessCidrs(); len(got) != 1 || &got[0] != &fullStruct.PublicAccessCidrs[0] {
+			t.Error("GetPublicAccessCidrs() expected to return field")
+		}
+	})
+	t.Run("WorkerGroups", func(t *testing.T) {
+		fullStruct := &Params{WorkerGroups: make([]WorkerGroup, 1)}
+		if nilStruct.GetWorkerGroups() != nil {
+			t.Error("GetWorkerGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetWorkerGroups(); got == nil || len(got) != 0 {
+			t.Error("GetWorkerGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetWorkerGroups(); len(got) != 1 || &got[0] != &fullStruct.WorkerGroups[0] {
+			t.Error("GetWorkerGroups() expected to return field")
+		}
+	})
+	t.Run("Auth", func(t *testing.T) {
+		v := Auth{}
+		fullStruct := &Params{Auth: &v}
+		if nilStruct.GetAuth() != nil || emptyStruct.GetAuth() != nil {
+			t.Error("GetAuth() expected to return nil")
+		}
+		if fullStruct.GetAuth() != &v {
+			t.Error("GetAuth() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAuthV(), Auth{}) || !reflect.DeepEqual(emptyStruct.GetAuthV(), Auth{}) {
+			t.Error("GetAuthV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAuthV(), v) {
+			t.Error("GetAuthV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAuthOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAuthOr(v), v) {
+			t.Error("GetAuthOr() expected to return default value")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("ClusterName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{ClusterName: &v}
+		if nilStruct.GetClusterName() != nil || emptyStruct.GetClusterName() != nil {
+			t.Error("GetClusterName() expected to return nil")
+		}
+		if fullStruct.GetClusterName() != &v {
+			t.Error("GetClusterName() expected to return field")
+		}
+		if nilStruct.GetClusterNameV() != "" || emptyStruct.GetClusterNameV() != "" {
+			t.Error("GetClusterNameV() expected to return zero value")
+		}
+		if fullStruct.GetClusterNameV() != v {
+			t.Error("GetClusterNameV() expected to return field value")
+		}
+		if nilStruct.GetClusterNameOr(v) != v || emptyStruct.GetClusterNameOr(v) != v {
+			t.Error("GetClusterNameOr() expected to return default value")
+		}
+		if fullStruct.GetClusterNameOr("") != v {
+			t.Error("GetClusterNameOr() expected to return field value")
+		}
+	})
+	t.Run("Endpoint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{Endpoint: &v}
+		if nilStruct.GetEndpoint() != nil || emptyStruct.GetEndpoint() != nil {
+			t.Error("GetEndpoint() expected to return nil")
+		}
+		if fullStruct.GetEndpoint() != &v {
+			t.Error("GetEndpoint() expected to return field")
+		}
+		if nilStruct.GetEndpointV() != "" || emptyStruct.GetEndpointV() != "" {
+			t.Error("GetEndpointV() expected to return zero value")
+		}
+		if fullStruct.GetEndpointV() != v {
+			t.Error("GetEndpointV() expected to return field value")
+		}
+		if nilStruct.GetEndpointOr(v) != v || emptyStruct.GetEndpointOr(v) != v {
+			t.Error("GetEndpointOr() expected to return default value")
+		}
+		if fullStruct.GetEndpointOr("") != v {
+			t.Error("GetEndpointOr() expected to return field value")
+		}
+	})
+	t.Run("KubeConfig", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{KubeConfig: &v}
+		if nilStruct.GetKubeConfig() != nil || emptyStruct.GetKubeConfig() != nil {
+			t.Error("GetKubeConfig() expected to return nil")
+		}
+		if fullStruct.GetKubeConfig() != &v {
+			t.Error("GetKubeConfig() expected to return field")
+		}
+		if nilStruct.GetKubeConfigV() != "" || emptyStruct.GetKubeConfigV() != "" {
+			t.Error("GetKubeConfigV() expected to return zero value")
+		}
+		if fullStruct.GetKubeConfigV() != v {
+			t.Error("GetKubeConfigV() expected to return field value")
+		}
+		if nilStruct.GetKubeConfigOr(v) != v || emptyStruct.GetKubeConfigOr(v) != v {
+			t.Error("GetKubeConfigOr() expected to return default value")
+		}
+		if fullStruct.GetKubeConfigOr("") != v {
+			t.Error("GetKubeConfigOr() expected to return field value")
+		}
+	})
+}
@@ -0,0 +1,223 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "awsks"
+	version = "v0.0.1"
+)
+
+type WorkerGroup struct {
+	Name             *string `json:"name" validate:"required,min=1"`
+	InstanceType     *string `json:"instance_type" validate:"required,min=1"`
+	DesiredSize      *int    `json:"desired_size" validate:"required,min=0,gtefield=MinSize,ltefield=MaxSize"`
+	MinSize          *int    `json:"min_size" validate:"required,min=0"`
+	MaxSize          *int    `json:"max_size" validate:"required,min=1,gtefield=MinSize"`
+	RootVolumeGbSize *int    `json:"root_volume_size" validate:"required,min=1"`
+	RootVolumeType   *string `json:"root_volume_type" validate:"required,eq=standard|eq=gp2|eq=gp3|eq=io1|eq=io2"` // https://registry.terraform.io/providers/hashicorp/aws/latest/docs/resources/ebs_volume#type
+}
+
+// RoleMapping maps IAM role to Kubernetes user and groups in aws-auth config map.
+type RoleMapping struct {
+	RoleArn  *string  `json:"role_arn" validate:"required,startswith=arn:"`
+	Username *string  `json:"username" validate:"required,min=1"`
+	Groups   []string `json:"groups" validate:"omitempty,dive,required"`
+}
+
+// UserMapping maps IAM user to Kubernetes user and groups in aws-auth config map.
+type UserMapping struct {
+	UserArn  *string  `json:"user_arn" validate:"required,startswith=arn:"`
+	Username *string  `json:"username" validate:"required,min=1"`
+	Groups   []string `json:"groups" validate:"omitempty,dive,required"`
+}
+
+type Auth struct {
+	// ClusterRoleArn is existing IAM role used by cluster control plane. It is created if empty.
+	ClusterRoleArn *string `json:"cluster_role_arn" validate:"omitempty,startswith=arn:"`
+	// WorkersRoleArn is existing IAM role used by worker nodes. It is created if empty.
+	WorkersRoleArn *string       `json:"workers_role_arn" validate:"omitempty,startswith=arn:"`
+	MapRoles       []RoleMapping `json:"map_roles" validate:"omitempty,dive"`
+	MapUsers       []UserMapping `json:"map_users" validate:"omitempty,dive"`
+	MapAccounts    []string      `json:"map_accounts" validate:"omitempty,dive,numeric,len=12"`
+}
+
+type Params struct {
+	Name              *string `json:"name" validate:"required,min=1"`
+	Region            *string `json:"region" validate:"required,min=1"`
+	KubernetesVersion *string `json:"kubernetes_version" validate:"required,min=1"`
+
+	// VpcId and SubnetIds point to network created by awsbi module. If empty, values from awsbi
+	// output are used (see UseAwsBIOutput).
+	VpcId     *string  `json:"vpc_id" validate:"omitempty,min=1"`
+	SubnetIds []string `json:"subnet_ids" validate:"omitempty,min=1,dive,required"`
+
+	EndpointPrivateAccess *bool    `json:"endpoint_private_access" validate:"required"`
+	EndpointPublicAccess  *bool    `json:"endpoint_public_access" validate:"required"`
+	PublicAccessCidrs     []string `json:"public_access_cidrs" validate:"omitempty,dive,required,cidr"`
+
+	WorkerGroups []WorkerGroup `json:"worker_groups" validate:"required,min=1,dive"`
+	Auth         *Auth         `json:"auth" validate:"required"`
+}
+
+// UseAwsBIOutput sets VpcId and SubnetIds to VPC and private subnets created by awsbi module.
+func (p *Params) UseAwsBIOutput(o *awsbi.Output) {
+	if p == nil || o == nil {
+		return
+	}
+	p.VpcId = to.StrPtr(o.GetVpcIdV())
+	p.SubnetIds = append([]string{}, o.PrivateSubnetIds...)
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=awsks"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:              to.StrPtr("epiphany"),
+			Region:            to.StrPtr("eu-central-1"),
+			KubernetesVersion: to.StrPtr("1.18"),
+
+			EndpointPrivateAccess: to.BooPtr(false),
+			EndpointPublicAccess:  to.BooPtr(true),
+			PublicAccessCidrs:     []string{"0.0.0.0/0"},
+
+			WorkerGroups: []WorkerGroup{
+				{
+					Name:             to.StrPtr("default_wg"),
+					InstanceType:     to.StrPtr("t3.medium"),
+					DesiredSize:      to.IntPtr(2),
+					MinSize:          to.IntPtr(2),
+					MaxSize:          to.IntPtr(5),
+					RootVolumeGbSize: to.IntPtr(20),
+					RootVolumeType:   to.StrPtr("gp2"),
+				},
+			},
+			Auth: &Auth{},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("awsks config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+type Output struct {
+	ClusterName *string `json:"cluster_name" validate:"required,min=1"`
+	Endpoint    *string `json:"endpoint" validate:"omitempty,url"`
+	KubeConfig  *string `json:"kubeconfig" validate:"required,kubeconfig" sensitive:"true"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
+
+// Redacted returns copy of Output with values of sensitive fields replaced, so it can be logged.
+func (o *Output) Redacted() *Output {
+	r := o.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
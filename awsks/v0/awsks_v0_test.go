@@ -0,0 +1,632 @@
+package v0
+
+import (
+	"testing"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"vpc_id": "vpc-0a1b2c3d4e5f67890",
+		"subnet_ids": [
+			"subnet-0a1b2c3d4e5f67890",
+			"subnet-0a1b2c3d4e5f67891"
+		],
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"public_access_cidrs": [
+			"0.0.0.0/0"
+		],
+		"worker_groups": [
+			{
+				"name": "default_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 2,
+				"min_size": 2,
+				"max_size": 5,
+				"root_volume_size": 20,
+				"root_volume_type": "gp2"
+			}
+		],
+		"auth": {
+			"cluster_role_arn": null,
+			"workers_role_arn": null,
+			"map_roles": [
+				{
+					"role_arn": "arn:aws:iam::123456789012:role/admins",
+					"username": "admin",
+					"groups": [
+						"system:masters"
+					]
+				}
+			],
+			"map_users": [],
+			"map_accounts": [
+				"123456789012"
+			]
+		}
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("awsks"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:                  to.StrPtr("epiphany"),
+					Region:                to.StrPtr("eu-central-1"),
+					KubernetesVersion:     to.StrPtr("1.18"),
+					VpcId:                 to.StrPtr("vpc-0a1b2c3d4e5f67890"),
+					SubnetIds:             []string{"subnet-0a1b2c3d4e5f67890", "subnet-0a1b2c3d4e5f67891"},
+					EndpointPrivateAccess: to.BooPtr(false),
+					EndpointPublicAccess:  to.BooPtr(true),
+					PublicAccessCidrs:     []string{"0.0.0.0/0"},
+					WorkerGroups: []WorkerGroup{
+						{
+							Name:             to.StrPtr("default_wg"),
+							InstanceType:     to.StrPtr("t3.medium"),
+							DesiredSize:      to.IntPtr(2),
+							MinSize:          to.IntPtr(2),
+							MaxSize:          to.IntPtr(5),
+							RootVolumeGbSize: to.IntPtr(20),
+							RootVolumeType:   to.StrPtr("gp2"),
+						},
+					},
+					Auth: &Auth{
+						MapRoles: []RoleMapping{
+							{
+								RoleArn:  to.StrPtr("arn:aws:iam::123456789012:role/admins"),
+								Username: to.StrPtr("admin"),
+								Groups:   []string{"system:masters"},
+							},
+						},
+						MapUsers:    []UserMapping{},
+						MapAccounts: []string{"123456789012"},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"extra_outer_field" : "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"extra_inner_field" : "extra_inner_value",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"worker_groups": [
+			{
+				"name": "default_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 2,
+				"min_size": 2,
+				"max_size": 5,
+				"root_volume_size": 20,
+				"root_volume_type": "gp2",
+				"extra_wg_field": "extra_wg_value"
+			}
+		],
+		"auth": {}
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("awsks"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:                  to.StrPtr("epiphany"),
+					Region:                to.StrPtr("eu-central-1"),
+					KubernetesVersion:     to.StrPtr("1.18"),
+					EndpointPrivateAccess: to.BooPtr(false),
+					EndpointPublicAccess:  to.BooPtr(true),
+					WorkerGroups: []WorkerGroup{
+						{
+							Name:             to.StrPtr("default_wg"),
+							InstanceType:     to.StrPtr("t3.medium"),
+							DesiredSize:      to.IntPtr(2),
+							MinSize:          to.IntPtr(2),
+							MaxSize:          to.IntPtr(5),
+							RootVolumeGbSize: to.IntPtr(20),
+							RootVolumeType:   to.StrPtr("gp2"),
+						},
+					},
+					Auth: &Auth{},
+				},
+				Unused: []string{"extra_outer_field", "params.extra_inner_field", "params.worker_groups[0].extra_wg_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "azks",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"worker_groups": [
+			{
+				"name": "default_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 2,
+				"min_size": 2,
+				"max_size": 5,
+				"root_volume_size": 20,
+				"root_volume_type": "gp2"
+			}
+		],
+		"auth": {}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "missing required params",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"params": {}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Region",
+					Field: "Region",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.KubernetesVersion",
+					Field: "KubernetesVersion",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.EndpointPrivateAccess",
+					Field: "EndpointPrivateAccess",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.EndpointPublicAccess",
+					Field: "EndpointPublicAccess",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.WorkerGroups",
+					Field: "WorkerGroups",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Auth",
+					Field: "Auth",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect network values",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"vpc_id": "",
+		"subnet_ids": [
+			""
+		],
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"public_access_cidrs": [
+			"0.0.0.0"
+		],
+		"worker_groups": [
+			{
+				"name": "default_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 2,
+				"min_size": 2,
+				"max_size": 5,
+				"root_volume_size": 20,
+				"root_volume_type": "gp2"
+			}
+		],
+		"auth": {}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.VpcId",
+					Field: "VpcId",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.SubnetIds[0]",
+					Field: "SubnetIds[0]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.PublicAccessCidrs[0]",
+					Field: "PublicAccessCidrs[0]",
+					Tag:   "cidr",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_WorkerGroups contains all scenarios related to validation of WorkerGroup structures.
+func TestConfig_Load_WorkerGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty worker_groups",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"worker_groups": [],
+		"auth": {}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.WorkerGroups",
+					Field: "WorkerGroups",
+					Tag:   "min",
+				},
+			},
+		},
+		{
+			name: "desired_size out of range",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"worker_groups": [
+			{
+				"name": "default_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 6,
+				"min_size": 2,
+				"max_size": 5,
+				"root_volume_size": 20,
+				"root_volume_type": "gp2"
+			},
+			{
+				"name": "other_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 1,
+				"min_size": 2,
+				"max_size": 5,
+				"root_volume_size": 20,
+				"root_volume_type": "gp2"
+			}
+		],
+		"auth": {}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.WorkerGroups[0].DesiredSize",
+					Field: "DesiredSize",
+					Tag:   "ltefield",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.WorkerGroups[1].DesiredSize",
+					Field: "DesiredSize",
+					Tag:   "gtefield",
+				},
+			},
+		},
+		{
+			name: "max_size lower than min_size and incorrect volume type",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"worker_groups": [
+			{
+				"name": "default_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 3,
+				"min_size": 3,
+				"max_size": 2,
+				"root_volume_size": 20,
+				"root_volume_type": "ssd"
+			}
+		],
+		"auth": {}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.WorkerGroups[0].DesiredSize",
+					Field: "DesiredSize",
+					Tag:   "ltefield",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.WorkerGroups[0].MaxSize",
+					Field: "MaxSize",
+					Tag:   "gtefield",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.WorkerGroups[0].RootVolumeType",
+					Field: "RootVolumeType",
+					Tag:   "eq=standard|eq=gp2|eq=gp3|eq=io1|eq=io2",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Auth contains all scenarios related to validation of Auth structure.
+func TestConfig_Load_Auth(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect arns and accounts",
+			json: []byte(`{
+	"kind": "awsks",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"region": "eu-central-1",
+		"kubernetes_version": "1.18",
+		"endpoint_private_access": false,
+		"endpoint_public_access": true,
+		"worker_groups": [
+			{
+				"name": "default_wg",
+				"instance_type": "t3.medium",
+				"desired_size": 2,
+				"min_size": 2,
+				"max_size": 5,
+				"root_volume_size": 20,
+				"root_volume_type": "gp2"
+			}
+		],
+		"auth": {
+			"cluster_role_arn": "admins",
+			"map_roles": [
+				{
+					"role_arn": "role/admins"
+				}
+			],
+			"map_users": [
+				{
+					"user_arn": "arn:aws:iam::123456789012:user/jane",
+					"username": "jane",
+					"groups": [
+						""
+					]
+				}
+			],
+			"map_accounts": [
+				"1234"
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Auth.ClusterRoleArn",
+					Field: "ClusterRoleArn",
+					Tag:   "startswith",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Auth.MapRoles[0].RoleArn",
+					Field: "RoleArn",
+					Tag:   "startswith",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Auth.MapRoles[0].Username",
+					Field: "Username",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Auth.MapUsers[0].Groups[0]",
+					Field: "Groups[0]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Auth.MapAccounts[0]",
+					Field: "MapAccounts[0]",
+					Tag:   "len",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParams_UseAwsBIOutput(t *testing.T) {
+	c := NewConfig()
+	c.Params.UseAwsBIOutput(&awsbi.Output{
+		VpcId:            to.StrPtr("vpc-0a1b2c3d4e5f67890"),
+		PrivateSubnetIds: []string{"subnet-1", "subnet-2"},
+		PublicSubnetIds:  []string{"subnet-3"},
+	})
+	if got := c.Params.GetVpcIdV(); got != "vpc-0a1b2c3d4e5f67890" {
+		t.Errorf("UseAwsBIOutput() vpc_id = %s, want vpc-0a1b2c3d4e5f67890", got)
+	}
+	if diff := cmp.Diff([]string{"subnet-1", "subnet-2"}, c.Params.SubnetIds); diff != "" {
+		t.Errorf("UseAwsBIOutput() subnet_ids mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := c.Marshal(); err != nil {
+		t.Errorf("Marshal() after UseAwsBIOutput() unexpected error occurred: %v", err)
+	}
+
+	var nilParams *Params
+	nilParams.UseAwsBIOutput(&awsbi.Output{})
+}
+
+func TestOutput_Redacted(t *testing.T) {
+	o := &Output{
+		ClusterName: to.StrPtr("epiphany"),
+		KubeConfig:  to.StrPtr("apiVersion: v1\nkind: Config\n"),
+	}
+	r := o.Redacted()
+	if got := r.GetKubeConfigV(); got != "<redacted>" {
+		t.Errorf("Redacted() kubeconfig = %s, want <redacted>", got)
+	}
+	if got := r.GetClusterNameV(); got != "epiphany" {
+		t.Errorf("Redacted() changed not sensitive cluster_name = %s", got)
+	}
+	if got := o.GetKubeConfigV(); got != "apiVersion: v1\nkind: Config\n" {
+		t.Errorf("Redacted() modified original output, kubeconfig = %s", got)
+	}
+}
@@ -0,0 +1,411 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of WorkerGroup or nil if WorkerGroup is nil.
+func (w *WorkerGroup) DeepCopy() *WorkerGroup {
+	if w == nil {
+		return nil
+	}
+	out := new(WorkerGroup)
+	if w.Name != nil {
+		x := *w.Name
+		out.Name = &x
+	}
+	if w.InstanceType != nil {
+		x := *w.InstanceType
+		out.InstanceType = &x
+	}
+	if w.DesiredSize != nil {
+		x := *w.DesiredSize
+		out.DesiredSize = &x
+	}
+	if w.MinSize != nil {
+		x := *w.MinSize
+		out.MinSize = &x
+	}
+	if w.MaxSize != nil {
+		x := *w.MaxSize
+		out.MaxSize = &x
+	}
+	if w.RootVolumeGbSize != nil {
+		x := *w.RootVolumeGbSize
+		out.RootVolumeGbSize = &x
+	}
+	if w.RootVolumeType != nil {
+		x := *w.RootVolumeType
+		out.RootVolumeType = &x
+	}
+	return out
+}
+
+// Equal reports whether WorkerGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (w *WorkerGroup) Equal(other *WorkerGroup) bool {
+	if w == nil || other == nil {
+		return w == other
+	}
+	if (w.Name == nil) != (other.Name == nil) || w.Name != nil && *w.Name != *other.Name {
+		return false
+	}
+	if (w.InstanceType == nil) != (other.InstanceType == nil) || w.InstanceType != nil && *w.InstanceType != *other.InstanceType {
+		return false
+	}
+	if (w.DesiredSize == nil) != (other.DesiredSize == nil) || w.DesiredSize != nil && *w.DesiredSize != *other.DesiredSize {
+		return false
+	}
+	if (w.MinSize == nil) != (other.MinSize == nil) || w.MinSize != nil && *w.MinSize != *other.MinSize {
+		return false
+	}
+	if (w.MaxSize == nil) != (other.MaxSize == nil) || w.MaxSize != nil && *w.MaxSize != *other.MaxSize {
+		return false
+	}
+	if (w.RootVolumeGbSize == nil) != (other.RootVolumeGbSize == nil) || w.RootVolumeGbSize != nil && *w.RootVolumeGbSize != *other.RootVolumeGbSize {
+		return false
+	}
+	if (w.RootVolumeType == nil) != (other.RootVolumeType == nil) || w.RootVolumeType != nil && *w.RootVolumeType != *other.RootVolumeType {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of RoleMapping or nil if RoleMapping is nil.
+func (r *RoleMapping) DeepCopy() *RoleMapping {
+	if r == nil {
+		return nil
+	}
+	out := new(RoleMapping)
+	if r.RoleArn != nil {
+		x := *r.RoleArn
+		out.RoleArn = &x
+	}
+	if r.Username != nil {
+		x := *r.Username
+		out.Username = &x
+	}
+	if r.Groups != nil {
+		out.Groups = make([]string, len(r.Groups))
+		copy(out.Groups, r.Groups)
+	}
+	return out
+}
+
+// Equal reports whether RoleMapping and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (r *RoleMapping) Equal(other *RoleMapping) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	if (r.RoleArn == nil) != (other.RoleArn == nil) || r.RoleArn != nil && *r.RoleArn != *other.RoleArn {
+		return false
+	}
+	if (r.Username == nil) != (other.Username == nil) || r.Username != nil && *r.Username != *other.Username {
+		return false
+	}
+	if (r.Groups == nil) != (other.Groups == nil) || len(r.Groups) != len(other.Groups) {
+		return false
+	}
+	for i := range r.Groups {
+		if r.Groups[i] != other.Groups[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of UserMapping or nil if UserMapping is nil.
+func (u *UserMapping) DeepCopy() *UserMapping {
+	if u == nil {
+		return nil
+	}
+	out := new(UserMapping)
+	if u.UserArn != nil {
+		x := *u.UserArn
+		out.UserArn = &x
+	}
+	if u.Username != nil {
+		x := *u.Username
+		out.Username = &x
+	}
+	if u.Groups != nil {
+		out.Groups = make([]string, len(u.Groups))
+		copy(out.Groups, u.Groups)
+	}
+	return out
+}
+
+// Equal reports whether UserMapping and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (u *UserMapping) Equal(other *UserMapping) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if (u.UserArn == nil) != (other.UserArn == nil) || u.UserArn != nil && *u.UserArn != *other.UserArn {
+		return false
+	}
+	if (u.Username == nil) != (other.Username == nil) || u.Username != nil && *u.Username != *other.Username {
+		return false
+	}
+	if (u.Groups == nil) != (other.Groups == nil) || len(u.Groups) != len(other.Groups) {
+		return false
+	}
+	for i := range u.Groups {
+		if u.Groups[i] != other.Groups[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Auth or nil if Auth is nil.
+func (a *Auth) DeepCopy() *Auth {
+	if a == nil {
+		return nil
+	}
+	out := new(Auth)
+	if a.ClusterRoleArn != nil {
+		x := *a.ClusterRoleArn
+		out.ClusterRoleArn = &x
+	}
+	if a.WorkersRoleArn != nil {
+		x := *a.WorkersRoleArn
+		out.WorkersRoleArn = &x
+	}
+	if a.MapRoles != nil {
+		out.MapRoles = make([]RoleMapping, len(a.MapRoles))
+		for i := range a.MapRoles {
+			out.MapRoles[i] = *a.MapRoles[i].DeepCopy()
+		}
+	}
+	if a.MapUsers != nil {
+		out.MapUsers = make([]UserMapping, len(a.MapUsers))
+		for i := range a.MapUsers {
+			out.MapUsers[i] = *a.MapUsers[i].DeepCopy()
+		}
+	}
+	if a.MapAccounts != nil {
+		out.MapAccounts = make([]string, len(a.MapAccounts))
+		copy(out.MapAccounts, a.MapAccounts)
+	}
+	return out
+}
+
+// Equal reports whether Auth and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *Auth) Equal(other *Auth) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.ClusterRoleArn == nil) != (other.ClusterRoleArn == nil) || a.ClusterRoleArn != nil && *a.ClusterRoleArn != *other.ClusterRoleArn {
+		return false
+	}
+	if (a.WorkersRoleArn == nil) != (other.WorkersRoleArn == nil) || a.WorkersRoleArn != nil && *a.WorkersRoleArn != *other.WorkersRoleArn {
+		return false
+	}
+	if (a.MapRoles == nil) != (other.MapRoles == nil) || len(a.MapRoles) != len(other.MapRoles) {
+		return false
+	}
+	for i := range a.MapRoles {
+		if !a.MapRoles[i].Equal(&other.MapRoles[i]) {
+			return false
+		}
+	}
+	if (a.MapUsers == nil) != (other.MapUsers == nil) || len(a.MapUsers) != len(other.MapUsers) {
+		return false
+	}
+	for i := range a.MapUsers {
+		if !a.MapUsers[i].Equal(&other.MapUsers[i]) {
+			return false
+		}
+	}
+	if (a.MapAccounts == nil) != (other.MapAccounts == nil) || len(a.MapAccounts) != len(other.MapAccounts) {
+		return false
+	}
+	for i := range a.MapAccounts {
+		if a.MapAccounts[i] != other.MapAccounts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Region != nil {
+		x := *p.Region
+		out.Region = &x
+	}
+	if p.KubernetesVersion != nil {
+		x := *p.KubernetesVersion
+		out.KubernetesVersion = &x
+	}
+	if p.VpcId != nil {
+		x := *p.VpcId
+		out.VpcId = &x
+	}
+	if p.SubnetIds != nil {
+		out.SubnetIds = make([]string, len(p.SubnetIds))
+		copy(out.SubnetIds, p.SubnetIds)
+	}
+	if p.EndpointPrivateAccess != nil {
+		x := *p.EndpointPrivateAccess
+		out.EndpointPrivateAccess = &x
+	}
+	if p.EndpointPublicAccess != nil {
+		x := *p.EndpointPublicAccess
+		out.EndpointPublicAccess = &x
+	}
+	if p.PublicAccessCidrs != nil {
+		out.PublicAccessCidrs = make([]string, len(p.PublicAccessCidrs))
+		copy(out.PublicAccessCidrs, p.PublicAccessCidrs)
+	}
+	if p.WorkerGroups != nil {
+		out.WorkerGroups = make([]WorkerGroup, len(p.WorkerGroups))
+		for i := range p.WorkerGroups {
+			out.WorkerGroups[i] = *p.WorkerGroups[i].DeepCopy()
+		}
+	}
+	out.Auth = p.Auth.DeepCopy()
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Region == nil) != (other.Region == nil) || p.Region != nil && *p.Region != *other.Region {
+		return false
+	}
+	if (p.KubernetesVersion == nil) != (other.KubernetesVersion == nil) || p.KubernetesVersion != nil && *p.KubernetesVersion != *other.KubernetesVersion {
+		return false
+	}
+	if (p.VpcId == nil) != (other.VpcId == nil) || p.VpcId != nil && *p.VpcId != *other.VpcId {
+		return false
+	}
+	if (p.SubnetIds == nil) != (other.SubnetIds == nil) || len(p.SubnetIds) != len(other.SubnetIds) {
+		return false
+	}
+	for i := range p.SubnetIds {
+		if p.SubnetIds[i] != other.SubnetIds[i] {
+			return false
+		}
+	}
+	if (p.EndpointPrivateAccess == nil) != (other.EndpointPrivateAccess == nil) || p.EndpointPrivateAccess != nil && *p.EndpointPrivateAccess != *other.EndpointPrivateAccess {
+		return false
+	}
+	if (p.EndpointPublicAccess == nil) != (other.EndpointPublicAccess == nil) || p.EndpointPublicAccess != nil && *p.EndpointPublicAccess != *other.EndpointPublicAccess {
+		return false
+	}
+	if (p.PublicAccessCidrs == nil) != (other.PublicAccessCidrs == nil) || len(p.PublicAccessCidrs) != len(other.PublicAccessCidrs) {
+		return false
+	}
+	for i := range p.PublicAccessCidrs {
+		if p.PublicAccessCidrs[i] != other.PublicAccessCidrs[i] {
+			return false
+		}
+	}
+	if (p.WorkerGroups == nil) != (other.WorkerGroups == nil) || len(p.WorkerGroups) != len(other.WorkerGroups) {
+		return false
+	}
+	for i := range p.WorkerGroups {
+		if !p.WorkerGroups[i].Equal(&other.WorkerGroups[i]) {
+			return false
+		}
+	}
+	if !p.Auth.Equal(other.Auth) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.ClusterName != nil {
+		x := *o.ClusterName
+		out.ClusterName = &x
+	}
+	if o.Endpoint != nil {
+		x := *o.Endpoint
+		out.Endpoint = &x
+	}
+	if o.KubeConfig != nil {
+		x := *o.KubeConfig
+		out.KubeConfig = &x
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.ClusterName == nil) != (other.ClusterName == nil) || o.ClusterName != nil && *o.ClusterName != *other.ClusterName {
+		return false
+	}
+	if (o.Endpoint == nil) != (other.Endpoint == nil) || o.Endpoint != nil && *o.Endpoint != *other.Endpoint {
+		return false
+	}
+	if (o.KubeConfig == nil) != (other.KubeConfig == nil) || o.KubeConfig != nil && *o.KubeConfig != *other.KubeConfig {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,724 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetGbSize returns GbSize field of DataDisk or nil if DataDisk is nil.
+func (d *DataDisk) GetGbSize() *int {
+	if d == nil {
+		return nil
+	}
+	return d.GbSize
+}
+
+// GetGbSizeV returns value of GbSize field of DataDisk or zero value if either DataDisk or field is nil.
+func (d *DataDisk) GetGbSizeV() int {
+	if d == nil || d.GbSize == nil {
+		return 0
+	}
+	return *d.GbSize
+}
+
+// GetGbSizeOr returns value of GbSize field of DataDisk or def if either DataDisk or field is nil.
+func (d *DataDisk) GetGbSizeOr(def int) int {
+	if d == nil || d.GbSize == nil {
+		return def
+	}
+	return *d.GbSize
+}
+
+// GetStorageType returns StorageType field of DataDisk or nil if DataDisk is nil.
+func (d *DataDisk) GetStorageType() *string {
+	if d == nil {
+		return nil
+	}
+	return d.StorageType
+}
+
+// GetStorageTypeV returns value of StorageType field of DataDisk or zero value if either DataDisk or field is nil.
+func (d *DataDisk) GetStorageTypeV() string {
+	if d == nil || d.StorageType == nil {
+		return ""
+	}
+	return *d.StorageType
+}
+
+// GetStorageTypeOr returns value of StorageType field of DataDisk or def if either DataDisk or field is nil.
+func (d *DataDisk) GetStorageTypeOr(def string) string {
+	if d == nil || d.StorageType == nil {
+		return def
+	}
+	return *d.StorageType
+}
+
+// GetName returns Name field of Subnet or nil if Subnet is nil.
+func (s *Subnet) GetName() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Name
+}
+
+// GetNameV returns value of Name field of Subnet or zero value if either Subnet or field is nil.
+func (s *Subnet) GetNameV() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOr returns value of Name field of Subnet or def if either Subnet or field is nil.
+func (s *Subnet) GetNameOr(def string) string {
+	if s == nil || s.Name == nil {
+		return def
+	}
+	return *s.Name
+}
+
+// GetAddressPrefixes returns AddressPrefixes field of Subnet, nil if Subnet is nil or empty slice if field is nil.
+func (s *Subnet) GetAddressPrefixes() []string {
+	if s == nil {
+		return nil
+	}
+	if len(s.AddressPrefixes) == 0 {
+		return []string{}
+	}
+	return s.AddressPrefixes
+}
+
+// GetPublisher returns Publisher field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetPublisher() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Publisher
+}
+
+// GetPublisherV returns value of Publisher field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetPublisherV() string {
+	if v == nil || v.Publisher == nil {
+		return ""
+	}
+	return *v.Publisher
+}
+
+// GetPublisherOr returns value of Publisher field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetPublisherOr(def string) string {
+	if v == nil || v.Publisher == nil {
+		return def
+	}
+	return *v.Publisher
+}
+
+// GetOffer returns Offer field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetOffer() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Offer
+}
+
+// GetOfferV returns value of Offer field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetOfferV() string {
+	if v == nil || v.Offer == nil {
+		return ""
+	}
+	return *v.Offer
+}
+
+// GetOfferOr returns value of Offer field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetOfferOr(def string) string {
+	if v == nil || v.Offer == nil {
+		return def
+	}
+	return *v.Offer
+}
+
+// GetSku returns Sku field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetSku() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Sku
+}
+
+// GetSkuV returns value of Sku field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetSkuV() string {
+	if v == nil || v.Sku == nil {
+		return ""
+	}
+	return *v.Sku
+}
+
+// GetSkuOr returns value of Sku field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetSkuOr(def string) string {
+	if v == nil || v.Sku == nil {
+		return def
+	}
+	return *v.Sku
+}
+
+// GetVersion returns Version field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetVersion() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Version
+}
+
+// GetVersionV returns value of Version field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetVersionV() string {
+	if v == nil || v.Version == nil {
+		return ""
+	}
+	return *v.Version
+}
+
+// GetVersionOr returns value of Version field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetVersionOr(def string) string {
+	if v == nil || v.Version == nil {
+		return def
+	}
+	return *v.Version
+}
+
+// GetName returns Name field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Name
+}
+
+// GetNameV returns value of Name field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetNameV() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
+// GetNameOr returns value of Name field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetNameOr(def string) string {
+	if v == nil || v.Name == nil {
+		return def
+	}
+	return *v.Name
+}
+
+// GetVmCount returns VmCount field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmCount() *int {
+	if v == nil {
+		return nil
+	}
+	return v.VmCount
+}
+
+// GetVmCountV returns value of VmCount field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmCountV() int {
+	if v == nil || v.VmCount == nil {
+		return 0
+	}
+	return *v.VmCount
+}
+
+// GetVmCountOr returns value of VmCount field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmCountOr(def int) int {
+	if v == nil || v.VmCount == nil {
+		return def
+	}
+	return *v.VmCount
+}
+
+// GetVmSize returns VmSize field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmSize() *string {
+	if v == nil {
+		return nil
+	}
+	return v.VmSize
+}
+
+// GetVmSizeV returns value of VmSize field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmSizeV() string {
+	if v == nil || v.VmSize == nil {
+		return ""
+	}
+	return *v.VmSize
+}
+
+// GetVmSizeOr returns value of VmSize field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmSizeOr(def string) string {
+	if v == nil || v.VmSize == nil {
+		return def
+	}
+	return *v.VmSize
+}
+
+// GetUsePublicIP returns UsePublicIP field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetUsePublicIP() *bool {
+	if v == nil {
+		return nil
+	}
+	return v.UsePublicIP
+}
+
+// GetUsePublicIPV returns value of UsePublicIP field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetUsePublicIPV() bool {
+	if v == nil || v.UsePublicIP == nil {
+		return false
+	}
+	return *v.UsePublicIP
+}
+
+// GetUsePublicIPOr returns value of UsePublicIP field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetUsePublicIPOr(def bool) bool {
+	if v == nil || v.UsePublicIP == nil {
+		return def
+	}
+	return *v.UsePublicIP
+}
+
+// GetSubnetNames returns SubnetNames field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetSubnetNames() []string {
+	if v == nil {
+		return nil
+	}
+	if len(v.SubnetNames) == 0 {
+		return []string{}
+	}
+	return v.SubnetNames
+}
+
+// GetVmImage returns VmImage field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmImage() *VmImage {
+	if v == nil {
+		return nil
+	}
+	return v.VmImage
+}
+
+// GetVmImageV returns value of VmImage field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmImageV() VmImage {
+	if v == nil || v.VmImage == nil {
+		return VmImage{}
+	}
+	return *v.VmImage
+}
+
+// GetVmImageOr returns value of VmImage field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmImageOr(def VmImage) VmImage {
+	if v == nil || v.VmImage == nil {
+		return def
+	}
+	return *v.VmImage
+}
+
+// GetDataDisks returns DataDisks field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetDataDisks() []DataDisk {
+	if v == nil {
+		return nil
+	}
+	if len(v.DataDisks) == 0 {
+		return []DataDisk{}
+	}
+	return v.DataDisks
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetLocation returns Location field of Params or nil if Params is nil.
+func (p *Params) GetLocation() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Location
+}
+
+// GetLocationV returns value of Location field of Params or zero value if either Params or field is nil.
+func (p *Params) GetLocationV() string {
+	if p == nil || p.Location == nil {
+		return ""
+	}
+	return *p.Location
+}
+
+// GetLocationOr returns value of Location field of Params or def if either Params or field is nil.
+func (p *Params) GetLocationOr(def string) string {
+	if p == nil || p.Location == nil {
+		return def
+	}
+	return *p.Location
+}
+
+// GetAddressSpace returns AddressSpace field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetAddressSpace() []string {
+	if p == nil {
+		return nil
+	}
+	if len(p.AddressSpace) == 0 {
+		return []string{}
+	}
+	return p.AddressSpace
+}
+
+// GetSubnets returns Subnets field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetSubnets() []Subnet {
+	if p == nil {
+		return nil
+	}
+	if len(p.Subnets) == 0 {
+		return []Subnet{}
+	}
+	return p.Subnets
+}
+
+// GetVmGroups returns VmGroups field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetVmGroups() []VmGroup {
+	if p == nil {
+		return nil
+	}
+	if len(p.VmGroups) == 0 {
+		return []VmGroup{}
+	}
+	return p.VmGroups
+}
+
+// GetRsaPublicKeyPath returns RsaPublicKeyPath field of Params or nil if Params is nil.
+func (p *Params) GetRsaPublicKeyPath() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathV returns value of RsaPublicKeyPath field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathV() string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return ""
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathOr returns value of RsaPublicKeyPath field of Params or def if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathOr(def string) string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return def
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetSize returns Size field of OutputDataDisk or nil if OutputDataDisk is nil.
+func (o *OutputDataDisk) GetSize() *int {
+	if o == nil {
+		return nil
+	}
+	return o.Size
+}
+
+// GetSizeV returns value of Size field of OutputDataDisk or zero value if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetSizeV() int {
+	if o == nil || o.Size == nil {
+		return 0
+	}
+	return *o.Size
+}
+
+// GetSizeOr returns value of Size field of OutputDataDisk or def if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetSizeOr(def int) int {
+	if o == nil || o.Size == nil {
+		return def
+	}
+	return *o.Size
+}
+
+// GetLun returns Lun field of OutputDataDisk or nil if OutputDataDisk is nil.
+func (o *OutputDataDisk) GetLun() *int {
+	if o == nil {
+		return nil
+	}
+	return o.Lun
+}
+
+// GetLunV returns value of Lun field of OutputDataDisk or zero value if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetLunV() int {
+	if o == nil || o.Lun == nil {
+		return 0
+	}
+	return *o.Lun
+}
+
+// GetLunOr returns value of Lun field of OutputDataDisk or def if either OutputDataDisk or field is nil.
+func (o *OutputDataDisk) GetLunOr(def int) int {
+	if o == nil || o.Lun == nil {
+		return def
+	}
+	return *o.Lun
+}
+
+// GetName returns Name field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetPrivateIps returns PrivateIps field of OutputVm, nil if OutputVm is nil or empty slice if field is nil.
+func (o *OutputVm) GetPrivateIps() []string {
+	if o == nil {
+		return nil
+	}
+	if len(o.PrivateIps) == 0 {
+		return []string{}
+	}
+	return o.PrivateIps
+}
+
+// GetPublicIp returns PublicIp field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetPublicIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PublicIp
+}
+
+// GetPublicIpV returns value of PublicIp field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetPublicIpV() string {
+	if o == nil || o.PublicIp == nil {
+		return ""
+	}
+	return *o.PublicIp
+}
+
+// GetPublicIpOr returns value of PublicIp field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetPublicIpOr(def string) string {
+	if o == nil || o.PublicIp == nil {
+		return def
+	}
+	return *o.PublicIp
+}
+
+// GetDataDisks returns DataDisks field of OutputVm, nil if OutputVm is nil or empty slice if field is nil.
+func (o *OutputVm) GetDataDisks() []OutputDataDisk {
+	if o == nil {
+		return nil
+	}
+	if len(o.DataDisks) == 0 {
+		return []OutputDataDisk{}
+	}
+	return o.DataDisks
+}
+
+// GetName returns Name field of OutputVmGroup or nil if OutputVmGroup is nil.
+func (o *OutputVmGroup) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputVmGroup or zero value if either OutputVmGroup or field is nil.
+func (o *OutputVmGroup) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputVmGroup or def if either OutputVmGroup or field is nil.
+func (o *OutputVmGroup) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetVms returns Vms field of OutputVmGroup, nil if OutputVmGroup is nil or empty slice if field is nil.
+func (o *OutputVmGroup) GetVms() []OutputVm {
+	if o == nil {
+		return nil
+	}
+	if len(o.Vms) == 0 {
+		return []OutputVm{}
+	}
+	return o.Vms
+}
+
+// GetRgName returns RgName field of Output or nil if Output is nil.
+func (o *Output) GetRgName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.RgName
+}
+
+// GetRgNameV returns value of RgName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetRgNameV() string {
+	if o == nil || o.RgName == nil {
+		return ""
+	}
+	return *o.RgName
+}
+
+// GetRgNameOr returns value of RgName field of Output or def if either Output or field is nil.
+func (o *Output) GetRgNameOr(def string) string {
+	if o == nil || o.RgName == nil {
+		return def
+	}
+	return *o.RgName
+}
+
+// GetVnetName returns VnetName field of Output or nil if Output is nil.
+func (o *Output) GetVnetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.VnetName
+}
+
+// GetVnetNameV returns value of VnetName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetVnetNameV() string {
+	if o == nil || o.VnetName == nil {
+		return ""
+	}
+	return *o.VnetName
+}
+
+// GetVnetNameOr returns value of VnetName field of Output or def if either Output or field is nil.
+func (o *Output) GetVnetNameOr(def string) string {
+	if o == nil || o.VnetName == nil {
+		return def
+	}
+	return *o.VnetName
+}
+
+// GetVmGroups returns VmGroups field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetVmGroups() []OutputVmGroup {
+	if o == nil {
+		return nil
+	}
+	if len(o.VmGroups) == 0 {
+		return []OutputVmGroup{}
+	}
+	return o.VmGroups
+}
@@ -0,0 +1,734 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDataDisk_Accessors(t *testing.T) {
+	var nilStruct *DataDisk
+	emptyStruct := &DataDisk{}
+	t.Run("GbSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &DataDisk{GbSize: &v}
+		if nilStruct.GetGbSize() != nil || emptyStruct.GetGbSize() != nil {
+			t.Error("GetGbSize() expected to return nil")
+		}
+		if fullStruct.GetGbSize() != &v {
+			t.Error("GetGbSize() expected to return field")
+		}
+		if nilStruct.GetGbSizeV() != 0 || emptyStruct.GetGbSizeV() != 0 {
+			t.Error("GetGbSizeV() expected to return zero value")
+		}
+		if fullStruct.GetGbSizeV() != v {
+			t.Error("GetGbSizeV() expected to return field value")
+		}
+		if nilStruct.GetGbSizeOr(v) != v || emptyStruct.GetGbSizeOr(v) != v {
+			t.Error("GetGbSizeOr() expected to return default value")
+		}
+		if fullStruct.GetGbSizeOr(0) != v {
+			t.Error("GetGbSizeOr() expected to return field value")
+		}
+	})
+	t.Run("StorageType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &DataDisk{StorageType: &v}
+		if nilStruct.GetStorageType() != nil || emptyStruct.GetStorageType() != nil {
+			t.Error("GetStorageType() expected to return nil")
+		}
+		if fullStruct.GetStorageType() != &v {
+			t.Error("GetStorageType() expected to return field")
+		}
+		if nilStruct.GetStorageTypeV() != "" || emptyStruct.GetStorageTypeV() != "" {
+			t.Error("GetStorageTypeV() expected to return zero value")
+		}
+		if fullStruct.GetStorageTypeV() != v {
+			t.Error("GetStorageTypeV() expected to return field value")
+		}
+		if nilStruct.GetStorageTypeOr(v) != v || emptyStruct.GetStorageTypeOr(v) != v {
+			t.Error("GetStorageTypeOr() expected to return default value")
+		}
+		if fullStruct.GetStorageTypeOr("") != v {
+			t.Error("GetStorageTypeOr() expected to return field value")
+		}
+	})
+}
+
+func TestSubnet_Accessors(t *testing.T) {
+	var nilStruct *Subnet
+	emptyStruct := &Subnet{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Subnet{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("AddressPrefixes", func(t *testing.T) {
+		fullStruct := &Subnet{AddressPrefixes: make([]string, 1)}
+		if nilStruct.GetAddressPrefixes() != nil {
+			t.Error("GetAddressPrefixes() expected to return nil")
+		}
+		if got := emptyStruct.GetAddressPrefixes(); got == nil || len(got) != 0 {
+			t.Error("GetAddressPrefixes() expected to return empty slice")
+		}
+		if got := fullStruct.GetAddressPrefixes(); len(got) != 1 || &got[0] != &fullStruct.AddressPrefixes[0] {
+			t.Error("GetAddressPrefixes() expected to return field")
+		}
+	})
+}
+
+func TestVmImage_Accessors(t *testing.T) {
+	var nilStruct *VmImage
+	emptyStruct := &VmImage{}
+	t.Run("Publisher", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{Publisher: &v}
+		if nilStruct.GetPublisher() != nil || emptyStruct.GetPublisher() != nil {
+			t.Error("GetPublisher() expected to return nil")
+		}
+		if fullStruct.GetPublisher() != &v {
+			t.Error("GetPublisher() expected to return field")
+		}
+		if nilStruct.GetPublisherV() != "" || emptyStruct.GetPublisherV() != "" {
+			t.Error("GetPublisherV() expected to return zero value")
+		}
+		if fullStruct.GetPublisherV() != v {
+			t.Error("GetPublisherV() expected to return field value")
+		}
+		if nilStruct.GetPublisherOr(v) != v || emptyStruct.GetPublisherOr(v) != v {
+			t.Error("GetPublisherOr() expected to return default value")
+		}
+		if fullStruct.GetPublisherOr("") != v {
+			t.Error("GetPublisherOr() expected to return field value")
+		}
+	})
+	t.Run("Offer", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{Offer: &v}
+		if nilStruct.GetOffer() != nil || emptyStruct.GetOffer() != nil {
+			t.Error("GetOffer() expected to return nil")
+		}
+		if fullStruct.GetOffer() != &v {
+			t.Error("GetOffer() expected to return field")
+		}
+		if nilStruct.GetOfferV() != "" || emptyStruct.GetOfferV() != "" {
+			t.Error("GetOfferV() expected to return zero value")
+		}
+		if fullStruct.GetOfferV() != v {
+			t.Error("GetOfferV() expected to return field value")
+		}
+		if nilStruct.GetOfferOr(v) != v || emptyStruct.GetOfferOr(v) != v {
+			t.Error("GetOfferOr() expected to return default value")
+		}
+		if fullStruct.GetOfferOr("") != v {
+			t.Error("GetOfferOr() expected to return field value")
+		}
+	})
+	t.Run("Sku", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{Sku: &v}
+		if nilStruct.GetSku() != nil || emptyStruct.GetSku() != nil {
+			t.Error("GetSku() expected to return nil")
+		}
+		if fullStruct.GetSku() != &v {
+			t.Error("GetSku() expected to return field")
+		}
+		if nilStruct.GetSkuV() != "" || emptyStruct.GetSkuV() != "" {
+			t.Error("GetSkuV() expected to return zero value")
+		}
+		if fullStruct.GetSkuV() != v {
+			t.Error("GetSkuV() expected to return field value")
+		}
+		if nilStruct.GetSkuOr(v) != v || emptyStruct.GetSkuOr(v) != v {
+			t.Error("GetSkuOr() expected to return default value")
+		}
+		if fullStruct.GetSkuOr("") != v {
+			t.Error("GetSkuOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+}
+
+func TestVmGroup_Accessors(t *testing.T) {
+	var nilStruct *VmGroup
+	emptyStruct := &VmGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VmCount", func(t *testing.T) {
+		v := 1
+		fullStruct := &VmGroup{VmCount: &v}
+		if nilStruct.GetVmCount() != nil || emptyStruct.GetVmCount() != nil {
+			t.Error("GetVmCount() expected to return nil")
+		}
+		if fullStruct.GetVmCount() != &v {
+			t.Error("GetVmCount() expected to return field")
+		}
+		if nilStruct.GetVmCountV() != 0 || emptyStruct.GetVmCountV() != 0 {
+			t.Error("GetVmCountV() expected to return zero value")
+		}
+		if fullStruct.GetVmCountV() != v {
+			t.Error("GetVmCountV() expected to return field value")
+		}
+		if nilStruct.GetVmCountOr(v) != v || emptyStruct.GetVmCountOr(v) != v {
+			t.Error("GetVmCountOr() expected to return default value")
+		}
+		if fullStruct.GetVmCountOr(0) != v {
+			t.Error("GetVmCountOr() expected to return field value")
+		}
+	})
+	t.Run("VmSize", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{VmSize: &v}
+		if nilStruct.GetVmSize() != nil || emptyStruct.GetVmSize() != nil {
+			t.Error("GetVmSize() expected to return nil")
+		}
+		if fullStruct.GetVmSize() != &v {
+			t.Error("GetVmSize() expected to return field")
+		}
+		if nilStruct.GetVmSizeV() != "" || emptyStruct.GetVmSizeV() != "" {
+			t.Error("GetVmSizeV() expected to return zero value")
+		}
+		if fullStruct.GetVmSizeV() != v {
+			t.Error("GetVmSizeV() expected to return field value")
+		}
+		if nilStruct.GetVmSizeOr(v) != v || emptyStruct.GetVmSizeOr(v) != v {
+			t.Error("GetVmSizeOr() expected to return default value")
+		}
+		if fullStruct.GetVmSizeOr("") != v {
+			t.Error("GetVmSizeOr() expected to return field value")
+		}
+	})
+	t.Run("UsePublicIP", func(t *testing.T) {
+		v := true
+		fullStruct := &VmGroup{UsePublicIP: &v}
+		if nilStruct.GetUsePublicIP() != nil || emptyStruct.GetUsePublicIP() != nil {
+			t.Error("GetUsePublicIP() expected to return nil")
+		}
+		if fullStruct.GetUsePublicIP() != &v {
+			t.Error("GetUsePublicIP() expected to return field")
+		}
+		if nilStruct.GetUsePublicIPV() != false || emptyStruct.GetUsePublicIPV() != false {
+			t.Error("GetUsePublicIPV() expected to return zero value")
+		}
+		if fullStruct.GetUsePublicIPV() != v {
+			t.Error("GetUsePublicIPV() expected to return field value")
+		}
+		if nilStruct.GetUsePublicIPOr(v) != v || emptyStruct.GetUsePublicIPOr(v) != v {
+			t.Error("GetUsePublicIPOr() expected to return default value")
+		}
+		if fullStruct.GetUsePublicIPOr(false) != v {
+			t.Error("GetUsePublicIPOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetNames", func(t *testing.T) {
+		fullStruct := &VmGroup{SubnetNames: make([]string, 1)}
+		if nilStruct.GetSubnetNames() != nil {
+			t.Error("GetSubnetNames() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnetNames(); got == nil || len(got) != 0 {
+			t.Error("GetSubnetNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnetNames(); len(got) != 1 || &got[0] != &fullStruct.SubnetNames[0] {
+			t.Error("GetSubnetNames() expected to return field")
+		}
+	})
+	t.Run("VmImage", func(t *testing.T) {
+		v := VmImage{}
+		fullStruct := &VmGroup{VmImage: &v}
+		if nilStruct.GetVmImage() != nil || emptyStruct.GetVmImage() != nil {
+			t.Error("GetVmImage() expected to return nil")
+		}
+		if fullStruct.GetVmImage() != &v {
+			t.Error("GetVmImage() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetVmImageV(), VmImage{}) || !reflect.DeepEqual(emptyStruct.GetVmImageV(), VmImage{}) {
+			t.Error("GetVmImageV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetVmImageV(), v) {
+			t.Error("GetVmImageV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetVmImageOr(v), v) || !reflect.DeepEqual(emptyStruct.GetVmImageOr(v), v) {
+			t.Error("GetVmImageOr() expected to return default value")
+		}
+	})
+	t.Run("DataDisks", func(t *testing.T) {
+		fullStruct := &VmGroup{DataDisks: make([]DataDisk, 1)}
+		if nilStruct.GetDataDisks() != nil {
+			t.Error("GetDataDisks() expected to return nil")
+		}
+		if got := emptyStruct.GetDataDisks(); got == nil || len(got) != 0 {
+			t.Error("GetDataDisks() expected to return empty slice")
+		}
+		if got := fullStruct.GetDataDisks(); len(got) != 1 || &got[0] != &fullStruct.DataDisks[0] {
+			t.Error("GetDataDisks() expected to return field")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Location", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Location: &v}
+		if nilStruct.GetLocation() != nil || emptyStruct.GetLocation() != nil {
+			t.Error("GetLocation() expected to return nil")
+		}
+		if fullStruct.GetLocation() != &v {
+			t.Error("GetLocation() expected to return field")
+		}
+		if nilStruct.GetLocationV() != "" || emptyStruct.GetLocationV() != "" {
+			t.Error("GetLocationV() expected to return zero value")
+		}
+		if fullStruct.GetLocationV() != v {
+			t.Error("GetLocationV() expected to return field value")
+		}
+		if nilStruct.GetLocationOr(v) != v || emptyStruct.GetLocationOr(v) != v {
+			t.Error("GetLocationOr() expected to return default value")
+		}
+		if fullStruct.GetLocationOr("") != v {
+			t.Error("GetLocationOr() expected to return field value")
+		}
+	})
+	t.Run("AddressSpace", func(t *testing.T) {
+		fullStruct := &Params{AddressSpace: make([]string, 1)}
+		if nilStruct.GetAddressSpace() != nil {
+			t.Error("GetAddressSpace() expected to return nil")
+		}
+		if got := emptyStruct.GetAddressSpace(); got == nil || len(got) != 0 {
+			t.Error("GetAddressSpace() expected to return empty slice")
+		}
+		if got := fullStruct.GetAddressSpace(); len(got) != 1 || &got[0] != &fullStruct.AddressSpace[0] {
+			t.Error("GetAddressSpace() expected to return field")
+		}
+	})
+	t.Run("Subnets", func(t *testing.T) {
+		fullStruct := &Params{Subnets: make([]Subnet, 1)}
+		if nilStruct.GetSubnets() != nil {
+			t.Error("GetSubnets() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnets(); got == nil || len(got) != 0 {
+			t.Error("GetSubnets() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnets(); len(got) != 1 || &got[0] != &fullStruct.Subnets[0] {
+			t.Error("GetSubnets() expected to return field")
+		}
+	})
+	t.Run("VmGroups", func(t *testing.T) {
+		fullStruct := &Params{VmGroups: make([]VmGroup, 1)}
+		if nilStruct.GetVmGroups() != nil {
+			t.Error("GetVmGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroups(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroups(); len(got) != 1 || &got[0] != &fullStruct.VmGroups[0] {
+			t.Error("GetVmGroups() expected to return field")
+		}
+	})
+	t.Run("RsaPublicKeyPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RsaPublicKeyPath: &v}
+		if nilStruct.GetRsaPublicKeyPath() != nil || emptyStruct.GetRsaPublicKeyPath() != nil {
+			t.Error("GetRsaPublicKeyPath() expected to return nil")
+		}
+		if fullStruct.GetRsaPublicKeyPath() != &v {
+			t.Error("GetRsaPublicKeyPath() expected to return field")
+		}
+		if nilStruct.GetRsaPublicKeyPathV() != "" || emptyStruct.GetRsaPublicKeyPathV() != "" {
+			t.Error("GetRsaPublicKeyPathV() expected to return zero value")
+		}
+		if fullStruct.GetRsaPublicKeyPathV() != v {
+			t.Error("GetRsaPublicKeyPathV() expected to return field value")
+		}
+		if nilStruct.GetRsaPublicKeyPathOr(v) != v || emptyStruct.GetRsaPublicKeyPathOr(v) != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return default value")
+		}
+		if fullStruct.GetRsaPublicKeyPathOr("") != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return field value")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputDataDisk_Accessors(t *testing.T) {
+	var nilStruct *OutputDataDisk
+	emptyStruct := &OutputDataDisk{}
+	t.Run("Size", func(t *testing.T) {
+		v := 1
+		fullStruct := &OutputDataDisk{Size: &v}
+		if nilStruct.GetSize() != nil || emptyStruct.GetSize() != nil {
+			t.Error("GetSize() expected to return nil")
+		}
+		if fullStruct.GetSize() != &v {
+			t.Error("GetSize() expected to return field")
+		}
+		if nilStruct.GetSizeV() != 0 || emptyStruct.GetSizeV() != 0 {
+			t.Error("GetSizeV() expected to return zero value")
+		}
+		if fullStruct.GetSizeV() != v {
+			t.Error("GetSizeV() expected to return field value")
+		}
+		if nilStruct.GetSizeOr(v) != v || emptyStruct.GetSizeOr(v) != v {
+			t.Error("GetSizeOr() expected to return default value")
+		}
+		if fullStruct.GetSizeOr(0) != v {
+			t.Error("GetSizeOr() expected to return field value")
+		}
+	})
+	t.Run("Lun", func(t *testing.T) {
+		v := 1
+		fullStruct := &OutputDataDisk{Lun: &v}
+		if nilStruct.GetLun() != nil || emptyStruct.GetLun() != nil {
+			t.Error("GetLun() expected to return nil")
+		}
+		if fullStruct.GetLun() != &v {
+			t.Error("GetLun() expected to return field")
+		}
+		if nilStruct.GetLunV() != 0 || emptyStruct.GetLunV() != 0 {
+			t.Error("GetLunV() expected to return zero value")
+		}
+		if fullStruct.GetLunV() != v {
+			t.Error("GetLunV() expected to return field value")
+		}
+		if nilStruct.GetLunOr(v) != v || emptyStruct.GetLunOr(v) != v {
+			t.Error("GetLunOr() expected to return default value")
+		}
+		if fullStruct.GetLunOr(0) != v {
+			t.Error("GetLunOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutputVm_Accessors(t *testing.T) {
+	var nilStruct *OutputVm
+	emptyStruct := &OutputVm{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("PrivateIps", func(t *testing.T) {
+		fullStruct := &OutputVm{PrivateIps: make([]string, 1)}
+		if nilStruct.GetPrivateIps() != nil {
+			t.Error("GetPrivateIps() expected to return nil")
+		}
+		if got := emptyStruct.GetPrivateIps(); got == nil || len(got) != 0 {
+			t.Error("GetPrivateIps() expected to return empty slice")
+		}
+		if got := fullStruct.GetPrivateIps(); len(got) != 1 || &got[0] != &fullStruct.PrivateIps[0] {
+			t.Error("GetPrivateIps() expected to return field")
+		}
+	})
+	t.Run("PublicIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{PublicIp: &v}
+		if nilStruct.GetPublicIp() != nil || emptyStruct.GetPublicIp() != nil {
+			t.Error("GetPublicIp() expected to return nil")
+		}
+		if fullStruct.GetPublicIp() != &v {
+			t.Error("GetPublicIp() expected to return field")
+		}
+		if nilStruct.GetPublicIpV() != "" || emptyStruct.GetPublicIpV() != "" {
+			t.Error("GetPublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetPublicIpV() != v {
+			t.Error("GetPublicIpV() expected to return field value")
+		}
+		if nilStruct.GetPublicIpOr(v) != v || emptyStruct.GetPublicIpOr(v) != v {
+			t.Error("GetPublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetPublicIpOr("") != v {
+			t.Error("GetPublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("DataDisks", func(t *testing.T) {
+		fullStruct := &OutputVm{DataDisks: make([]OutputDataDisk, 1)}
+		if nilStruct.GetDataDisks() != nil {
+			t.Error("GetDataDisks() expected to return nil")
+		}
+		if got := emptyStruct.GetDataDisks(); got == nil || len(got) != 0 {
+			t.Error("GetDataDisks() expected to return empty slice")
+		}
+		if got := fullStruct.GetDataDisks(); len(got) != 1 || &got[0] != &fullStruct.DataDisks[0] {
+			t.Error("GetDataDisks() expected to return field")
+		}
+	})
+}
+
+func TestOutputVmGroup_Accessors(t *testing.T) {
+	var nilStruct *OutputVmGroup
+	emptyStruct := &OutputVmGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVmGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Vms", func(t *testing.T) {
+		fullStruct := &OutputVmGroup{Vms: make([]OutputVm, 1)}
+		if nilStruct.GetVms() != nil {
+			t.Error("GetVms() expected to return nil")
+		}
+		if got := emptyStruct.GetVms(); got == nil || len(got) != 0 {
+			t.Error("GetVms() expected to return empty slice")
+		}
+		if got := fullStruct.GetVms(); len(got) != 1 || &got[0] != &fullStruct.Vms[0] {
+			t.Error("GetVms() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("RgName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{RgName: &v}
+		if nilStruct.GetRgName() != nil || emptyStruct.GetRgName() != nil {
+			t.Error("GetRgName() expected to return nil")
+		}
+		if fullStruct.GetRgName() != &v {
+			t.Error("GetRgName() expected to return field")
+		}
+		if nilStruct.GetRgNameV() != "" || emptyStruct.GetRgNameV() != "" {
+			t.Error("GetRgNameV() expected to return zero value")
+		}
+		if fullStruct.GetRgNameV() != v {
+			t.Error("GetRgNameV() expected to return field value")
+		}
+		if nilStruct.GetRgNameOr(v) != v || emptyStruct.GetRgNameOr(v) != v {
+			t.Error("GetRgNameOr() expected to return default value")
+		}
+		if fullStruct.GetRgNameOr("") != v {
+			t.Error("GetRgNameOr() expected to return field value")
+		}
+	})
+	t.Run("VnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{VnetName: &v}
+		if nilStruct.GetVnetName() != nil || emptyStruct.GetVnetName() != nil {
+			t.Error("GetVnetName() expected to return nil")
+		}
+		if fullStruct.GetVnetName() != &v {
+			t.Error("GetVnetName() expected to return field")
+		}
+		if nilStruct.GetVnetNameV() != "" || emptyStruct.GetVnetNameV() != "" {
+			t.Error("GetVnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetVnetNameV() != v {
+			t.Error("GetVnetNameV() expected to return field value")
+		}
+		if nilStruct.GetVnetNameOr(v) != v || emptyStruct.GetVnetNameOr(v) != v {
+			t.Error("GetVnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetVnetNameOr("") != v {
+			t.Error("GetVnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VmGroups", func(t *testing.T) {
+		fullStruct := &Output{VmGroups: make([]OutputVmGroup, 1)}
+		if nilStruct.GetVmGroups() != nil {
+			t.Error("GetVmGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroups(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroups(); len(got) != 1 || &got[0] != &fullStruct.VmGroups[0] {
+			t.Error("GetVmGroups() expected to return field")
+		}
+	})
+}
@@ -1,10 +1,16 @@
 package v0
 
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
 import (
 	"encoding/json"
 	"errors"
 	"fmt"
 
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
 	"github.com/epiphany-platform/e-structures/utils/to"
 	"github.com/epiphany-platform/e-structures/utils/validators"
 	"github.com/go-playground/validator/v10"
@@ -52,25 +58,9 @@ type Params struct {
 	RsaPublicKeyPath *string   `json:"rsa_pub_path" validate:"required,min=1"`
 }
 
+// Deprecated: use GetRsaPublicKeyPathV.
 func (p *Params) GetRsaPublicKeyV() string {
-	if p == nil {
-		return ""
-	}
-	return *p.RsaPublicKeyPath
-}
-
-func (p *Params) GetNameV() string {
-	if p == nil {
-		return ""
-	}
-	return *p.Name
-}
-
-func (p *Params) GetLocationV() string {
-	if p == nil {
-		return ""
-	}
-	return *p.Location
+	return p.GetRsaPublicKeyPathV()
 }
 
 // ExtractEmptySubnets gets params and extracts from it list of Subnet unassigned to any of VmGroup.
@@ -117,13 +107,6 @@ type Config struct {
 	Unused  []string `json:"-"`
 }
 
-func (c *Config) GetParams() *Params {
-	if c == nil {
-		return nil
-	}
-	return c.Params
-}
-
 //TODO test
 func NewConfig() *Config {
 	return &Config{
@@ -174,7 +157,40 @@ func (c *Config) Marshal() ([]byte, error) {
 	return json.MarshalIndent(c, "", "\t")
 }
 
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
 func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
 	var input map[string]interface{}
 	if err = json.Unmarshal(b, &input); err != nil {
 		return
@@ -193,7 +209,6 @@ func (c *Config) Unmarshal(b []byte) (err error) {
 		return
 	}
 	c.Unused = md.Unused
-	err = c.isValid()
 	return
 }
 
@@ -219,40 +234,20 @@ func (c *Config) isValid() error {
 }
 
 type OutputDataDisk struct {
-	Size *int `json:"size"`
-	Lun  *int `json:"lun"`
+	Size *int `json:"size" validate:"required,min=1"`
+	Lun  *int `json:"lun" validate:"required,min=0,max=63"` // https://docs.microsoft.com/en-us/azure/virtual-machines/linux/add-disk
 }
 
 type OutputVm struct {
-	Name       *string          `json:"vm_name"`
-	PrivateIps []string         `json:"private_ips"`
-	PublicIp   *string          `json:"public_ip"`
-	DataDisks  []OutputDataDisk `json:"data_disks"`
-}
-
-func (v *OutputVm) GetDataDisks() []OutputDataDisk {
-	if v == nil {
-		return nil
-	}
-	if v.DataDisks == nil || len(v.DataDisks) == 0 {
-		return []OutputDataDisk{}
-	}
-	return v.DataDisks
+	Name       *string          `json:"vm_name" validate:"required,min=1"`
+	PrivateIps []string         `json:"private_ips" validate:"omitempty,dive,required,ip"`
+	PublicIp   *string          `json:"public_ip" validate:"omitempty,eq=|ip"`
+	DataDisks  []OutputDataDisk `json:"data_disks" validate:"omitempty,dive"`
 }
 
 type OutputVmGroup struct {
-	Name *string    `json:"vm_group_name"`
-	Vms  []OutputVm `json:"vms"`
-}
-
-func (g *OutputVmGroup) GetVms() []OutputVm {
-	if g == nil {
-		return nil
-	}
-	if g.Vms == nil || len(g.Vms) == 0 {
-		return []OutputVm{}
-	}
-	return g.Vms
+	Name *string    `json:"vm_group_name" validate:"required,min=1"`
+	Vms  []OutputVm `json:"vms" validate:"omitempty,dive"`
 }
 
 func (g *OutputVmGroup) GetFirstVm() *OutputVm {
@@ -266,33 +261,14 @@ func (g *OutputVmGroup) GetFirstVm() *OutputVm {
 }
 
 type Output struct {
-	RgName   *string         `json:"rg_name"`
-	VnetName *string         `json:"vnet_name"`
-	VmGroups []OutputVmGroup `json:"vm_groups"`
-}
-
-func (o *Output) GetRgNameV() string {
-	if o == nil {
-		return ""
-	}
-	return *o.RgName
+	RgName   *string         `json:"rg_name" validate:"required,min=1"`
+	VnetName *string         `json:"vnet_name" validate:"required,min=1"`
+	VmGroups []OutputVmGroup `json:"vm_groups" validate:"omitempty,dive"`
 }
 
-func (o *Output) GetVnetNameV() string {
-	if o == nil {
-		return ""
-	}
-	return *o.VnetName
-}
-
-func (o *Output) GetVmGroups() []OutputVmGroup {
-	if o == nil {
-		return nil
-	}
-	if o.VmGroups == nil || len(o.VmGroups) == 0 {
-		return []OutputVmGroup{}
-	}
-	return o.VmGroups
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
 }
 
 func AzBISubnetsValidation(sl validator.StructLevel) {
@@ -328,3 +304,23 @@ func AzBISubnetsValidation(sl validator.StructLevel) {
 		}
 	}
 }
+
+// AzBIOutputVmValidation checks that data disks attached to single OutputVm do not share LUN.
+func AzBIOutputVmValidation(sl validator.StructLevel) {
+	vm := sl.Current().Interface().(OutputVm)
+	luns := make(map[int]bool)
+	for i, dd := range vm.DataDisks {
+		if dd.Lun == nil {
+			continue
+		}
+		if luns[*dd.Lun] {
+			sl.ReportError(
+				vm.DataDisks[i].Lun,
+				fmt.Sprintf("DataDisks[%d].Lun", i),
+				"Lun",
+				"unique",
+				"")
+		}
+		luns[*dd.Lun] = true
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/epiphany-platform/e-structures/utils/strict"
 	"github.com/epiphany-platform/e-structures/utils/test"
 	"github.com/epiphany-platform/e-structures/utils/to"
 	"github.com/go-playground/validator/v10"
@@ -2492,3 +2493,113 @@ func TestParams_ExtractEmptySubnets(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_Fingerprint(t *testing.T) {
+	a := &Config{}
+	err := a.Unmarshal([]byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": []
+	}
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Config{}
+	err = b.Unmarshal([]byte(`{
+	"params": {"vm_groups": [], "rsa_pub_path": "/shared/vms_rsa.pub", "location": "northeurope", "name": "epiphany", "extra": 1},
+	"version": "v0.1.4",
+	"kind": "azbi"
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fa, err := a.Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fb, err := b.Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fa != fb {
+		t.Errorf("Fingerprint() expected to be equal for configs differing only in keys order and unused keys, got %s and %s", fa, fb)
+	}
+	b.Params.Location = to.StrPtr("westeurope")
+	fb, err = b.Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fa == fb {
+		t.Error("Fingerprint() expected to differ for different configs")
+	}
+}
+
+func TestConfig_UnmarshalStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		wantErr error
+	}{
+		{
+			name: "no unknown keys",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": []
+	}
+}`),
+			wantErr: nil,
+		},
+		{
+			name: "typo reported instead of validation error",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": [
+			{
+				"name": "vm-group0",
+				"vm_cont": 1,
+				"vm_size": "Standard_DS2_v2",
+				"use_public_ip": true,
+				"vm_image": {
+					"publisher": "Canonical",
+					"offer": "UbuntuServer",
+					"sku": "18.04-LTS",
+					"version": "18.04.202006101"
+				},
+				"data_disks": []
+			}
+		]
+	}
+}`),
+			wantErr: strict.UnknownKeysError{
+				{
+					Key:        "params.vm_groups[0].vm_cont",
+					Suggestion: "vm_count",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &Config{}
+			err := got.UnmarshalStrict(tt.json)
+			if diff := cmp.Diff(tt.wantErr, err); diff != "" {
+				t.Errorf("UnmarshalStrict() error mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,488 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of DataDisk or nil if DataDisk is nil.
+func (d *DataDisk) DeepCopy() *DataDisk {
+	if d == nil {
+		return nil
+	}
+	out := new(DataDisk)
+	if d.GbSize != nil {
+		x := *d.GbSize
+		out.GbSize = &x
+	}
+	if d.StorageType != nil {
+		x := *d.StorageType
+		out.StorageType = &x
+	}
+	return out
+}
+
+// Equal reports whether DataDisk and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (d *DataDisk) Equal(other *DataDisk) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if (d.GbSize == nil) != (other.GbSize == nil) || d.GbSize != nil && *d.GbSize != *other.GbSize {
+		return false
+	}
+	if (d.StorageType == nil) != (other.StorageType == nil) || d.StorageType != nil && *d.StorageType != *other.StorageType {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Subnet or nil if Subnet is nil.
+func (s *Subnet) DeepCopy() *Subnet {
+	if s == nil {
+		return nil
+	}
+	out := new(Subnet)
+	if s.Name != nil {
+		x := *s.Name
+		out.Name = &x
+	}
+	if s.AddressPrefixes != nil {
+		out.AddressPrefixes = make([]string, len(s.AddressPrefixes))
+		copy(out.AddressPrefixes, s.AddressPrefixes)
+	}
+	return out
+}
+
+// Equal reports whether Subnet and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *Subnet) Equal(other *Subnet) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Name == nil) != (other.Name == nil) || s.Name != nil && *s.Name != *other.Name {
+		return false
+	}
+	if (s.AddressPrefixes == nil) != (other.AddressPrefixes == nil) || len(s.AddressPrefixes) != len(other.AddressPrefixes) {
+		return false
+	}
+	for i := range s.AddressPrefixes {
+		if s.AddressPrefixes[i] != other.AddressPrefixes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmImage or nil if VmImage is nil.
+func (v *VmImage) DeepCopy() *VmImage {
+	if v == nil {
+		return nil
+	}
+	out := new(VmImage)
+	if v.Publisher != nil {
+		x := *v.Publisher
+		out.Publisher = &x
+	}
+	if v.Offer != nil {
+		x := *v.Offer
+		out.Offer = &x
+	}
+	if v.Sku != nil {
+		x := *v.Sku
+		out.Sku = &x
+	}
+	if v.Version != nil {
+		x := *v.Version
+		out.Version = &x
+	}
+	return out
+}
+
+// Equal reports whether VmImage and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmImage) Equal(other *VmImage) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Publisher == nil) != (other.Publisher == nil) || v.Publisher != nil && *v.Publisher != *other.Publisher {
+		return false
+	}
+	if (v.Offer == nil) != (other.Offer == nil) || v.Offer != nil && *v.Offer != *other.Offer {
+		return false
+	}
+	if (v.Sku == nil) != (other.Sku == nil) || v.Sku != nil && *v.Sku != *other.Sku {
+		return false
+	}
+	if (v.Version == nil) != (other.Version == nil) || v.Version != nil && *v.Version != *other.Version {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) DeepCopy() *VmGroup {
+	if v == nil {
+		return nil
+	}
+	out := new(VmGroup)
+	if v.Name != nil {
+		x := *v.Name
+		out.Name = &x
+	}
+	if v.VmCount != nil {
+		x := *v.VmCount
+		out.VmCount = &x
+	}
+	if v.VmSize != nil {
+		x := *v.VmSize
+		out.VmSize = &x
+	}
+	if v.UsePublicIP != nil {
+		x := *v.UsePublicIP
+		out.UsePublicIP = &x
+	}
+	if v.SubnetNames != nil {
+		out.SubnetNames = make([]string, len(v.SubnetNames))
+		copy(out.SubnetNames, v.SubnetNames)
+	}
+	out.VmImage = v.VmImage.DeepCopy()
+	if v.DataDisks != nil {
+		out.DataDisks = make([]DataDisk, len(v.DataDisks))
+		for i := range v.DataDisks {
+			out.DataDisks[i] = *v.DataDisks[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether VmGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmGroup) Equal(other *VmGroup) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Name == nil) != (other.Name == nil) || v.Name != nil && *v.Name != *other.Name {
+		return false
+	}
+	if (v.VmCount == nil) != (other.VmCount == nil) || v.VmCount != nil && *v.VmCount != *other.VmCount {
+		return false
+	}
+	if (v.VmSize == nil) != (other.VmSize == nil) || v.VmSize != nil && *v.VmSize != *other.VmSize {
+		return false
+	}
+	if (v.UsePublicIP == nil) != (other.UsePublicIP == nil) || v.UsePublicIP != nil && *v.UsePublicIP != *other.UsePublicIP {
+		return false
+	}
+	if (v.SubnetNames == nil) != (other.SubnetNames == nil) || len(v.SubnetNames) != len(other.SubnetNames) {
+		return false
+	}
+	for i := range v.SubnetNames {
+		if v.SubnetNames[i] != other.SubnetNames[i] {
+			return false
+		}
+	}
+	if !v.VmImage.Equal(other.VmImage) {
+		return false
+	}
+	if (v.DataDisks == nil) != (other.DataDisks == nil) || len(v.DataDisks) != len(other.DataDisks) {
+		return false
+	}
+	for i := range v.DataDisks {
+		if !v.DataDisks[i].Equal(&other.DataDisks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Location != nil {
+		x := *p.Location
+		out.Location = &x
+	}
+	if p.AddressSpace != nil {
+		out.AddressSpace = make([]string, len(p.AddressSpace))
+		copy(out.AddressSpace, p.AddressSpace)
+	}
+	if p.Subnets != nil {
+		out.Subnets = make([]Subnet, len(p.Subnets))
+		for i := range p.Subnets {
+			out.Subnets[i] = *p.Subnets[i].DeepCopy()
+		}
+	}
+	if p.VmGroups != nil {
+		out.VmGroups = make([]VmGroup, len(p.VmGroups))
+		for i := range p.VmGroups {
+			out.VmGroups[i] = *p.VmGroups[i].DeepCopy()
+		}
+	}
+	if p.RsaPublicKeyPath != nil {
+		x := *p.RsaPublicKeyPath
+		out.RsaPublicKeyPath = &x
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Location == nil) != (other.Location == nil) || p.Location != nil && *p.Location != *other.Location {
+		return false
+	}
+	if (p.AddressSpace == nil) != (other.AddressSpace == nil) || len(p.AddressSpace) != len(other.AddressSpace) {
+		return false
+	}
+	for i := range p.AddressSpace {
+		if p.AddressSpace[i] != other.AddressSpace[i] {
+			return false
+		}
+	}
+	if (p.Subnets == nil) != (other.Subnets == nil) || len(p.Subnets) != len(other.Subnets) {
+		return false
+	}
+	for i := range p.Subnets {
+		if !p.Subnets[i].Equal(&other.Subnets[i]) {
+			return false
+		}
+	}
+	if (p.VmGroups == nil) != (other.VmGroups == nil) || len(p.VmGroups) != len(other.VmGroups) {
+		return false
+	}
+	for i := range p.VmGroups {
+		if !p.VmGroups[i].Equal(&other.VmGroups[i]) {
+			return false
+		}
+	}
+	if (p.RsaPublicKeyPath == nil) != (other.RsaPublicKeyPath == nil) || p.RsaPublicKeyPath != nil && *p.RsaPublicKeyPath != *other.RsaPublicKeyPath {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputDataDisk or nil if OutputDataDisk is nil.
+func (o *OutputDataDisk) DeepCopy() *OutputDataDisk {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputDataDisk)
+	if o.Size != nil {
+		x := *o.Size
+		out.Size = &x
+	}
+	if o.Lun != nil {
+		x := *o.Lun
+		out.Lun = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputDataDisk and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputDataDisk) Equal(other *OutputDataDisk) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Size == nil) != (other.Size == nil) || o.Size != nil && *o.Size != *other.Size {
+		return false
+	}
+	if (o.Lun == nil) != (other.Lun == nil) || o.Lun != nil && *o.Lun != *other.Lun {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) DeepCopy() *OutputVm {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputVm)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.PrivateIps != nil {
+		out.PrivateIps = make([]string, len(o.PrivateIps))
+		copy(out.PrivateIps, o.PrivateIps)
+	}
+	if o.PublicIp != nil {
+		x := *o.PublicIp
+		out.PublicIp = &x
+	}
+	if o.DataDisks != nil {
+		out.DataDisks = make([]OutputDataDisk, len(o.DataDisks))
+		for i := range o.DataDisks {
+			out.DataDisks[i] = *o.DataDisks[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether OutputVm and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputVm) Equal(other *OutputVm) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.PrivateIps == nil) != (other.PrivateIps == nil) || len(o.PrivateIps) != len(other.PrivateIps) {
+		return false
+	}
+	for i := range o.PrivateIps {
+		if o.PrivateIps[i] != other.PrivateIps[i] {
+			return false
+		}
+	}
+	if (o.PublicIp == nil) != (other.PublicIp == nil) || o.PublicIp != nil && *o.PublicIp != *other.PublicIp {
+		return false
+	}
+	if (o.DataDisks == nil) != (other.DataDisks == nil) || len(o.DataDisks) != len(other.DataDisks) {
+		return false
+	}
+	for i := range o.DataDisks {
+		if !o.DataDisks[i].Equal(&other.DataDisks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputVmGroup or nil if OutputVmGroup is nil.
+func (o *OutputVmGroup) DeepCopy() *OutputVmGroup {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputVmGroup)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Vms != nil {
+		out.Vms = make([]OutputVm, len(o.Vms))
+		for i := range o.Vms {
+			out.Vms[i] = *o.Vms[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether OutputVmGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputVmGroup) Equal(other *OutputVmGroup) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Vms == nil) != (other.Vms == nil) || len(o.Vms) != len(other.Vms) {
+		return false
+	}
+	for i := range o.Vms {
+		if !o.Vms[i].Equal(&other.Vms[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.RgName != nil {
+		x := *o.RgName
+		out.RgName = &x
+	}
+	if o.VnetName != nil {
+		x := *o.VnetName
+		out.VnetName = &x
+	}
+	if o.VmGroups != nil {
+		out.VmGroups = make([]OutputVmGroup, len(o.VmGroups))
+		for i := range o.VmGroups {
+			out.VmGroups[i] = *o.VmGroups[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.RgName == nil) != (other.RgName == nil) || o.RgName != nil && *o.RgName != *other.RgName {
+		return false
+	}
+	if (o.VnetName == nil) != (other.VnetName == nil) || o.VnetName != nil && *o.VnetName != *other.VnetName {
+		return false
+	}
+	if (o.VmGroups == nil) != (other.VmGroups == nil) || len(o.VmGroups) != len(other.VmGroups) {
+		return false
+	}
+	for i := range o.VmGroups {
+		if !o.VmGroups[i].Equal(&other.VmGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
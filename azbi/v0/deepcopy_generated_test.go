@@ -0,0 +1,410 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+import (
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDataDisk_DeepCopy(t *testing.T) {
+	var nilStruct *DataDisk
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &DataDisk{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestDataDisk_Equal(t *testing.T) {
+	var nilStruct *DataDisk
+	original := &DataDisk{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&DataDisk{}).Equal(&DataDisk{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestSubnet_DeepCopy(t *testing.T) {
+	var nilStruct *Subnet
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Subnet{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestSubnet_Equal(t *testing.T) {
+	var nilStruct *Subnet
+	original := &Subnet{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Subnet{}).Equal(&Subnet{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestVmImage_DeepCopy(t *testing.T) {
+	var nilStruct *VmImage
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &VmImage{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestVmImage_Equal(t *testing.T) {
+	var nilStruct *VmImage
+	original := &VmImage{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&VmImage{}).Equal(&VmImage{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestVmGroup_DeepCopy(t *testing.T) {
+	var nilStruct *VmGroup
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &VmGroup{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestVmGroup_Equal(t *testing.T) {
+	var nilStruct *VmGroup
+	original := &VmGroup{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&VmGroup{}).Equal(&VmGroup{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestParams_DeepCopy(t *testing.T) {
+	var nilStruct *Params
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Params{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestParams_Equal(t *testing.T) {
+	var nilStruct *Params
+	original := &Params{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Params{}).Equal(&Params{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestConfig_DeepCopy(t *testing.T) {
+	var nilStruct *Config
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Config{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestConfig_Equal(t *testing.T) {
+	var nilStruct *Config
+	original := &Config{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Config{}).Equal(&Config{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputDataDisk_DeepCopy(t *testing.T) {
+	var nilStruct *OutputDataDisk
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputDataDisk{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputDataDisk_Equal(t *testing.T) {
+	var nilStruct *OutputDataDisk
+	original := &OutputDataDisk{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputDataDisk{}).Equal(&OutputDataDisk{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputVm_DeepCopy(t *testing.T) {
+	var nilStruct *OutputVm
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputVm{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputVm_Equal(t *testing.T) {
+	var nilStruct *OutputVm
+	original := &OutputVm{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputVm{}).Equal(&OutputVm{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputVmGroup_DeepCopy(t *testing.T) {
+	var nilStruct *OutputVmGroup
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputVmGroup{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputVmGroup_Equal(t *testing.T) {
+	var nilStruct *OutputVmGroup
+	original := &OutputVmGroup{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputVmGroup{}).Equal(&OutputVmGroup{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutput_DeepCopy(t *testing.T) {
+	var nilStruct *Output
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Output{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutput_Equal(t *testing.T) {
+	var nilStruct *Output
+	original := &Output{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Output{}).Equal(&Output{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
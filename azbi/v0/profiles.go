@@ -0,0 +1,85 @@
+package v0
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/epiphany-platform/e-structures/utils/to"
+)
+
+const (
+	// ProfileDefault is profile returned by NewConfig.
+	ProfileDefault = "default"
+	// ProfileMinimal is single small VM with standard disk, suitable for development.
+	ProfileMinimal = "minimal"
+	// ProfileHA is three VMs with Premium disks.
+	ProfileHA = "ha"
+	// ProfileProduction is three bigger VMs without public IPs, with Premium disks.
+	ProfileProduction = "production"
+)
+
+var profiles = map[string]func() *Config{
+	ProfileDefault: NewConfig,
+	ProfileMinimal: func() *Config {
+		c := NewConfig()
+		g := &c.Params.VmGroups[0]
+		g.VmSize = to.StrPtr("Standard_B2s")
+		g.DataDisks = []DataDisk{
+			{
+				GbSize:      to.IntPtr(10),
+				StorageType: to.StrPtr("Standard_LRS"),
+			},
+		}
+		return c
+	},
+	ProfileHA: func() *Config {
+		c := NewConfig()
+		g := &c.Params.VmGroups[0]
+		g.VmCount = to.IntPtr(3)
+		g.DataDisks = []DataDisk{
+			{
+				GbSize:      to.IntPtr(32),
+				StorageType: to.StrPtr("Premium_LRS"),
+			},
+		}
+		return c
+	},
+	ProfileProduction: func() *Config {
+		c := NewConfig()
+		g := &c.Params.VmGroups[0]
+		g.VmCount = to.IntPtr(3)
+		g.VmSize = to.StrPtr("Standard_DS3_v2")
+		g.UsePublicIP = to.BooPtr(false)
+		g.DataDisks = []DataDisk{
+			{
+				GbSize:      to.IntPtr(128),
+				StorageType: to.StrPtr("Premium_LRS"),
+			},
+		}
+		return c
+	},
+}
+
+// Profiles returns sorted names of profiles accepted by NewConfigWithProfile.
+func Profiles() []string {
+	var result []string
+	for name := range profiles {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// NewConfigWithProfile returns new Config with defaults of named profile. Empty name means
+// ProfileDefault.
+func NewConfigWithProfile(name string) (*Config, error) {
+	if name == "" {
+		name = ProfileDefault
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown %s profile '%s', known profiles are: %s", kind, name, strings.Join(Profiles(), ", "))
+	}
+	return profile(), nil
+}
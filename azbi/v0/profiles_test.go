@@ -0,0 +1,97 @@
+package v0
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewConfigWithProfile(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(t *testing.T, c *Config)
+	}{
+		{
+			name: "",
+			check: func(t *testing.T, c *Config) {
+				if diff := cmp.Diff(NewConfig(), c); diff != "" {
+					t.Errorf("NewConfigWithProfile() mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: ProfileDefault,
+			check: func(t *testing.T, c *Config) {
+				if diff := cmp.Diff(NewConfig(), c); diff != "" {
+					t.Errorf("NewConfigWithProfile() mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: ProfileMinimal,
+			check: func(t *testing.T, c *Config) {
+				if got := c.Params.VmGroups[0].GetVmCountV(); got != 1 {
+					t.Errorf("vm_count = %d, want 1", got)
+				}
+			},
+		},
+		{
+			name: ProfileHA,
+			check: func(t *testing.T, c *Config) {
+				checkVmGroups(t, c, 3, false)
+			},
+		},
+		{
+			name: ProfileProduction,
+			check: func(t *testing.T, c *Config) {
+				checkVmGroups(t, c, 3, true)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewConfigWithProfile(tt.name)
+			if err != nil {
+				t.Fatalf("NewConfigWithProfile() unexpected error occurred: %v", err)
+			}
+			b, err := c.Marshal()
+			if err != nil {
+				t.Fatalf("NewConfigWithProfile() returned invalid config: %v", err)
+			}
+			loaded := &Config{}
+			if err = loaded.UnmarshalStrict(b); err != nil {
+				t.Fatalf("NewConfigWithProfile() returned config that cannot be loaded: %v", err)
+			}
+			tt.check(t, c)
+		})
+	}
+
+	for _, name := range Profiles() {
+		if _, err := NewConfigWithProfile(name); err != nil {
+			t.Errorf("NewConfigWithProfile() of listed profile %s unexpected error occurred: %v", name, err)
+		}
+	}
+
+	_, err := NewConfigWithProfile("unknown")
+	want := "unknown azbi profile 'unknown', known profiles are: default, ha, minimal, production"
+	if err == nil || err.Error() != want {
+		t.Errorf("NewConfigWithProfile() error = %v, want %s", err, want)
+	}
+}
+
+func checkVmGroups(t *testing.T, c *Config, minCount int, private bool) {
+	t.Helper()
+	for _, g := range c.Params.VmGroups {
+		if g.GetVmCountV() < minCount {
+			t.Errorf("vm_count of %s = %d, want at least %d", g.GetNameV(), g.GetVmCountV(), minCount)
+		}
+		if private && g.GetUsePublicIPV() {
+			t.Errorf("use_public_ip of %s = true, want false", g.GetNameV())
+		}
+		for _, d := range g.DataDisks {
+			if d.GetStorageTypeV() != "Premium_LRS" {
+				t.Errorf("storage_type of %s data disk = %s, want Premium_LRS", g.GetNameV(), d.GetStorageTypeV())
+			}
+		}
+	}
+}
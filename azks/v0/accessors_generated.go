@@ -0,0 +1,889 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetManaged returns Managed field of AzureAd or nil if AzureAd is nil.
+func (a *AzureAd) GetManaged() *bool {
+	if a == nil {
+		return nil
+	}
+	return a.Managed
+}
+
+// GetManagedV returns value of Managed field of AzureAd or zero value if either AzureAd or field is nil.
+func (a *AzureAd) GetManagedV() bool {
+	if a == nil || a.Managed == nil {
+		return false
+	}
+	return *a.Managed
+}
+
+// GetManagedOr returns value of Managed field of AzureAd or def if either AzureAd or field is nil.
+func (a *AzureAd) GetManagedOr(def bool) bool {
+	if a == nil || a.Managed == nil {
+		return def
+	}
+	return *a.Managed
+}
+
+// GetTenantId returns TenantId field of AzureAd or nil if AzureAd is nil.
+func (a *AzureAd) GetTenantId() *string {
+	if a == nil {
+		return nil
+	}
+	return a.TenantId
+}
+
+// GetTenantIdV returns value of TenantId field of AzureAd or zero value if either AzureAd or field is nil.
+func (a *AzureAd) GetTenantIdV() string {
+	if a == nil || a.TenantId == nil {
+		return ""
+	}
+	return *a.TenantId
+}
+
+// GetTenantIdOr returns value of TenantId field of AzureAd or def if either AzureAd or field is nil.
+func (a *AzureAd) GetTenantIdOr(def string) string {
+	if a == nil || a.TenantId == nil {
+		return def
+	}
+	return *a.TenantId
+}
+
+// GetAdminGroupObjectIds returns AdminGroupObjectIds field of AzureAd, nil if AzureAd is nil or empty slice if field is nil.
+func (a *AzureAd) GetAdminGroupObjectIds() []string {
+	if a == nil {
+		return nil
+	}
+	if len(a.AdminGroupObjectIds) == 0 {
+		return []string{}
+	}
+	return a.AdminGroupObjectIds
+}
+
+// GetBalanceSimilarNodeGroups returns BalanceSimilarNodeGroups field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetBalanceSimilarNodeGroups() *bool {
+	if a == nil {
+		return nil
+	}
+	return a.BalanceSimilarNodeGroups
+}
+
+// GetBalanceSimilarNodeGroupsV returns value of BalanceSimilarNodeGroups field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetBalanceSimilarNodeGroupsV() bool {
+	if a == nil || a.BalanceSimilarNodeGroups == nil {
+		return false
+	}
+	return *a.BalanceSimilarNodeGroups
+}
+
+// GetBalanceSimilarNodeGroupsOr returns value of BalanceSimilarNodeGroups field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetBalanceSimilarNodeGroupsOr(def bool) bool {
+	if a == nil || a.BalanceSimilarNodeGroups == nil {
+		return def
+	}
+	return *a.BalanceSimilarNodeGroups
+}
+
+// GetMaxGracefulTerminationSec returns MaxGracefulTerminationSec field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetMaxGracefulTerminationSec() *string {
+	if a == nil {
+		return nil
+	}
+	return a.MaxGracefulTerminationSec
+}
+
+// GetMaxGracefulTerminationSecV returns value of MaxGracefulTerminationSec field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetMaxGracefulTerminationSecV() string {
+	if a == nil || a.MaxGracefulTerminationSec == nil {
+		return ""
+	}
+	return *a.MaxGracefulTerminationSec
+}
+
+// GetMaxGracefulTerminationSecOr returns value of MaxGracefulTerminationSec field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetMaxGracefulTerminationSecOr(def string) string {
+	if a == nil || a.MaxGracefulTerminationSec == nil {
+		return def
+	}
+	return *a.MaxGracefulTerminationSec
+}
+
+// GetScaleDownDelayAfterAdd returns ScaleDownDelayAfterAdd field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterAdd() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ScaleDownDelayAfterAdd
+}
+
+// GetScaleDownDelayAfterAddV returns value of ScaleDownDelayAfterAdd field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterAddV() string {
+	if a == nil || a.ScaleDownDelayAfterAdd == nil {
+		return ""
+	}
+	return *a.ScaleDownDelayAfterAdd
+}
+
+// GetScaleDownDelayAfterAddOr returns value of ScaleDownDelayAfterAdd field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterAddOr(def string) string {
+	if a == nil || a.ScaleDownDelayAfterAdd == nil {
+		return def
+	}
+	return *a.ScaleDownDelayAfterAdd
+}
+
+// GetScaleDownDelayAfterDelete returns ScaleDownDelayAfterDelete field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterDelete() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ScaleDownDelayAfterDelete
+}
+
+// GetScaleDownDelayAfterDeleteV returns value of ScaleDownDelayAfterDelete field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterDeleteV() string {
+	if a == nil || a.ScaleDownDelayAfterDelete == nil {
+		return ""
+	}
+	return *a.ScaleDownDelayAfterDelete
+}
+
+// GetScaleDownDelayAfterDeleteOr returns value of ScaleDownDelayAfterDelete field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterDeleteOr(def string) string {
+	if a == nil || a.ScaleDownDelayAfterDelete == nil {
+		return def
+	}
+	return *a.ScaleDownDelayAfterDelete
+}
+
+// GetScaleDownDelayAfterFailure returns ScaleDownDelayAfterFailure field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterFailure() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ScaleDownDelayAfterFailure
+}
+
+// GetScaleDownDelayAfterFailureV returns value of ScaleDownDelayAfterFailure field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterFailureV() string {
+	if a == nil || a.ScaleDownDelayAfterFailure == nil {
+		return ""
+	}
+	return *a.ScaleDownDelayAfterFailure
+}
+
+// GetScaleDownDelayAfterFailureOr returns value of ScaleDownDelayAfterFailure field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownDelayAfterFailureOr(def string) string {
+	if a == nil || a.ScaleDownDelayAfterFailure == nil {
+		return def
+	}
+	return *a.ScaleDownDelayAfterFailure
+}
+
+// GetScanInterval returns ScanInterval field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetScanInterval() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ScanInterval
+}
+
+// GetScanIntervalV returns value of ScanInterval field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScanIntervalV() string {
+	if a == nil || a.ScanInterval == nil {
+		return ""
+	}
+	return *a.ScanInterval
+}
+
+// GetScanIntervalOr returns value of ScanInterval field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScanIntervalOr(def string) string {
+	if a == nil || a.ScanInterval == nil {
+		return def
+	}
+	return *a.ScanInterval
+}
+
+// GetScaleDownUnneeded returns ScaleDownUnneeded field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetScaleDownUnneeded() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ScaleDownUnneeded
+}
+
+// GetScaleDownUnneededV returns value of ScaleDownUnneeded field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownUnneededV() string {
+	if a == nil || a.ScaleDownUnneeded == nil {
+		return ""
+	}
+	return *a.ScaleDownUnneeded
+}
+
+// GetScaleDownUnneededOr returns value of ScaleDownUnneeded field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownUnneededOr(def string) string {
+	if a == nil || a.ScaleDownUnneeded == nil {
+		return def
+	}
+	return *a.ScaleDownUnneeded
+}
+
+// GetScaleDownUnready returns ScaleDownUnready field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetScaleDownUnready() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ScaleDownUnready
+}
+
+// GetScaleDownUnreadyV returns value of ScaleDownUnready field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownUnreadyV() string {
+	if a == nil || a.ScaleDownUnready == nil {
+		return ""
+	}
+	return *a.ScaleDownUnready
+}
+
+// GetScaleDownUnreadyOr returns value of ScaleDownUnready field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownUnreadyOr(def string) string {
+	if a == nil || a.ScaleDownUnready == nil {
+		return def
+	}
+	return *a.ScaleDownUnready
+}
+
+// GetScaleDownUtilizationThreshold returns ScaleDownUtilizationThreshold field of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) GetScaleDownUtilizationThreshold() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ScaleDownUtilizationThreshold
+}
+
+// GetScaleDownUtilizationThresholdV returns value of ScaleDownUtilizationThreshold field of AutoScalerProfile or zero value if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownUtilizationThresholdV() string {
+	if a == nil || a.ScaleDownUtilizationThreshold == nil {
+		return ""
+	}
+	return *a.ScaleDownUtilizationThreshold
+}
+
+// GetScaleDownUtilizationThresholdOr returns value of ScaleDownUtilizationThreshold field of AutoScalerProfile or def if either AutoScalerProfile or field is nil.
+func (a *AutoScalerProfile) GetScaleDownUtilizationThresholdOr(def string) string {
+	if a == nil || a.ScaleDownUtilizationThreshold == nil {
+		return def
+	}
+	return *a.ScaleDownUtilizationThreshold
+}
+
+// GetSize returns Size field of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) GetSize() *int {
+	if d == nil {
+		return nil
+	}
+	return d.Size
+}
+
+// GetSizeV returns value of Size field of DefaultNodePool or zero value if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetSizeV() int {
+	if d == nil || d.Size == nil {
+		return 0
+	}
+	return *d.Size
+}
+
+// GetSizeOr returns value of Size field of DefaultNodePool or def if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetSizeOr(def int) int {
+	if d == nil || d.Size == nil {
+		return def
+	}
+	return *d.Size
+}
+
+// GetMin returns Min field of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) GetMin() *int {
+	if d == nil {
+		return nil
+	}
+	return d.Min
+}
+
+// GetMinV returns value of Min field of DefaultNodePool or zero value if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetMinV() int {
+	if d == nil || d.Min == nil {
+		return 0
+	}
+	return *d.Min
+}
+
+// GetMinOr returns value of Min field of DefaultNodePool or def if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetMinOr(def int) int {
+	if d == nil || d.Min == nil {
+		return def
+	}
+	return *d.Min
+}
+
+// GetMax returns Max field of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) GetMax() *int {
+	if d == nil {
+		return nil
+	}
+	return d.Max
+}
+
+// GetMaxV returns value of Max field of DefaultNodePool or zero value if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetMaxV() int {
+	if d == nil || d.Max == nil {
+		return 0
+	}
+	return *d.Max
+}
+
+// GetMaxOr returns value of Max field of DefaultNodePool or def if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetMaxOr(def int) int {
+	if d == nil || d.Max == nil {
+		return def
+	}
+	return *d.Max
+}
+
+// GetVmSize returns VmSize field of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) GetVmSize() *string {
+	if d == nil {
+		return nil
+	}
+	return d.VmSize
+}
+
+// GetVmSizeV returns value of VmSize field of DefaultNodePool or zero value if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetVmSizeV() string {
+	if d == nil || d.VmSize == nil {
+		return ""
+	}
+	return *d.VmSize
+}
+
+// GetVmSizeOr returns value of VmSize field of DefaultNodePool or def if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetVmSizeOr(def string) string {
+	if d == nil || d.VmSize == nil {
+		return def
+	}
+	return *d.VmSize
+}
+
+// GetDiskGbSize returns DiskGbSize field of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) GetDiskGbSize() *int {
+	if d == nil {
+		return nil
+	}
+	return d.DiskGbSize
+}
+
+// GetDiskGbSizeV returns value of DiskGbSize field of DefaultNodePool or zero value if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetDiskGbSizeV() int {
+	if d == nil || d.DiskGbSize == nil {
+		return 0
+	}
+	return *d.DiskGbSize
+}
+
+// GetDiskGbSizeOr returns value of DiskGbSize field of DefaultNodePool or def if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetDiskGbSizeOr(def int) int {
+	if d == nil || d.DiskGbSize == nil {
+		return def
+	}
+	return *d.DiskGbSize
+}
+
+// GetAutoScaling returns AutoScaling field of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) GetAutoScaling() *bool {
+	if d == nil {
+		return nil
+	}
+	return d.AutoScaling
+}
+
+// GetAutoScalingV returns value of AutoScaling field of DefaultNodePool or zero value if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetAutoScalingV() bool {
+	if d == nil || d.AutoScaling == nil {
+		return false
+	}
+	return *d.AutoScaling
+}
+
+// GetAutoScalingOr returns value of AutoScaling field of DefaultNodePool or def if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetAutoScalingOr(def bool) bool {
+	if d == nil || d.AutoScaling == nil {
+		return def
+	}
+	return *d.AutoScaling
+}
+
+// GetType returns Type field of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) GetType() *string {
+	if d == nil {
+		return nil
+	}
+	return d.Type
+}
+
+// GetTypeV returns value of Type field of DefaultNodePool or zero value if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetTypeV() string {
+	if d == nil || d.Type == nil {
+		return ""
+	}
+	return *d.Type
+}
+
+// GetTypeOr returns value of Type field of DefaultNodePool or def if either DefaultNodePool or field is nil.
+func (d *DefaultNodePool) GetTypeOr(def string) string {
+	if d == nil || d.Type == nil {
+		return def
+	}
+	return *d.Type
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetLocation returns Location field of Params or nil if Params is nil.
+func (p *Params) GetLocation() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Location
+}
+
+// GetLocationV returns value of Location field of Params or zero value if either Params or field is nil.
+func (p *Params) GetLocationV() string {
+	if p == nil || p.Location == nil {
+		return ""
+	}
+	return *p.Location
+}
+
+// GetLocationOr returns value of Location field of Params or def if either Params or field is nil.
+func (p *Params) GetLocationOr(def string) string {
+	if p == nil || p.Location == nil {
+		return def
+	}
+	return *p.Location
+}
+
+// GetRsaPublicKeyPath returns RsaPublicKeyPath field of Params or nil if Params is nil.
+func (p *Params) GetRsaPublicKeyPath() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathV returns value of RsaPublicKeyPath field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathV() string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return ""
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathOr returns value of RsaPublicKeyPath field of Params or def if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathOr(def string) string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return def
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetRgName returns RgName field of Params or nil if Params is nil.
+func (p *Params) GetRgName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RgName
+}
+
+// GetRgNameV returns value of RgName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRgNameV() string {
+	if p == nil || p.RgName == nil {
+		return ""
+	}
+	return *p.RgName
+}
+
+// GetRgNameOr returns value of RgName field of Params or def if either Params or field is nil.
+func (p *Params) GetRgNameOr(def string) string {
+	if p == nil || p.RgName == nil {
+		return def
+	}
+	return *p.RgName
+}
+
+// GetVnetName returns VnetName field of Params or nil if Params is nil.
+func (p *Params) GetVnetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.VnetName
+}
+
+// GetVnetNameV returns value of VnetName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetVnetNameV() string {
+	if p == nil || p.VnetName == nil {
+		return ""
+	}
+	return *p.VnetName
+}
+
+// GetVnetNameOr returns value of VnetName field of Params or def if either Params or field is nil.
+func (p *Params) GetVnetNameOr(def string) string {
+	if p == nil || p.VnetName == nil {
+		return def
+	}
+	return *p.VnetName
+}
+
+// GetSubnetName returns SubnetName field of Params or nil if Params is nil.
+func (p *Params) GetSubnetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.SubnetName
+}
+
+// GetSubnetNameV returns value of SubnetName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSubnetNameV() string {
+	if p == nil || p.SubnetName == nil {
+		return ""
+	}
+	return *p.SubnetName
+}
+
+// GetSubnetNameOr returns value of SubnetName field of Params or def if either Params or field is nil.
+func (p *Params) GetSubnetNameOr(def string) string {
+	if p == nil || p.SubnetName == nil {
+		return def
+	}
+	return *p.SubnetName
+}
+
+// GetKubernetesVersion returns KubernetesVersion field of Params or nil if Params is nil.
+func (p *Params) GetKubernetesVersion() *string {
+	if p == nil {
+		return nil
+	}
+	return p.KubernetesVersion
+}
+
+// GetKubernetesVersionV returns value of KubernetesVersion field of Params or zero value if either Params or field is nil.
+func (p *Params) GetKubernetesVersionV() string {
+	if p == nil || p.KubernetesVersion == nil {
+		return ""
+	}
+	return *p.KubernetesVersion
+}
+
+// GetKubernetesVersionOr returns value of KubernetesVersion field of Params or def if either Params or field is nil.
+func (p *Params) GetKubernetesVersionOr(def string) string {
+	if p == nil || p.KubernetesVersion == nil {
+		return def
+	}
+	return *p.KubernetesVersion
+}
+
+// GetEnableNodePublicIp returns EnableNodePublicIp field of Params or nil if Params is nil.
+func (p *Params) GetEnableNodePublicIp() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.EnableNodePublicIp
+}
+
+// GetEnableNodePublicIpV returns value of EnableNodePublicIp field of Params or zero value if either Params or field is nil.
+func (p *Params) GetEnableNodePublicIpV() bool {
+	if p == nil || p.EnableNodePublicIp == nil {
+		return false
+	}
+	return *p.EnableNodePublicIp
+}
+
+// GetEnableNodePublicIpOr returns value of EnableNodePublicIp field of Params or def if either Params or field is nil.
+func (p *Params) GetEnableNodePublicIpOr(def bool) bool {
+	if p == nil || p.EnableNodePublicIp == nil {
+		return def
+	}
+	return *p.EnableNodePublicIp
+}
+
+// GetEnableRbac returns EnableRbac field of Params or nil if Params is nil.
+func (p *Params) GetEnableRbac() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.EnableRbac
+}
+
+// GetEnableRbacV returns value of EnableRbac field of Params or zero value if either Params or field is nil.
+func (p *Params) GetEnableRbacV() bool {
+	if p == nil || p.EnableRbac == nil {
+		return false
+	}
+	return *p.EnableRbac
+}
+
+// GetEnableRbacOr returns value of EnableRbac field of Params or def if either Params or field is nil.
+func (p *Params) GetEnableRbacOr(def bool) bool {
+	if p == nil || p.EnableRbac == nil {
+		return def
+	}
+	return *p.EnableRbac
+}
+
+// GetDefaultNodePool returns DefaultNodePool field of Params or nil if Params is nil.
+func (p *Params) GetDefaultNodePool() *DefaultNodePool {
+	if p == nil {
+		return nil
+	}
+	return p.DefaultNodePool
+}
+
+// GetDefaultNodePoolV returns value of DefaultNodePool field of Params or zero value if either Params or field is nil.
+func (p *Params) GetDefaultNodePoolV() DefaultNodePool {
+	if p == nil || p.DefaultNodePool == nil {
+		return DefaultNodePool{}
+	}
+	return *p.DefaultNodePool
+}
+
+// GetDefaultNodePoolOr returns value of DefaultNodePool field of Params or def if either Params or field is nil.
+func (p *Params) GetDefaultNodePoolOr(def DefaultNodePool) DefaultNodePool {
+	if p == nil || p.DefaultNodePool == nil {
+		return def
+	}
+	return *p.DefaultNodePool
+}
+
+// GetAutoScalerProfile returns AutoScalerProfile field of Params or nil if Params is nil.
+func (p *Params) GetAutoScalerProfile() *AutoScalerProfile {
+	if p == nil {
+		return nil
+	}
+	return p.AutoScalerProfile
+}
+
+// GetAutoScalerProfileV returns value of AutoScalerProfile field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAutoScalerProfileV() AutoScalerProfile {
+	if p == nil || p.AutoScalerProfile == nil {
+		return AutoScalerProfile{}
+	}
+	return *p.AutoScalerProfile
+}
+
+// GetAutoScalerProfileOr returns value of AutoScalerProfile field of Params or def if either Params or field is nil.
+func (p *Params) GetAutoScalerProfileOr(def AutoScalerProfile) AutoScalerProfile {
+	if p == nil || p.AutoScalerProfile == nil {
+		return def
+	}
+	return *p.AutoScalerProfile
+}
+
+// GetAzureAd returns AzureAd field of Params or nil if Params is nil.
+func (p *Params) GetAzureAd() *AzureAd {
+	if p == nil {
+		return nil
+	}
+	return p.AzureAd
+}
+
+// GetAzureAdV returns value of AzureAd field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAzureAdV() AzureAd {
+	if p == nil || p.AzureAd == nil {
+		return AzureAd{}
+	}
+	return *p.AzureAd
+}
+
+// GetAzureAdOr returns value of AzureAd field of Params or def if either Params or field is nil.
+func (p *Params) GetAzureAdOr(def AzureAd) AzureAd {
+	if p == nil || p.AzureAd == nil {
+		return def
+	}
+	return *p.AzureAd
+}
+
+// GetIdentityType returns IdentityType field of Params or nil if Params is nil.
+func (p *Params) GetIdentityType() *string {
+	if p == nil {
+		return nil
+	}
+	return p.IdentityType
+}
+
+// GetIdentityTypeV returns value of IdentityType field of Params or zero value if either Params or field is nil.
+func (p *Params) GetIdentityTypeV() string {
+	if p == nil || p.IdentityType == nil {
+		return ""
+	}
+	return *p.IdentityType
+}
+
+// GetIdentityTypeOr returns value of IdentityType field of Params or def if either Params or field is nil.
+func (p *Params) GetIdentityTypeOr(def string) string {
+	if p == nil || p.IdentityType == nil {
+		return def
+	}
+	return *p.IdentityType
+}
+
+// GetAdminUsername returns AdminUsername field of Params or nil if Params is nil.
+func (p *Params) GetAdminUsername() *string {
+	if p == nil {
+		return nil
+	}
+	return p.AdminUsername
+}
+
+// GetAdminUsernameV returns value of AdminUsername field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAdminUsernameV() string {
+	if p == nil || p.AdminUsername == nil {
+		return ""
+	}
+	return *p.AdminUsername
+}
+
+// GetAdminUsernameOr returns value of AdminUsername field of Params or def if either Params or field is nil.
+func (p *Params) GetAdminUsernameOr(def string) string {
+	if p == nil || p.AdminUsername == nil {
+		return def
+	}
+	return *p.AdminUsername
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetKubeConfig returns KubeConfig field of Output or nil if Output is nil.
+func (o *Output) GetKubeConfig() *string {
+	if o == nil {
+		return nil
+	}
+	return o.KubeConfig
+}
+
+// GetKubeConfigV returns value of KubeConfig field of Output or zero value if either Output or field is nil.
+func (o *Output) GetKubeConfigV() string {
+	if o == nil || o.KubeConfig == nil {
+		return ""
+	}
+	return *o.KubeConfig
+}
+
+// GetKubeConfigOr returns value of KubeConfig field of Output or def if either Output or field is nil.
+func (o *Output) GetKubeConfigOr(def string) string {
+	if o == nil || o.KubeConfig == nil {
+		return def
+	}
+	return *o.KubeConfig
+}
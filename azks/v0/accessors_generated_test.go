@@ -0,0 +1,842 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAzureAd_Accessors(t *testing.T) {
+	var nilStruct *AzureAd
+	emptyStruct := &AzureAd{}
+	t.Run("Managed", func(t *testing.T) {
+		v := true
+		fullStruct := &AzureAd{Managed: &v}
+		if nilStruct.GetManaged() != nil || emptyStruct.GetManaged() != nil {
+			t.Error("GetManaged() expected to return nil")
+		}
+		if fullStruct.GetManaged() != &v {
+			t.Error("GetManaged() expected to return field")
+		}
+		if nilStruct.GetManagedV() != false || emptyStruct.GetManagedV() != false {
+			t.Error("GetManagedV() expected to return zero value")
+		}
+		if fullStruct.GetManagedV() != v {
+			t.Error("GetManagedV() expected to return field value")
+		}
+		if nilStruct.GetManagedOr(v) != v || emptyStruct.GetManagedOr(v) != v {
+			t.Error("GetManagedOr() expected to return default value")
+		}
+		if fullStruct.GetManagedOr(false) != v {
+			t.Error("GetManagedOr() expected to return field value")
+		}
+	})
+	t.Run("TenantId", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AzureAd{TenantId: &v}
+		if nilStruct.GetTenantId() != nil || emptyStruct.GetTenantId() != nil {
+			t.Error("GetTenantId() expected to return nil")
+		}
+		if fullStruct.GetTenantId() != &v {
+			t.Error("GetTenantId() expected to return field")
+		}
+		if nilStruct.GetTenantIdV() != "" || emptyStruct.GetTenantIdV() != "" {
+			t.Error("GetTenantIdV() expected to return zero value")
+		}
+		if fullStruct.GetTenantIdV() != v {
+			t.Error("GetTenantIdV() expected to return field value")
+		}
+		if nilStruct.GetTenantIdOr(v) != v || emptyStruct.GetTenantIdOr(v) != v {
+			t.Error("GetTenantIdOr() expected to return default value")
+		}
+		if fullStruct.GetTenantIdOr("") != v {
+			t.Error("GetTenantIdOr() expected to return field value")
+		}
+	})
+	t.Run("AdminGroupObjectIds", func(t *testing.T) {
+		fullStruct := &AzureAd{AdminGroupObjectIds: make([]string, 1)}
+		if nilStruct.GetAdminGroupObjectIds() != nil {
+			t.Error("GetAdminGroupObjectIds() expected to return nil")
+		}
+		if got := emptyStruct.GetAdminGroupObjectIds(); got == nil || len(got) != 0 {
+			t.Error("GetAdminGroupObjectIds() expected to return empty slice")
+		}
+		if got := fullStruct.GetAdminGroupObjectIds(); len(got) != 1 || &got[0] != &fullStruct.AdminGroupObjectIds[0] {
+			t.Error("GetAdminGroupObjectIds() expected to return field")
+		}
+	})
+}
+
+func TestAutoScalerProfile_Accessors(t *testing.T) {
+	var nilStruct *AutoScalerProfile
+	emptyStruct := &AutoScalerProfile{}
+	t.Run("BalanceSimilarNodeGroups", func(t *testing.T) {
+		v := true
+		fullStruct := &AutoScalerProfile{BalanceSimilarNodeGroups: &v}
+		if nilStruct.GetBalanceSimilarNodeGroups() != nil || emptyStruct.GetBalanceSimilarNodeGroups() != nil {
+			t.Error("GetBalanceSimilarNodeGroups() expected to return nil")
+		}
+		if fullStruct.GetBalanceSimilarNodeGroups() != &v {
+			t.Error("GetBalanceSimilarNodeGroups() expected to return field")
+		}
+		if nilStruct.GetBalanceSimilarNodeGroupsV() != false || emptyStruct.GetBalanceSimilarNodeGroupsV() != false {
+			t.Error("GetBalanceSimilarNodeGroupsV() expected to return zero value")
+		}
+		if fullStruct.GetBalanceSimilarNodeGroupsV() != v {
+			t.Error("GetBalanceSimilarNodeGroupsV() expected to return field value")
+		}
+		if nilStruct.GetBalanceSimilarNodeGroupsOr(v) != v || emptyStruct.GetBalanceSimilarNodeGroupsOr(v) != v {
+			t.Error("GetBalanceSimilarNodeGroupsOr() expected to return default value")
+		}
+		if fullStruct.GetBalanceSimilarNodeGroupsOr(false) != v {
+			t.Error("GetBalanceSimilarNodeGroupsOr() expected to return field value")
+		}
+	})
+	t.Run("MaxGracefulTerminationSec", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{MaxGracefulTerminationSec: &v}
+		if nilStruct.GetMaxGracefulTerminationSec() != nil || emptyStruct.GetMaxGracefulTerminationSec() != nil {
+			t.Error("GetMaxGracefulTerminationSec() expected to return nil")
+		}
+		if fullStruct.GetMaxGracefulTerminationSec() != &v {
+			t.Error("GetMaxGracefulTerminationSec() expected to return field")
+		}
+		if nilStruct.GetMaxGracefulTerminationSecV() != "" || emptyStruct.GetMaxGracefulTerminationSecV() != "" {
+			t.Error("GetMaxGracefulTerminationSecV() expected to return zero value")
+		}
+		if fullStruct.GetMaxGracefulTerminationSecV() != v {
+			t.Error("GetMaxGracefulTerminationSecV() expected to return field value")
+		}
+		if nilStruct.GetMaxGracefulTerminationSecOr(v) != v || emptyStruct.GetMaxGracefulTerminationSecOr(v) != v {
+			t.Error("GetMaxGracefulTerminationSecOr() expected to return default value")
+		}
+		if fullStruct.GetMaxGracefulTerminationSecOr("") != v {
+			t.Error("GetMaxGracefulTerminationSecOr() expected to return field value")
+		}
+	})
+	t.Run("ScaleDownDelayAfterAdd", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{ScaleDownDelayAfterAdd: &v}
+		if nilStruct.GetScaleDownDelayAfterAdd() != nil || emptyStruct.GetScaleDownDelayAfterAdd() != nil {
+			t.Error("GetScaleDownDelayAfterAdd() expected to return nil")
+		}
+		if fullStruct.GetScaleDownDelayAfterAdd() != &v {
+			t.Error("GetScaleDownDelayAfterAdd() expected to return field")
+		}
+		if nilStruct.GetScaleDownDelayAfterAddV() != "" || emptyStruct.GetScaleDownDelayAfterAddV() != "" {
+			t.Error("GetScaleDownDelayAfterAddV() expected to return zero value")
+		}
+		if fullStruct.GetScaleDownDelayAfterAddV() != v {
+			t.Error("GetScaleDownDelayAfterAddV() expected to return field value")
+		}
+		if nilStruct.GetScaleDownDelayAfterAddOr(v) != v || emptyStruct.GetScaleDownDelayAfterAddOr(v) != v {
+			t.Error("GetScaleDownDelayAfterAddOr() expected to return default value")
+		}
+		if fullStruct.GetScaleDownDelayAfterAddOr("") != v {
+			t.Error("GetScaleDownDelayAfterAddOr() expected to return field value")
+		}
+	})
+	t.Run("ScaleDownDelayAfterDelete", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{ScaleDownDelayAfterDelete: &v}
+		if nilStruct.GetScaleDownDelayAfterDelete() != nil || emptyStruct.GetScaleDownDelayAfterDelete() != nil {
+			t.Error("GetScaleDownDelayAfterDelete() expected to return nil")
+		}
+		if fullStruct.GetScaleDownDelayAfterDelete() != &v {
+			t.Error("GetScaleDownDelayAfterDelete() expected to return field")
+		}
+		if nilStruct.GetScaleDownDelayAfterDeleteV() != "" || emptyStruct.GetScaleDownDelayAfterDeleteV() != "" {
+			t.Error("GetScaleDownDelayAfterDeleteV() expected to return zero value")
+		}
+		if fullStruct.GetScaleDownDelayAfterDeleteV() != v {
+			t.Error("GetScaleDownDelayAfterDeleteV() expected to return field value")
+		}
+		if nilStruct.GetScaleDownDelayAfterDeleteOr(v) != v || emptyStruct.GetScaleDownDelayAfterDeleteOr(v) != v {
+			t.Error("GetScaleDownDelayAfterDeleteOr() expected to return default value")
+		}
+		if fullStruct.GetScaleDownDelayAfterDeleteOr("") != v {
+			t.Error("GetScaleDownDelayAfterDeleteOr() expected to return field value")
+		}
+	})
+	t.Run("ScaleDownDelayAfterFailure", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{ScaleDownDelayAfterFailure: &v}
+		if nilStruct.GetScaleDownDelayAfterFailure() != nil || emptyStruct.GetScaleDownDelayAfterFailure() != nil {
+			t.Error("GetScaleDownDelayAfterFailure() expected to return nil")
+		}
+		if fullStruct.GetScaleDownDelayAfterFailure() != &v {
+			t.Error("GetScaleDownDelayAfterFailure() expected to return field")
+		}
+		if nilStruct.GetScaleDownDelayAfterFailureV() != "" || emptyStruct.GetScaleDownDelayAfterFailureV() != "" {
+			t.Error("GetScaleDownDelayAfterFailureV() expected to return zero value")
+		}
+		if fullStruct.GetScaleDownDelayAfterFailureV() != v {
+			t.Error("GetScaleDownDelayAfterFailureV() expected to return field value")
+		}
+		if nilStruct.GetScaleDownDelayAfterFailureOr(v) != v || emptyStruct.GetScaleDownDelayAfterFailureOr(v) != v {
+			t.Error("GetScaleDownDelayAfterFailureOr() expected to return default value")
+		}
+		if fullStruct.GetScaleDownDelayAfterFailureOr("") != v {
+			t.Error("GetScaleDownDelayAfterFailureOr() expected to return field value")
+		}
+	})
+	t.Run("ScanInterval", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{ScanInterval: &v}
+		if nilStruct.GetScanInterval() != nil || emptyStruct.GetScanInterval() != nil {
+			t.Error("GetScanInterval() expected to return nil")
+		}
+		if fullStruct.GetScanInterval() != &v {
+			t.Error("GetScanInterval() expected to return field")
+		}
+		if nilStruct.GetScanIntervalV() != "" || emptyStruct.GetScanIntervalV() != "" {
+			t.Error("GetScanIntervalV() expected to return zero value")
+		}
+		if fullStruct.GetScanIntervalV() != v {
+			t.Error("GetScanIntervalV() expected to return field value")
+		}
+		if nilStruct.GetScanIntervalOr(v) != v || emptyStruct.GetScanIntervalOr(v) != v {
+			t.Error("GetScanIntervalOr() expected to return default value")
+		}
+		if fullStruct.GetScanIntervalOr("") != v {
+			t.Error("GetScanIntervalOr() expected to return field value")
+		}
+	})
+	t.Run("ScaleDownUnneeded", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{ScaleDownUnneeded: &v}
+		if nilStruct.GetScaleDownUnneeded() != nil || emptyStruct.GetScaleDownUnneeded() != nil {
+			t.Error("GetScaleDownUnneeded() expected to return nil")
+		}
+		if fullStruct.GetScaleDownUnneeded() != &v {
+			t.Error("GetScaleDownUnneeded() expected to return field")
+		}
+		if nilStruct.GetScaleDownUnneededV() != "" || emptyStruct.GetScaleDownUnneededV() != "" {
+			t.Error("GetScaleDownUnneededV() expected to return zero value")
+		}
+		if fullStruct.GetScaleDownUnneededV() != v {
+			t.Error("GetScaleDownUnneededV() expected to return field value")
+		}
+		if nilStruct.GetScaleDownUnneededOr(v) != v || emptyStruct.GetScaleDownUnneededOr(v) != v {
+			t.Error("GetScaleDownUnneededOr() expected to return default value")
+		}
+		if fullStruct.GetScaleDownUnneededOr("") != v {
+			t.Error("GetScaleDownUnneededOr() expected to return field value")
+		}
+	})
+	t.Run("ScaleDownUnready", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{ScaleDownUnready: &v}
+		if nilStruct.GetScaleDownUnready() != nil || emptyStruct.GetScaleDownUnready() != nil {
+			t.Error("GetScaleDownUnready() expected to return nil")
+		}
+		if fullStruct.GetScaleDownUnready() != &v {
+			t.Error("GetScaleDownUnready() expected to return field")
+		}
+		if nilStruct.GetScaleDownUnreadyV() != "" || emptyStruct.GetScaleDownUnreadyV() != "" {
+			t.Error("GetScaleDownUnreadyV() expected to return zero value")
+		}
+		if fullStruct.GetScaleDownUnreadyV() != v {
+			t.Error("GetScaleDownUnreadyV() expected to return field value")
+		}
+		if nilStruct.GetScaleDownUnreadyOr(v) != v || emptyStruct.GetScaleDownUnreadyOr(v) != v {
+			t.Error("GetScaleDownUnreadyOr() expected to return default value")
+		}
+		if fullStruct.GetScaleDownUnreadyOr("") != v {
+			t.Error("GetScaleDownUnreadyOr() expected to return field value")
+		}
+	})
+	t.Run("ScaleDownUtilizationThreshold", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AutoScalerProfile{ScaleDownUtilizationThreshold: &v}
+		if nilStruct.GetScaleDownUtilizationThreshold() != nil || emptyStruct.GetScaleDownUtilizationThreshold() != nil {
+			t.Error("GetScaleDownUtilizationThreshold() expected to return nil")
+		}
+		if fullStruct.GetScaleDownUtilizationThreshold() != &v {
+			t.Error("GetScaleDownUtilizationThreshold() expected to return field")
+		}
+		if nilStruct.GetScaleDownUtilizationThresholdV() != "" || emptyStruct.GetScaleDownUtilizationThresholdV() != "" {
+			t.Error("GetScaleDownUtilizationThresholdV() expected to return zero value")
+		}
+		if fullStruct.GetScaleDownUtilizationThresholdV() != v {
+			t.Error("GetScaleDownUtilizationThresholdV() expected to return field value")
+		}
+		if nilStruct.GetScaleDownUtilizationThresholdOr(v) != v || emptyStruct.GetScaleDownUtilizationThresholdOr(v) != v {
+			t.Error("GetScaleDownUtilizationThresholdOr() expected to return default value")
+		}
+		if fullStruct.GetScaleDownUtilizationThresholdOr("") != v {
+			t.Error("GetScaleDownUtilizationThresholdOr() expected to return field value")
+		}
+	})
+}
+
+func TestDefaultNodePool_Accessors(t *testing.T) {
+	var nilStruct *DefaultNodePool
+	emptyStruct := &DefaultNodePool{}
+	t.Run("Size", func(t *testing.T) {
+		v := 1
+		fullStruct := &DefaultNodePool{Size: &v}
+		if nilStruct.GetSize() != nil || emptyStruct.GetSize() != nil {
+			t.Error("GetSize() expected to return nil")
+		}
+		if fullStruct.GetSize() != &v {
+			t.Error("GetSize() expected to return field")
+		}
+		if nilStruct.GetSizeV() != 0 || emptyStruct.GetSizeV() != 0 {
+			t.Error("GetSizeV() expected to return zero value")
+		}
+		if fullStruct.GetSizeV() != v {
+			t.Error("GetSizeV() expected to return field value")
+		}
+		if nilStruct.GetSizeOr(v) != v || emptyStruct.GetSizeOr(v) != v {
+			t.Error("GetSizeOr() expected to return default value")
+		}
+		if fullStruct.GetSizeOr(0) != v {
+			t.Error("GetSizeOr() expected to return field value")
+		}
+	})
+	t.Run("Min", func(t *testing.T) {
+		v := 1
+		fullStruct := &DefaultNodePool{Min: &v}
+		if nilStruct.GetMin() != nil || emptyStruct.GetMin() != nil {
+			t.Error("GetMin() expected to return nil")
+		}
+		if fullStruct.GetMin() != &v {
+			t.Error("GetMin() expected to return field")
+		}
+		if nilStruct.GetMinV() != 0 || emptyStruct.GetMinV() != 0 {
+			t.Error("GetMinV() expected to return zero value")
+		}
+		if fullStruct.GetMinV() != v {
+			t.Error("GetMinV() expected to return field value")
+		}
+		if nilStruct.GetMinOr(v) != v || emptyStruct.GetMinOr(v) != v {
+			t.Error("GetMinOr() expected to return default value")
+		}
+		if fullStruct.GetMinOr(0) != v {
+			t.Error("GetMinOr() expected to return field value")
+		}
+	})
+	t.Run("Max", func(t *testing.T) {
+		v := 1
+		fullStruct := &DefaultNodePool{Max: &v}
+		if nilStruct.GetMax() != nil || emptyStruct.GetMax() != nil {
+			t.Error("GetMax() expected to return nil")
+		}
+		if fullStruct.GetMax() != &v {
+			t.Error("GetMax() expected to return field")
+		}
+		if nilStruct.GetMaxV() != 0 || emptyStruct.GetMaxV() != 0 {
+			t.Error("GetMaxV() expected to return zero value")
+		}
+		if fullStruct.GetMaxV() != v {
+			t.Error("GetMaxV() expected to return field value")
+		}
+		if nilStruct.GetMaxOr(v) != v || emptyStruct.GetMaxOr(v) != v {
+			t.Error("GetMaxOr() expected to return default value")
+		}
+		if fullStruct.GetMaxOr(0) != v {
+			t.Error("GetMaxOr() expected to return field value")
+		}
+	})
+	t.Run("VmSize", func(t *testing.T) {
+		v := "value"
+		fullStruct := &DefaultNodePool{VmSize: &v}
+		if nilStruct.GetVmSize() != nil || emptyStruct.GetVmSize() != nil {
+			t.Error("GetVmSize() expected to return nil")
+		}
+		if fullStruct.GetVmSize() != &v {
+			t.Error("GetVmSize() expected to return field")
+		}
+		if nilStruct.GetVmSizeV() != "" || emptyStruct.GetVmSizeV() != "" {
+			t.Error("GetVmSizeV() expected to return zero value")
+		}
+		if fullStruct.GetVmSizeV() != v {
+			t.Error("GetVmSizeV() expected to return field value")
+		}
+		if nilStruct.GetVmSizeOr(v) != v || emptyStruct.GetVmSizeOr(v) != v {
+			t.Error("GetVmSizeOr() expected to return default value")
+		}
+		if fullStruct.GetVmSizeOr("") != v {
+			t.Error("GetVmSizeOr() expected to return field value")
+		}
+	})
+	t.Run("DiskGbSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &DefaultNodePool{DiskGbSize: &v}
+		if nilStruct.GetDiskGbSize() != nil || emptyStruct.GetDiskGbSize() != nil {
+			t.Error("GetDiskGbSize() expected to return nil")
+		}
+		if fullStruct.GetDiskGbSize() != &v {
+			t.Error("GetDiskGbSize() expected to return field")
+		}
+		if nilStruct.GetDiskGbSizeV() != 0 || emptyStruct.GetDiskGbSizeV() != 0 {
+			t.Error("GetDiskGbSizeV() expected to return zero value")
+		}
+		if fullStruct.GetDiskGbSizeV() != v {
+			t.Error("GetDiskGbSizeV() expected to return field value")
+		}
+		if nilStruct.GetDiskGbSizeOr(v) != v || emptyStruct.GetDiskGbSizeOr(v) != v {
+			t.Error("GetDiskGbSizeOr() expected to return default value")
+		}
+		if fullStruct.GetDiskGbSizeOr(0) != v {
+			t.Error("GetDiskGbSizeOr() expected to return field value")
+		}
+	})
+	t.Run("AutoScaling", func(t *testing.T) {
+		v := true
+		fullStruct := &DefaultNodePool{AutoScaling: &v}
+		if nilStruct.GetAutoScaling() != nil || emptyStruct.GetAutoScaling() != nil {
+			t.Error("GetAutoScaling() expected to return nil")
+		}
+		if fullStruct.GetAutoScaling() != &v {
+			t.Error("GetAutoScaling() expected to return field")
+		}
+		if nilStruct.GetAutoScalingV() != false || emptyStruct.GetAutoScalingV() != false {
+			t.Error("GetAutoScalingV() expected to return zero value")
+		}
+		if fullStruct.GetAutoScalingV() != v {
+			t.Error("GetAutoScalingV() expected to return field value")
+		}
+		if nilStruct.GetAutoScalingOr(v) != v || emptyStruct.GetAutoScalingOr(v) != v {
+			t.Error("GetAutoScalingOr() expected to return default value")
+		}
+		if fullStruct.GetAutoScalingOr(false) != v {
+			t.Error("GetAutoScalingOr() expected to return field value")
+		}
+	})
+	t.Run("Type", func(t *testing.T) {
+		v := "value"
+		fullStruct := &DefaultNodePool{Type: &v}
+		if nilStruct.GetType() != nil || emptyStruct.GetType() != nil {
+			t.Error("GetType() expected to return nil")
+		}
+		if fullStruct.GetType() != &v {
+			t.Error("GetType() expected to return field")
+		}
+		if nilStruct.GetTypeV() != "" || emptyStruct.GetTypeV() != "" {
+			t.Error("GetTypeV() expected to return zero value")
+		}
+		if fullStruct.GetTypeV() != v {
+			t.Error("GetTypeV() expected to return field value")
+		}
+		if nilStruct.GetTypeOr(v) != v || emptyStruct.GetTypeOr(v) != v {
+			t.Error("GetTypeOr() expected to return default value")
+		}
+		if fullStruct.GetTypeOr("") != v {
+			t.Error("GetTypeOr() expected to return field value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Location", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Location: &v}
+		if nilStruct.GetLocation() != nil || emptyStruct.GetLocation() != nil {
+			t.Error("GetLocation() expected to return nil")
+		}
+		if fullStruct.GetLocation() != &v {
+			t.Error("GetLocation() expected to return field")
+		}
+		if nilStruct.GetLocationV() != "" || emptyStruct.GetLocationV() != "" {
+			t.Error("GetLocationV() expected to return zero value")
+		}
+		if fullStruct.GetLocationV() != v {
+			t.Error("GetLocationV() expected to return field value")
+		}
+		if nilStruct.GetLocationOr(v) != v || emptyStruct.GetLocationOr(v) != v {
+			t.Error("GetLocationOr() expected to return default value")
+		}
+		if fullStruct.GetLocationOr("") != v {
+			t.Error("GetLocationOr() expected to return field value")
+		}
+	})
+	t.Run("RsaPublicKeyPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RsaPublicKeyPath: &v}
+		if nilStruct.GetRsaPublicKeyPath() != nil || emptyStruct.GetRsaPublicKeyPath() != nil {
+			t.Error("GetRsaPublicKeyPath() expected to return nil")
+		}
+		if fullStruct.GetRsaPublicKeyPath() != &v {
+			t.Error("GetRsaPublicKeyPath() expected to return field")
+		}
+		if nilStruct.GetRsaPublicKeyPathV() != "" || emptyStruct.GetRsaPublicKeyPathV() != "" {
+			t.Error("GetRsaPublicKeyPathV() expected to return zero value")
+		}
+		if fullStruct.GetRsaPublicKeyPathV() != v {
+			t.Error("GetRsaPublicKeyPathV() expected to return field value")
+		}
+		if nilStruct.GetRsaPublicKeyPathOr(v) != v || emptyStruct.GetRsaPublicKeyPathOr(v) != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return default value")
+		}
+		if fullStruct.GetRsaPublicKeyPathOr("") != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return field value")
+		}
+	})
+	t.Run("RgName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RgName: &v}
+		if nilStruct.GetRgName() != nil || emptyStruct.GetRgName() != nil {
+			t.Error("GetRgName() expected to return nil")
+		}
+		if fullStruct.GetRgName() != &v {
+			t.Error("GetRgName() expected to return field")
+		}
+		if nilStruct.GetRgNameV() != "" || emptyStruct.GetRgNameV() != "" {
+			t.Error("GetRgNameV() expected to return zero value")
+		}
+		if fullStruct.GetRgNameV() != v {
+			t.Error("GetRgNameV() expected to return field value")
+		}
+		if nilStruct.GetRgNameOr(v) != v || emptyStruct.GetRgNameOr(v) != v {
+			t.Error("GetRgNameOr() expected to return default value")
+		}
+		if fullStruct.GetRgNameOr("") != v {
+			t.Error("GetRgNameOr() expected to return field value")
+		}
+	})
+	t.Run("VnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{VnetName: &v}
+		if nilStruct.GetVnetName() != nil || emptyStruct.GetVnetName() != nil {
+			t.Error("GetVnetName() expected to return nil")
+		}
+		if fullStruct.GetVnetName() != &v {
+			t.Error("GetVnetName() expected to return field")
+		}
+		if nilStruct.GetVnetNameV() != "" || emptyStruct.GetVnetNameV() != "" {
+			t.Error("GetVnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetVnetNameV() != v {
+			t.Error("GetVnetNameV() expected to return field value")
+		}
+		if nilStruct.GetVnetNameOr(v) != v || emptyStruct.GetVnetNameOr(v) != v {
+			t.Error("GetVnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetVnetNameOr("") != v {
+			t.Error("GetVnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{SubnetName: &v}
+		if nilStruct.GetSubnetName() != nil || emptyStruct.GetSubnetName() != nil {
+			t.Error("GetSubnetName() expected to return nil")
+		}
+		if fullStruct.GetSubnetName() != &v {
+			t.Error("GetSubnetName() expected to return field")
+		}
+		if nilStruct.GetSubnetNameV() != "" || emptyStruct.GetSubnetNameV() != "" {
+			t.Error("GetSubnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetSubnetNameV() != v {
+			t.Error("GetSubnetNameV() expected to return field value")
+		}
+		if nilStruct.GetSubnetNameOr(v) != v || emptyStruct.GetSubnetNameOr(v) != v {
+			t.Error("GetSubnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetSubnetNameOr("") != v {
+			t.Error("GetSubnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("KubernetesVersion", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{KubernetesVersion: &v}
+		if nilStruct.GetKubernetesVersion() != nil || emptyStruct.GetKubernetesVersion() != nil {
+			t.Error("GetKubernetesVersion() expected to return nil")
+		}
+		if fullStruct.GetKubernetesVersion() != &v {
+			t.Error("GetKubernetesVersion() expected to return field")
+		}
+		if nilStruct.GetKubernetesVersionV() != "" || emptyStruct.GetKubernetesVersionV() != "" {
+			t.Error("GetKubernetesVersionV() expected to return zero value")
+		}
+		if fullStruct.GetKubernetesVersionV() != v {
+			t.Error("GetKubernetesVersionV() expected to return field value")
+		}
+		if nilStruct.GetKubernetesVersionOr(v) != v || emptyStruct.GetKubernetesVersionOr(v) != v {
+			t.Error("GetKubernetesVersionOr() expected to return default value")
+		}
+		if fullStruct.GetKubernetesVersionOr("") != v {
+			t.Error("GetKubernetesVersionOr() expected to return field value")
+		}
+	})
+	t.Run("EnableNodePublicIp", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{EnableNodePublicIp: &v}
+		if nilStruct.GetEnableNodePublicIp() != nil || emptyStruct.GetEnableNodePublicIp() != nil {
+			t.Error("GetEnableNodePublicIp() expected to return nil")
+		}
+		if fullStruct.GetEnableNodePublicIp() != &v {
+			t.Error("GetEnableNodePublicIp() expected to return field")
+		}
+		if nilStruct.GetEnableNodePublicIpV() != false || emptyStruct.GetEnableNodePublicIpV() != false {
+			t.Error("GetEnableNodePublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetEnableNodePublicIpV() != v {
+			t.Error("GetEnableNodePublicIpV() expected to return field value")
+		}
+		if nilStruct.GetEnableNodePublicIpOr(v) != v || emptyStruct.GetEnableNodePublicIpOr(v) != v {
+			t.Error("GetEnableNodePublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetEnableNodePublicIpOr(false) != v {
+			t.Error("GetEnableNodePublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("EnableRbac", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{EnableRbac: &v}
+		if nilStruct.GetEnableRbac() != nil || emptyStruct.GetEnableRbac() != nil {
+			t.Error("GetEnableRbac() expected to return nil")
+		}
+		if fullStruct.GetEnableRbac() != &v {
+			t.Error("GetEnableRbac() expected to return field")
+		}
+		if nilStruct.GetEnableRbacV() != false || emptyStruct.GetEnableRbacV() != false {
+			t.Error("GetEnableRbacV() expected to return zero value")
+		}
+		if fullStruct.GetEnableRbacV() != v {
+			t.Error("GetEnableRbacV() expected to return field value")
+		}
+		if nilStruct.GetEnableRbacOr(v) != v || emptyStruct.GetEnableRbacOr(v) != v {
+			t.Error("GetEnableRbacOr() expected to return default value")
+		}
+		if fullStruct.GetEnableRbacOr(false) != v {
+			t.Error("GetEnableRbacOr() expected to return field value")
+		}
+	})
+	t.Run("DefaultNodePool", func(t *testing.T) {
+		v := DefaultNodePool{}
+		fullStruct := &Params{DefaultNodePool: &v}
+		if nilStruct.GetDefaultNodePool() != nil || emptyStruct.GetDefaultNodePool() != nil {
+			t.Error("GetDefaultNodePool() expected to return nil")
+		}
+		if fullStruct.GetDefaultNodePool() != &v {
+			t.Error("GetDefaultNodePool() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetDefaultNodePoolV(), DefaultNodePool{}) || !reflect.DeepEqual(emptyStruct.GetDefaultNodePoolV(), DefaultNodePool{}) {
+			t.Error("GetDefaultNodePoolV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetDefaultNodePoolV(), v) {
+			t.Error("GetDefaultNodePoolV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetDefaultNodePoolOr(v), v) || !reflect.DeepEqual(emptyStruct.GetDefaultNodePoolOr(v), v) {
+			t.Error("GetDefaultNodePoolOr() expected to return default value")
+		}
+	})
+	t.Run("AutoScalerProfile", func(t *testing.T) {
+		v := AutoScalerProfile{}
+		fullStruct := &Params{AutoScalerProfile: &v}
+		if nilStruct.GetAutoScalerProfile() != nil || emptyStruct.GetAutoScalerProfile() != nil {
+			t.Error("GetAutoScalerProfile() expected to return nil")
+		}
+		if fullStruct.GetAutoScalerProfile() != &v {
+			t.Error("GetAutoScalerProfile() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAutoScalerProfileV(), AutoScalerProfile{}) || !reflect.DeepEqual(emptyStruct.GetAutoScalerProfileV(), AutoScalerProfile{}) {
+			t.Error("GetAutoScalerProfileV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAutoScalerProfileV(), v) {
+			t.Error("GetAutoScalerProfileV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAutoScalerProfileOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAutoScalerProfileOr(v), v) {
+			t.Error("GetAutoScalerProfileOr() expected to return default value")
+		}
+	})
+	t.Run("AzureAd", func(t *testing.T) {
+		v := AzureAd{}
+		fullStruct := &Params{AzureAd: &v}
+		if nilStruct.GetAzureAd() != nil || emptyStruct.GetAzureAd() != nil {
+			t.Error("GetAzureAd() expected to return nil")
+		}
+		if fullStruct.GetAzureAd() != &v {
+			t.Error("GetAzureAd() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzureAdV(), AzureAd{}) || !reflect.DeepEqual(emptyStruct.GetAzureAdV(), AzureAd{}) {
+			t.Error("GetAzureAdV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzureAdV(), v) {
+			t.Error("GetAzureAdV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzureAdOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzureAdOr(v), v) {
+			t.Error("GetAzureAdOr() expected to return default value")
+		}
+	})
+	t.Run("IdentityType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{IdentityType: &v}
+		if nilStruct.GetIdentityType() != nil || emptyStruct.GetIdentityType() != nil {
+			t.Error("GetIdentityType() expected to return nil")
+		}
+		if fullStruct.GetIdentityType() != &v {
+			t.Error("GetIdentityType() expected to return field")
+		}
+		if nilStruct.GetIdentityTypeV() != "" || emptyStruct.GetIdentityTypeV() != "" {
+			t.Error("GetIdentityTypeV() expected to return zero value")
+		}
+		if fullStruct.GetIdentityTypeV() != v {
+			t.Error("GetIdentityTypeV() expected to return field value")
+		}
+		if nilStruct.GetIdentityTypeOr(v) != v || emptyStruct.GetIdentityTypeOr(v) != v {
+			t.Error("GetIdentityTypeOr() expected to return default value")
+		}
+		if fullStruct.GetIdentityTypeOr("") != v {
+			t.Error("GetIdentityTypeOr() expected to return field value")
+		}
+	})
+	t.Run("AdminUsername", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{AdminUsername: &v}
+		if nilStruct.GetAdminUsername() != nil || emptyStruct.GetAdminUsername() != nil {
+			t.Error("GetAdminUsername() expected to return nil")
+		}
+		if fullStruct.GetAdminUsername() != &v {
+			t.Error("GetAdminUsername() expected to return field")
+		}
+		if nilStruct.GetAdminUsernameV() != "" || emptyStruct.GetAdminUsernameV() != "" {
+			t.Error("GetAdminUsernameV() expected to return zero value")
+		}
+		if fullStruct.GetAdminUsernameV() != v {
+			t.Error("GetAdminUsernameV() expected to return field value")
+		}
+		if nilStruct.GetAdminUsernameOr(v) != v || emptyStruct.GetAdminUsernameOr(v) != v {
+			t.Error("GetAdminUsernameOr() expected to return default value")
+		}
+		if fullStruct.GetAdminUsernameOr("") != v {
+			t.Error("GetAdminUsernameOr() expected to return field value")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("KubeConfig", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{KubeConfig: &v}
+		if nilStruct.GetKubeConfig() != nil || emptyStruct.GetKubeConfig() != nil {
+			t.Error("GetKubeConfig() expected to return nil")
+		}
+		if fullStruct.GetKubeConfig() != &v {
+			t.Error("GetKubeConfig() expected to return field")
+		}
+		if nilStruct.GetKubeConfigV() != "" || emptyStruct.GetKubeConfigV() != "" {
+			t.Error("GetKubeConfigV() expected to return zero value")
+		}
+		if fullStruct.GetKubeConfigV() != v {
+			t.Error("GetKubeConfigV() expected to return field value")
+		}
+		if nilStruct.GetKubeConfigOr(v) != v || emptyStruct.GetKubeConfigOr(v) != v {
+			t.Error("GetKubeConfigOr() expected to return default value")
+		}
+		if fullStruct.GetKubeConfigOr("") != v {
+			t.Error("GetKubeConfigOr() expected to return field value")
+		}
+	})
+}
@@ -1,8 +1,14 @@
 package v0
 
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
 import (
 	"encoding/json"
 
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
 	"github.com/epiphany-platform/e-structures/utils/to"
 	"github.com/epiphany-platform/e-structures/utils/validators"
 	"github.com/go-playground/validator/v10"
@@ -16,7 +22,7 @@ const (
 
 type AzureAd struct {
 	Managed             *bool    `json:"managed" validate:"required"`
-	TenantId            *string  `json:"tenant_id" validate:"required,min=1"`
+	TenantId            *string  `json:"tenant_id" validate:"required,min=1" sensitive:"true"`
 	AdminGroupObjectIds []string `json:"admin_group_object_ids" validate:"required,min=1,dive,required,min=1"`
 }
 
@@ -59,18 +65,9 @@ type Params struct {
 	AdminUsername      *string            `json:"admin_username" validate:"required,min=1"`
 }
 
+// Deprecated: use GetRsaPublicKeyPathV.
 func (p *Params) GetRsaPublicKeyV() string {
-	if p == nil {
-		return ""
-	}
-	return *p.RsaPublicKeyPath
-}
-
-func (p *Params) GetNameV() string {
-	if p == nil {
-		return ""
-	}
-	return *p.Name
+	return p.GetRsaPublicKeyPathV()
 }
 
 type Config struct {
@@ -80,13 +77,6 @@ type Config struct {
 	Unused  []string `json:"-"`
 }
 
-func (c *Config) GetParams() *Params {
-	if c == nil {
-		return nil
-	}
-	return c.Params
-}
-
 //TODO test
 func NewConfig() *Config {
 	return &Config{
@@ -142,7 +132,40 @@ func (c *Config) Marshal() ([]byte, error) {
 	return json.MarshalIndent(c, "", "\t")
 }
 
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
 func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
 	var input map[string]interface{}
 	if err = json.Unmarshal(b, &input); err != nil {
 		return
@@ -161,7 +184,6 @@ func (c *Config) Unmarshal(b []byte) (err error) {
 		return
 	}
 	c.Unused = md.Unused
-	err = c.isValid()
 	return
 }
 
@@ -184,5 +206,17 @@ func (c *Config) isValid() error {
 }
 
 type Output struct {
-	KubeConfig *string `json:"kubeconfig"`
+	KubeConfig *string `json:"kubeconfig" validate:"required,kubeconfig" sensitive:"true"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
+
+// Redacted returns copy of Output with values of sensitive fields replaced, so it can be logged.
+func (o *Output) Redacted() *Output {
+	r := o.DeepCopy()
+	sensitive.Redact(r)
+	return r
 }
@@ -1740,3 +1740,36 @@ func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr erro
 		}
 	}
 }
+
+func TestConfig_Redacted(t *testing.T) {
+	c := NewConfig()
+	c.Params.AzureAd = &AzureAd{
+		Managed:             to.BooPtr(true),
+		TenantId:            to.StrPtr("123123123123"),
+		AdminGroupObjectIds: []string{"123123123123"},
+	}
+	r := c.Redacted()
+	if got := r.GetParams().GetAzureAd().GetTenantIdV(); got != "<redacted>" {
+		t.Errorf("Redacted() tenant_id = %s, want <redacted>", got)
+	}
+	if diff := cmp.Diff([]string{"123123123123"}, r.GetParams().GetAzureAd().GetAdminGroupObjectIds()); diff != "" {
+		t.Errorf("Redacted() changed not sensitive field (-want +got):\n%s", diff)
+	}
+	if got := c.GetParams().GetAzureAd().GetTenantIdV(); got != "123123123123" {
+		t.Errorf("Redacted() modified original config, tenant_id = %s", got)
+	}
+	var nilConfig *Config
+	if nilConfig.Redacted() != nil {
+		t.Error("Redacted() of nil expected to return nil")
+	}
+}
+
+func TestOutput_Redacted(t *testing.T) {
+	o := &Output{KubeConfig: to.StrPtr("apiVersion: v1\nkind: Config\n")}
+	if got := o.Redacted().GetKubeConfigV(); got != "<redacted>" {
+		t.Errorf("Redacted() kubeconfig = %s, want <redacted>", got)
+	}
+	if got := o.GetKubeConfigV(); got != "apiVersion: v1\nkind: Config\n" {
+		t.Errorf("Redacted() modified original output, kubeconfig = %s", got)
+	}
+}
@@ -0,0 +1,367 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of AzureAd or nil if AzureAd is nil.
+func (a *AzureAd) DeepCopy() *AzureAd {
+	if a == nil {
+		return nil
+	}
+	out := new(AzureAd)
+	if a.Managed != nil {
+		x := *a.Managed
+		out.Managed = &x
+	}
+	if a.TenantId != nil {
+		x := *a.TenantId
+		out.TenantId = &x
+	}
+	if a.AdminGroupObjectIds != nil {
+		out.AdminGroupObjectIds = make([]string, len(a.AdminGroupObjectIds))
+		copy(out.AdminGroupObjectIds, a.AdminGroupObjectIds)
+	}
+	return out
+}
+
+// Equal reports whether AzureAd and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AzureAd) Equal(other *AzureAd) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.Managed == nil) != (other.Managed == nil) || a.Managed != nil && *a.Managed != *other.Managed {
+		return false
+	}
+	if (a.TenantId == nil) != (other.TenantId == nil) || a.TenantId != nil && *a.TenantId != *other.TenantId {
+		return false
+	}
+	if (a.AdminGroupObjectIds == nil) != (other.AdminGroupObjectIds == nil) || len(a.AdminGroupObjectIds) != len(other.AdminGroupObjectIds) {
+		return false
+	}
+	for i := range a.AdminGroupObjectIds {
+		if a.AdminGroupObjectIds[i] != other.AdminGroupObjectIds[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AutoScalerProfile or nil if AutoScalerProfile is nil.
+func (a *AutoScalerProfile) DeepCopy() *AutoScalerProfile {
+	if a == nil {
+		return nil
+	}
+	out := new(AutoScalerProfile)
+	if a.BalanceSimilarNodeGroups != nil {
+		x := *a.BalanceSimilarNodeGroups
+		out.BalanceSimilarNodeGroups = &x
+	}
+	if a.MaxGracefulTerminationSec != nil {
+		x := *a.MaxGracefulTerminationSec
+		out.MaxGracefulTerminationSec = &x
+	}
+	if a.ScaleDownDelayAfterAdd != nil {
+		x := *a.ScaleDownDelayAfterAdd
+		out.ScaleDownDelayAfterAdd = &x
+	}
+	if a.ScaleDownDelayAfterDelete != nil {
+		x := *a.ScaleDownDelayAfterDelete
+		out.ScaleDownDelayAfterDelete = &x
+	}
+	if a.ScaleDownDelayAfterFailure != nil {
+		x := *a.ScaleDownDelayAfterFailure
+		out.ScaleDownDelayAfterFailure = &x
+	}
+	if a.ScanInterval != nil {
+		x := *a.ScanInterval
+		out.ScanInterval = &x
+	}
+	if a.ScaleDownUnneeded != nil {
+		x := *a.ScaleDownUnneeded
+		out.ScaleDownUnneeded = &x
+	}
+	if a.ScaleDownUnready != nil {
+		x := *a.ScaleDownUnready
+		out.ScaleDownUnready = &x
+	}
+	if a.ScaleDownUtilizationThreshold != nil {
+		x := *a.ScaleDownUtilizationThreshold
+		out.ScaleDownUtilizationThreshold = &x
+	}
+	return out
+}
+
+// Equal reports whether AutoScalerProfile and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AutoScalerProfile) Equal(other *AutoScalerProfile) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.BalanceSimilarNodeGroups == nil) != (other.BalanceSimilarNodeGroups == nil) || a.BalanceSimilarNodeGroups != nil && *a.BalanceSimilarNodeGroups != *other.BalanceSimilarNodeGroups {
+		return false
+	}
+	if (a.MaxGracefulTerminationSec == nil) != (other.MaxGracefulTerminationSec == nil) || a.MaxGracefulTerminationSec != nil && *a.MaxGracefulTerminationSec != *other.MaxGracefulTerminationSec {
+		return false
+	}
+	if (a.ScaleDownDelayAfterAdd == nil) != (other.ScaleDownDelayAfterAdd == nil) || a.ScaleDownDelayAfterAdd != nil && *a.ScaleDownDelayAfterAdd != *other.ScaleDownDelayAfterAdd {
+		return false
+	}
+	if (a.ScaleDownDelayAfterDelete == nil) != (other.ScaleDownDelayAfterDelete == nil) || a.ScaleDownDelayAfterDelete != nil && *a.ScaleDownDelayAfterDelete != *other.ScaleDownDelayAfterDelete {
+		return false
+	}
+	if (a.ScaleDownDelayAfterFailure == nil) != (other.ScaleDownDelayAfterFailure == nil) || a.ScaleDownDelayAfterFailure != nil && *a.ScaleDownDelayAfterFailure != *other.ScaleDownDelayAfterFailure {
+		return false
+	}
+	if (a.ScanInterval == nil) != (other.ScanInterval == nil) || a.ScanInterval != nil && *a.ScanInterval != *other.ScanInterval {
+		return false
+	}
+	if (a.ScaleDownUnneeded == nil) != (other.ScaleDownUnneeded == nil) || a.ScaleDownUnneeded != nil && *a.ScaleDownUnneeded != *other.ScaleDownUnneeded {
+		return false
+	}
+	if (a.ScaleDownUnready == nil) != (other.ScaleDownUnready == nil) || a.ScaleDownUnready != nil && *a.ScaleDownUnready != *other.ScaleDownUnready {
+		return false
+	}
+	if (a.ScaleDownUtilizationThreshold == nil) != (other.ScaleDownUtilizationThreshold == nil) || a.ScaleDownUtilizationThreshold != nil && *a.ScaleDownUtilizationThreshold != *other.ScaleDownUtilizationThreshold {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of DefaultNodePool or nil if DefaultNodePool is nil.
+func (d *DefaultNodePool) DeepCopy() *DefaultNodePool {
+	if d == nil {
+		return nil
+	}
+	out := new(DefaultNodePool)
+	if d.Size != nil {
+		x := *d.Size
+		out.Size = &x
+	}
+	if d.Min != nil {
+		x := *d.Min
+		out.Min = &x
+	}
+	if d.Max != nil {
+		x := *d.Max
+		out.Max = &x
+	}
+	if d.VmSize != nil {
+		x := *d.VmSize
+		out.VmSize = &x
+	}
+	if d.DiskGbSize != nil {
+		x := *d.DiskGbSize
+		out.DiskGbSize = &x
+	}
+	if d.AutoScaling != nil {
+		x := *d.AutoScaling
+		out.AutoScaling = &x
+	}
+	if d.Type != nil {
+		x := *d.Type
+		out.Type = &x
+	}
+	return out
+}
+
+// Equal reports whether DefaultNodePool and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (d *DefaultNodePool) Equal(other *DefaultNodePool) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if (d.Size == nil) != (other.Size == nil) || d.Size != nil && *d.Size != *other.Size {
+		return false
+	}
+	if (d.Min == nil) != (other.Min == nil) || d.Min != nil && *d.Min != *other.Min {
+		return false
+	}
+	if (d.Max == nil) != (other.Max == nil) || d.Max != nil && *d.Max != *other.Max {
+		return false
+	}
+	if (d.VmSize == nil) != (other.VmSize == nil) || d.VmSize != nil && *d.VmSize != *other.VmSize {
+		return false
+	}
+	if (d.DiskGbSize == nil) != (other.DiskGbSize == nil) || d.DiskGbSize != nil && *d.DiskGbSize != *other.DiskGbSize {
+		return false
+	}
+	if (d.AutoScaling == nil) != (other.AutoScaling == nil) || d.AutoScaling != nil && *d.AutoScaling != *other.AutoScaling {
+		return false
+	}
+	if (d.Type == nil) != (other.Type == nil) || d.Type != nil && *d.Type != *other.Type {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Location != nil {
+		x := *p.Location
+		out.Location = &x
+	}
+	if p.RsaPublicKeyPath != nil {
+		x := *p.RsaPublicKeyPath
+		out.RsaPublicKeyPath = &x
+	}
+	if p.RgName != nil {
+		x := *p.RgName
+		out.RgName = &x
+	}
+	if p.VnetName != nil {
+		x := *p.VnetName
+		out.VnetName = &x
+	}
+	if p.SubnetName != nil {
+		x := *p.SubnetName
+		out.SubnetName = &x
+	}
+	if p.KubernetesVersion != nil {
+		x := *p.KubernetesVersion
+		out.KubernetesVersion = &x
+	}
+	if p.EnableNodePublicIp != nil {
+		x := *p.EnableNodePublicIp
+		out.EnableNodePublicIp = &x
+	}
+	if p.EnableRbac != nil {
+		x := *p.EnableRbac
+		out.EnableRbac = &x
+	}
+	out.DefaultNodePool = p.DefaultNodePool.DeepCopy()
+	out.AutoScalerProfile = p.AutoScalerProfile.DeepCopy()
+	out.AzureAd = p.AzureAd.DeepCopy()
+	if p.IdentityType != nil {
+		x := *p.IdentityType
+		out.IdentityType = &x
+	}
+	if p.AdminUsername != nil {
+		x := *p.AdminUsername
+		out.AdminUsername = &x
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Location == nil) != (other.Location == nil) || p.Location != nil && *p.Location != *other.Location {
+		return false
+	}
+	if (p.RsaPublicKeyPath == nil) != (other.RsaPublicKeyPath == nil) || p.RsaPublicKeyPath != nil && *p.RsaPublicKeyPath != *other.RsaPublicKeyPath {
+		return false
+	}
+	if (p.RgName == nil) != (other.RgName == nil) || p.RgName != nil && *p.RgName != *other.RgName {
+		return false
+	}
+	if (p.VnetName == nil) != (other.VnetName == nil) || p.VnetName != nil && *p.VnetName != *other.VnetName {
+		return false
+	}
+	if (p.SubnetName == nil) != (other.SubnetName == nil) || p.SubnetName != nil && *p.SubnetName != *other.SubnetName {
+		return false
+	}
+	if (p.KubernetesVersion == nil) != (other.KubernetesVersion == nil) || p.KubernetesVersion != nil && *p.KubernetesVersion != *other.KubernetesVersion {
+		return false
+	}
+	if (p.EnableNodePublicIp == nil) != (other.EnableNodePublicIp == nil) || p.EnableNodePublicIp != nil && *p.EnableNodePublicIp != *other.EnableNodePublicIp {
+		return false
+	}
+	if (p.EnableRbac == nil) != (other.EnableRbac == nil) || p.EnableRbac != nil && *p.EnableRbac != *other.EnableRbac {
+		return false
+	}
+	if !p.DefaultNodePool.Equal(other.DefaultNodePool) {
+		return false
+	}
+	if !p.AutoScalerProfile.Equal(other.AutoScalerProfile) {
+		return false
+	}
+	if !p.AzureAd.Equal(other.AzureAd) {
+		return false
+	}
+	if (p.IdentityType == nil) != (other.IdentityType == nil) || p.IdentityType != nil && *p.IdentityType != *other.IdentityType {
+		return false
+	}
+	if (p.AdminUsername == nil) != (other.AdminUsername == nil) || p.AdminUsername != nil && *p.AdminUsername != *other.AdminUsername {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.KubeConfig != nil {
+		x := *o.KubeConfig
+		out.KubeConfig = &x
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.KubeConfig == nil) != (other.KubeConfig == nil) || o.KubeConfig != nil && *o.KubeConfig != *other.KubeConfig {
+		return false
+	}
+	return true
+}
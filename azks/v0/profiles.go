@@ -0,0 +1,92 @@
+package v0
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/epiphany-platform/e-structures/utils/to"
+)
+
+const (
+	// ProfileDefault is profile returned by NewConfig.
+	ProfileDefault = "default"
+	// ProfileMinimal is single node cluster without autoscaling, suitable for development.
+	ProfileMinimal = "minimal"
+	// ProfileHA is cluster of at least three nodes with RBAC enabled.
+	ProfileHA = "ha"
+	// ProfileProduction is cluster of at least three bigger nodes with RBAC and managed Azure AD
+	// integration enabled. Tenant id is secret reference to ARM_TENANT_ID environment variable
+	// and admin group is ${var.aks_admin_group_object_id} variable, so config has to be loaded
+	// with load.ResolveSecrets and load.Interpolate options, or both values have to be replaced.
+	ProfileProduction = "production"
+)
+
+var profiles = map[string]func() *Config{
+	ProfileDefault: NewConfig,
+	ProfileMinimal: func() *Config {
+		c := NewConfig()
+		c.Params.DefaultNodePool = &DefaultNodePool{
+			Size:        to.IntPtr(1),
+			Min:         to.IntPtr(1),
+			Max:         to.IntPtr(1),
+			VmSize:      to.StrPtr("Standard_B2s"),
+			DiskGbSize:  to.IntPtr(32),
+			AutoScaling: to.BooPtr(false),
+			Type:        to.StrPtr("VirtualMachineScaleSets"),
+		}
+		return c
+	},
+	ProfileHA: func() *Config {
+		c := NewConfig()
+		c.Params.EnableRbac = to.BooPtr(true)
+		c.Params.DefaultNodePool.Size = to.IntPtr(3)
+		c.Params.DefaultNodePool.Min = to.IntPtr(3)
+		c.Params.DefaultNodePool.Max = to.IntPtr(6)
+		c.Params.AutoScalerProfile.BalanceSimilarNodeGroups = to.BooPtr(true)
+		return c
+	},
+	ProfileProduction: func() *Config {
+		c := NewConfig()
+		c.Params.EnableRbac = to.BooPtr(true)
+		c.Params.DefaultNodePool = &DefaultNodePool{
+			Size:        to.IntPtr(3),
+			Min:         to.IntPtr(3),
+			Max:         to.IntPtr(10),
+			VmSize:      to.StrPtr("Standard_DS3_v2"),
+			DiskGbSize:  to.IntPtr(128),
+			AutoScaling: to.BooPtr(true),
+			Type:        to.StrPtr("VirtualMachineScaleSets"),
+		}
+		c.Params.AutoScalerProfile.BalanceSimilarNodeGroups = to.BooPtr(true)
+		c.Params.AzureAd = &AzureAd{
+			Managed:             to.BooPtr(true),
+			TenantId:            to.StrPtr("env://ARM_TENANT_ID"),
+			AdminGroupObjectIds: []string{"${var.aks_admin_group_object_id}"},
+		}
+		return c
+	},
+}
+
+// Profiles returns sorted names of profiles accepted by NewConfigWithProfile.
+func Profiles() []string {
+	var result []string
+	for name := range profiles {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// NewConfigWithProfile returns new Config with defaults of named profile. Empty name means
+// ProfileDefault.
+func NewConfigWithProfile(name string) (*Config, error) {
+	if name == "" {
+		name = ProfileDefault
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown %s profile '%s', known profiles are: %s", kind, name, strings.Join(Profiles(), ", "))
+	}
+	return profile(), nil
+}
@@ -0,0 +1,93 @@
+package v0
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewConfigWithProfile(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(t *testing.T, c *Config)
+	}{
+		{
+			name: "",
+			check: func(t *testing.T, c *Config) {
+				if diff := cmp.Diff(NewConfig(), c); diff != "" {
+					t.Errorf("NewConfigWithProfile() mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: ProfileDefault,
+			check: func(t *testing.T, c *Config) {
+				if diff := cmp.Diff(NewConfig(), c); diff != "" {
+					t.Errorf("NewConfigWithProfile() mismatch (-want +got):\n%s", diff)
+				}
+			},
+		},
+		{
+			name: ProfileMinimal,
+			check: func(t *testing.T, c *Config) {
+				if got := c.Params.DefaultNodePool.GetMaxV(); got != 1 {
+					t.Errorf("default_node_pool.max = %d, want 1", got)
+				}
+			},
+		},
+		{
+			name: ProfileHA,
+			check: func(t *testing.T, c *Config) {
+				checkCluster(t, c, false)
+			},
+		},
+		{
+			name: ProfileProduction,
+			check: func(t *testing.T, c *Config) {
+				checkCluster(t, c, true)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewConfigWithProfile(tt.name)
+			if err != nil {
+				t.Fatalf("NewConfigWithProfile() unexpected error occurred: %v", err)
+			}
+			b, err := c.Marshal()
+			if err != nil {
+				t.Fatalf("NewConfigWithProfile() returned invalid config: %v", err)
+			}
+			loaded := &Config{}
+			if err = loaded.UnmarshalStrict(b); err != nil {
+				t.Fatalf("NewConfigWithProfile() returned config that cannot be loaded: %v", err)
+			}
+			tt.check(t, c)
+		})
+	}
+
+	for _, name := range Profiles() {
+		if _, err := NewConfigWithProfile(name); err != nil {
+			t.Errorf("NewConfigWithProfile() of listed profile %s unexpected error occurred: %v", name, err)
+		}
+	}
+
+	_, err := NewConfigWithProfile("unknown")
+	want := "unknown azks profile 'unknown', known profiles are: default, ha, minimal, production"
+	if err == nil || err.Error() != want {
+		t.Errorf("NewConfigWithProfile() error = %v, want %s", err, want)
+	}
+}
+
+func checkCluster(t *testing.T, c *Config, azureAd bool) {
+	t.Helper()
+	if !c.Params.GetEnableRbacV() {
+		t.Errorf("enable_rbac = false, want true")
+	}
+	if got := c.Params.DefaultNodePool.GetMinV(); got < 3 {
+		t.Errorf("default_node_pool.min = %d, want at least 3", got)
+	}
+	if azureAd && (c.Params.AzureAd == nil || !c.Params.AzureAd.GetManagedV()) {
+		t.Errorf("azure_ad = %v, want managed Azure AD integration", c.Params.AzureAd)
+	}
+}
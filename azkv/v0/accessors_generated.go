@@ -0,0 +1,654 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetObjectId returns ObjectId field of AccessPolicy or nil if AccessPolicy is nil.
+func (a *AccessPolicy) GetObjectId() *string {
+	if a == nil {
+		return nil
+	}
+	return a.ObjectId
+}
+
+// GetObjectIdV returns value of ObjectId field of AccessPolicy or zero value if either AccessPolicy or field is nil.
+func (a *AccessPolicy) GetObjectIdV() string {
+	if a == nil || a.ObjectId == nil {
+		return ""
+	}
+	return *a.ObjectId
+}
+
+// GetObjectIdOr returns value of ObjectId field of AccessPolicy or def if either AccessPolicy or field is nil.
+func (a *AccessPolicy) GetObjectIdOr(def string) string {
+	if a == nil || a.ObjectId == nil {
+		return def
+	}
+	return *a.ObjectId
+}
+
+// GetKeyPermissions returns KeyPermissions field of AccessPolicy, nil if AccessPolicy is nil or empty slice if field is nil.
+func (a *AccessPolicy) GetKeyPermissions() []string {
+	if a == nil {
+		return nil
+	}
+	if len(a.KeyPermissions) == 0 {
+		return []string{}
+	}
+	return a.KeyPermissions
+}
+
+// GetSecretPermissions returns SecretPermissions field of AccessPolicy, nil if AccessPolicy is nil or empty slice if field is nil.
+func (a *AccessPolicy) GetSecretPermissions() []string {
+	if a == nil {
+		return nil
+	}
+	if len(a.SecretPermissions) == 0 {
+		return []string{}
+	}
+	return a.SecretPermissions
+}
+
+// GetCertificatePermissions returns CertificatePermissions field of AccessPolicy, nil if AccessPolicy is nil or empty slice if field is nil.
+func (a *AccessPolicy) GetCertificatePermissions() []string {
+	if a == nil {
+		return nil
+	}
+	if len(a.CertificatePermissions) == 0 {
+		return []string{}
+	}
+	return a.CertificatePermissions
+}
+
+// GetDefaultAction returns DefaultAction field of NetworkAcls or nil if NetworkAcls is nil.
+func (n *NetworkAcls) GetDefaultAction() *string {
+	if n == nil {
+		return nil
+	}
+	return n.DefaultAction
+}
+
+// GetDefaultActionV returns value of DefaultAction field of NetworkAcls or zero value if either NetworkAcls or field is nil.
+func (n *NetworkAcls) GetDefaultActionV() string {
+	if n == nil || n.DefaultAction == nil {
+		return ""
+	}
+	return *n.DefaultAction
+}
+
+// GetDefaultActionOr returns value of DefaultAction field of NetworkAcls or def if either NetworkAcls or field is nil.
+func (n *NetworkAcls) GetDefaultActionOr(def string) string {
+	if n == nil || n.DefaultAction == nil {
+		return def
+	}
+	return *n.DefaultAction
+}
+
+// GetBypass returns Bypass field of NetworkAcls or nil if NetworkAcls is nil.
+func (n *NetworkAcls) GetBypass() *string {
+	if n == nil {
+		return nil
+	}
+	return n.Bypass
+}
+
+// GetBypassV returns value of Bypass field of NetworkAcls or zero value if either NetworkAcls or field is nil.
+func (n *NetworkAcls) GetBypassV() string {
+	if n == nil || n.Bypass == nil {
+		return ""
+	}
+	return *n.Bypass
+}
+
+// GetBypassOr returns value of Bypass field of NetworkAcls or def if either NetworkAcls or field is nil.
+func (n *NetworkAcls) GetBypassOr(def string) string {
+	if n == nil || n.Bypass == nil {
+		return def
+	}
+	return *n.Bypass
+}
+
+// GetIpRules returns IpRules field of NetworkAcls, nil if NetworkAcls is nil or empty slice if field is nil.
+func (n *NetworkAcls) GetIpRules() []string {
+	if n == nil {
+		return nil
+	}
+	if len(n.IpRules) == 0 {
+		return []string{}
+	}
+	return n.IpRules
+}
+
+// GetVnetName returns VnetName field of NetworkAcls or nil if NetworkAcls is nil.
+func (n *NetworkAcls) GetVnetName() *string {
+	if n == nil {
+		return nil
+	}
+	return n.VnetName
+}
+
+// GetVnetNameV returns value of VnetName field of NetworkAcls or zero value if either NetworkAcls or field is nil.
+func (n *NetworkAcls) GetVnetNameV() string {
+	if n == nil || n.VnetName == nil {
+		return ""
+	}
+	return *n.VnetName
+}
+
+// GetVnetNameOr returns value of VnetName field of NetworkAcls or def if either NetworkAcls or field is nil.
+func (n *NetworkAcls) GetVnetNameOr(def string) string {
+	if n == nil || n.VnetName == nil {
+		return def
+	}
+	return *n.VnetName
+}
+
+// GetSubnetNames returns SubnetNames field of NetworkAcls, nil if NetworkAcls is nil or empty slice if field is nil.
+func (n *NetworkAcls) GetSubnetNames() []string {
+	if n == nil {
+		return nil
+	}
+	if len(n.SubnetNames) == 0 {
+		return []string{}
+	}
+	return n.SubnetNames
+}
+
+// GetName returns Name field of Secret or nil if Secret is nil.
+func (s *Secret) GetName() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Name
+}
+
+// GetNameV returns value of Name field of Secret or zero value if either Secret or field is nil.
+func (s *Secret) GetNameV() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOr returns value of Name field of Secret or def if either Secret or field is nil.
+func (s *Secret) GetNameOr(def string) string {
+	if s == nil || s.Name == nil {
+		return def
+	}
+	return *s.Name
+}
+
+// GetValue returns Value field of Secret or nil if Secret is nil.
+func (s *Secret) GetValue() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Value
+}
+
+// GetValueV returns value of Value field of Secret or zero value if either Secret or field is nil.
+func (s *Secret) GetValueV() string {
+	if s == nil || s.Value == nil {
+		return ""
+	}
+	return *s.Value
+}
+
+// GetValueOr returns value of Value field of Secret or def if either Secret or field is nil.
+func (s *Secret) GetValueOr(def string) string {
+	if s == nil || s.Value == nil {
+		return def
+	}
+	return *s.Value
+}
+
+// GetContentType returns ContentType field of Secret or nil if Secret is nil.
+func (s *Secret) GetContentType() *string {
+	if s == nil {
+		return nil
+	}
+	return s.ContentType
+}
+
+// GetContentTypeV returns value of ContentType field of Secret or zero value if either Secret or field is nil.
+func (s *Secret) GetContentTypeV() string {
+	if s == nil || s.ContentType == nil {
+		return ""
+	}
+	return *s.ContentType
+}
+
+// GetContentTypeOr returns value of ContentType field of Secret or def if either Secret or field is nil.
+func (s *Secret) GetContentTypeOr(def string) string {
+	if s == nil || s.ContentType == nil {
+		return def
+	}
+	return *s.ContentType
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetLocation returns Location field of Params or nil if Params is nil.
+func (p *Params) GetLocation() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Location
+}
+
+// GetLocationV returns value of Location field of Params or zero value if either Params or field is nil.
+func (p *Params) GetLocationV() string {
+	if p == nil || p.Location == nil {
+		return ""
+	}
+	return *p.Location
+}
+
+// GetLocationOr returns value of Location field of Params or def if either Params or field is nil.
+func (p *Params) GetLocationOr(def string) string {
+	if p == nil || p.Location == nil {
+		return def
+	}
+	return *p.Location
+}
+
+// GetRgName returns RgName field of Params or nil if Params is nil.
+func (p *Params) GetRgName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RgName
+}
+
+// GetRgNameV returns value of RgName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRgNameV() string {
+	if p == nil || p.RgName == nil {
+		return ""
+	}
+	return *p.RgName
+}
+
+// GetRgNameOr returns value of RgName field of Params or def if either Params or field is nil.
+func (p *Params) GetRgNameOr(def string) string {
+	if p == nil || p.RgName == nil {
+		return def
+	}
+	return *p.RgName
+}
+
+// GetVaultName returns VaultName field of Params or nil if Params is nil.
+func (p *Params) GetVaultName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.VaultName
+}
+
+// GetVaultNameV returns value of VaultName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetVaultNameV() string {
+	if p == nil || p.VaultName == nil {
+		return ""
+	}
+	return *p.VaultName
+}
+
+// GetVaultNameOr returns value of VaultName field of Params or def if either Params or field is nil.
+func (p *Params) GetVaultNameOr(def string) string {
+	if p == nil || p.VaultName == nil {
+		return def
+	}
+	return *p.VaultName
+}
+
+// GetTenantId returns TenantId field of Params or nil if Params is nil.
+func (p *Params) GetTenantId() *string {
+	if p == nil {
+		return nil
+	}
+	return p.TenantId
+}
+
+// GetTenantIdV returns value of TenantId field of Params or zero value if either Params or field is nil.
+func (p *Params) GetTenantIdV() string {
+	if p == nil || p.TenantId == nil {
+		return ""
+	}
+	return *p.TenantId
+}
+
+// GetTenantIdOr returns value of TenantId field of Params or def if either Params or field is nil.
+func (p *Params) GetTenantIdOr(def string) string {
+	if p == nil || p.TenantId == nil {
+		return def
+	}
+	return *p.TenantId
+}
+
+// GetSku returns Sku field of Params or nil if Params is nil.
+func (p *Params) GetSku() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Sku
+}
+
+// GetSkuV returns value of Sku field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSkuV() string {
+	if p == nil || p.Sku == nil {
+		return ""
+	}
+	return *p.Sku
+}
+
+// GetSkuOr returns value of Sku field of Params or def if either Params or field is nil.
+func (p *Params) GetSkuOr(def string) string {
+	if p == nil || p.Sku == nil {
+		return def
+	}
+	return *p.Sku
+}
+
+// GetSoftDeleteRetentionDays returns SoftDeleteRetentionDays field of Params or nil if Params is nil.
+func (p *Params) GetSoftDeleteRetentionDays() *int {
+	if p == nil {
+		return nil
+	}
+	return p.SoftDeleteRetentionDays
+}
+
+// GetSoftDeleteRetentionDaysV returns value of SoftDeleteRetentionDays field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSoftDeleteRetentionDaysV() int {
+	if p == nil || p.SoftDeleteRetentionDays == nil {
+		return 0
+	}
+	return *p.SoftDeleteRetentionDays
+}
+
+// GetSoftDeleteRetentionDaysOr returns value of SoftDeleteRetentionDays field of Params or def if either Params or field is nil.
+func (p *Params) GetSoftDeleteRetentionDaysOr(def int) int {
+	if p == nil || p.SoftDeleteRetentionDays == nil {
+		return def
+	}
+	return *p.SoftDeleteRetentionDays
+}
+
+// GetPurgeProtection returns PurgeProtection field of Params or nil if Params is nil.
+func (p *Params) GetPurgeProtection() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.PurgeProtection
+}
+
+// GetPurgeProtectionV returns value of PurgeProtection field of Params or zero value if either Params or field is nil.
+func (p *Params) GetPurgeProtectionV() bool {
+	if p == nil || p.PurgeProtection == nil {
+		return false
+	}
+	return *p.PurgeProtection
+}
+
+// GetPurgeProtectionOr returns value of PurgeProtection field of Params or def if either Params or field is nil.
+func (p *Params) GetPurgeProtectionOr(def bool) bool {
+	if p == nil || p.PurgeProtection == nil {
+		return def
+	}
+	return *p.PurgeProtection
+}
+
+// GetAccessPolicies returns AccessPolicies field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetAccessPolicies() []AccessPolicy {
+	if p == nil {
+		return nil
+	}
+	if len(p.AccessPolicies) == 0 {
+		return []AccessPolicy{}
+	}
+	return p.AccessPolicies
+}
+
+// GetNetworkAcls returns NetworkAcls field of Params or nil if Params is nil.
+func (p *Params) GetNetworkAcls() *NetworkAcls {
+	if p == nil {
+		return nil
+	}
+	return p.NetworkAcls
+}
+
+// GetNetworkAclsV returns value of NetworkAcls field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNetworkAclsV() NetworkAcls {
+	if p == nil || p.NetworkAcls == nil {
+		return NetworkAcls{}
+	}
+	return *p.NetworkAcls
+}
+
+// GetNetworkAclsOr returns value of NetworkAcls field of Params or def if either Params or field is nil.
+func (p *Params) GetNetworkAclsOr(def NetworkAcls) NetworkAcls {
+	if p == nil || p.NetworkAcls == nil {
+		return def
+	}
+	return *p.NetworkAcls
+}
+
+// GetSecrets returns Secrets field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetSecrets() []Secret {
+	if p == nil {
+		return nil
+	}
+	if len(p.Secrets) == 0 {
+		return []Secret{}
+	}
+	return p.Secrets
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetName returns Name field of OutputSecret or nil if OutputSecret is nil.
+func (o *OutputSecret) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputSecret or zero value if either OutputSecret or field is nil.
+func (o *OutputSecret) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputSecret or def if either OutputSecret or field is nil.
+func (o *OutputSecret) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetId returns Id field of OutputSecret or nil if OutputSecret is nil.
+func (o *OutputSecret) GetId() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Id
+}
+
+// GetIdV returns value of Id field of OutputSecret or zero value if either OutputSecret or field is nil.
+func (o *OutputSecret) GetIdV() string {
+	if o == nil || o.Id == nil {
+		return ""
+	}
+	return *o.Id
+}
+
+// GetIdOr returns value of Id field of OutputSecret or def if either OutputSecret or field is nil.
+func (o *OutputSecret) GetIdOr(def string) string {
+	if o == nil || o.Id == nil {
+		return def
+	}
+	return *o.Id
+}
+
+// GetVaultId returns VaultId field of Output or nil if Output is nil.
+func (o *Output) GetVaultId() *string {
+	if o == nil {
+		return nil
+	}
+	return o.VaultId
+}
+
+// GetVaultIdV returns value of VaultId field of Output or zero value if either Output or field is nil.
+func (o *Output) GetVaultIdV() string {
+	if o == nil || o.VaultId == nil {
+		return ""
+	}
+	return *o.VaultId
+}
+
+// GetVaultIdOr returns value of VaultId field of Output or def if either Output or field is nil.
+func (o *Output) GetVaultIdOr(def string) string {
+	if o == nil || o.VaultId == nil {
+		return def
+	}
+	return *o.VaultId
+}
+
+// GetVaultUri returns VaultUri field of Output or nil if Output is nil.
+func (o *Output) GetVaultUri() *string {
+	if o == nil {
+		return nil
+	}
+	return o.VaultUri
+}
+
+// GetVaultUriV returns value of VaultUri field of Output or zero value if either Output or field is nil.
+func (o *Output) GetVaultUriV() string {
+	if o == nil || o.VaultUri == nil {
+		return ""
+	}
+	return *o.VaultUri
+}
+
+// GetVaultUriOr returns value of VaultUri field of Output or def if either Output or field is nil.
+func (o *Output) GetVaultUriOr(def string) string {
+	if o == nil || o.VaultUri == nil {
+		return def
+	}
+	return *o.VaultUri
+}
+
+// GetSecrets returns Secrets field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetSecrets() []OutputSecret {
+	if o == nil {
+		return nil
+	}
+	if len(o.Secrets) == 0 {
+		return []OutputSecret{}
+	}
+	return o.Secrets
+}
@@ -0,0 +1,651 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAccessPolicy_Accessors(t *testing.T) {
+	var nilStruct *AccessPolicy
+	emptyStruct := &AccessPolicy{}
+	t.Run("ObjectId", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AccessPolicy{ObjectId: &v}
+		if nilStruct.GetObjectId() != nil || emptyStruct.GetObjectId() != nil {
+			t.Error("GetObjectId() expected to return nil")
+		}
+		if fullStruct.GetObjectId() != &v {
+			t.Error("GetObjectId() expected to return field")
+		}
+		if nilStruct.GetObjectIdV() != "" || emptyStruct.GetObjectIdV() != "" {
+			t.Error("GetObjectIdV() expected to return zero value")
+		}
+		if fullStruct.GetObjectIdV() != v {
+			t.Error("GetObjectIdV() expected to return field value")
+		}
+		if nilStruct.GetObjectIdOr(v) != v || emptyStruct.GetObjectIdOr(v) != v {
+			t.Error("GetObjectIdOr() expected to return default value")
+		}
+		if fullStruct.GetObjectIdOr("") != v {
+			t.Error("GetObjectIdOr() expected to return field value")
+		}
+	})
+	t.Run("KeyPermissions", func(t *testing.T) {
+		fullStruct := &AccessPolicy{KeyPermissions: make([]string, 1)}
+		if nilStruct.GetKeyPermissions() != nil {
+			t.Error("GetKeyPermissions() expected to return nil")
+		}
+		if got := emptyStruct.GetKeyPermissions(); got == nil || len(got) != 0 {
+			t.Error("GetKeyPermissions() expected to return empty slice")
+		}
+		if got := fullStruct.GetKeyPermissions(); len(got) != 1 || &got[0] != &fullStruct.KeyPermissions[0] {
+			t.Error("GetKeyPermissions() expected to return field")
+		}
+	})
+	t.Run("SecretPermissions", func(t *testing.T) {
+		fullStruct := &AccessPolicy{SecretPermissions: make([]string, 1)}
+		if nilStruct.GetSecretPermissions() != nil {
+			t.Error("GetSecretPermissions() expected to return nil")
+		}
+		if got := emptyStruct.GetSecretPermissions(); got == nil || len(got) != 0 {
+			t.Error("GetSecretPermissions() expected to return empty slice")
+		}
+		if got := fullStruct.GetSecretPermissions(); len(got) != 1 || &got[0] != &fullStruct.SecretPermissions[0] {
+			t.Error("GetSecretPermissions() expected to return field")
+		}
+	})
+	t.Run("CertificatePermissions", func(t *testing.T) {
+		fullStruct := &AccessPolicy{CertificatePermissions: make([]string, 1)}
+		if nilStruct.GetCertificatePermissions() != nil {
+			t.Error("GetCertificatePermissions() expected to return nil")
+		}
+		if got := emptyStruct.GetCertificatePermissions(); got == nil || len(got) != 0 {
+			t.Error("GetCertificatePermissions() expected to return empty slice")
+		}
+		if got := fullStruct.GetCertificatePermissions(); len(got) != 1 || &got[0] != &fullStruct.CertificatePermissions[0] {
+			t.Error("GetCertificatePermissions() expected to return field")
+		}
+	})
+}
+
+func TestNetworkAcls_Accessors(t *testing.T) {
+	var nilStruct *NetworkAcls
+	emptyStruct := &NetworkAcls{}
+	t.Run("DefaultAction", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NetworkAcls{DefaultAction: &v}
+		if nilStruct.GetDefaultAction() != nil || emptyStruct.GetDefaultAction() != nil {
+			t.Error("GetDefaultAction() expected to return nil")
+		}
+		if fullStruct.GetDefaultAction() != &v {
+			t.Error("GetDefaultAction() expected to return field")
+		}
+		if nilStruct.GetDefaultActionV() != "" || emptyStruct.GetDefaultActionV() != "" {
+			t.Error("GetDefaultActionV() expected to return zero value")
+		}
+		if fullStruct.GetDefaultActionV() != v {
+			t.Error("GetDefaultActionV() expected to return field value")
+		}
+		if nilStruct.GetDefaultActionOr(v) != v || emptyStruct.GetDefaultActionOr(v) != v {
+			t.Error("GetDefaultActionOr() expected to return default value")
+		}
+		if fullStruct.GetDefaultActionOr("") != v {
+			t.Error("GetDefaultActionOr() expected to return field value")
+		}
+	})
+	t.Run("Bypass", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NetworkAcls{Bypass: &v}
+		if nilStruct.GetBypass() != nil || emptyStruct.GetBypass() != nil {
+			t.Error("GetBypass() expected to return nil")
+		}
+		if fullStruct.GetBypass() != &v {
+			t.Error("GetBypass() expected to return field")
+		}
+		if nilStruct.GetBypassV() != "" || emptyStruct.GetBypassV() != "" {
+			t.Error("GetBypassV() expected to return zero value")
+		}
+		if fullStruct.GetBypassV() != v {
+			t.Error("GetBypassV() expected to return field value")
+		}
+		if nilStruct.GetBypassOr(v) != v || emptyStruct.GetBypassOr(v) != v {
+			t.Error("GetBypassOr() expected to return default value")
+		}
+		if fullStruct.GetBypassOr("") != v {
+			t.Error("GetBypassOr() expected to return field value")
+		}
+	})
+	t.Run("IpRules", func(t *testing.T) {
+		fullStruct := &NetworkAcls{IpRules: make([]string, 1)}
+		if nilStruct.GetIpRules() != nil {
+			t.Error("GetIpRules() expected to return nil")
+		}
+		if got := emptyStruct.GetIpRules(); got == nil || len(got) != 0 {
+			t.Error("GetIpRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetIpRules(); len(got) != 1 || &got[0] != &fullStruct.IpRules[0] {
+			t.Error("GetIpRules() expected to return field")
+		}
+	})
+	t.Run("VnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NetworkAcls{VnetName: &v}
+		if nilStruct.GetVnetName() != nil || emptyStruct.GetVnetName() != nil {
+			t.Error("GetVnetName() expected to return nil")
+		}
+		if fullStruct.GetVnetName() != &v {
+			t.Error("GetVnetName() expected to return field")
+		}
+		if nilStruct.GetVnetNameV() != "" || emptyStruct.GetVnetNameV() != "" {
+			t.Error("GetVnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetVnetNameV() != v {
+			t.Error("GetVnetNameV() expected to return field value")
+		}
+		if nilStruct.GetVnetNameOr(v) != v || emptyStruct.GetVnetNameOr(v) != v {
+			t.Error("GetVnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetVnetNameOr("") != v {
+			t.Error("GetVnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetNames", func(t *testing.T) {
+		fullStruct := &NetworkAcls{SubnetNames: make([]string, 1)}
+		if nilStruct.GetSubnetNames() != nil {
+			t.Error("GetSubnetNames() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnetNames(); got == nil || len(got) != 0 {
+			t.Error("GetSubnetNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnetNames(); len(got) != 1 || &got[0] != &fullStruct.SubnetNames[0] {
+			t.Error("GetSubnetNames() expected to return field")
+		}
+	})
+}
+
+func TestSecret_Accessors(t *testing.T) {
+	var nilStruct *Secret
+	emptyStruct := &Secret{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Secret{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Value", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Secret{Value: &v}
+		if nilStruct.GetValue() != nil || emptyStruct.GetValue() != nil {
+			t.Error("GetValue() expected to return nil")
+		}
+		if fullStruct.GetValue() != &v {
+			t.Error("GetValue() expected to return field")
+		}
+		if nilStruct.GetValueV() != "" || emptyStruct.GetValueV() != "" {
+			t.Error("GetValueV() expected to return zero value")
+		}
+		if fullStruct.GetValueV() != v {
+			t.Error("GetValueV() expected to return field value")
+		}
+		if nilStruct.GetValueOr(v) != v || emptyStruct.GetValueOr(v) != v {
+			t.Error("GetValueOr() expected to return default value")
+		}
+		if fullStruct.GetValueOr("") != v {
+			t.Error("GetValueOr() expected to return field value")
+		}
+	})
+	t.Run("ContentType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Secret{ContentType: &v}
+		if nilStruct.GetContentType() != nil || emptyStruct.GetContentType() != nil {
+			t.Error("GetContentType() expected to return nil")
+		}
+		if fullStruct.GetContentType() != &v {
+			t.Error("GetContentType() expected to return field")
+		}
+		if nilStruct.GetContentTypeV() != "" || emptyStruct.GetContentTypeV() != "" {
+			t.Error("GetContentTypeV() expected to return zero value")
+		}
+		if fullStruct.GetContentTypeV() != v {
+			t.Error("GetContentTypeV() expected to return field value")
+		}
+		if nilStruct.GetContentTypeOr(v) != v || emptyStruct.GetContentTypeOr(v) != v {
+			t.Error("GetContentTypeOr() expected to return default value")
+		}
+		if fullStruct.GetContentTypeOr("") != v {
+			t.Error("GetContentTypeOr() expected to return field value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Location", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Location: &v}
+		if nilStruct.GetLocation() != nil || emptyStruct.GetLocation() != nil {
+			t.Error("GetLocation() expected to return nil")
+		}
+		if fullStruct.GetLocation() != &v {
+			t.Error("GetLocation() expected to return field")
+		}
+		if nilStruct.GetLocationV() != "" || emptyStruct.GetLocationV() != "" {
+			t.Error("GetLocationV() expected to return zero value")
+		}
+		if fullStruct.GetLocationV() != v {
+			t.Error("GetLocationV() expected to return field value")
+		}
+		if nilStruct.GetLocationOr(v) != v || emptyStruct.GetLocationOr(v) != v {
+			t.Error("GetLocationOr() expected to return default value")
+		}
+		if fullStruct.GetLocationOr("") != v {
+			t.Error("GetLocationOr() expected to return field value")
+		}
+	})
+	t.Run("RgName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RgName: &v}
+		if nilStruct.GetRgName() != nil || emptyStruct.GetRgName() != nil {
+			t.Error("GetRgName() expected to return nil")
+		}
+		if fullStruct.GetRgName() != &v {
+			t.Error("GetRgName() expected to return field")
+		}
+		if nilStruct.GetRgNameV() != "" || emptyStruct.GetRgNameV() != "" {
+			t.Error("GetRgNameV() expected to return zero value")
+		}
+		if fullStruct.GetRgNameV() != v {
+			t.Error("GetRgNameV() expected to return field value")
+		}
+		if nilStruct.GetRgNameOr(v) != v || emptyStruct.GetRgNameOr(v) != v {
+			t.Error("GetRgNameOr() expected to return default value")
+		}
+		if fullStruct.GetRgNameOr("") != v {
+			t.Error("GetRgNameOr() expected to return field value")
+		}
+	})
+	t.Run("VaultName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{VaultName: &v}
+		if nilStruct.GetVaultName() != nil || emptyStruct.GetVaultName() != nil {
+			t.Error("GetVaultName() expected to return nil")
+		}
+		if fullStruct.GetVaultName() != &v {
+			t.Error("GetVaultName() expected to return field")
+		}
+		if nilStruct.GetVaultNameV() != "" || emptyStruct.GetVaultNameV() != "" {
+			t.Error("GetVaultNameV() expected to return zero value")
+		}
+		if fullStruct.GetVaultNameV() != v {
+			t.Error("GetVaultNameV() expected to return field value")
+		}
+		if nilStruct.GetVaultNameOr(v) != v || emptyStruct.GetVaultNameOr(v) != v {
+			t.Error("GetVaultNameOr() expected to return default value")
+		}
+		if fullStruct.GetVaultNameOr("") != v {
+			t.Error("GetVaultNameOr() expected to return field value")
+		}
+	})
+	t.Run("TenantId", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{TenantId: &v}
+		if nilStruct.GetTenantId() != nil || emptyStruct.GetTenantId() != nil {
+			t.Error("GetTenantId() expected to return nil")
+		}
+		if fullStruct.GetTenantId() != &v {
+			t.Error("GetTenantId() expected to return field")
+		}
+		if nilStruct.GetTenantIdV() != "" || emptyStruct.GetTenantIdV() != "" {
+			t.Error("GetTenantIdV() expected to return zero value")
+		}
+		if fullStruct.GetTenantIdV() != v {
+			t.Error("GetTenantIdV() expected to return field value")
+		}
+		if nilStruct.GetTenantIdOr(v) != v || emptyStruct.GetTenantIdOr(v) != v {
+			t.Error("GetTenantIdOr() expected to return default value")
+		}
+		if fullStruct.GetTenantIdOr("") != v {
+			t.Error("GetTenantIdOr() expected to return field value")
+		}
+	})
+	t.Run("Sku", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Sku: &v}
+		if nilStruct.GetSku() != nil || emptyStruct.GetSku() != nil {
+			t.Error("GetSku() expected to return nil")
+		}
+		if fullStruct.GetSku() != &v {
+			t.Error("GetSku() expected to return field")
+		}
+		if nilStruct.GetSkuV() != "" || emptyStruct.GetSkuV() != "" {
+			t.Error("GetSkuV() expected to return zero value")
+		}
+		if fullStruct.GetSkuV() != v {
+			t.Error("GetSkuV() expected to return field value")
+		}
+		if nilStruct.GetSkuOr(v) != v || emptyStruct.GetSkuOr(v) != v {
+			t.Error("GetSkuOr() expected to return default value")
+		}
+		if fullStruct.GetSkuOr("") != v {
+			t.Error("GetSkuOr() expected to return field value")
+		}
+	})
+	t.Run("SoftDeleteRetentionDays", func(t *testing.T) {
+		v := 1
+		fullStruct := &Params{SoftDeleteRetentionDays: &v}
+		if nilStruct.GetSoftDeleteRetentionDays() != nil || emptyStruct.GetSoftDeleteRetentionDays() != nil {
+			t.Error("GetSoftDeleteRetentionDays() expected to return nil")
+		}
+		if fullStruct.GetSoftDeleteRetentionDays() != &v {
+			t.Error("GetSoftDeleteRetentionDays() expected to return field")
+		}
+		if nilStruct.GetSoftDeleteRetentionDaysV() != 0 || emptyStruct.GetSoftDeleteRetentionDaysV() != 0 {
+			t.Error("GetSoftDeleteRetentionDaysV() expected to return zero value")
+		}
+		if fullStruct.GetSoftDeleteRetentionDaysV() != v {
+			t.Error("GetSoftDeleteRetentionDaysV() expected to return field value")
+		}
+		if nilStruct.GetSoftDeleteRetentionDaysOr(v) != v || emptyStruct.GetSoftDeleteRetentionDaysOr(v) != v {
+			t.Error("GetSoftDeleteRetentionDaysOr() expected to return default value")
+		}
+		if fullStruct.GetSoftDeleteRetentionDaysOr(0) != v {
+			t.Error("GetSoftDeleteRetentionDaysOr() expected to return field value")
+		}
+	})
+	t.Run("PurgeProtection", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{PurgeProtection: &v}
+		if nilStruct.GetPurgeProtection() != nil || emptyStruct.GetPurgeProtection() != nil {
+			t.Error("GetPurgeProtection() expected to return nil")
+		}
+		if fullStruct.GetPurgeProtection() != &v {
+			t.Error("GetPurgeProtection() expected to return field")
+		}
+		if nilStruct.GetPurgeProtectionV() != false || emptyStruct.GetPurgeProtectionV() != false {
+			t.Error("GetPurgeProtectionV() expected to return zero value")
+		}
+		if fullStruct.GetPurgeProtectionV() != v {
+			t.Error("GetPurgeProtectionV() expected to return field value")
+		}
+		if nilStruct.GetPurgeProtectionOr(v) != v || emptyStruct.GetPurgeProtectionOr(v) != v {
+			t.Error("GetPurgeProtectionOr() expected to return default value")
+		}
+		if fullStruct.GetPurgeProtectionOr(false) != v {
+			t.Error("GetPurgeProtectionOr() expected to return field value")
+		}
+	})
+	t.Run("AccessPolicies", func(t *testing.T) {
+		fullStruct := &Params{AccessPolicies: make([]AccessPolicy, 1)}
+		if nilStruct.GetAccessPolicies() != nil {
+			t.Error("GetAccessPolicies() expected to return nil")
+		}
+		if got := emptyStruct.GetAccessPolicies(); got == nil || len(got) != 0 {
+			t.Error("GetAccessPolicies() expected to return empty slice")
+		}
+		if got := fullStruct.GetAccessPolicies(); len(got) != 1 || &got[0] != &fullStruct.AccessPolicies[0] {
+			t.Error("GetAccessPolicies() expected to return field")
+		}
+	})
+	t.Run("NetworkAcls", func(t *testing.T) {
+		v := NetworkAcls{}
+		fullStruct := &Params{NetworkAcls: &v}
+		if nilStruct.GetNetworkAcls() != nil || emptyStruct.GetNetworkAcls() != nil {
+			t.Error("GetNetworkAcls() expected to return nil")
+		}
+		if fullStruct.GetNetworkAcls() != &v {
+			t.Error("GetNetworkAcls() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetNetworkAclsV(), NetworkAcls{}) || !reflect.DeepEqual(emptyStruct.GetNetworkAclsV(), NetworkAcls{}) {
+			t.Error("GetNetworkAclsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetNetworkAclsV(), v) {
+			t.Error("GetNetworkAclsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetNetworkAclsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetNetworkAclsOr(v), v) {
+			t.Error("GetNetworkAclsOr() expected to return default value")
+		}
+	})
+	t.Run("Secrets", func(t *testing.T) {
+		fullStruct := &Params{Secrets: make([]Secret, 1)}
+		if nilStruct.GetSecrets() != nil {
+			t.Error("GetSecrets() expected to return nil")
+		}
+		if got := emptyStruct.GetSecrets(); got == nil || len(got) != 0 {
+			t.Error("GetSecrets() expected to return empty slice")
+		}
+		if got := fullStruct.GetSecrets(); len(got) != 1 || &got[0] != &fullStruct.Secrets[0] {
+			t.Error("GetSecrets() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputSecret_Accessors(t *testing.T) {
+	var nilStruct *OutputSecret
+	emptyStruct := &OutputSecret{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputSecret{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Id", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputSecret{Id: &v}
+		if nilStruct.GetId() != nil || emptyStruct.GetId() != nil {
+			t.Error("GetId() expected to return nil")
+		}
+		if fullStruct.GetId() != &v {
+			t.Error("GetId() expected to return field")
+		}
+		if nilStruct.GetIdV() != "" || emptyStruct.GetIdV() != "" {
+			t.Error("GetIdV() expected to return zero value")
+		}
+		if fullStruct.GetIdV() != v {
+			t.Error("GetIdV() expected to return field value")
+		}
+		if nilStruct.GetIdOr(v) != v || emptyStruct.GetIdOr(v) != v {
+			t.Error("GetIdOr() expected to return default value")
+		}
+		if fullStruct.GetIdOr("") != v {
+			t.Error("GetIdOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("VaultId", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{VaultId: &v}
+		if nilStruct.GetVaultId() != nil || emptyStruct.GetVaultId() != nil {
+			t.Error("GetVaultId() expected to return nil")
+		}
+		if fullStruct.GetVaultId() != &v {
+			t.Error("GetVaultId() expected to return field")
+		}
+		if nilStruct.GetVaultIdV() != "" || emptyStruct.GetVaultIdV() != "" {
+			t.Error("GetVaultIdV() expected to return zero value")
+		}
+		if fullStruct.GetVaultIdV() != v {
+			t.Error("GetVaultIdV() expected to return field value")
+		}
+		if nilStruct.GetVaultIdOr(v) != v || emptyStruct.GetVaultIdOr(v) != v {
+			t.Error("GetVaultIdOr() expected to return default value")
+		}
+		if fullStruct.GetVaultIdOr("") != v {
+			t.Error("GetVaultIdOr() expected to return field value")
+		}
+	})
+	t.Run("VaultUri", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{VaultUri: &v}
+		if nilStruct.GetVaultUri() != nil || emptyStruct.GetVaultUri() != nil {
+			t.Error("GetVaultUri() expected to return nil")
+		}
+		if fullStruct.GetVaultUri() != &v {
+			t.Error("GetVaultUri() expected to return field")
+		}
+		if nilStruct.GetVaultUriV() != "" || emptyStruct.GetVaultUriV() != "" {
+			t.Error("GetVaultUriV() expected to return zero value")
+		}
+		if fullStruct.GetVaultUriV() != v {
+			t.Error("GetVaultUriV() expected to return field value")
+		}
+		if nilStruct.GetVaultUriOr(v) != v || emptyStruct.GetVaultUriOr(v) != v {
+			t.Error("GetVaultUriOr() expected to return default value")
+		}
+		if fullStruct.GetVaultUriOr("") != v {
+			t.Error("GetVaultUriOr() expected to return field value")
+		}
+	})
+	t.Run("Secrets", func(t *testing.T) {
+		fullStruct := &Output{Secrets: make([]OutputSecret, 1)}
+		if nilStruct.GetSecrets() != nil {
+			t.Error("GetSecrets() expected to return nil")
+		}
+		if got := emptyStruct.GetSecrets(); got == nil || len(got) != 0 {
+			t.Error("GetSecrets() expected to return empty slice")
+		}
+		if got := fullStruct.GetSecrets(); len(got) != 1 || &got[0] != &fullStruct.Secrets[0] {
+			t.Error("GetSecrets() expected to return field")
+		}
+	})
+}
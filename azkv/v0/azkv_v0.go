@@ -0,0 +1,288 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "azkv"
+	version = "v0.0.1"
+
+	// KubeConfigSecretName is name of secret created by Params.UseAzKSOutput.
+	KubeConfigSecretName = "kubeconfig"
+)
+
+var (
+	// https://docs.microsoft.com/en-us/azure/azure-resource-manager/management/resource-name-rules#microsoftkeyvault
+	vaultNameRegexp  = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*(-[a-zA-Z0-9]+)*$`)
+	secretNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+)
+
+// AccessPolicy grants permissions to Azure AD object with ObjectId, i.e. user, group or service
+// principal.
+type AccessPolicy struct {
+	ObjectId               *string  `json:"object_id" validate:"required,uuid"`
+	KeyPermissions         []string `json:"key_permissions" validate:"omitempty,dive,required,eq=backup|eq=create|eq=decrypt|eq=delete|eq=encrypt|eq=get|eq=import|eq=list|eq=purge|eq=recover|eq=restore|eq=sign|eq=unwrapKey|eq=update|eq=verify|eq=wrapKey"`
+	SecretPermissions      []string `json:"secret_permissions" validate:"omitempty,dive,required,eq=backup|eq=delete|eq=get|eq=list|eq=purge|eq=recover|eq=restore|eq=set"`
+	CertificatePermissions []string `json:"certificate_permissions" validate:"omitempty,dive,required,eq=backup|eq=create|eq=delete|eq=deleteissuers|eq=get|eq=getissuers|eq=import|eq=list|eq=listissuers|eq=managecontacts|eq=manageissuers|eq=purge|eq=recover|eq=restore|eq=setissuers|eq=update"`
+}
+
+// NetworkAcls limit access to vault. SubnetNames have to be names of subnets created by azbi
+// module in VnetName virtual network.
+type NetworkAcls struct {
+	DefaultAction *string  `json:"default_action" validate:"required,eq=Allow|eq=Deny"`
+	Bypass        *string  `json:"bypass" validate:"required,eq=AzureServices|eq=None"`
+	IpRules       []string `json:"ip_rules" validate:"omitempty,dive,required,cidr|ipv4"`
+	VnetName      *string  `json:"vnet_name" validate:"required_with=SubnetNames,omitempty,min=1"`
+	SubnetNames   []string `json:"subnet_names" validate:"omitempty,dive,required"`
+}
+
+// Secret is created in vault. Value can be secret reference, i.e. file:///shared/vms_rsa.pub.
+type Secret struct {
+	Name        *string `json:"name" validate:"required,min=1,max=127"`
+	Value       *string `json:"value" validate:"required,min=1" sensitive:"true"`
+	ContentType *string `json:"content_type" validate:"omitempty,min=1"`
+}
+
+type Params struct {
+	Name                    *string        `json:"name" validate:"required,min=1"`
+	Location                *string        `json:"location" validate:"required,min=1"`
+	RgName                  *string        `json:"rg_name" validate:"required,min=1"`
+	VaultName               *string        `json:"vault_name" validate:"required,min=3,max=24"`
+	TenantId                *string        `json:"tenant_id" validate:"required,min=1" sensitive:"true"`
+	Sku                     *string        `json:"sku" validate:"required,eq=standard|eq=premium"`
+	SoftDeleteRetentionDays *int           `json:"soft_delete_retention_days" validate:"required,min=7,max=90"`
+	PurgeProtection         *bool          `json:"purge_protection" validate:"required"`
+	AccessPolicies          []AccessPolicy `json:"access_policies" validate:"omitempty,max=1024,dive"`
+	NetworkAcls             *NetworkAcls   `json:"network_acls" validate:"omitempty"`
+	Secrets                 []Secret       `json:"secrets" validate:"omitempty,dive"`
+}
+
+// UseAzBIOutput limits access to vault to subnetNames of virtual network created by azbi module.
+func (p *Params) UseAzBIOutput(o *azbi.Output, subnetNames ...string) {
+	if p == nil || o == nil {
+		return
+	}
+	if p.NetworkAcls == nil {
+		p.NetworkAcls = &NetworkAcls{
+			DefaultAction: to.StrPtr("Deny"),
+			Bypass:        to.StrPtr("AzureServices"),
+		}
+	}
+	p.NetworkAcls.VnetName = to.StrPtr(o.GetVnetNameV())
+	p.NetworkAcls.SubnetNames = append([]string{}, subnetNames...)
+}
+
+// UseAzKSOutput sets value of KubeConfigSecretName secret to kubeconfig created by azks module.
+func (p *Params) UseAzKSOutput(o *azks.Output) {
+	if p == nil || o == nil {
+		return
+	}
+	for i, s := range p.Secrets {
+		if s.GetNameV() == KubeConfigSecretName {
+			p.Secrets[i].Value = to.StrPtr(o.GetKubeConfigV())
+			return
+		}
+	}
+	p.Secrets = append(p.Secrets, Secret{
+		Name:  to.StrPtr(KubeConfigSecretName),
+		Value: to.StrPtr(o.GetKubeConfigV()),
+	})
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=azkv"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:                    to.StrPtr("epiphany"),
+			Location:                to.StrPtr("northeurope"),
+			RgName:                  to.StrPtr("epiphany-rg"),
+			VaultName:               to.StrPtr("epiphany-kv"),
+			TenantId:                to.StrPtr("env://ARM_TENANT_ID"),
+			Sku:                     to.StrPtr("standard"),
+			SoftDeleteRetentionDays: to.IntPtr(90),
+			PurgeProtection:         to.BooPtr(false),
+			AccessPolicies:          []AccessPolicy{},
+			NetworkAcls:             nil,
+			Secrets: []Secret{
+				{
+					Name:        to.StrPtr("vms-rsa-pub"),
+					Value:       to.StrPtr("file:///shared/vms_rsa.pub"),
+					ContentType: to.StrPtr("text/plain"),
+				},
+			},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("azkv config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(AzKVParamsValidation, Params{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// AzKVParamsValidation checks vault and secret names against Azure naming rules and that secret
+// names and access policy object IDs are unique.
+func AzKVParamsValidation(sl validator.StructLevel) {
+	params := sl.Current().Interface().(Params)
+	if params.VaultName != nil && !vaultNameRegexp.MatchString(*params.VaultName) {
+		sl.ReportError(params.VaultName, "VaultName", "VaultName", "vaultname", "")
+	}
+	objectIds := make(map[string]bool)
+	for i, p := range params.AccessPolicies {
+		if p.ObjectId == nil {
+			continue
+		}
+		if objectIds[*p.ObjectId] {
+			sl.ReportError(
+				params.AccessPolicies[i].ObjectId,
+				fmt.Sprintf("AccessPolicies[%d].ObjectId", i),
+				"ObjectId",
+				"unique",
+				"")
+		}
+		objectIds[*p.ObjectId] = true
+	}
+	secrets := make(map[string]bool)
+	for i, s := range params.Secrets {
+		if s.Name == nil || *s.Name == "" {
+			continue
+		}
+		if !secretNameRegexp.MatchString(*s.Name) {
+			sl.ReportError(
+				params.Secrets[i].Name,
+				fmt.Sprintf("Secrets[%d].Name", i),
+				"Name",
+				"secretname",
+				"")
+		}
+		if secrets[*s.Name] {
+			sl.ReportError(
+				params.Secrets[i].Name,
+				fmt.Sprintf("Secrets[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		secrets[*s.Name] = true
+	}
+}
+
+type OutputSecret struct {
+	Name *string `json:"name" validate:"required,min=1"`
+	Id   *string `json:"id" validate:"required,url"`
+}
+
+type Output struct {
+	VaultId  *string        `json:"vault_id" validate:"required,min=1"`
+	VaultUri *string        `json:"vault_uri" validate:"required,url"`
+	Secrets  []OutputSecret `json:"secrets" validate:"omitempty,dive"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
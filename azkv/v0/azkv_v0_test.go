@@ -0,0 +1,601 @@
+package v0
+
+import (
+	"testing"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "azkv",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"vault_name": "epiphany-kv",
+		"tenant_id": "00000000-0000-0000-0000-000000000000",
+		"sku": "standard",
+		"soft_delete_retention_days": 90,
+		"purge_protection": true,
+		"access_policies": [
+			{
+				"object_id": "11111111-2222-3333-4444-555555555555",
+				"key_permissions": ["get", "list"],
+				"secret_permissions": ["get", "list", "set"],
+				"certificate_permissions": []
+			}
+		],
+		"network_acls": {
+			"default_action": "Deny",
+			"bypass": "AzureServices",
+			"ip_rules": ["100.0.0.1"],
+			"vnet_name": "epiphany-vnet",
+			"subnet_names": ["main"]
+		},
+		"secrets": [
+			{
+				"name": "vms-rsa-pub",
+				"value": "ssh-rsa AAAA",
+				"content_type": "text/plain"
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azkv"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:                    to.StrPtr("epiphany"),
+					Location:                to.StrPtr("northeurope"),
+					RgName:                  to.StrPtr("epiphany-rg"),
+					VaultName:               to.StrPtr("epiphany-kv"),
+					TenantId:                to.StrPtr("00000000-0000-0000-0000-000000000000"),
+					Sku:                     to.StrPtr("standard"),
+					SoftDeleteRetentionDays: to.IntPtr(90),
+					PurgeProtection:         to.BooPtr(true),
+					AccessPolicies: []AccessPolicy{
+						{
+							ObjectId:               to.StrPtr("11111111-2222-3333-4444-555555555555"),
+							KeyPermissions:         []string{"get", "list"},
+							SecretPermissions:      []string{"get", "list", "set"},
+							CertificatePermissions: []string{},
+						},
+					},
+					NetworkAcls: &NetworkAcls{
+						DefaultAction: to.StrPtr("Deny"),
+						Bypass:        to.StrPtr("AzureServices"),
+						IpRules:       []string{"100.0.0.1"},
+						VnetName:      to.StrPtr("epiphany-vnet"),
+						SubnetNames:   []string{"main"},
+					},
+					Secrets: []Secret{
+						{
+							Name:        to.StrPtr("vms-rsa-pub"),
+							Value:       to.StrPtr("ssh-rsa AAAA"),
+							ContentType: to.StrPtr("text/plain"),
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "azkv",
+	"version": "v0.0.1",
+	"extra_outer_field": "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"vault_name": "epiphany-kv",
+		"tenant_id": "00000000-0000-0000-0000-000000000000",
+		"sku": "standard",
+		"soft_delete_retention_days": 90,
+		"purge_protection": true,
+		"extra_inner_field": "extra_inner_value",
+		"secrets": [
+			{
+				"name": "token",
+				"value": "env://TOKEN",
+				"extra_secret_field": "extra_secret_value"
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azkv"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:                    to.StrPtr("epiphany"),
+					Location:                to.StrPtr("northeurope"),
+					RgName:                  to.StrPtr("epiphany-rg"),
+					VaultName:               to.StrPtr("epiphany-kv"),
+					TenantId:                to.StrPtr("00000000-0000-0000-0000-000000000000"),
+					Sku:                     to.StrPtr("standard"),
+					SoftDeleteRetentionDays: to.IntPtr(90),
+					PurgeProtection:         to.BooPtr(true),
+					Secrets: []Secret{
+						{
+							Name:  to.StrPtr("token"),
+							Value: to.StrPtr("env://TOKEN"),
+						},
+					},
+				},
+				Unused: []string{"extra_outer_field", "params.extra_inner_field", "params.secrets[0].extra_secret_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "azks",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"vault_name": "epiphany-kv",
+		"tenant_id": "00000000-0000-0000-0000-000000000000",
+		"sku": "standard",
+		"soft_delete_retention_days": 90,
+		"purge_protection": true
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "azkv",
+	"version": "v0.0.1",
+	"params": {}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Location",
+					Field: "Location",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.RgName",
+					Field: "RgName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VaultName",
+					Field: "VaultName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.TenantId",
+					Field: "TenantId",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Sku",
+					Field: "Sku",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.SoftDeleteRetentionDays",
+					Field: "SoftDeleteRetentionDays",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.PurgeProtection",
+					Field: "PurgeProtection",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect params values",
+			json: []byte(`{
+	"kind": "azkv",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"vault_name": "1-epiphany--kv",
+		"tenant_id": "",
+		"sku": "basic",
+		"soft_delete_retention_days": 5,
+		"purge_protection": true
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.VaultName",
+					Field: "VaultName",
+					Tag:   "vaultname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.TenantId",
+					Field: "TenantId",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Sku",
+					Field: "Sku",
+					Tag:   "eq=standard|eq=premium",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.SoftDeleteRetentionDays",
+					Field: "SoftDeleteRetentionDays",
+					Tag:   "min",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_AccessPolicies contains all scenarios related to validation of AccessPolicy structures.
+func TestConfig_Load_AccessPolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect access policies",
+			json: []byte(`{
+	"kind": "azkv",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"vault_name": "epiphany-kv",
+		"tenant_id": "00000000-0000-0000-0000-000000000000",
+		"sku": "standard",
+		"soft_delete_retention_days": 90,
+		"purge_protection": true,
+		"access_policies": [
+			{
+				"object_id": "not-an-uuid",
+				"key_permissions": ["all"],
+				"secret_permissions": [""]
+			},
+			{
+				"object_id": "11111111-2222-3333-4444-555555555555",
+				"certificate_permissions": ["get"]
+			},
+			{
+				"object_id": "11111111-2222-3333-4444-555555555555",
+				"secret_permissions": ["get"]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.AccessPolicies[0].ObjectId",
+					Field: "ObjectId",
+					Tag:   "uuid",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AccessPolicies[0].KeyPermissions[0]",
+					Field: "KeyPermissions[0]",
+					Tag:   "eq=backup|eq=create|eq=decrypt|eq=delete|eq=encrypt|eq=get|eq=import|eq=list|eq=purge|eq=recover|eq=restore|eq=sign|eq=unwrapKey|eq=update|eq=verify|eq=wrapKey",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AccessPolicies[0].SecretPermissions[0]",
+					Field: "SecretPermissions[0]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AccessPolicies[2].ObjectId",
+					Field: "AccessPolicies[2].ObjectId",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_NetworkAcls contains all scenarios related to validation of NetworkAcls structure.
+func TestConfig_Load_NetworkAcls(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect network acls",
+			json: []byte(`{
+	"kind": "azkv",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"vault_name": "epiphany-kv",
+		"tenant_id": "00000000-0000-0000-0000-000000000000",
+		"sku": "standard",
+		"soft_delete_retention_days": 90,
+		"purge_protection": true,
+		"network_acls": {
+			"default_action": "Block",
+			"ip_rules": ["100.0.0"],
+			"subnet_names": ["main"]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkAcls.DefaultAction",
+					Field: "DefaultAction",
+					Tag:   "eq=Allow|eq=Deny",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkAcls.Bypass",
+					Field: "Bypass",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkAcls.IpRules[0]",
+					Field: "IpRules[0]",
+					Tag:   "cidr|ipv4",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkAcls.VnetName",
+					Field: "VnetName",
+					Tag:   "required_with",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Secrets contains all scenarios related to validation of Secret structures.
+func TestConfig_Load_Secrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect secrets",
+			json: []byte(`{
+	"kind": "azkv",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"vault_name": "epiphany-kv",
+		"tenant_id": "00000000-0000-0000-0000-000000000000",
+		"sku": "standard",
+		"soft_delete_retention_days": 90,
+		"purge_protection": true,
+		"secrets": [
+			{
+				"name": "vms_rsa_pub",
+				"value": "ssh-rsa AAAA"
+			},
+			{
+				"name": "token"
+			},
+			{
+				"name": "token",
+				"value": "env://TOKEN",
+				"content_type": ""
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Secrets[0].Name",
+					Field: "Secrets[0].Name",
+					Tag:   "secretname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Secrets[1].Value",
+					Field: "Value",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Secrets[2].ContentType",
+					Field: "ContentType",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Secrets[2].Name",
+					Field: "Secrets[2].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParams_UseOutputs(t *testing.T) {
+	p := NewConfig().Params
+	p.UseAzBIOutput(&azbi.Output{
+		RgName:   to.StrPtr("epiphany-rg"),
+		VnetName: to.StrPtr("epiphany-vnet"),
+	}, "main")
+	p.UseAzKSOutput(&azks.Output{
+		KubeConfig: to.StrPtr("kubeconfig content"),
+	})
+	p.UseAzKSOutput(&azks.Output{
+		KubeConfig: to.StrPtr("new kubeconfig content"),
+	})
+	wantAcls := &NetworkAcls{
+		DefaultAction: to.StrPtr("Deny"),
+		Bypass:        to.StrPtr("AzureServices"),
+		VnetName:      to.StrPtr("epiphany-vnet"),
+		SubnetNames:   []string{"main"},
+	}
+	if diff := cmp.Diff(wantAcls, p.NetworkAcls); diff != "" {
+		t.Errorf("UseAzBIOutput() mismatch (-want +got):\n%s", diff)
+	}
+	wantSecrets := []Secret{
+		{
+			Name:        to.StrPtr("vms-rsa-pub"),
+			Value:       to.StrPtr("file:///shared/vms_rsa.pub"),
+			ContentType: to.StrPtr("text/plain"),
+		},
+		{
+			Name:  to.StrPtr(KubeConfigSecretName),
+			Value: to.StrPtr("new kubeconfig content"),
+		},
+	}
+	if diff := cmp.Diff(wantSecrets, p.Secrets); diff != "" {
+		t.Errorf("UseAzKSOutput() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfig_Redacted(t *testing.T) {
+	c := NewConfig()
+	c.Params.UseAzKSOutput(&azks.Output{
+		KubeConfig: to.StrPtr("kubeconfig content"),
+	})
+	r := c.Redacted()
+	if got := r.Params.GetTenantIdV(); got != sensitive.Placeholder {
+		t.Errorf("Redacted() tenant id = %s, want %s", got, sensitive.Placeholder)
+	}
+	for _, s := range r.Params.Secrets {
+		if got := s.GetValueV(); got != sensitive.Placeholder {
+			t.Errorf("Redacted() secret %s = %s, want %s", s.GetNameV(), got, sensitive.Placeholder)
+		}
+	}
+	if got := c.Params.Secrets[1].GetValueV(); got != "kubeconfig content" {
+		t.Errorf("Redacted() modified original config, got %s", got)
+	}
+}
@@ -0,0 +1,390 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of AccessPolicy or nil if AccessPolicy is nil.
+func (a *AccessPolicy) DeepCopy() *AccessPolicy {
+	if a == nil {
+		return nil
+	}
+	out := new(AccessPolicy)
+	if a.ObjectId != nil {
+		x := *a.ObjectId
+		out.ObjectId = &x
+	}
+	if a.KeyPermissions != nil {
+		out.KeyPermissions = make([]string, len(a.KeyPermissions))
+		copy(out.KeyPermissions, a.KeyPermissions)
+	}
+	if a.SecretPermissions != nil {
+		out.SecretPermissions = make([]string, len(a.SecretPermissions))
+		copy(out.SecretPermissions, a.SecretPermissions)
+	}
+	if a.CertificatePermissions != nil {
+		out.CertificatePermissions = make([]string, len(a.CertificatePermissions))
+		copy(out.CertificatePermissions, a.CertificatePermissions)
+	}
+	return out
+}
+
+// Equal reports whether AccessPolicy and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AccessPolicy) Equal(other *AccessPolicy) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.ObjectId == nil) != (other.ObjectId == nil) || a.ObjectId != nil && *a.ObjectId != *other.ObjectId {
+		return false
+	}
+	if (a.KeyPermissions == nil) != (other.KeyPermissions == nil) || len(a.KeyPermissions) != len(other.KeyPermissions) {
+		return false
+	}
+	for i := range a.KeyPermissions {
+		if a.KeyPermissions[i] != other.KeyPermissions[i] {
+			return false
+		}
+	}
+	if (a.SecretPermissions == nil) != (other.SecretPermissions == nil) || len(a.SecretPermissions) != len(other.SecretPermissions) {
+		return false
+	}
+	for i := range a.SecretPermissions {
+		if a.SecretPermissions[i] != other.SecretPermissions[i] {
+			return false
+		}
+	}
+	if (a.CertificatePermissions == nil) != (other.CertificatePermissions == nil) || len(a.CertificatePermissions) != len(other.CertificatePermissions) {
+		return false
+	}
+	for i := range a.CertificatePermissions {
+		if a.CertificatePermissions[i] != other.CertificatePermissions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of NetworkAcls or nil if NetworkAcls is nil.
+func (n *NetworkAcls) DeepCopy() *NetworkAcls {
+	if n == nil {
+		return nil
+	}
+	out := new(NetworkAcls)
+	if n.DefaultAction != nil {
+		x := *n.DefaultAction
+		out.DefaultAction = &x
+	}
+	if n.Bypass != nil {
+		x := *n.Bypass
+		out.Bypass = &x
+	}
+	if n.IpRules != nil {
+		out.IpRules = make([]string, len(n.IpRules))
+		copy(out.IpRules, n.IpRules)
+	}
+	if n.VnetName != nil {
+		x := *n.VnetName
+		out.VnetName = &x
+	}
+	if n.SubnetNames != nil {
+		out.SubnetNames = make([]string, len(n.SubnetNames))
+		copy(out.SubnetNames, n.SubnetNames)
+	}
+	return out
+}
+
+// Equal reports whether NetworkAcls and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (n *NetworkAcls) Equal(other *NetworkAcls) bool {
+	if n == nil || other == nil {
+		return n == other
+	}
+	if (n.DefaultAction == nil) != (other.DefaultAction == nil) || n.DefaultAction != nil && *n.DefaultAction != *other.DefaultAction {
+		return false
+	}
+	if (n.Bypass == nil) != (other.Bypass == nil) || n.Bypass != nil && *n.Bypass != *other.Bypass {
+		return false
+	}
+	if (n.IpRules == nil) != (other.IpRules == nil) || len(n.IpRules) != len(other.IpRules) {
+		return false
+	}
+	for i := range n.IpRules {
+		if n.IpRules[i] != other.IpRules[i] {
+			return false
+		}
+	}
+	if (n.VnetName == nil) != (other.VnetName == nil) || n.VnetName != nil && *n.VnetName != *other.VnetName {
+		return false
+	}
+	if (n.SubnetNames == nil) != (other.SubnetNames == nil) || len(n.SubnetNames) != len(other.SubnetNames) {
+		return false
+	}
+	for i := range n.SubnetNames {
+		if n.SubnetNames[i] != other.SubnetNames[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Secret or nil if Secret is nil.
+func (s *Secret) DeepCopy() *Secret {
+	if s == nil {
+		return nil
+	}
+	out := new(Secret)
+	if s.Name != nil {
+		x := *s.Name
+		out.Name = &x
+	}
+	if s.Value != nil {
+		x := *s.Value
+		out.Value = &x
+	}
+	if s.ContentType != nil {
+		x := *s.ContentType
+		out.ContentType = &x
+	}
+	return out
+}
+
+// Equal reports whether Secret and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *Secret) Equal(other *Secret) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Name == nil) != (other.Name == nil) || s.Name != nil && *s.Name != *other.Name {
+		return false
+	}
+	if (s.Value == nil) != (other.Value == nil) || s.Value != nil && *s.Value != *other.Value {
+		return false
+	}
+	if (s.ContentType == nil) != (other.ContentType == nil) || s.ContentType != nil && *s.ContentType != *other.ContentType {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Location != nil {
+		x := *p.Location
+		out.Location = &x
+	}
+	if p.RgName != nil {
+		x := *p.RgName
+		out.RgName = &x
+	}
+	if p.VaultName != nil {
+		x := *p.VaultName
+		out.VaultName = &x
+	}
+	if p.TenantId != nil {
+		x := *p.TenantId
+		out.TenantId = &x
+	}
+	if p.Sku != nil {
+		x := *p.Sku
+		out.Sku = &x
+	}
+	if p.SoftDeleteRetentionDays != nil {
+		x := *p.SoftDeleteRetentionDays
+		out.SoftDeleteRetentionDays = &x
+	}
+	if p.PurgeProtection != nil {
+		x := *p.PurgeProtection
+		out.PurgeProtection = &x
+	}
+	if p.AccessPolicies != nil {
+		out.AccessPolicies = make([]AccessPolicy, len(p.AccessPolicies))
+		for i := range p.AccessPolicies {
+			out.AccessPolicies[i] = *p.AccessPolicies[i].DeepCopy()
+		}
+	}
+	out.NetworkAcls = p.NetworkAcls.DeepCopy()
+	if p.Secrets != nil {
+		out.Secrets = make([]Secret, len(p.Secrets))
+		for i := range p.Secrets {
+			out.Secrets[i] = *p.Secrets[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Location == nil) != (other.Location == nil) || p.Location != nil && *p.Location != *other.Location {
+		return false
+	}
+	if (p.RgName == nil) != (other.RgName == nil) || p.RgName != nil && *p.RgName != *other.RgName {
+		return false
+	}
+	if (p.VaultName == nil) != (other.VaultName == nil) || p.VaultName != nil && *p.VaultName != *other.VaultName {
+		return false
+	}
+	if (p.TenantId == nil) != (other.TenantId == nil) || p.TenantId != nil && *p.TenantId != *other.TenantId {
+		return false
+	}
+	if (p.Sku == nil) != (other.Sku == nil) || p.Sku != nil && *p.Sku != *other.Sku {
+		return false
+	}
+	if (p.SoftDeleteRetentionDays == nil) != (other.SoftDeleteRetentionDays == nil) || p.SoftDeleteRetentionDays != nil && *p.SoftDeleteRetentionDays != *other.SoftDeleteRetentionDays {
+		return false
+	}
+	if (p.PurgeProtection == nil) != (other.PurgeProtection == nil) || p.PurgeProtection != nil && *p.PurgeProtection != *other.PurgeProtection {
+		return false
+	}
+	if (p.AccessPolicies == nil) != (other.AccessPolicies == nil) || len(p.AccessPolicies) != len(other.AccessPolicies) {
+		return false
+	}
+	for i := range p.AccessPolicies {
+		if !p.AccessPolicies[i].Equal(&other.AccessPolicies[i]) {
+			return false
+		}
+	}
+	if !p.NetworkAcls.Equal(other.NetworkAcls) {
+		return false
+	}
+	if (p.Secrets == nil) != (other.Secrets == nil) || len(p.Secrets) != len(other.Secrets) {
+		return false
+	}
+	for i := range p.Secrets {
+		if !p.Secrets[i].Equal(&other.Secrets[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputSecret or nil if OutputSecret is nil.
+func (o *OutputSecret) DeepCopy() *OutputSecret {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputSecret)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Id != nil {
+		x := *o.Id
+		out.Id = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputSecret and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputSecret) Equal(other *OutputSecret) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Id == nil) != (other.Id == nil) || o.Id != nil && *o.Id != *other.Id {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.VaultId != nil {
+		x := *o.VaultId
+		out.VaultId = &x
+	}
+	if o.VaultUri != nil {
+		x := *o.VaultUri
+		out.VaultUri = &x
+	}
+	if o.Secrets != nil {
+		out.Secrets = make([]OutputSecret, len(o.Secrets))
+		for i := range o.Secrets {
+			out.Secrets[i] = *o.Secrets[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.VaultId == nil) != (other.VaultId == nil) || o.VaultId != nil && *o.VaultId != *other.VaultId {
+		return false
+	}
+	if (o.VaultUri == nil) != (other.VaultUri == nil) || o.VaultUri != nil && *o.VaultUri != *other.VaultUri {
+		return false
+	}
+	if (o.Secrets == nil) != (other.Secrets == nil) || len(o.Secrets) != len(other.Secrets) {
+		return false
+	}
+	for i := range o.Secrets {
+		if !o.Secrets[i].Equal(&other.Secrets[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,846 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetName returns Name field of Frontend or nil if Frontend is nil.
+func (f *Frontend) GetName() *string {
+	if f == nil {
+		return nil
+	}
+	return f.Name
+}
+
+// GetNameV returns value of Name field of Frontend or zero value if either Frontend or field is nil.
+func (f *Frontend) GetNameV() string {
+	if f == nil || f.Name == nil {
+		return ""
+	}
+	return *f.Name
+}
+
+// GetNameOr returns value of Name field of Frontend or def if either Frontend or field is nil.
+func (f *Frontend) GetNameOr(def string) string {
+	if f == nil || f.Name == nil {
+		return def
+	}
+	return *f.Name
+}
+
+// GetType returns Type field of Frontend or nil if Frontend is nil.
+func (f *Frontend) GetType() *string {
+	if f == nil {
+		return nil
+	}
+	return f.Type
+}
+
+// GetTypeV returns value of Type field of Frontend or zero value if either Frontend or field is nil.
+func (f *Frontend) GetTypeV() string {
+	if f == nil || f.Type == nil {
+		return ""
+	}
+	return *f.Type
+}
+
+// GetTypeOr returns value of Type field of Frontend or def if either Frontend or field is nil.
+func (f *Frontend) GetTypeOr(def string) string {
+	if f == nil || f.Type == nil {
+		return def
+	}
+	return *f.Type
+}
+
+// GetSubnetName returns SubnetName field of Frontend or nil if Frontend is nil.
+func (f *Frontend) GetSubnetName() *string {
+	if f == nil {
+		return nil
+	}
+	return f.SubnetName
+}
+
+// GetSubnetNameV returns value of SubnetName field of Frontend or zero value if either Frontend or field is nil.
+func (f *Frontend) GetSubnetNameV() string {
+	if f == nil || f.SubnetName == nil {
+		return ""
+	}
+	return *f.SubnetName
+}
+
+// GetSubnetNameOr returns value of SubnetName field of Frontend or def if either Frontend or field is nil.
+func (f *Frontend) GetSubnetNameOr(def string) string {
+	if f == nil || f.SubnetName == nil {
+		return def
+	}
+	return *f.SubnetName
+}
+
+// GetPrivateIp returns PrivateIp field of Frontend or nil if Frontend is nil.
+func (f *Frontend) GetPrivateIp() *string {
+	if f == nil {
+		return nil
+	}
+	return f.PrivateIp
+}
+
+// GetPrivateIpV returns value of PrivateIp field of Frontend or zero value if either Frontend or field is nil.
+func (f *Frontend) GetPrivateIpV() string {
+	if f == nil || f.PrivateIp == nil {
+		return ""
+	}
+	return *f.PrivateIp
+}
+
+// GetPrivateIpOr returns value of PrivateIp field of Frontend or def if either Frontend or field is nil.
+func (f *Frontend) GetPrivateIpOr(def string) string {
+	if f == nil || f.PrivateIp == nil {
+		return def
+	}
+	return *f.PrivateIp
+}
+
+// GetName returns Name field of BackendPool or nil if BackendPool is nil.
+func (b *BackendPool) GetName() *string {
+	if b == nil {
+		return nil
+	}
+	return b.Name
+}
+
+// GetNameV returns value of Name field of BackendPool or zero value if either BackendPool or field is nil.
+func (b *BackendPool) GetNameV() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+// GetNameOr returns value of Name field of BackendPool or def if either BackendPool or field is nil.
+func (b *BackendPool) GetNameOr(def string) string {
+	if b == nil || b.Name == nil {
+		return def
+	}
+	return *b.Name
+}
+
+// GetVmGroupNames returns VmGroupNames field of BackendPool, nil if BackendPool is nil or empty slice if field is nil.
+func (b *BackendPool) GetVmGroupNames() []string {
+	if b == nil {
+		return nil
+	}
+	if len(b.VmGroupNames) == 0 {
+		return []string{}
+	}
+	return b.VmGroupNames
+}
+
+// GetName returns Name field of Probe or nil if Probe is nil.
+func (p *Probe) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Probe or zero value if either Probe or field is nil.
+func (p *Probe) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Probe or def if either Probe or field is nil.
+func (p *Probe) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetProtocol returns Protocol field of Probe or nil if Probe is nil.
+func (p *Probe) GetProtocol() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Protocol
+}
+
+// GetProtocolV returns value of Protocol field of Probe or zero value if either Probe or field is nil.
+func (p *Probe) GetProtocolV() string {
+	if p == nil || p.Protocol == nil {
+		return ""
+	}
+	return *p.Protocol
+}
+
+// GetProtocolOr returns value of Protocol field of Probe or def if either Probe or field is nil.
+func (p *Probe) GetProtocolOr(def string) string {
+	if p == nil || p.Protocol == nil {
+		return def
+	}
+	return *p.Protocol
+}
+
+// GetPort returns Port field of Probe or nil if Probe is nil.
+func (p *Probe) GetPort() *int {
+	if p == nil {
+		return nil
+	}
+	return p.Port
+}
+
+// GetPortV returns value of Port field of Probe or zero value if either Probe or field is nil.
+func (p *Probe) GetPortV() int {
+	if p == nil || p.Port == nil {
+		return 0
+	}
+	return *p.Port
+}
+
+// GetPortOr returns value of Port field of Probe or def if either Probe or field is nil.
+func (p *Probe) GetPortOr(def int) int {
+	if p == nil || p.Port == nil {
+		return def
+	}
+	return *p.Port
+}
+
+// GetRequestPath returns RequestPath field of Probe or nil if Probe is nil.
+func (p *Probe) GetRequestPath() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RequestPath
+}
+
+// GetRequestPathV returns value of RequestPath field of Probe or zero value if either Probe or field is nil.
+func (p *Probe) GetRequestPathV() string {
+	if p == nil || p.RequestPath == nil {
+		return ""
+	}
+	return *p.RequestPath
+}
+
+// GetRequestPathOr returns value of RequestPath field of Probe or def if either Probe or field is nil.
+func (p *Probe) GetRequestPathOr(def string) string {
+	if p == nil || p.RequestPath == nil {
+		return def
+	}
+	return *p.RequestPath
+}
+
+// GetIntervalSeconds returns IntervalSeconds field of Probe or nil if Probe is nil.
+func (p *Probe) GetIntervalSeconds() *int {
+	if p == nil {
+		return nil
+	}
+	return p.IntervalSeconds
+}
+
+// GetIntervalSecondsV returns value of IntervalSeconds field of Probe or zero value if either Probe or field is nil.
+func (p *Probe) GetIntervalSecondsV() int {
+	if p == nil || p.IntervalSeconds == nil {
+		return 0
+	}
+	return *p.IntervalSeconds
+}
+
+// GetIntervalSecondsOr returns value of IntervalSeconds field of Probe or def if either Probe or field is nil.
+func (p *Probe) GetIntervalSecondsOr(def int) int {
+	if p == nil || p.IntervalSeconds == nil {
+		return def
+	}
+	return *p.IntervalSeconds
+}
+
+// GetNumberOfProbes returns NumberOfProbes field of Probe or nil if Probe is nil.
+func (p *Probe) GetNumberOfProbes() *int {
+	if p == nil {
+		return nil
+	}
+	return p.NumberOfProbes
+}
+
+// GetNumberOfProbesV returns value of NumberOfProbes field of Probe or zero value if either Probe or field is nil.
+func (p *Probe) GetNumberOfProbesV() int {
+	if p == nil || p.NumberOfProbes == nil {
+		return 0
+	}
+	return *p.NumberOfProbes
+}
+
+// GetNumberOfProbesOr returns value of NumberOfProbes field of Probe or def if either Probe or field is nil.
+func (p *Probe) GetNumberOfProbesOr(def int) int {
+	if p == nil || p.NumberOfProbes == nil {
+		return def
+	}
+	return *p.NumberOfProbes
+}
+
+// GetName returns Name field of Rule or nil if Rule is nil.
+func (r *Rule) GetName() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Name
+}
+
+// GetNameV returns value of Name field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetNameV() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNameOr returns value of Name field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetNameOr(def string) string {
+	if r == nil || r.Name == nil {
+		return def
+	}
+	return *r.Name
+}
+
+// GetProtocol returns Protocol field of Rule or nil if Rule is nil.
+func (r *Rule) GetProtocol() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Protocol
+}
+
+// GetProtocolV returns value of Protocol field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetProtocolV() string {
+	if r == nil || r.Protocol == nil {
+		return ""
+	}
+	return *r.Protocol
+}
+
+// GetProtocolOr returns value of Protocol field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetProtocolOr(def string) string {
+	if r == nil || r.Protocol == nil {
+		return def
+	}
+	return *r.Protocol
+}
+
+// GetFrontendName returns FrontendName field of Rule or nil if Rule is nil.
+func (r *Rule) GetFrontendName() *string {
+	if r == nil {
+		return nil
+	}
+	return r.FrontendName
+}
+
+// GetFrontendNameV returns value of FrontendName field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetFrontendNameV() string {
+	if r == nil || r.FrontendName == nil {
+		return ""
+	}
+	return *r.FrontendName
+}
+
+// GetFrontendNameOr returns value of FrontendName field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetFrontendNameOr(def string) string {
+	if r == nil || r.FrontendName == nil {
+		return def
+	}
+	return *r.FrontendName
+}
+
+// GetBackendPoolName returns BackendPoolName field of Rule or nil if Rule is nil.
+func (r *Rule) GetBackendPoolName() *string {
+	if r == nil {
+		return nil
+	}
+	return r.BackendPoolName
+}
+
+// GetBackendPoolNameV returns value of BackendPoolName field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetBackendPoolNameV() string {
+	if r == nil || r.BackendPoolName == nil {
+		return ""
+	}
+	return *r.BackendPoolName
+}
+
+// GetBackendPoolNameOr returns value of BackendPoolName field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetBackendPoolNameOr(def string) string {
+	if r == nil || r.BackendPoolName == nil {
+		return def
+	}
+	return *r.BackendPoolName
+}
+
+// GetProbeName returns ProbeName field of Rule or nil if Rule is nil.
+func (r *Rule) GetProbeName() *string {
+	if r == nil {
+		return nil
+	}
+	return r.ProbeName
+}
+
+// GetProbeNameV returns value of ProbeName field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetProbeNameV() string {
+	if r == nil || r.ProbeName == nil {
+		return ""
+	}
+	return *r.ProbeName
+}
+
+// GetProbeNameOr returns value of ProbeName field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetProbeNameOr(def string) string {
+	if r == nil || r.ProbeName == nil {
+		return def
+	}
+	return *r.ProbeName
+}
+
+// GetFrontendPort returns FrontendPort field of Rule or nil if Rule is nil.
+func (r *Rule) GetFrontendPort() *int {
+	if r == nil {
+		return nil
+	}
+	return r.FrontendPort
+}
+
+// GetFrontendPortV returns value of FrontendPort field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetFrontendPortV() int {
+	if r == nil || r.FrontendPort == nil {
+		return 0
+	}
+	return *r.FrontendPort
+}
+
+// GetFrontendPortOr returns value of FrontendPort field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetFrontendPortOr(def int) int {
+	if r == nil || r.FrontendPort == nil {
+		return def
+	}
+	return *r.FrontendPort
+}
+
+// GetBackendPort returns BackendPort field of Rule or nil if Rule is nil.
+func (r *Rule) GetBackendPort() *int {
+	if r == nil {
+		return nil
+	}
+	return r.BackendPort
+}
+
+// GetBackendPortV returns value of BackendPort field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetBackendPortV() int {
+	if r == nil || r.BackendPort == nil {
+		return 0
+	}
+	return *r.BackendPort
+}
+
+// GetBackendPortOr returns value of BackendPort field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetBackendPortOr(def int) int {
+	if r == nil || r.BackendPort == nil {
+		return def
+	}
+	return *r.BackendPort
+}
+
+// GetIdleTimeoutMinutes returns IdleTimeoutMinutes field of Rule or nil if Rule is nil.
+func (r *Rule) GetIdleTimeoutMinutes() *int {
+	if r == nil {
+		return nil
+	}
+	return r.IdleTimeoutMinutes
+}
+
+// GetIdleTimeoutMinutesV returns value of IdleTimeoutMinutes field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetIdleTimeoutMinutesV() int {
+	if r == nil || r.IdleTimeoutMinutes == nil {
+		return 0
+	}
+	return *r.IdleTimeoutMinutes
+}
+
+// GetIdleTimeoutMinutesOr returns value of IdleTimeoutMinutes field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetIdleTimeoutMinutesOr(def int) int {
+	if r == nil || r.IdleTimeoutMinutes == nil {
+		return def
+	}
+	return *r.IdleTimeoutMinutes
+}
+
+// GetEnableFloatingIp returns EnableFloatingIp field of Rule or nil if Rule is nil.
+func (r *Rule) GetEnableFloatingIp() *bool {
+	if r == nil {
+		return nil
+	}
+	return r.EnableFloatingIp
+}
+
+// GetEnableFloatingIpV returns value of EnableFloatingIp field of Rule or zero value if either Rule or field is nil.
+func (r *Rule) GetEnableFloatingIpV() bool {
+	if r == nil || r.EnableFloatingIp == nil {
+		return false
+	}
+	return *r.EnableFloatingIp
+}
+
+// GetEnableFloatingIpOr returns value of EnableFloatingIp field of Rule or def if either Rule or field is nil.
+func (r *Rule) GetEnableFloatingIpOr(def bool) bool {
+	if r == nil || r.EnableFloatingIp == nil {
+		return def
+	}
+	return *r.EnableFloatingIp
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetLocation returns Location field of Params or nil if Params is nil.
+func (p *Params) GetLocation() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Location
+}
+
+// GetLocationV returns value of Location field of Params or zero value if either Params or field is nil.
+func (p *Params) GetLocationV() string {
+	if p == nil || p.Location == nil {
+		return ""
+	}
+	return *p.Location
+}
+
+// GetLocationOr returns value of Location field of Params or def if either Params or field is nil.
+func (p *Params) GetLocationOr(def string) string {
+	if p == nil || p.Location == nil {
+		return def
+	}
+	return *p.Location
+}
+
+// GetRgName returns RgName field of Params or nil if Params is nil.
+func (p *Params) GetRgName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RgName
+}
+
+// GetRgNameV returns value of RgName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRgNameV() string {
+	if p == nil || p.RgName == nil {
+		return ""
+	}
+	return *p.RgName
+}
+
+// GetRgNameOr returns value of RgName field of Params or def if either Params or field is nil.
+func (p *Params) GetRgNameOr(def string) string {
+	if p == nil || p.RgName == nil {
+		return def
+	}
+	return *p.RgName
+}
+
+// GetSku returns Sku field of Params or nil if Params is nil.
+func (p *Params) GetSku() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Sku
+}
+
+// GetSkuV returns value of Sku field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSkuV() string {
+	if p == nil || p.Sku == nil {
+		return ""
+	}
+	return *p.Sku
+}
+
+// GetSkuOr returns value of Sku field of Params or def if either Params or field is nil.
+func (p *Params) GetSkuOr(def string) string {
+	if p == nil || p.Sku == nil {
+		return def
+	}
+	return *p.Sku
+}
+
+// GetFrontends returns Frontends field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetFrontends() []Frontend {
+	if p == nil {
+		return nil
+	}
+	if len(p.Frontends) == 0 {
+		return []Frontend{}
+	}
+	return p.Frontends
+}
+
+// GetBackendPools returns BackendPools field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetBackendPools() []BackendPool {
+	if p == nil {
+		return nil
+	}
+	if len(p.BackendPools) == 0 {
+		return []BackendPool{}
+	}
+	return p.BackendPools
+}
+
+// GetProbes returns Probes field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetProbes() []Probe {
+	if p == nil {
+		return nil
+	}
+	if len(p.Probes) == 0 {
+		return []Probe{}
+	}
+	return p.Probes
+}
+
+// GetRules returns Rules field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetRules() []Rule {
+	if p == nil {
+		return nil
+	}
+	if len(p.Rules) == 0 {
+		return []Rule{}
+	}
+	return p.Rules
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetName returns Name field of OutputFrontend or nil if OutputFrontend is nil.
+func (o *OutputFrontend) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputFrontend or zero value if either OutputFrontend or field is nil.
+func (o *OutputFrontend) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputFrontend or def if either OutputFrontend or field is nil.
+func (o *OutputFrontend) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetIp returns Ip field of OutputFrontend or nil if OutputFrontend is nil.
+func (o *OutputFrontend) GetIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Ip
+}
+
+// GetIpV returns value of Ip field of OutputFrontend or zero value if either OutputFrontend or field is nil.
+func (o *OutputFrontend) GetIpV() string {
+	if o == nil || o.Ip == nil {
+		return ""
+	}
+	return *o.Ip
+}
+
+// GetIpOr returns value of Ip field of OutputFrontend or def if either OutputFrontend or field is nil.
+func (o *OutputFrontend) GetIpOr(def string) string {
+	if o == nil || o.Ip == nil {
+		return def
+	}
+	return *o.Ip
+}
+
+// GetName returns Name field of OutputBackendPool or nil if OutputBackendPool is nil.
+func (o *OutputBackendPool) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputBackendPool or zero value if either OutputBackendPool or field is nil.
+func (o *OutputBackendPool) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputBackendPool or def if either OutputBackendPool or field is nil.
+func (o *OutputBackendPool) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetVmNames returns VmNames field of OutputBackendPool, nil if OutputBackendPool is nil or empty slice if field is nil.
+func (o *OutputBackendPool) GetVmNames() []string {
+	if o == nil {
+		return nil
+	}
+	if len(o.VmNames) == 0 {
+		return []string{}
+	}
+	return o.VmNames
+}
+
+// GetLbName returns LbName field of Output or nil if Output is nil.
+func (o *Output) GetLbName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.LbName
+}
+
+// GetLbNameV returns value of LbName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetLbNameV() string {
+	if o == nil || o.LbName == nil {
+		return ""
+	}
+	return *o.LbName
+}
+
+// GetLbNameOr returns value of LbName field of Output or def if either Output or field is nil.
+func (o *Output) GetLbNameOr(def string) string {
+	if o == nil || o.LbName == nil {
+		return def
+	}
+	return *o.LbName
+}
+
+// GetFrontends returns Frontends field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetFrontends() []OutputFrontend {
+	if o == nil {
+		return nil
+	}
+	if len(o.Frontends) == 0 {
+		return []OutputFrontend{}
+	}
+	return o.Frontends
+}
+
+// GetBackendPools returns BackendPools field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetBackendPools() []OutputBackendPool {
+	if o == nil {
+		return nil
+	}
+	if len(o.BackendPools) == 0 {
+		return []OutputBackendPool{}
+	}
+	return o.BackendPools
+}
@@ -0,0 +1,840 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFrontend_Accessors(t *testing.T) {
+	var nilStruct *Frontend
+	emptyStruct := &Frontend{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Frontend{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Type", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Frontend{Type: &v}
+		if nilStruct.GetType() != nil || emptyStruct.GetType() != nil {
+			t.Error("GetType() expected to return nil")
+		}
+		if fullStruct.GetType() != &v {
+			t.Error("GetType() expected to return field")
+		}
+		if nilStruct.GetTypeV() != "" || emptyStruct.GetTypeV() != "" {
+			t.Error("GetTypeV() expected to return zero value")
+		}
+		if fullStruct.GetTypeV() != v {
+			t.Error("GetTypeV() expected to return field value")
+		}
+		if nilStruct.GetTypeOr(v) != v || emptyStruct.GetTypeOr(v) != v {
+			t.Error("GetTypeOr() expected to return default value")
+		}
+		if fullStruct.GetTypeOr("") != v {
+			t.Error("GetTypeOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Frontend{SubnetName: &v}
+		if nilStruct.GetSubnetName() != nil || emptyStruct.GetSubnetName() != nil {
+			t.Error("GetSubnetName() expected to return nil")
+		}
+		if fullStruct.GetSubnetName() != &v {
+			t.Error("GetSubnetName() expected to return field")
+		}
+		if nilStruct.GetSubnetNameV() != "" || emptyStruct.GetSubnetNameV() != "" {
+			t.Error("GetSubnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetSubnetNameV() != v {
+			t.Error("GetSubnetNameV() expected to return field value")
+		}
+		if nilStruct.GetSubnetNameOr(v) != v || emptyStruct.GetSubnetNameOr(v) != v {
+			t.Error("GetSubnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetSubnetNameOr("") != v {
+			t.Error("GetSubnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("PrivateIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Frontend{PrivateIp: &v}
+		if nilStruct.GetPrivateIp() != nil || emptyStruct.GetPrivateIp() != nil {
+			t.Error("GetPrivateIp() expected to return nil")
+		}
+		if fullStruct.GetPrivateIp() != &v {
+			t.Error("GetPrivateIp() expected to return field")
+		}
+		if nilStruct.GetPrivateIpV() != "" || emptyStruct.GetPrivateIpV() != "" {
+			t.Error("GetPrivateIpV() expected to return zero value")
+		}
+		if fullStruct.GetPrivateIpV() != v {
+			t.Error("GetPrivateIpV() expected to return field value")
+		}
+		if nilStruct.GetPrivateIpOr(v) != v || emptyStruct.GetPrivateIpOr(v) != v {
+			t.Error("GetPrivateIpOr() expected to return default value")
+		}
+		if fullStruct.GetPrivateIpOr("") != v {
+			t.Error("GetPrivateIpOr() expected to return field value")
+		}
+	})
+}
+
+func TestBackendPool_Accessors(t *testing.T) {
+	var nilStruct *BackendPool
+	emptyStruct := &BackendPool{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &BackendPool{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VmGroupNames", func(t *testing.T) {
+		fullStruct := &BackendPool{VmGroupNames: make([]string, 1)}
+		if nilStruct.GetVmGroupNames() != nil {
+			t.Error("GetVmGroupNames() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroupNames(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroupNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroupNames(); len(got) != 1 || &got[0] != &fullStruct.VmGroupNames[0] {
+			t.Error("GetVmGroupNames() expected to return field")
+		}
+	})
+}
+
+func TestProbe_Accessors(t *testing.T) {
+	var nilStruct *Probe
+	emptyStruct := &Probe{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Probe{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Protocol", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Probe{Protocol: &v}
+		if nilStruct.GetProtocol() != nil || emptyStruct.GetProtocol() != nil {
+			t.Error("GetProtocol() expected to return nil")
+		}
+		if fullStruct.GetProtocol() != &v {
+			t.Error("GetProtocol() expected to return field")
+		}
+		if nilStruct.GetProtocolV() != "" || emptyStruct.GetProtocolV() != "" {
+			t.Error("GetProtocolV() expected to return zero value")
+		}
+		if fullStruct.GetProtocolV() != v {
+			t.Error("GetProtocolV() expected to return field value")
+		}
+		if nilStruct.GetProtocolOr(v) != v || emptyStruct.GetProtocolOr(v) != v {
+			t.Error("GetProtocolOr() expected to return default value")
+		}
+		if fullStruct.GetProtocolOr("") != v {
+			t.Error("GetProtocolOr() expected to return field value")
+		}
+	})
+	t.Run("Port", func(t *testing.T) {
+		v := 1
+		fullStruct := &Probe{Port: &v}
+		if nilStruct.GetPort() != nil || emptyStruct.GetPort() != nil {
+			t.Error("GetPort() expected to return nil")
+		}
+		if fullStruct.GetPort() != &v {
+			t.Error("GetPort() expected to return field")
+		}
+		if nilStruct.GetPortV() != 0 || emptyStruct.GetPortV() != 0 {
+			t.Error("GetPortV() expected to return zero value")
+		}
+		if fullStruct.GetPortV() != v {
+			t.Error("GetPortV() expected to return field value")
+		}
+		if nilStruct.GetPortOr(v) != v || emptyStruct.GetPortOr(v) != v {
+			t.Error("GetPortOr() expected to return default value")
+		}
+		if fullStruct.GetPortOr(0) != v {
+			t.Error("GetPortOr() expected to return field value")
+		}
+	})
+	t.Run("RequestPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Probe{RequestPath: &v}
+		if nilStruct.GetRequestPath() != nil || emptyStruct.GetRequestPath() != nil {
+			t.Error("GetRequestPath() expected to return nil")
+		}
+		if fullStruct.GetRequestPath() != &v {
+			t.Error("GetRequestPath() expected to return field")
+		}
+		if nilStruct.GetRequestPathV() != "" || emptyStruct.GetRequestPathV() != "" {
+			t.Error("GetRequestPathV() expected to return zero value")
+		}
+		if fullStruct.GetRequestPathV() != v {
+			t.Error("GetRequestPathV() expected to return field value")
+		}
+		if nilStruct.GetRequestPathOr(v) != v || emptyStruct.GetRequestPathOr(v) != v {
+			t.Error("GetRequestPathOr() expected to return default value")
+		}
+		if fullStruct.GetRequestPathOr("") != v {
+			t.Error("GetRequestPathOr() expected to return field value")
+		}
+	})
+	t.Run("IntervalSeconds", func(t *testing.T) {
+		v := 1
+		fullStruct := &Probe{IntervalSeconds: &v}
+		if nilStruct.GetIntervalSeconds() != nil || emptyStruct.GetIntervalSeconds() != nil {
+			t.Error("GetIntervalSeconds() expected to return nil")
+		}
+		if fullStruct.GetIntervalSeconds() != &v {
+			t.Error("GetIntervalSeconds() expected to return field")
+		}
+		if nilStruct.GetIntervalSecondsV() != 0 || emptyStruct.GetIntervalSecondsV() != 0 {
+			t.Error("GetIntervalSecondsV() expected to return zero value")
+		}
+		if fullStruct.GetIntervalSecondsV() != v {
+			t.Error("GetIntervalSecondsV() expected to return field value")
+		}
+		if nilStruct.GetIntervalSecondsOr(v) != v || emptyStruct.GetIntervalSecondsOr(v) != v {
+			t.Error("GetIntervalSecondsOr() expected to return default value")
+		}
+		if fullStruct.GetIntervalSecondsOr(0) != v {
+			t.Error("GetIntervalSecondsOr() expected to return field value")
+		}
+	})
+	t.Run("NumberOfProbes", func(t *testing.T) {
+		v := 1
+		fullStruct := &Probe{NumberOfProbes: &v}
+		if nilStruct.GetNumberOfProbes() != nil || emptyStruct.GetNumberOfProbes() != nil {
+			t.Error("GetNumberOfProbes() expected to return nil")
+		}
+		if fullStruct.GetNumberOfProbes() != &v {
+			t.Error("GetNumberOfProbes() expected to return field")
+		}
+		if nilStruct.GetNumberOfProbesV() != 0 || emptyStruct.GetNumberOfProbesV() != 0 {
+			t.Error("GetNumberOfProbesV() expected to return zero value")
+		}
+		if fullStruct.GetNumberOfProbesV() != v {
+			t.Error("GetNumberOfProbesV() expected to return field value")
+		}
+		if nilStruct.GetNumberOfProbesOr(v) != v || emptyStruct.GetNumberOfProbesOr(v) != v {
+			t.Error("GetNumberOfProbesOr() expected to return default value")
+		}
+		if fullStruct.GetNumberOfProbesOr(0) != v {
+			t.Error("GetNumberOfProbesOr() expected to return field value")
+		}
+	})
+}
+
+func TestRule_Accessors(t *testing.T) {
+	var nilStruct *Rule
+	emptyStruct := &Rule{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Rule{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Protocol", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Rule{Protocol: &v}
+		if nilStruct.GetProtocol() != nil || emptyStruct.GetProtocol() != nil {
+			t.Error("GetProtocol() expected to return nil")
+		}
+		if fullStruct.GetProtocol() != &v {
+			t.Error("GetProtocol() expected to return field")
+		}
+		if nilStruct.GetProtocolV() != "" || emptyStruct.GetProtocolV() != "" {
+			t.Error("GetProtocolV() expected to return zero value")
+		}
+		if fullStruct.GetProtocolV() != v {
+			t.Error("GetProtocolV() expected to return field value")
+		}
+		if nilStruct.GetProtocolOr(v) != v || emptyStruct.GetProtocolOr(v) != v {
+			t.Error("GetProtocolOr() expected to return default value")
+		}
+		if fullStruct.GetProtocolOr("") != v {
+			t.Error("GetProtocolOr() expected to return field value")
+		}
+	})
+	t.Run("FrontendName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Rule{FrontendName: &v}
+		if nilStruct.GetFrontendName() != nil || emptyStruct.GetFrontendName() != nil {
+			t.Error("GetFrontendName() expected to return nil")
+		}
+		if fullStruct.GetFrontendName() != &v {
+			t.Error("GetFrontendName() expected to return field")
+		}
+		if nilStruct.GetFrontendNameV() != "" || emptyStruct.GetFrontendNameV() != "" {
+			t.Error("GetFrontendNameV() expected to return zero value")
+		}
+		if fullStruct.GetFrontendNameV() != v {
+			t.Error("GetFrontendNameV() expected to return field value")
+		}
+		if nilStruct.GetFrontendNameOr(v) != v || emptyStruct.GetFrontendNameOr(v) != v {
+			t.Error("GetFrontendNameOr() expected to return default value")
+		}
+		if fullStruct.GetFrontendNameOr("") != v {
+			t.Error("GetFrontendNameOr() expected to return field value")
+		}
+	})
+	t.Run("BackendPoolName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Rule{BackendPoolName: &v}
+		if nilStruct.GetBackendPoolName() != nil || emptyStruct.GetBackendPoolName() != nil {
+			t.Error("GetBackendPoolName() expected to return nil")
+		}
+		if fullStruct.GetBackendPoolName() != &v {
+			t.Error("GetBackendPoolName() expected to return field")
+		}
+		if nilStruct.GetBackendPoolNameV() != "" || emptyStruct.GetBackendPoolNameV() != "" {
+			t.Error("GetBackendPoolNameV() expected to return zero value")
+		}
+		if fullStruct.GetBackendPoolNameV() != v {
+			t.Error("GetBackendPoolNameV() expected to return field value")
+		}
+		if nilStruct.GetBackendPoolNameOr(v) != v || emptyStruct.GetBackendPoolNameOr(v) != v {
+			t.Error("GetBackendPoolNameOr() expected to return default value")
+		}
+		if fullStruct.GetBackendPoolNameOr("") != v {
+			t.Error("GetBackendPoolNameOr() expected to return field value")
+		}
+	})
+	t.Run("ProbeName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Rule{ProbeName: &v}
+		if nilStruct.GetProbeName() != nil || emptyStruct.GetProbeName() != nil {
+			t.Error("GetProbeName() expected to return nil")
+		}
+		if fullStruct.GetProbeName() != &v {
+			t.Error("GetProbeName() expected to return field")
+		}
+		if nilStruct.GetProbeNameV() != "" || emptyStruct.GetProbeNameV() != "" {
+			t.Error("GetProbeNameV() expected to return zero value")
+		}
+		if fullStruct.GetProbeNameV() != v {
+			t.Error("GetProbeNameV() expected to return field value")
+		}
+		if nilStruct.GetProbeNameOr(v) != v || emptyStruct.GetProbeNameOr(v) != v {
+			t.Error("GetProbeNameOr() expected to return default value")
+		}
+		if fullStruct.GetProbeNameOr("") != v {
+			t.Error("GetProbeNameOr() expected to return field value")
+		}
+	})
+	t.Run("FrontendPort", func(t *testing.T) {
+		v := 1
+		fullStruct := &Rule{FrontendPort: &v}
+		if nilStruct.GetFrontendPort() != nil || emptyStruct.GetFrontendPort() != nil {
+			t.Error("GetFrontendPort() expected to return nil")
+		}
+		if fullStruct.GetFrontendPort() != &v {
+			t.Error("GetFrontendPort() expected to return field")
+		}
+		if nilStruct.GetFrontendPortV() != 0 || emptyStruct.GetFrontendPortV() != 0 {
+			t.Error("GetFrontendPortV() expected to return zero value")
+		}
+		if fullStruct.GetFrontendPortV() != v {
+			t.Error("GetFrontendPortV() expected to return field value")
+		}
+		if nilStruct.GetFrontendPortOr(v) != v || emptyStruct.GetFrontendPortOr(v) != v {
+			t.Error("GetFrontendPortOr() expected to return default value")
+		}
+		if fullStruct.GetFrontendPortOr(0) != v {
+			t.Error("GetFrontendPortOr() expected to return field value")
+		}
+	})
+	t.Run("BackendPort", func(t *testing.T) {
+		v := 1
+		fullStruct := &Rule{BackendPort: &v}
+		if nilStruct.GetBackendPort() != nil || emptyStruct.GetBackendPort() != nil {
+			t.Error("GetBackendPort() expected to return nil")
+		}
+		if fullStruct.GetBackendPort() != &v {
+			t.Error("GetBackendPort() expected to return field")
+		}
+		if nilStruct.GetBackendPortV() != 0 || emptyStruct.GetBackendPortV() != 0 {
+			t.Error("GetBackendPortV() expected to return zero value")
+		}
+		if fullStruct.GetBackendPortV() != v {
+			t.Error("GetBackendPortV() expected to return field value")
+		}
+		if nilStruct.GetBackendPortOr(v) != v || emptyStruct.GetBackendPortOr(v) != v {
+			t.Error("GetBackendPortOr() expected to return default value")
+		}
+		if fullStruct.GetBackendPortOr(0) != v {
+			t.Error("GetBackendPortOr() expected to return field value")
+		}
+	})
+	t.Run("IdleTimeoutMinutes", func(t *testing.T) {
+		v := 1
+		fullStruct := &Rule{IdleTimeoutMinutes: &v}
+		if nilStruct.GetIdleTimeoutMinutes() != nil || emptyStruct.GetIdleTimeoutMinutes() != nil {
+			t.Error("GetIdleTimeoutMinutes() expected to return nil")
+		}
+		if fullStruct.GetIdleTimeoutMinutes() != &v {
+			t.Error("GetIdleTimeoutMinutes() expected to return field")
+		}
+		if nilStruct.GetIdleTimeoutMinutesV() != 0 || emptyStruct.GetIdleTimeoutMinutesV() != 0 {
+			t.Error("GetIdleTimeoutMinutesV() expected to return zero value")
+		}
+		if fullStruct.GetIdleTimeoutMinutesV() != v {
+			t.Error("GetIdleTimeoutMinutesV() expected to return field value")
+		}
+		if nilStruct.GetIdleTimeoutMinutesOr(v) != v || emptyStruct.GetIdleTimeoutMinutesOr(v) != v {
+			t.Error("GetIdleTimeoutMinutesOr() expected to return default value")
+		}
+		if fullStruct.GetIdleTimeoutMinutesOr(0) != v {
+			t.Error("GetIdleTimeoutMinutesOr() expected to return field value")
+		}
+	})
+	t.Run("EnableFloatingIp", func(t *testing.T) {
+		v := true
+		fullStruct := &Rule{EnableFloatingIp: &v}
+		if nilStruct.GetEnableFloatingIp() != nil || emptyStruct.GetEnableFloatingIp() != nil {
+			t.Error("GetEnableFloatingIp() expected to return nil")
+		}
+		if fullStruct.GetEnableFloatingIp() != &v {
+			t.Error("GetEnableFloatingIp() expected to return field")
+		}
+		if nilStruct.GetEnableFloatingIpV() != false || emptyStruct.GetEnableFloatingIpV() != false {
+			t.Error("GetEnableFloatingIpV() expected to return zero value")
+		}
+		if fullStruct.GetEnableFloatingIpV() != v {
+			t.Error("GetEnableFloatingIpV() expected to return field value")
+		}
+		if nilStruct.GetEnableFloatingIpOr(v) != v || emptyStruct.GetEnableFloatingIpOr(v) != v {
+			t.Error("GetEnableFloatingIpOr() expected to return default value")
+		}
+		if fullStruct.GetEnableFloatingIpOr(false) != v {
+			t.Error("GetEnableFloatingIpOr() expected to return field value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Location", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Location: &v}
+		if nilStruct.GetLocation() != nil || emptyStruct.GetLocation() != nil {
+			t.Error("GetLocation() expected to return nil")
+		}
+		if fullStruct.GetLocation() != &v {
+			t.Error("GetLocation() expected to return field")
+		}
+		if nilStruct.GetLocationV() != "" || emptyStruct.GetLocationV() != "" {
+			t.Error("GetLocationV() expected to return zero value")
+		}
+		if fullStruct.GetLocationV() != v {
+			t.Error("GetLocationV() expected to return field value")
+		}
+		if nilStruct.GetLocationOr(v) != v || emptyStruct.GetLocationOr(v) != v {
+			t.Error("GetLocationOr() expected to return default value")
+		}
+		if fullStruct.GetLocationOr("") != v {
+			t.Error("GetLocationOr() expected to return field value")
+		}
+	})
+	t.Run("RgName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RgName: &v}
+		if nilStruct.GetRgName() != nil || emptyStruct.GetRgName() != nil {
+			t.Error("GetRgName() expected to return nil")
+		}
+		if fullStruct.GetRgName() != &v {
+			t.Error("GetRgName() expected to return field")
+		}
+		if nilStruct.GetRgNameV() != "" || emptyStruct.GetRgNameV() != "" {
+			t.Error("GetRgNameV() expected to return zero value")
+		}
+		if fullStruct.GetRgNameV() != v {
+			t.Error("GetRgNameV() expected to return field value")
+		}
+		if nilStruct.GetRgNameOr(v) != v || emptyStruct.GetRgNameOr(v) != v {
+			t.Error("GetRgNameOr() expected to return default value")
+		}
+		if fullStruct.GetRgNameOr("") != v {
+			t.Error("GetRgNameOr() expected to return field value")
+		}
+	})
+	t.Run("Sku", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Sku: &v}
+		if nilStruct.GetSku() != nil || emptyStruct.GetSku() != nil {
+			t.Error("GetSku() expected to return nil")
+		}
+		if fullStruct.GetSku() != &v {
+			t.Error("GetSku() expected to return field")
+		}
+		if nilStruct.GetSkuV() != "" || emptyStruct.GetSkuV() != "" {
+			t.Error("GetSkuV() expected to return zero value")
+		}
+		if fullStruct.GetSkuV() != v {
+			t.Error("GetSkuV() expected to return field value")
+		}
+		if nilStruct.GetSkuOr(v) != v || emptyStruct.GetSkuOr(v) != v {
+			t.Error("GetSkuOr() expected to return default value")
+		}
+		if fullStruct.GetSkuOr("") != v {
+			t.Error("GetSkuOr() expected to return field value")
+		}
+	})
+	t.Run("Frontends", func(t *testing.T) {
+		fullStruct := &Params{Frontends: make([]Frontend, 1)}
+		if nilStruct.GetFrontends() != nil {
+			t.Error("GetFrontends() expected to return nil")
+		}
+		if got := emptyStruct.GetFrontends(); got == nil || len(got) != 0 {
+			t.Error("GetFrontends() expected to return empty slice")
+		}
+		if got := fullStruct.GetFrontends(); len(got) != 1 || &got[0] != &fullStruct.Frontends[0] {
+			t.Error("GetFrontends() expected to return field")
+		}
+	})
+	t.Run("BackendPools", func(t *testing.T) {
+		fullStruct := &Params{BackendPools: make([]BackendPool, 1)}
+		if nilStruct.GetBackendPools() != nil {
+			t.Error("GetBackendPools() expected to return nil")
+		}
+		if got := emptyStruct.GetBackendPools(); got == nil || len(got) != 0 {
+			t.Error("GetBackendPools() expected to return empty slice")
+		}
+		if got := fullStruct.GetBackendPools(); len(got) != 1 || &got[0] != &fullStruct.BackendPools[0] {
+			t.Error("GetBackendPools() expected to return field")
+		}
+	})
+	t.Run("Probes", func(t *testing.T) {
+		fullStruct := &Params{Probes: make([]Probe, 1)}
+		if nilStruct.GetProbes() != nil {
+			t.Error("GetProbes() expected to return nil")
+		}
+		if got := emptyStruct.GetProbes(); got == nil || len(got) != 0 {
+			t.Error("GetProbes() expected to return empty slice")
+		}
+		if got := fullStruct.GetProbes(); len(got) != 1 || &got[0] != &fullStruct.Probes[0] {
+			t.Error("GetProbes() expected to return field")
+		}
+	})
+	t.Run("Rules", func(t *testing.T) {
+		fullStruct := &Params{Rules: make([]Rule, 1)}
+		if nilStruct.GetRules() != nil {
+			t.Error("GetRules() expected to return nil")
+		}
+		if got := emptyStruct.GetRules(); got == nil || len(got) != 0 {
+			t.Error("GetRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetRules(); len(got) != 1 || &got[0] != &fullStruct.Rules[0] {
+			t.Error("GetRules() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputFrontend_Accessors(t *testing.T) {
+	var nilStruct *OutputFrontend
+	emptyStruct := &OutputFrontend{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputFrontend{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Ip", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputFrontend{Ip: &v}
+		if nilStruct.GetIp() != nil || emptyStruct.GetIp() != nil {
+			t.Error("GetIp() expected to return nil")
+		}
+		if fullStruct.GetIp() != &v {
+			t.Error("GetIp() expected to return field")
+		}
+		if nilStruct.GetIpV() != "" || emptyStruct.GetIpV() != "" {
+			t.Error("GetIpV() expected to return zero value")
+		}
+		if fullStruct.GetIpV() != v {
+			t.Error("GetIpV() expected to return field value")
+		}
+		if nilStruct.GetIpOr(v) != v || emptyStruct.GetIpOr(v) != v {
+			t.Error("GetIpOr() expected to return default value")
+		}
+		if fullStruct.GetIpOr("") != v {
+			t.Error("GetIpOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutputBackendPool_Accessors(t *testing.T) {
+	var nilStruct *OutputBackendPool
+	emptyStruct := &OutputBackendPool{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputBackendPool{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VmNames", func(t *testing.T) {
+		fullStruct := &OutputBackendPool{VmNames: make([]string, 1)}
+		if nilStruct.GetVmNames() != nil {
+			t.Error("GetVmNames() expected to return nil")
+		}
+		if got := emptyStruct.GetVmNames(); got == nil || len(got) != 0 {
+			t.Error("GetVmNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmNames(); len(got) != 1 || &got[0] != &fullStruct.VmNames[0] {
+			t.Error("GetVmNames() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("LbName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{LbName: &v}
+		if nilStruct.GetLbName() != nil || emptyStruct.GetLbName() != nil {
+			t.Error("GetLbName() expected to return nil")
+		}
+		if fullStruct.GetLbName() != &v {
+			t.Error("GetLbName() expected to return field")
+		}
+		if nilStruct.GetLbNameV() != "" || emptyStruct.GetLbNameV() != "" {
+			t.Error("GetLbNameV() expected to return zero value")
+		}
+		if fullStruct.GetLbNameV() != v {
+			t.Error("GetLbNameV() expected to return field value")
+		}
+		if nilStruct.GetLbNameOr(v) != v || emptyStruct.GetLbNameOr(v) != v {
+			t.Error("GetLbNameOr() expected to return default value")
+		}
+		if fullStruct.GetLbNameOr("") != v {
+			t.Error("GetLbNameOr() expected to return field value")
+		}
+	})
+	t.Run("Frontends", func(t *testing.T) {
+		fullStruct := &Output{Frontends: make([]OutputFrontend, 1)}
+		if nilStruct.GetFrontends() != nil {
+			t.Error("GetFrontends() expected to return nil")
+		}
+		if got := emptyStruct.GetFrontends(); got == nil || len(got) != 0 {
+			t.Error("GetFrontends() expected to return empty slice")
+		}
+		if got := fullStruct.GetFrontends(); len(got) != 1 || &got[0] != &fullStruct.Frontends[0] {
+			t.Error("GetFrontends() expected to return field")
+		}
+	})
+	t.Run("BackendPools", func(t *testing.T) {
+		fullStruct := &Output{BackendPools: make([]OutputBackendPool, 1)}
+		if nilStruct.GetBackendPools() != nil {
+			t.Error("GetBackendPools() expected to return nil")
+		}
+		if got := emptyStruct.GetBackendPools(); got == nil || len(got) != 0 {
+			t.Error("GetBackendPools() expected to return empty slice")
+		}
+		if got := fullStruct.GetBackendPools(); len(got) != 1 || &got[0] != &fullStruct.BackendPools[0] {
+			t.Error("GetBackendPools() expected to return field")
+		}
+	})
+}
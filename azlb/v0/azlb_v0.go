@@ -0,0 +1,333 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "azlb"
+	version = "v0.0.1"
+)
+
+// Frontend is IP address on which load balancer receives traffic. Private frontends are placed in
+// SubnetName subnet created by azbi module, public ones can't have SubnetName nor PrivateIp.
+type Frontend struct {
+	Name       *string `json:"name" validate:"required,min=1"`
+	Type       *string `json:"type" validate:"required,eq=public|eq=private"`
+	SubnetName *string `json:"subnet_name" validate:"required_if=Type private,omitempty,min=1"`
+	PrivateIp  *string `json:"private_ip" validate:"omitempty,ipv4"`
+}
+
+// BackendPool groups VMs of azbi VmGroups with VmGroupNames.
+type BackendPool struct {
+	Name         *string  `json:"name" validate:"required,min=1"`
+	VmGroupNames []string `json:"vm_group_names" validate:"required,min=1,dive,required"`
+}
+
+type Probe struct {
+	Name            *string `json:"name" validate:"required,min=1"`
+	Protocol        *string `json:"protocol" validate:"required,eq=Tcp|eq=Http|eq=Https"`
+	Port            *int    `json:"port" validate:"required,min=1,max=65535"`
+	RequestPath     *string `json:"request_path" validate:"required_unless=Protocol Tcp,omitempty,startswith=/"`
+	IntervalSeconds *int    `json:"interval_seconds" validate:"required,min=5"`
+	NumberOfProbes  *int    `json:"number_of_probes" validate:"required,min=1"`
+}
+
+// Rule forwards traffic received on FrontendPort of frontend to BackendPort of backend pool VMs.
+// Protocol All requires both ports to be 0 (HA ports).
+type Rule struct {
+	Name               *string `json:"name" validate:"required,min=1"`
+	Protocol           *string `json:"protocol" validate:"required,eq=Tcp|eq=Udp|eq=All"`
+	FrontendName       *string `json:"frontend_name" validate:"required,min=1"`
+	BackendPoolName    *string `json:"backend_pool_name" validate:"required,min=1"`
+	ProbeName          *string `json:"probe_name" validate:"omitempty,min=1"`
+	FrontendPort       *int    `json:"frontend_port" validate:"required,min=0,max=65535"`
+	BackendPort        *int    `json:"backend_port" validate:"required,min=0,max=65535"`
+	IdleTimeoutMinutes *int    `json:"idle_timeout_minutes" validate:"required,min=4,max=30"`
+	EnableFloatingIp   *bool   `json:"enable_floating_ip" validate:"required"`
+}
+
+type Params struct {
+	Name         *string       `json:"name" validate:"required,min=1"`
+	Location     *string       `json:"location" validate:"required,min=1"`
+	RgName       *string       `json:"rg_name" validate:"required,min=1"`
+	Sku          *string       `json:"sku" validate:"required,eq=Basic|eq=Standard"`
+	Frontends    []Frontend    `json:"frontends" validate:"required,min=1,dive"`
+	BackendPools []BackendPool `json:"backend_pools" validate:"required,min=1,dive"`
+	Probes       []Probe       `json:"probes" validate:"omitempty,dive"`
+	Rules        []Rule        `json:"rules" validate:"omitempty,dive"`
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=azlb"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:     to.StrPtr("epiphany"),
+			Location: to.StrPtr("northeurope"),
+			RgName:   to.StrPtr("epiphany-rg"),
+			Sku:      to.StrPtr("Standard"),
+			Frontends: []Frontend{
+				{
+					Name: to.StrPtr("public"),
+					Type: to.StrPtr("public"),
+				},
+			},
+			BackendPools: []BackendPool{
+				{
+					Name:         to.StrPtr("vm-group0"),
+					VmGroupNames: []string{"vm-group0"},
+				},
+			},
+			Probes: []Probe{
+				{
+					Name:            to.StrPtr("http"),
+					Protocol:        to.StrPtr("Http"),
+					Port:            to.IntPtr(80),
+					RequestPath:     to.StrPtr("/"),
+					IntervalSeconds: to.IntPtr(15),
+					NumberOfProbes:  to.IntPtr(2),
+				},
+			},
+			Rules: []Rule{
+				{
+					Name:               to.StrPtr("http"),
+					Protocol:           to.StrPtr("Tcp"),
+					FrontendName:       to.StrPtr("public"),
+					BackendPoolName:    to.StrPtr("vm-group0"),
+					ProbeName:          to.StrPtr("http"),
+					FrontendPort:       to.IntPtr(80),
+					BackendPort:        to.IntPtr(80),
+					IdleTimeoutMinutes: to.IntPtr(4),
+					EnableFloatingIp:   to.BooPtr(false),
+				},
+			},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("azlb config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(AzLBParamsValidation, Params{})
+	validate.RegisterStructValidation(AzLBFrontendValidation, Frontend{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// AzLBParamsValidation checks that names of frontends, backend pools, probes and rules are unique,
+// that all frontends are of the same type, as load balancer is either public or internal, that
+// rules refer to existing frontends, backend pools and probes, and that rule ports are consistent
+// with protocol and don't collide on the same frontend.
+func AzLBParamsValidation(sl validator.StructLevel) {
+	params := sl.Current().Interface().(Params)
+	frontends := make(map[string]bool)
+	var frontendType string
+	for i, f := range params.Frontends {
+		reportDuplicate(sl, frontends, f.Name, fmt.Sprintf("Frontends[%d].Name", i))
+		if f.Type == nil || (*f.Type != "public" && *f.Type != "private") {
+			continue
+		}
+		if frontendType == "" {
+			frontendType = *f.Type
+		} else if *f.Type != frontendType {
+			sl.ReportError(f.Type, fmt.Sprintf("Frontends[%d].Type", i), "Type", "sametype", frontendType)
+		}
+	}
+	pools := make(map[string]bool)
+	for i, p := range params.BackendPools {
+		reportDuplicate(sl, pools, p.Name, fmt.Sprintf("BackendPools[%d].Name", i))
+	}
+	probes := make(map[string]bool)
+	for i, p := range params.Probes {
+		reportDuplicate(sl, probes, p.Name, fmt.Sprintf("Probes[%d].Name", i))
+	}
+	rules := make(map[string]bool)
+	ports := make(map[string]bool)
+	for i, r := range params.Rules {
+		reportDuplicate(sl, rules, r.Name, fmt.Sprintf("Rules[%d].Name", i))
+		reportMissing(sl, frontends, r.FrontendName, fmt.Sprintf("Rules[%d].FrontendName", i), "FrontendName", "infrontends")
+		reportMissing(sl, pools, r.BackendPoolName, fmt.Sprintf("Rules[%d].BackendPoolName", i), "BackendPoolName", "inbackendpools")
+		reportMissing(sl, probes, r.ProbeName, fmt.Sprintf("Rules[%d].ProbeName", i), "ProbeName", "inprobes")
+		if r.Protocol == nil || r.FrontendPort == nil || r.BackendPort == nil {
+			continue
+		}
+		if *r.Protocol == "All" {
+			if *r.FrontendPort != 0 {
+				sl.ReportError(r.FrontendPort, fmt.Sprintf("Rules[%d].FrontendPort", i), "FrontendPort", "eq", "0")
+			}
+			if *r.BackendPort != 0 {
+				sl.ReportError(r.BackendPort, fmt.Sprintf("Rules[%d].BackendPort", i), "BackendPort", "eq", "0")
+			}
+			if params.Sku != nil && *params.Sku != "Standard" {
+				sl.ReportError(r.Protocol, fmt.Sprintf("Rules[%d].Protocol", i), "Protocol", "standardsku", "")
+			}
+		} else {
+			if *r.FrontendPort == 0 {
+				sl.ReportError(r.FrontendPort, fmt.Sprintf("Rules[%d].FrontendPort", i), "FrontendPort", "min", "1")
+			}
+			if *r.BackendPort == 0 {
+				sl.ReportError(r.BackendPort, fmt.Sprintf("Rules[%d].BackendPort", i), "BackendPort", "min", "1")
+			}
+		}
+		if r.FrontendName == nil {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s/%d", *r.FrontendName, *r.Protocol, *r.FrontendPort)
+		if ports[key] {
+			sl.ReportError(r.FrontendPort, fmt.Sprintf("Rules[%d].FrontendPort", i), "FrontendPort", "unique", "")
+		}
+		ports[key] = true
+	}
+}
+
+// AzLBFrontendValidation checks that public frontends don't have private addressing set.
+func AzLBFrontendValidation(sl validator.StructLevel) {
+	f := sl.Current().Interface().(Frontend)
+	if f.Type == nil || *f.Type != "public" {
+		return
+	}
+	if f.SubnetName != nil {
+		sl.ReportError(f.SubnetName, "SubnetName", "SubnetName", "excluded", "")
+	}
+	if f.PrivateIp != nil {
+		sl.ReportError(f.PrivateIp, "PrivateIp", "PrivateIp", "excluded", "")
+	}
+}
+
+func reportDuplicate(sl validator.StructLevel, names map[string]bool, name *string, field string) {
+	if name == nil {
+		return
+	}
+	if names[*name] {
+		sl.ReportError(name, field, "Name", "unique", "")
+	}
+	names[*name] = true
+}
+
+func reportMissing(sl validator.StructLevel, names map[string]bool, name *string, field, structField, tag string) {
+	if name == nil || *name == "" {
+		return
+	}
+	if !names[*name] {
+		sl.ReportError(name, field, structField, tag, "")
+	}
+}
+
+type OutputFrontend struct {
+	Name *string `json:"name" validate:"required,min=1"`
+	Ip   *string `json:"ip" validate:"required,ip"`
+}
+
+type OutputBackendPool struct {
+	Name    *string  `json:"name" validate:"required,min=1"`
+	VmNames []string `json:"vm_names" validate:"omitempty,dive,required"`
+}
+
+type Output struct {
+	LbName       *string             `json:"lb_name" validate:"required,min=1"`
+	Frontends    []OutputFrontend    `json:"frontends" validate:"omitempty,dive"`
+	BackendPools []OutputBackendPool `json:"backend_pools" validate:"omitempty,dive"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
@@ -0,0 +1,848 @@
+package v0
+
+import (
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Standard",
+		"frontends": [
+			{
+				"name": "web",
+				"type": "private",
+				"subnet_name": "main",
+				"private_ip": "10.0.1.101"
+			},
+			{
+				"name": "internal",
+				"type": "private",
+				"subnet_name": "main",
+				"private_ip": "10.0.1.100"
+			}
+		],
+		"backend_pools": [
+			{
+				"name": "web",
+				"vm_group_names": ["vm-group0", "vm-group1"]
+			}
+		],
+		"probes": [
+			{
+				"name": "http",
+				"protocol": "Http",
+				"port": 80,
+				"request_path": "/healthz",
+				"interval_seconds": 15,
+				"number_of_probes": 2
+			},
+			{
+				"name": "api",
+				"protocol": "Tcp",
+				"port": 6443,
+				"interval_seconds": 5,
+				"number_of_probes": 1
+			}
+		],
+		"rules": [
+			{
+				"name": "http",
+				"protocol": "Tcp",
+				"frontend_name": "web",
+				"backend_pool_name": "web",
+				"probe_name": "http",
+				"frontend_port": 80,
+				"backend_port": 8080,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			},
+			{
+				"name": "api",
+				"protocol": "Tcp",
+				"frontend_name": "internal",
+				"backend_pool_name": "web",
+				"probe_name": "api",
+				"frontend_port": 6443,
+				"backend_port": 6443,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azlb"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:     to.StrPtr("epiphany"),
+					Location: to.StrPtr("northeurope"),
+					RgName:   to.StrPtr("epiphany-rg"),
+					Sku:      to.StrPtr("Standard"),
+					Frontends: []Frontend{
+						{
+							Name:       to.StrPtr("web"),
+							Type:       to.StrPtr("private"),
+							SubnetName: to.StrPtr("main"),
+							PrivateIp:  to.StrPtr("10.0.1.101"),
+						},
+						{
+							Name:       to.StrPtr("internal"),
+							Type:       to.StrPtr("private"),
+							SubnetName: to.StrPtr("main"),
+							PrivateIp:  to.StrPtr("10.0.1.100"),
+						},
+					},
+					BackendPools: []BackendPool{
+						{
+							Name:         to.StrPtr("web"),
+							VmGroupNames: []string{"vm-group0", "vm-group1"},
+						},
+					},
+					Probes: []Probe{
+						{
+							Name:            to.StrPtr("http"),
+							Protocol:        to.StrPtr("Http"),
+							Port:            to.IntPtr(80),
+							RequestPath:     to.StrPtr("/healthz"),
+							IntervalSeconds: to.IntPtr(15),
+							NumberOfProbes:  to.IntPtr(2),
+						},
+						{
+							Name:            to.StrPtr("api"),
+							Protocol:        to.StrPtr("Tcp"),
+							Port:            to.IntPtr(6443),
+							IntervalSeconds: to.IntPtr(5),
+							NumberOfProbes:  to.IntPtr(1),
+						},
+					},
+					Rules: []Rule{
+						{
+							Name:               to.StrPtr("http"),
+							Protocol:           to.StrPtr("Tcp"),
+							FrontendName:       to.StrPtr("web"),
+							BackendPoolName:    to.StrPtr("web"),
+							ProbeName:          to.StrPtr("http"),
+							FrontendPort:       to.IntPtr(80),
+							BackendPort:        to.IntPtr(8080),
+							IdleTimeoutMinutes: to.IntPtr(4),
+							EnableFloatingIp:   to.BooPtr(false),
+						},
+						{
+							Name:               to.StrPtr("api"),
+							Protocol:           to.StrPtr("Tcp"),
+							FrontendName:       to.StrPtr("internal"),
+							BackendPoolName:    to.StrPtr("web"),
+							ProbeName:          to.StrPtr("api"),
+							FrontendPort:       to.IntPtr(6443),
+							BackendPort:        to.IntPtr(6443),
+							IdleTimeoutMinutes: to.IntPtr(4),
+							EnableFloatingIp:   to.BooPtr(false),
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"extra_outer_field": "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Basic",
+		"frontends": [
+			{
+				"name": "public",
+				"type": "public",
+				"extra_frontend_field": "extra_frontend_value"
+			}
+		],
+		"backend_pools": [
+			{
+				"name": "web",
+				"vm_group_names": ["vm-group0"]
+			}
+		],
+		"extra_inner_field": "extra_inner_value"
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azlb"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:     to.StrPtr("epiphany"),
+					Location: to.StrPtr("northeurope"),
+					RgName:   to.StrPtr("epiphany-rg"),
+					Sku:      to.StrPtr("Basic"),
+					Frontends: []Frontend{
+						{
+							Name: to.StrPtr("public"),
+							Type: to.StrPtr("public"),
+						},
+					},
+					BackendPools: []BackendPool{
+						{
+							Name:         to.StrPtr("web"),
+							VmGroupNames: []string{"vm-group0"},
+						},
+					},
+				},
+				Unused: []string{"extra_outer_field", "params.extra_inner_field", "params.frontends[0].extra_frontend_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "azks",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Basic",
+		"frontends": [
+			{
+				"name": "public",
+				"type": "public"
+			}
+		],
+		"backend_pools": [
+			{
+				"name": "web",
+				"vm_group_names": ["vm-group0"]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"params": {}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Location",
+					Field: "Location",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.RgName",
+					Field: "RgName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Sku",
+					Field: "Sku",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends",
+					Field: "Frontends",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.BackendPools",
+					Field: "BackendPools",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "empty frontends and backend pools",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Premium",
+		"frontends": [],
+		"backend_pools": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Sku",
+					Field: "Sku",
+					Tag:   "eq=Basic|eq=Standard",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends",
+					Field: "Frontends",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.BackendPools",
+					Field: "BackendPools",
+					Tag:   "min",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Frontends contains all scenarios related to validation of Frontend and BackendPool structures.
+func TestConfig_Load_Frontends(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect frontends and backend pools",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Standard",
+		"frontends": [
+			{
+				"name": "public",
+				"type": "public",
+				"subnet_name": "main",
+				"private_ip": "10.0.1.100"
+			},
+			{
+				"name": "internal",
+				"type": "private",
+				"private_ip": "10.0.1"
+			},
+			{
+				"name": "public",
+				"type": "external"
+			}
+		],
+		"backend_pools": [
+			{
+				"name": "web",
+				"vm_group_names": []
+			},
+			{
+				"name": "web",
+				"vm_group_names": [""]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends[0].SubnetName",
+					Field: "SubnetName",
+					Tag:   "excluded",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends[0].PrivateIp",
+					Field: "PrivateIp",
+					Tag:   "excluded",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends[1].SubnetName",
+					Field: "SubnetName",
+					Tag:   "required_if",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends[1].PrivateIp",
+					Field: "PrivateIp",
+					Tag:   "ipv4",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends[2].Type",
+					Field: "Type",
+					Tag:   "eq=public|eq=private",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends[1].Type",
+					Field: "Frontends[1].Type",
+					Tag:   "sametype",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Frontends[2].Name",
+					Field: "Frontends[2].Name",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.BackendPools[0].VmGroupNames",
+					Field: "VmGroupNames",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.BackendPools[1].VmGroupNames[0]",
+					Field: "VmGroupNames[0]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.BackendPools[1].Name",
+					Field: "BackendPools[1].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Probes contains all scenarios related to validation of Probe structures.
+func TestConfig_Load_Probes(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect probes",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Standard",
+		"frontends": [
+			{
+				"name": "public",
+				"type": "public"
+			}
+		],
+		"backend_pools": [
+			{
+				"name": "web",
+				"vm_group_names": ["vm-group0", "vm-group1"]
+			}
+		],
+		"probes": [
+			{
+				"name": "http",
+				"protocol": "Http",
+				"port": 0,
+				"interval_seconds": 1,
+				"number_of_probes": 0
+			},
+			{
+				"name": "http",
+				"protocol": "Udp",
+				"port": 80,
+				"request_path": "healthz",
+				"interval_seconds": 5,
+				"number_of_probes": 1
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Probes[0].Port",
+					Field: "Port",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Probes[0].RequestPath",
+					Field: "RequestPath",
+					Tag:   "required_unless",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Probes[0].IntervalSeconds",
+					Field: "IntervalSeconds",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Probes[0].NumberOfProbes",
+					Field: "NumberOfProbes",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Probes[1].Protocol",
+					Field: "Protocol",
+					Tag:   "eq=Tcp|eq=Http|eq=Https",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Probes[1].RequestPath",
+					Field: "RequestPath",
+					Tag:   "startswith",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Probes[1].Name",
+					Field: "Probes[1].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Rules contains all scenarios related to validation of Rule structures and their references.
+func TestConfig_Load_Rules(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "rules referencing unknown elements",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Standard",
+		"frontends": [
+			{
+				"name": "public",
+				"type": "public"
+			},
+			{
+				"name": "secondary",
+				"type": "public"
+			}
+		],
+		"backend_pools": [
+			{
+				"name": "web",
+				"vm_group_names": ["vm-group0", "vm-group1"]
+			}
+		],
+		"probes": [
+			{
+				"name": "http",
+				"protocol": "Http",
+				"port": 80,
+				"request_path": "/healthz",
+				"interval_seconds": 15,
+				"number_of_probes": 2
+			},
+			{
+				"name": "api",
+				"protocol": "Tcp",
+				"port": 6443,
+				"interval_seconds": 5,
+				"number_of_probes": 1
+			}
+		],
+		"rules": [
+			{
+				"name": "http",
+				"protocol": "Tcp",
+				"frontend_name": "external",
+				"backend_pool_name": "api",
+				"probe_name": "https",
+				"frontend_port": 80,
+				"backend_port": 80,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			},
+			{
+				"name": "http",
+				"protocol": "Tcp",
+				"frontend_name": "public",
+				"backend_pool_name": "web",
+				"frontend_port": 80,
+				"backend_port": 80,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[0].FrontendName",
+					Field: "Rules[0].FrontendName",
+					Tag:   "infrontends",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[0].BackendPoolName",
+					Field: "Rules[0].BackendPoolName",
+					Tag:   "inbackendpools",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[0].ProbeName",
+					Field: "Rules[0].ProbeName",
+					Tag:   "inprobes",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[1].Name",
+					Field: "Rules[1].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+		{
+			name: "inconsistent rule ports",
+			json: []byte(`{
+	"kind": "azlb",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"sku": "Basic",
+		"frontends": [
+			{
+				"name": "public",
+				"type": "public"
+			},
+			{
+				"name": "secondary",
+				"type": "public"
+			}
+		],
+		"backend_pools": [
+			{
+				"name": "web",
+				"vm_group_names": ["vm-group0", "vm-group1"]
+			}
+		],
+		"probes": [
+			{
+				"name": "http",
+				"protocol": "Http",
+				"port": 80,
+				"request_path": "/healthz",
+				"interval_seconds": 15,
+				"number_of_probes": 2
+			},
+			{
+				"name": "api",
+				"protocol": "Tcp",
+				"port": 6443,
+				"interval_seconds": 5,
+				"number_of_probes": 1
+			}
+		],
+		"rules": [
+			{
+				"name": "http",
+				"protocol": "Tcp",
+				"frontend_name": "public",
+				"backend_pool_name": "web",
+				"probe_name": "http",
+				"frontend_port": 80,
+				"backend_port": 0,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			},
+			{
+				"name": "http-alt",
+				"protocol": "Tcp",
+				"frontend_name": "public",
+				"backend_pool_name": "web",
+				"probe_name": "http",
+				"frontend_port": 80,
+				"backend_port": 8080,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			},
+			{
+				"name": "udp",
+				"protocol": "Udp",
+				"frontend_name": "public",
+				"backend_pool_name": "web",
+				"frontend_port": 80,
+				"backend_port": 80,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			},
+			{
+				"name": "ha",
+				"protocol": "All",
+				"frontend_name": "secondary",
+				"backend_pool_name": "web",
+				"probe_name": "api",
+				"frontend_port": 6443,
+				"backend_port": 0,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			},
+			{
+				"name": "big",
+				"protocol": "Tcp",
+				"frontend_name": "secondary",
+				"backend_pool_name": "web",
+				"probe_name": "api",
+				"frontend_port": 70000,
+				"backend_port": 80,
+				"idle_timeout_minutes": 4,
+				"enable_floating_ip": false
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[0].BackendPort",
+					Field: "Rules[0].BackendPort",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[1].FrontendPort",
+					Field: "Rules[1].FrontendPort",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[3].FrontendPort",
+					Field: "Rules[3].FrontendPort",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[3].Protocol",
+					Field: "Rules[3].Protocol",
+					Tag:   "standardsku",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Rules[4].FrontendPort",
+					Field: "FrontendPort",
+					Tag:   "max",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,491 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Frontend or nil if Frontend is nil.
+func (f *Frontend) DeepCopy() *Frontend {
+	if f == nil {
+		return nil
+	}
+	out := new(Frontend)
+	if f.Name != nil {
+		x := *f.Name
+		out.Name = &x
+	}
+	if f.Type != nil {
+		x := *f.Type
+		out.Type = &x
+	}
+	if f.SubnetName != nil {
+		x := *f.SubnetName
+		out.SubnetName = &x
+	}
+	if f.PrivateIp != nil {
+		x := *f.PrivateIp
+		out.PrivateIp = &x
+	}
+	return out
+}
+
+// Equal reports whether Frontend and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (f *Frontend) Equal(other *Frontend) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if (f.Name == nil) != (other.Name == nil) || f.Name != nil && *f.Name != *other.Name {
+		return false
+	}
+	if (f.Type == nil) != (other.Type == nil) || f.Type != nil && *f.Type != *other.Type {
+		return false
+	}
+	if (f.SubnetName == nil) != (other.SubnetName == nil) || f.SubnetName != nil && *f.SubnetName != *other.SubnetName {
+		return false
+	}
+	if (f.PrivateIp == nil) != (other.PrivateIp == nil) || f.PrivateIp != nil && *f.PrivateIp != *other.PrivateIp {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of BackendPool or nil if BackendPool is nil.
+func (b *BackendPool) DeepCopy() *BackendPool {
+	if b == nil {
+		return nil
+	}
+	out := new(BackendPool)
+	if b.Name != nil {
+		x := *b.Name
+		out.Name = &x
+	}
+	if b.VmGroupNames != nil {
+		out.VmGroupNames = make([]string, len(b.VmGroupNames))
+		copy(out.VmGroupNames, b.VmGroupNames)
+	}
+	return out
+}
+
+// Equal reports whether BackendPool and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (b *BackendPool) Equal(other *BackendPool) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if (b.Name == nil) != (other.Name == nil) || b.Name != nil && *b.Name != *other.Name {
+		return false
+	}
+	if (b.VmGroupNames == nil) != (other.VmGroupNames == nil) || len(b.VmGroupNames) != len(other.VmGroupNames) {
+		return false
+	}
+	for i := range b.VmGroupNames {
+		if b.VmGroupNames[i] != other.VmGroupNames[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Probe or nil if Probe is nil.
+func (p *Probe) DeepCopy() *Probe {
+	if p == nil {
+		return nil
+	}
+	out := new(Probe)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Protocol != nil {
+		x := *p.Protocol
+		out.Protocol = &x
+	}
+	if p.Port != nil {
+		x := *p.Port
+		out.Port = &x
+	}
+	if p.RequestPath != nil {
+		x := *p.RequestPath
+		out.RequestPath = &x
+	}
+	if p.IntervalSeconds != nil {
+		x := *p.IntervalSeconds
+		out.IntervalSeconds = &x
+	}
+	if p.NumberOfProbes != nil {
+		x := *p.NumberOfProbes
+		out.NumberOfProbes = &x
+	}
+	return out
+}
+
+// Equal reports whether Probe and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Probe) Equal(other *Probe) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Protocol == nil) != (other.Protocol == nil) || p.Protocol != nil && *p.Protocol != *other.Protocol {
+		return false
+	}
+	if (p.Port == nil) != (other.Port == nil) || p.Port != nil && *p.Port != *other.Port {
+		return false
+	}
+	if (p.RequestPath == nil) != (other.RequestPath == nil) || p.RequestPath != nil && *p.RequestPath != *other.RequestPath {
+		return false
+	}
+	if (p.IntervalSeconds == nil) != (other.IntervalSeconds == nil) || p.IntervalSeconds != nil && *p.IntervalSeconds != *other.IntervalSeconds {
+		return false
+	}
+	if (p.NumberOfProbes == nil) != (other.NumberOfProbes == nil) || p.NumberOfProbes != nil && *p.NumberOfProbes != *other.NumberOfProbes {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Rule or nil if Rule is nil.
+func (r *Rule) DeepCopy() *Rule {
+	if r == nil {
+		return nil
+	}
+	out := new(Rule)
+	if r.Name != nil {
+		x := *r.Name
+		out.Name = &x
+	}
+	if r.Protocol != nil {
+		x := *r.Protocol
+		out.Protocol = &x
+	}
+	if r.FrontendName != nil {
+		x := *r.FrontendName
+		out.FrontendName = &x
+	}
+	if r.BackendPoolName != nil {
+		x := *r.BackendPoolName
+		out.BackendPoolName = &x
+	}
+	if r.ProbeName != nil {
+		x := *r.ProbeName
+		out.ProbeName = &x
+	}
+	if r.FrontendPort != nil {
+		x := *r.FrontendPort
+		out.FrontendPort = &x
+	}
+	if r.BackendPort != nil {
+		x := *r.BackendPort
+		out.BackendPort = &x
+	}
+	if r.IdleTimeoutMinutes != nil {
+		x := *r.IdleTimeoutMinutes
+		out.IdleTimeoutMinutes = &x
+	}
+	if r.EnableFloatingIp != nil {
+		x := *r.EnableFloatingIp
+		out.EnableFloatingIp = &x
+	}
+	return out
+}
+
+// Equal reports whether Rule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (r *Rule) Equal(other *Rule) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.Protocol == nil) != (other.Protocol == nil) || r.Protocol != nil && *r.Protocol != *other.Protocol {
+		return false
+	}
+	if (r.FrontendName == nil) != (other.FrontendName == nil) || r.FrontendName != nil && *r.FrontendName != *other.FrontendName {
+		return false
+	}
+	if (r.BackendPoolName == nil) != (other.BackendPoolName == nil) || r.BackendPoolName != nil && *r.BackendPoolName != *other.BackendPoolName {
+		return false
+	}
+	if (r.ProbeName == nil) != (other.ProbeName == nil) || r.ProbeName != nil && *r.ProbeName != *other.ProbeName {
+		return false
+	}
+	if (r.FrontendPort == nil) != (other.FrontendPort == nil) || r.FrontendPort != nil && *r.FrontendPort != *other.FrontendPort {
+		return false
+	}
+	if (r.BackendPort == nil) != (other.BackendPort == nil) || r.BackendPort != nil && *r.BackendPort != *other.BackendPort {
+		return false
+	}
+	if (r.IdleTimeoutMinutes == nil) != (other.IdleTimeoutMinutes == nil) || r.IdleTimeoutMinutes != nil && *r.IdleTimeoutMinutes != *other.IdleTimeoutMinutes {
+		return false
+	}
+	if (r.EnableFloatingIp == nil) != (other.EnableFloatingIp == nil) || r.EnableFloatingIp != nil && *r.EnableFloatingIp != *other.EnableFloatingIp {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Location != nil {
+		x := *p.Location
+		out.Location = &x
+	}
+	if p.RgName != nil {
+		x := *p.RgName
+		out.RgName = &x
+	}
+	if p.Sku != nil {
+		x := *p.Sku
+		out.Sku = &x
+	}
+	if p.Frontends != nil {
+		out.Frontends = make([]Frontend, len(p.Frontends))
+		for i := range p.Frontends {
+			out.Frontends[i] = *p.Frontends[i].DeepCopy()
+		}
+	}
+	if p.BackendPools != nil {
+		out.BackendPools = make([]BackendPool, len(p.BackendPools))
+		for i := range p.BackendPools {
+			out.BackendPools[i] = *p.BackendPools[i].DeepCopy()
+		}
+	}
+	if p.Probes != nil {
+		out.Probes = make([]Probe, len(p.Probes))
+		for i := range p.Probes {
+			out.Probes[i] = *p.Probes[i].DeepCopy()
+		}
+	}
+	if p.Rules != nil {
+		out.Rules = make([]Rule, len(p.Rules))
+		for i := range p.Rules {
+			out.Rules[i] = *p.Rules[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Location == nil) != (other.Location == nil) || p.Location != nil && *p.Location != *other.Location {
+		return false
+	}
+	if (p.RgName == nil) != (other.RgName == nil) || p.RgName != nil && *p.RgName != *other.RgName {
+		return false
+	}
+	if (p.Sku == nil) != (other.Sku == nil) || p.Sku != nil && *p.Sku != *other.Sku {
+		return false
+	}
+	if (p.Frontends == nil) != (other.Frontends == nil) || len(p.Frontends) != len(other.Frontends) {
+		return false
+	}
+	for i := range p.Frontends {
+		if !p.Frontends[i].Equal(&other.Frontends[i]) {
+			return false
+		}
+	}
+	if (p.BackendPools == nil) != (other.BackendPools == nil) || len(p.BackendPools) != len(other.BackendPools) {
+		return false
+	}
+	for i := range p.BackendPools {
+		if !p.BackendPools[i].Equal(&other.BackendPools[i]) {
+			return false
+		}
+	}
+	if (p.Probes == nil) != (other.Probes == nil) || len(p.Probes) != len(other.Probes) {
+		return false
+	}
+	for i := range p.Probes {
+		if !p.Probes[i].Equal(&other.Probes[i]) {
+			return false
+		}
+	}
+	if (p.Rules == nil) != (other.Rules == nil) || len(p.Rules) != len(other.Rules) {
+		return false
+	}
+	for i := range p.Rules {
+		if !p.Rules[i].Equal(&other.Rules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputFrontend or nil if OutputFrontend is nil.
+func (o *OutputFrontend) DeepCopy() *OutputFrontend {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputFrontend)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Ip != nil {
+		x := *o.Ip
+		out.Ip = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputFrontend and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputFrontend) Equal(other *OutputFrontend) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Ip == nil) != (other.Ip == nil) || o.Ip != nil && *o.Ip != *other.Ip {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputBackendPool or nil if OutputBackendPool is nil.
+func (o *OutputBackendPool) DeepCopy() *OutputBackendPool {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputBackendPool)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.VmNames != nil {
+		out.VmNames = make([]string, len(o.VmNames))
+		copy(out.VmNames, o.VmNames)
+	}
+	return out
+}
+
+// Equal reports whether OutputBackendPool and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputBackendPool) Equal(other *OutputBackendPool) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.VmNames == nil) != (other.VmNames == nil) || len(o.VmNames) != len(other.VmNames) {
+		return false
+	}
+	for i := range o.VmNames {
+		if o.VmNames[i] != other.VmNames[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.LbName != nil {
+		x := *o.LbName
+		out.LbName = &x
+	}
+	if o.Frontends != nil {
+		out.Frontends = make([]OutputFrontend, len(o.Frontends))
+		for i := range o.Frontends {
+			out.Frontends[i] = *o.Frontends[i].DeepCopy()
+		}
+	}
+	if o.BackendPools != nil {
+		out.BackendPools = make([]OutputBackendPool, len(o.BackendPools))
+		for i := range o.BackendPools {
+			out.BackendPools[i] = *o.BackendPools[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.LbName == nil) != (other.LbName == nil) || o.LbName != nil && *o.LbName != *other.LbName {
+		return false
+	}
+	if (o.Frontends == nil) != (other.Frontends == nil) || len(o.Frontends) != len(other.Frontends) {
+		return false
+	}
+	for i := range o.Frontends {
+		if !o.Frontends[i].Equal(&other.Frontends[i]) {
+			return false
+		}
+	}
+	if (o.BackendPools == nil) != (other.BackendPools == nil) || len(o.BackendPools) != len(other.BackendPools) {
+		return false
+	}
+	for i := range o.BackendPools {
+		if !o.BackendPools[i].Equal(&other.BackendPools[i]) {
+			return false
+		}
+	}
+	return true
+}
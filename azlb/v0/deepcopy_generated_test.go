@@ -0,0 +1,370 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+import (
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFrontend_DeepCopy(t *testing.T) {
+	var nilStruct *Frontend
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Frontend{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestFrontend_Equal(t *testing.T) {
+	var nilStruct *Frontend
+	original := &Frontend{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Frontend{}).Equal(&Frontend{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestBackendPool_DeepCopy(t *testing.T) {
+	var nilStruct *BackendPool
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &BackendPool{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestBackendPool_Equal(t *testing.T) {
+	var nilStruct *BackendPool
+	original := &BackendPool{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&BackendPool{}).Equal(&BackendPool{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestProbe_DeepCopy(t *testing.T) {
+	var nilStruct *Probe
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Probe{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestProbe_Equal(t *testing.T) {
+	var nilStruct *Probe
+	original := &Probe{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Probe{}).Equal(&Probe{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestRule_DeepCopy(t *testing.T) {
+	var nilStruct *Rule
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Rule{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestRule_Equal(t *testing.T) {
+	var nilStruct *Rule
+	original := &Rule{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Rule{}).Equal(&Rule{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestParams_DeepCopy(t *testing.T) {
+	var nilStruct *Params
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Params{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestParams_Equal(t *testing.T) {
+	var nilStruct *Params
+	original := &Params{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Params{}).Equal(&Params{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestConfig_DeepCopy(t *testing.T) {
+	var nilStruct *Config
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Config{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestConfig_Equal(t *testing.T) {
+	var nilStruct *Config
+	original := &Config{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Config{}).Equal(&Config{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputFrontend_DeepCopy(t *testing.T) {
+	var nilStruct *OutputFrontend
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputFrontend{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputFrontend_Equal(t *testing.T) {
+	var nilStruct *OutputFrontend
+	original := &OutputFrontend{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputFrontend{}).Equal(&OutputFrontend{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputBackendPool_DeepCopy(t *testing.T) {
+	var nilStruct *OutputBackendPool
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputBackendPool{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputBackendPool_Equal(t *testing.T) {
+	var nilStruct *OutputBackendPool
+	original := &OutputBackendPool{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputBackendPool{}).Equal(&OutputBackendPool{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutput_DeepCopy(t *testing.T) {
+	var nilStruct *Output
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Output{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutput_Equal(t *testing.T) {
+	var nilStruct *Output
+	original := &Output{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Output{}).Equal(&Output{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
@@ -0,0 +1,708 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetName returns Name field of Sku or nil if Sku is nil.
+func (s *Sku) GetName() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Name
+}
+
+// GetNameV returns value of Name field of Sku or zero value if either Sku or field is nil.
+func (s *Sku) GetNameV() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOr returns value of Name field of Sku or def if either Sku or field is nil.
+func (s *Sku) GetNameOr(def string) string {
+	if s == nil || s.Name == nil {
+		return def
+	}
+	return *s.Name
+}
+
+// GetStorageMb returns StorageMb field of Sku or nil if Sku is nil.
+func (s *Sku) GetStorageMb() *int {
+	if s == nil {
+		return nil
+	}
+	return s.StorageMb
+}
+
+// GetStorageMbV returns value of StorageMb field of Sku or zero value if either Sku or field is nil.
+func (s *Sku) GetStorageMbV() int {
+	if s == nil || s.StorageMb == nil {
+		return 0
+	}
+	return *s.StorageMb
+}
+
+// GetStorageMbOr returns value of StorageMb field of Sku or def if either Sku or field is nil.
+func (s *Sku) GetStorageMbOr(def int) int {
+	if s == nil || s.StorageMb == nil {
+		return def
+	}
+	return *s.StorageMb
+}
+
+// GetRetentionDays returns RetentionDays field of Backup or nil if Backup is nil.
+func (b *Backup) GetRetentionDays() *int {
+	if b == nil {
+		return nil
+	}
+	return b.RetentionDays
+}
+
+// GetRetentionDaysV returns value of RetentionDays field of Backup or zero value if either Backup or field is nil.
+func (b *Backup) GetRetentionDaysV() int {
+	if b == nil || b.RetentionDays == nil {
+		return 0
+	}
+	return *b.RetentionDays
+}
+
+// GetRetentionDaysOr returns value of RetentionDays field of Backup or def if either Backup or field is nil.
+func (b *Backup) GetRetentionDaysOr(def int) int {
+	if b == nil || b.RetentionDays == nil {
+		return def
+	}
+	return *b.RetentionDays
+}
+
+// GetGeoRedundant returns GeoRedundant field of Backup or nil if Backup is nil.
+func (b *Backup) GetGeoRedundant() *bool {
+	if b == nil {
+		return nil
+	}
+	return b.GeoRedundant
+}
+
+// GetGeoRedundantV returns value of GeoRedundant field of Backup or zero value if either Backup or field is nil.
+func (b *Backup) GetGeoRedundantV() bool {
+	if b == nil || b.GeoRedundant == nil {
+		return false
+	}
+	return *b.GeoRedundant
+}
+
+// GetGeoRedundantOr returns value of GeoRedundant field of Backup or def if either Backup or field is nil.
+func (b *Backup) GetGeoRedundantOr(def bool) bool {
+	if b == nil || b.GeoRedundant == nil {
+		return def
+	}
+	return *b.GeoRedundant
+}
+
+// GetAutoGrowEnabled returns AutoGrowEnabled field of Backup or nil if Backup is nil.
+func (b *Backup) GetAutoGrowEnabled() *bool {
+	if b == nil {
+		return nil
+	}
+	return b.AutoGrowEnabled
+}
+
+// GetAutoGrowEnabledV returns value of AutoGrowEnabled field of Backup or zero value if either Backup or field is nil.
+func (b *Backup) GetAutoGrowEnabledV() bool {
+	if b == nil || b.AutoGrowEnabled == nil {
+		return false
+	}
+	return *b.AutoGrowEnabled
+}
+
+// GetAutoGrowEnabledOr returns value of AutoGrowEnabled field of Backup or def if either Backup or field is nil.
+func (b *Backup) GetAutoGrowEnabledOr(def bool) bool {
+	if b == nil || b.AutoGrowEnabled == nil {
+		return def
+	}
+	return *b.AutoGrowEnabled
+}
+
+// GetName returns Name field of VnetRule or nil if VnetRule is nil.
+func (v *VnetRule) GetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Name
+}
+
+// GetNameV returns value of Name field of VnetRule or zero value if either VnetRule or field is nil.
+func (v *VnetRule) GetNameV() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
+// GetNameOr returns value of Name field of VnetRule or def if either VnetRule or field is nil.
+func (v *VnetRule) GetNameOr(def string) string {
+	if v == nil || v.Name == nil {
+		return def
+	}
+	return *v.Name
+}
+
+// GetVnetName returns VnetName field of VnetRule or nil if VnetRule is nil.
+func (v *VnetRule) GetVnetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.VnetName
+}
+
+// GetVnetNameV returns value of VnetName field of VnetRule or zero value if either VnetRule or field is nil.
+func (v *VnetRule) GetVnetNameV() string {
+	if v == nil || v.VnetName == nil {
+		return ""
+	}
+	return *v.VnetName
+}
+
+// GetVnetNameOr returns value of VnetName field of VnetRule or def if either VnetRule or field is nil.
+func (v *VnetRule) GetVnetNameOr(def string) string {
+	if v == nil || v.VnetName == nil {
+		return def
+	}
+	return *v.VnetName
+}
+
+// GetSubnetName returns SubnetName field of VnetRule or nil if VnetRule is nil.
+func (v *VnetRule) GetSubnetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.SubnetName
+}
+
+// GetSubnetNameV returns value of SubnetName field of VnetRule or zero value if either VnetRule or field is nil.
+func (v *VnetRule) GetSubnetNameV() string {
+	if v == nil || v.SubnetName == nil {
+		return ""
+	}
+	return *v.SubnetName
+}
+
+// GetSubnetNameOr returns value of SubnetName field of VnetRule or def if either VnetRule or field is nil.
+func (v *VnetRule) GetSubnetNameOr(def string) string {
+	if v == nil || v.SubnetName == nil {
+		return def
+	}
+	return *v.SubnetName
+}
+
+// GetName returns Name field of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) GetName() *string {
+	if f == nil {
+		return nil
+	}
+	return f.Name
+}
+
+// GetNameV returns value of Name field of FirewallRule or zero value if either FirewallRule or field is nil.
+func (f *FirewallRule) GetNameV() string {
+	if f == nil || f.Name == nil {
+		return ""
+	}
+	return *f.Name
+}
+
+// GetNameOr returns value of Name field of FirewallRule or def if either FirewallRule or field is nil.
+func (f *FirewallRule) GetNameOr(def string) string {
+	if f == nil || f.Name == nil {
+		return def
+	}
+	return *f.Name
+}
+
+// GetStartIp returns StartIp field of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) GetStartIp() *string {
+	if f == nil {
+		return nil
+	}
+	return f.StartIp
+}
+
+// GetStartIpV returns value of StartIp field of FirewallRule or zero value if either FirewallRule or field is nil.
+func (f *FirewallRule) GetStartIpV() string {
+	if f == nil || f.StartIp == nil {
+		return ""
+	}
+	return *f.StartIp
+}
+
+// GetStartIpOr returns value of StartIp field of FirewallRule or def if either FirewallRule or field is nil.
+func (f *FirewallRule) GetStartIpOr(def string) string {
+	if f == nil || f.StartIp == nil {
+		return def
+	}
+	return *f.StartIp
+}
+
+// GetEndIp returns EndIp field of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) GetEndIp() *string {
+	if f == nil {
+		return nil
+	}
+	return f.EndIp
+}
+
+// GetEndIpV returns value of EndIp field of FirewallRule or zero value if either FirewallRule or field is nil.
+func (f *FirewallRule) GetEndIpV() string {
+	if f == nil || f.EndIp == nil {
+		return ""
+	}
+	return *f.EndIp
+}
+
+// GetEndIpOr returns value of EndIp field of FirewallRule or def if either FirewallRule or field is nil.
+func (f *FirewallRule) GetEndIpOr(def string) string {
+	if f == nil || f.EndIp == nil {
+		return def
+	}
+	return *f.EndIp
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetLocation returns Location field of Params or nil if Params is nil.
+func (p *Params) GetLocation() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Location
+}
+
+// GetLocationV returns value of Location field of Params or zero value if either Params or field is nil.
+func (p *Params) GetLocationV() string {
+	if p == nil || p.Location == nil {
+		return ""
+	}
+	return *p.Location
+}
+
+// GetLocationOr returns value of Location field of Params or def if either Params or field is nil.
+func (p *Params) GetLocationOr(def string) string {
+	if p == nil || p.Location == nil {
+		return def
+	}
+	return *p.Location
+}
+
+// GetRgName returns RgName field of Params or nil if Params is nil.
+func (p *Params) GetRgName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RgName
+}
+
+// GetRgNameV returns value of RgName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRgNameV() string {
+	if p == nil || p.RgName == nil {
+		return ""
+	}
+	return *p.RgName
+}
+
+// GetRgNameOr returns value of RgName field of Params or def if either Params or field is nil.
+func (p *Params) GetRgNameOr(def string) string {
+	if p == nil || p.RgName == nil {
+		return def
+	}
+	return *p.RgName
+}
+
+// GetServerName returns ServerName field of Params or nil if Params is nil.
+func (p *Params) GetServerName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.ServerName
+}
+
+// GetServerNameV returns value of ServerName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetServerNameV() string {
+	if p == nil || p.ServerName == nil {
+		return ""
+	}
+	return *p.ServerName
+}
+
+// GetServerNameOr returns value of ServerName field of Params or def if either Params or field is nil.
+func (p *Params) GetServerNameOr(def string) string {
+	if p == nil || p.ServerName == nil {
+		return def
+	}
+	return *p.ServerName
+}
+
+// GetPostgresVersion returns PostgresVersion field of Params or nil if Params is nil.
+func (p *Params) GetPostgresVersion() *string {
+	if p == nil {
+		return nil
+	}
+	return p.PostgresVersion
+}
+
+// GetPostgresVersionV returns value of PostgresVersion field of Params or zero value if either Params or field is nil.
+func (p *Params) GetPostgresVersionV() string {
+	if p == nil || p.PostgresVersion == nil {
+		return ""
+	}
+	return *p.PostgresVersion
+}
+
+// GetPostgresVersionOr returns value of PostgresVersion field of Params or def if either Params or field is nil.
+func (p *Params) GetPostgresVersionOr(def string) string {
+	if p == nil || p.PostgresVersion == nil {
+		return def
+	}
+	return *p.PostgresVersion
+}
+
+// GetSku returns Sku field of Params or nil if Params is nil.
+func (p *Params) GetSku() *Sku {
+	if p == nil {
+		return nil
+	}
+	return p.Sku
+}
+
+// GetSkuV returns value of Sku field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSkuV() Sku {
+	if p == nil || p.Sku == nil {
+		return Sku{}
+	}
+	return *p.Sku
+}
+
+// GetSkuOr returns value of Sku field of Params or def if either Params or field is nil.
+func (p *Params) GetSkuOr(def Sku) Sku {
+	if p == nil || p.Sku == nil {
+		return def
+	}
+	return *p.Sku
+}
+
+// GetBackup returns Backup field of Params or nil if Params is nil.
+func (p *Params) GetBackup() *Backup {
+	if p == nil {
+		return nil
+	}
+	return p.Backup
+}
+
+// GetBackupV returns value of Backup field of Params or zero value if either Params or field is nil.
+func (p *Params) GetBackupV() Backup {
+	if p == nil || p.Backup == nil {
+		return Backup{}
+	}
+	return *p.Backup
+}
+
+// GetBackupOr returns value of Backup field of Params or def if either Params or field is nil.
+func (p *Params) GetBackupOr(def Backup) Backup {
+	if p == nil || p.Backup == nil {
+		return def
+	}
+	return *p.Backup
+}
+
+// GetSslEnforcement returns SslEnforcement field of Params or nil if Params is nil.
+func (p *Params) GetSslEnforcement() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.SslEnforcement
+}
+
+// GetSslEnforcementV returns value of SslEnforcement field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSslEnforcementV() bool {
+	if p == nil || p.SslEnforcement == nil {
+		return false
+	}
+	return *p.SslEnforcement
+}
+
+// GetSslEnforcementOr returns value of SslEnforcement field of Params or def if either Params or field is nil.
+func (p *Params) GetSslEnforcementOr(def bool) bool {
+	if p == nil || p.SslEnforcement == nil {
+		return def
+	}
+	return *p.SslEnforcement
+}
+
+// GetAdminLogin returns AdminLogin field of Params or nil if Params is nil.
+func (p *Params) GetAdminLogin() *string {
+	if p == nil {
+		return nil
+	}
+	return p.AdminLogin
+}
+
+// GetAdminLoginV returns value of AdminLogin field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAdminLoginV() string {
+	if p == nil || p.AdminLogin == nil {
+		return ""
+	}
+	return *p.AdminLogin
+}
+
+// GetAdminLoginOr returns value of AdminLogin field of Params or def if either Params or field is nil.
+func (p *Params) GetAdminLoginOr(def string) string {
+	if p == nil || p.AdminLogin == nil {
+		return def
+	}
+	return *p.AdminLogin
+}
+
+// GetAdminPassword returns AdminPassword field of Params or nil if Params is nil.
+func (p *Params) GetAdminPassword() *string {
+	if p == nil {
+		return nil
+	}
+	return p.AdminPassword
+}
+
+// GetAdminPasswordV returns value of AdminPassword field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAdminPasswordV() string {
+	if p == nil || p.AdminPassword == nil {
+		return ""
+	}
+	return *p.AdminPassword
+}
+
+// GetAdminPasswordOr returns value of AdminPassword field of Params or def if either Params or field is nil.
+func (p *Params) GetAdminPasswordOr(def string) string {
+	if p == nil || p.AdminPassword == nil {
+		return def
+	}
+	return *p.AdminPassword
+}
+
+// GetVnetRules returns VnetRules field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetVnetRules() []VnetRule {
+	if p == nil {
+		return nil
+	}
+	if len(p.VnetRules) == 0 {
+		return []VnetRule{}
+	}
+	return p.VnetRules
+}
+
+// GetFirewallRules returns FirewallRules field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetFirewallRules() []FirewallRule {
+	if p == nil {
+		return nil
+	}
+	if len(p.FirewallRules) == 0 {
+		return []FirewallRule{}
+	}
+	return p.FirewallRules
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetServerName returns ServerName field of Output or nil if Output is nil.
+func (o *Output) GetServerName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.ServerName
+}
+
+// GetServerNameV returns value of ServerName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetServerNameV() string {
+	if o == nil || o.ServerName == nil {
+		return ""
+	}
+	return *o.ServerName
+}
+
+// GetServerNameOr returns value of ServerName field of Output or def if either Output or field is nil.
+func (o *Output) GetServerNameOr(def string) string {
+	if o == nil || o.ServerName == nil {
+		return def
+	}
+	return *o.ServerName
+}
+
+// GetFqdn returns Fqdn field of Output or nil if Output is nil.
+func (o *Output) GetFqdn() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Fqdn
+}
+
+// GetFqdnV returns value of Fqdn field of Output or zero value if either Output or field is nil.
+func (o *Output) GetFqdnV() string {
+	if o == nil || o.Fqdn == nil {
+		return ""
+	}
+	return *o.Fqdn
+}
+
+// GetFqdnOr returns value of Fqdn field of Output or def if either Output or field is nil.
+func (o *Output) GetFqdnOr(def string) string {
+	if o == nil || o.Fqdn == nil {
+		return def
+	}
+	return *o.Fqdn
+}
+
+// GetAdminLogin returns AdminLogin field of Output or nil if Output is nil.
+func (o *Output) GetAdminLogin() *string {
+	if o == nil {
+		return nil
+	}
+	return o.AdminLogin
+}
+
+// GetAdminLoginV returns value of AdminLogin field of Output or zero value if either Output or field is nil.
+func (o *Output) GetAdminLoginV() string {
+	if o == nil || o.AdminLogin == nil {
+		return ""
+	}
+	return *o.AdminLogin
+}
+
+// GetAdminLoginOr returns value of AdminLogin field of Output or def if either Output or field is nil.
+func (o *Output) GetAdminLoginOr(def string) string {
+	if o == nil || o.AdminLogin == nil {
+		return def
+	}
+	return *o.AdminLogin
+}
+
+// GetAdminPassword returns AdminPassword field of Output or nil if Output is nil.
+func (o *Output) GetAdminPassword() *string {
+	if o == nil {
+		return nil
+	}
+	return o.AdminPassword
+}
+
+// GetAdminPasswordV returns value of AdminPassword field of Output or zero value if either Output or field is nil.
+func (o *Output) GetAdminPasswordV() string {
+	if o == nil || o.AdminPassword == nil {
+		return ""
+	}
+	return *o.AdminPassword
+}
+
+// GetAdminPasswordOr returns value of AdminPassword field of Output or def if either Output or field is nil.
+func (o *Output) GetAdminPasswordOr(def string) string {
+	if o == nil || o.AdminPassword == nil {
+		return def
+	}
+	return *o.AdminPassword
+}
@@ -0,0 +1,686 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSku_Accessors(t *testing.T) {
+	var nilStruct *Sku
+	emptyStruct := &Sku{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Sku{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("StorageMb", func(t *testing.T) {
+		v := 1
+		fullStruct := &Sku{StorageMb: &v}
+		if nilStruct.GetStorageMb() != nil || emptyStruct.GetStorageMb() != nil {
+			t.Error("GetStorageMb() expected to return nil")
+		}
+		if fullStruct.GetStorageMb() != &v {
+			t.Error("GetStorageMb() expected to return field")
+		}
+		if nilStruct.GetStorageMbV() != 0 || emptyStruct.GetStorageMbV() != 0 {
+			t.Error("GetStorageMbV() expected to return zero value")
+		}
+		if fullStruct.GetStorageMbV() != v {
+			t.Error("GetStorageMbV() expected to return field value")
+		}
+		if nilStruct.GetStorageMbOr(v) != v || emptyStruct.GetStorageMbOr(v) != v {
+			t.Error("GetStorageMbOr() expected to return default value")
+		}
+		if fullStruct.GetStorageMbOr(0) != v {
+			t.Error("GetStorageMbOr() expected to return field value")
+		}
+	})
+}
+
+func TestBackup_Accessors(t *testing.T) {
+	var nilStruct *Backup
+	emptyStruct := &Backup{}
+	t.Run("RetentionDays", func(t *testing.T) {
+		v := 1
+		fullStruct := &Backup{RetentionDays: &v}
+		if nilStruct.GetRetentionDays() != nil || emptyStruct.GetRetentionDays() != nil {
+			t.Error("GetRetentionDays() expected to return nil")
+		}
+		if fullStruct.GetRetentionDays() != &v {
+			t.Error("GetRetentionDays() expected to return field")
+		}
+		if nilStruct.GetRetentionDaysV() != 0 || emptyStruct.GetRetentionDaysV() != 0 {
+			t.Error("GetRetentionDaysV() expected to return zero value")
+		}
+		if fullStruct.GetRetentionDaysV() != v {
+			t.Error("GetRetentionDaysV() expected to return field value")
+		}
+		if nilStruct.GetRetentionDaysOr(v) != v || emptyStruct.GetRetentionDaysOr(v) != v {
+			t.Error("GetRetentionDaysOr() expected to return default value")
+		}
+		if fullStruct.GetRetentionDaysOr(0) != v {
+			t.Error("GetRetentionDaysOr() expected to return field value")
+		}
+	})
+	t.Run("GeoRedundant", func(t *testing.T) {
+		v := true
+		fullStruct := &Backup{GeoRedundant: &v}
+		if nilStruct.GetGeoRedundant() != nil || emptyStruct.GetGeoRedundant() != nil {
+			t.Error("GetGeoRedundant() expected to return nil")
+		}
+		if fullStruct.GetGeoRedundant() != &v {
+			t.Error("GetGeoRedundant() expected to return field")
+		}
+		if nilStruct.GetGeoRedundantV() != false || emptyStruct.GetGeoRedundantV() != false {
+			t.Error("GetGeoRedundantV() expected to return zero value")
+		}
+		if fullStruct.GetGeoRedundantV() != v {
+			t.Error("GetGeoRedundantV() expected to return field value")
+		}
+		if nilStruct.GetGeoRedundantOr(v) != v || emptyStruct.GetGeoRedundantOr(v) != v {
+			t.Error("GetGeoRedundantOr() expected to return default value")
+		}
+		if fullStruct.GetGeoRedundantOr(false) != v {
+			t.Error("GetGeoRedundantOr() expected to return field value")
+		}
+	})
+	t.Run("AutoGrowEnabled", func(t *testing.T) {
+		v := true
+		fullStruct := &Backup{AutoGrowEnabled: &v}
+		if nilStruct.GetAutoGrowEnabled() != nil || emptyStruct.GetAutoGrowEnabled() != nil {
+			t.Error("GetAutoGrowEnabled() expected to return nil")
+		}
+		if fullStruct.GetAutoGrowEnabled() != &v {
+			t.Error("GetAutoGrowEnabled() expected to return field")
+		}
+		if nilStruct.GetAutoGrowEnabledV() != false || emptyStruct.GetAutoGrowEnabledV() != false {
+			t.Error("GetAutoGrowEnabledV() expected to return zero value")
+		}
+		if fullStruct.GetAutoGrowEnabledV() != v {
+			t.Error("GetAutoGrowEnabledV() expected to return field value")
+		}
+		if nilStruct.GetAutoGrowEnabledOr(v) != v || emptyStruct.GetAutoGrowEnabledOr(v) != v {
+			t.Error("GetAutoGrowEnabledOr() expected to return default value")
+		}
+		if fullStruct.GetAutoGrowEnabledOr(false) != v {
+			t.Error("GetAutoGrowEnabledOr() expected to return field value")
+		}
+	})
+}
+
+func TestVnetRule_Accessors(t *testing.T) {
+	var nilStruct *VnetRule
+	emptyStruct := &VnetRule{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VnetRule{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VnetRule{VnetName: &v}
+		if nilStruct.GetVnetName() != nil || emptyStruct.GetVnetName() != nil {
+			t.Error("GetVnetName() expected to return nil")
+		}
+		if fullStruct.GetVnetName() != &v {
+			t.Error("GetVnetName() expected to return field")
+		}
+		if nilStruct.GetVnetNameV() != "" || emptyStruct.GetVnetNameV() != "" {
+			t.Error("GetVnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetVnetNameV() != v {
+			t.Error("GetVnetNameV() expected to return field value")
+		}
+		if nilStruct.GetVnetNameOr(v) != v || emptyStruct.GetVnetNameOr(v) != v {
+			t.Error("GetVnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetVnetNameOr("") != v {
+			t.Error("GetVnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VnetRule{SubnetName: &v}
+		if nilStruct.GetSubnetName() != nil || emptyStruct.GetSubnetName() != nil {
+			t.Error("GetSubnetName() expected to return nil")
+		}
+		if fullStruct.GetSubnetName() != &v {
+			t.Error("GetSubnetName() expected to return field")
+		}
+		if nilStruct.GetSubnetNameV() != "" || emptyStruct.GetSubnetNameV() != "" {
+			t.Error("GetSubnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetSubnetNameV() != v {
+			t.Error("GetSubnetNameV() expected to return field value")
+		}
+		if nilStruct.GetSubnetNameOr(v) != v || emptyStruct.GetSubnetNameOr(v) != v {
+			t.Error("GetSubnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetSubnetNameOr("") != v {
+			t.Error("GetSubnetNameOr() expected to return field value")
+		}
+	})
+}
+
+func TestFirewallRule_Accessors(t *testing.T) {
+	var nilStruct *FirewallRule
+	emptyStruct := &FirewallRule{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &FirewallRule{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("StartIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &FirewallRule{StartIp: &v}
+		if nilStruct.GetStartIp() != nil || emptyStruct.GetStartIp() != nil {
+			t.Error("GetStartIp() expected to return nil")
+		}
+		if fullStruct.GetStartIp() != &v {
+			t.Error("GetStartIp() expected to return field")
+		}
+		if nilStruct.GetStartIpV() != "" || emptyStruct.GetStartIpV() != "" {
+			t.Error("GetStartIpV() expected to return zero value")
+		}
+		if fullStruct.GetStartIpV() != v {
+			t.Error("GetStartIpV() expected to return field value")
+		}
+		if nilStruct.GetStartIpOr(v) != v || emptyStruct.GetStartIpOr(v) != v {
+			t.Error("GetStartIpOr() expected to return default value")
+		}
+		if fullStruct.GetStartIpOr("") != v {
+			t.Error("GetStartIpOr() expected to return field value")
+		}
+	})
+	t.Run("EndIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &FirewallRule{EndIp: &v}
+		if nilStruct.GetEndIp() != nil || emptyStruct.GetEndIp() != nil {
+			t.Error("GetEndIp() expected to return nil")
+		}
+		if fullStruct.GetEndIp() != &v {
+			t.Error("GetEndIp() expected to return field")
+		}
+		if nilStruct.GetEndIpV() != "" || emptyStruct.GetEndIpV() != "" {
+			t.Error("GetEndIpV() expected to return zero value")
+		}
+		if fullStruct.GetEndIpV() != v {
+			t.Error("GetEndIpV() expected to return field value")
+		}
+		if nilStruct.GetEndIpOr(v) != v || emptyStruct.GetEndIpOr(v) != v {
+			t.Error("GetEndIpOr() expected to return default value")
+		}
+		if fullStruct.GetEndIpOr("") != v {
+			t.Error("GetEndIpOr() expected to return field value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Location", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Location: &v}
+		if nilStruct.GetLocation() != nil || emptyStruct.GetLocation() != nil {
+			t.Error("GetLocation() expected to return nil")
+		}
+		if fullStruct.GetLocation() != &v {
+			t.Error("GetLocation() expected to return field")
+		}
+		if nilStruct.GetLocationV() != "" || emptyStruct.GetLocationV() != "" {
+			t.Error("GetLocationV() expected to return zero value")
+		}
+		if fullStruct.GetLocationV() != v {
+			t.Error("GetLocationV() expected to return field value")
+		}
+		if nilStruct.GetLocationOr(v) != v || emptyStruct.GetLocationOr(v) != v {
+			t.Error("GetLocationOr() expected to return default value")
+		}
+		if fullStruct.GetLocationOr("") != v {
+			t.Error("GetLocationOr() expected to return field value")
+		}
+	})
+	t.Run("RgName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RgName: &v}
+		if nilStruct.GetRgName() != nil || emptyStruct.GetRgName() != nil {
+			t.Error("GetRgName() expected to return nil")
+		}
+		if fullStruct.GetRgName() != &v {
+			t.Error("GetRgName() expected to return field")
+		}
+		if nilStruct.GetRgNameV() != "" || emptyStruct.GetRgNameV() != "" {
+			t.Error("GetRgNameV() expected to return zero value")
+		}
+		if fullStruct.GetRgNameV() != v {
+			t.Error("GetRgNameV() expected to return field value")
+		}
+		if nilStruct.GetRgNameOr(v) != v || emptyStruct.GetRgNameOr(v) != v {
+			t.Error("GetRgNameOr() expected to return default value")
+		}
+		if fullStruct.GetRgNameOr("") != v {
+			t.Error("GetRgNameOr() expected to return field value")
+		}
+	})
+	t.Run("ServerName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{ServerName: &v}
+		if nilStruct.GetServerName() != nil || emptyStruct.GetServerName() != nil {
+			t.Error("GetServerName() expected to return nil")
+		}
+		if fullStruct.GetServerName() != &v {
+			t.Error("GetServerName() expected to return field")
+		}
+		if nilStruct.GetServerNameV() != "" || emptyStruct.GetServerNameV() != "" {
+			t.Error("GetServerNameV() expected to return zero value")
+		}
+		if fullStruct.GetServerNameV() != v {
+			t.Error("GetServerNameV() expected to return field value")
+		}
+		if nilStruct.GetServerNameOr(v) != v || emptyStruct.GetServerNameOr(v) != v {
+			t.Error("GetServerNameOr() expected to return default value")
+		}
+		if fullStruct.GetServerNameOr("") != v {
+			t.Error("GetServerNameOr() expected to return field value")
+		}
+	})
+	t.Run("PostgresVersion", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{PostgresVersion: &v}
+		if nilStruct.GetPostgresVersion() != nil || emptyStruct.GetPostgresVersion() != nil {
+			t.Error("GetPostgresVersion() expected to return nil")
+		}
+		if fullStruct.GetPostgresVersion() != &v {
+			t.Error("GetPostgresVersion() expected to return field")
+		}
+		if nilStruct.GetPostgresVersionV() != "" || emptyStruct.GetPostgresVersionV() != "" {
+			t.Error("GetPostgresVersionV() expected to return zero value")
+		}
+		if fullStruct.GetPostgresVersionV() != v {
+			t.Error("GetPostgresVersionV() expected to return field value")
+		}
+		if nilStruct.GetPostgresVersionOr(v) != v || emptyStruct.GetPostgresVersionOr(v) != v {
+			t.Error("GetPostgresVersionOr() expected to return default value")
+		}
+		if fullStruct.GetPostgresVersionOr("") != v {
+			t.Error("GetPostgresVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Sku", func(t *testing.T) {
+		v := Sku{}
+		fullStruct := &Params{Sku: &v}
+		if nilStruct.GetSku() != nil || emptyStruct.GetSku() != nil {
+			t.Error("GetSku() expected to return nil")
+		}
+		if fullStruct.GetSku() != &v {
+			t.Error("GetSku() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetSkuV(), Sku{}) || !reflect.DeepEqual(emptyStruct.GetSkuV(), Sku{}) {
+			t.Error("GetSkuV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetSkuV(), v) {
+			t.Error("GetSkuV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetSkuOr(v), v) || !reflect.DeepEqual(emptyStruct.GetSkuOr(v), v) {
+			t.Error("GetSkuOr() expected to return default value")
+		}
+	})
+	t.Run("Backup", func(t *testing.T) {
+		v := Backup{}
+		fullStruct := &Params{Backup: &v}
+		if nilStruct.GetBackup() != nil || emptyStruct.GetBackup() != nil {
+			t.Error("GetBackup() expected to return nil")
+		}
+		if fullStruct.GetBackup() != &v {
+			t.Error("GetBackup() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetBackupV(), Backup{}) || !reflect.DeepEqual(emptyStruct.GetBackupV(), Backup{}) {
+			t.Error("GetBackupV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetBackupV(), v) {
+			t.Error("GetBackupV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetBackupOr(v), v) || !reflect.DeepEqual(emptyStruct.GetBackupOr(v), v) {
+			t.Error("GetBackupOr() expected to return default value")
+		}
+	})
+	t.Run("SslEnforcement", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{SslEnforcement: &v}
+		if nilStruct.GetSslEnforcement() != nil || emptyStruct.GetSslEnforcement() != nil {
+			t.Error("GetSslEnforcement() expected to return nil")
+		}
+		if fullStruct.GetSslEnforcement() != &v {
+			t.Error("GetSslEnforcement() expected to return field")
+		}
+		if nilStruct.GetSslEnforcementV() != false || emptyStruct.GetSslEnforcementV() != false {
+			t.Error("GetSslEnforcementV() expected to return zero value")
+		}
+		if fullStruct.GetSslEnforcementV() != v {
+			t.Error("GetSslEnforcementV() expected to return field value")
+		}
+		if nilStruct.GetSslEnforcementOr(v) != v || emptyStruct.GetSslEnforcementOr(v) != v {
+			t.Error("GetSslEnforcementOr() expected to return default value")
+		}
+		if fullStruct.GetSslEnforcementOr(false) != v {
+			t.Error("GetSslEnforcementOr() expected to return field value")
+		}
+	})
+	t.Run("AdminLogin", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{AdminLogin: &v}
+		if nilStruct.GetAdminLogin() != nil || emptyStruct.GetAdminLogin() != nil {
+			t.Error("GetAdminLogin() expected to return nil")
+		}
+		if fullStruct.GetAdminLogin() != &v {
+			t.Error("GetAdminLogin() expected to return field")
+		}
+		if nilStruct.GetAdminLoginV() != "" || emptyStruct.GetAdminLoginV() != "" {
+			t.Error("GetAdminLoginV() expected to return zero value")
+		}
+		if fullStruct.GetAdminLoginV() != v {
+			t.Error("GetAdminLoginV() expected to return field value")
+		}
+		if nilStruct.GetAdminLoginOr(v) != v || emptyStruct.GetAdminLoginOr(v) != v {
+			t.Error("GetAdminLoginOr() expected to return default value")
+		}
+		if fullStruct.GetAdminLoginOr("") != v {
+			t.Error("GetAdminLoginOr() expected to return field value")
+		}
+	})
+	t.Run("AdminPassword", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{AdminPassword: &v}
+		if nilStruct.GetAdminPassword() != nil || emptyStruct.GetAdminPassword() != nil {
+			t.Error("GetAdminPassword() expected to return nil")
+		}
+		if fullStruct.GetAdminPassword() != &v {
+			t.Error("GetAdminPassword() expected to return field")
+		}
+		if nilStruct.GetAdminPasswordV() != "" || emptyStruct.GetAdminPasswordV() != "" {
+			t.Error("GetAdminPasswordV() expected to return zero value")
+		}
+		if fullStruct.GetAdminPasswordV() != v {
+			t.Error("GetAdminPasswordV() expected to return field value")
+		}
+		if nilStruct.GetAdminPasswordOr(v) != v || emptyStruct.GetAdminPasswordOr(v) != v {
+			t.Error("GetAdminPasswordOr() expected to return default value")
+		}
+		if fullStruct.GetAdminPasswordOr("") != v {
+			t.Error("GetAdminPasswordOr() expected to return field value")
+		}
+	})
+	t.Run("VnetRules", func(t *testing.T) {
+		fullStruct := &Params{VnetRules: make([]VnetRule, 1)}
+		if nilStruct.GetVnetRules() != nil {
+			t.Error("GetVnetRules() expected to return nil")
+		}
+		if got := emptyStruct.GetVnetRules(); got == nil || len(got) != 0 {
+			t.Error("GetVnetRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetVnetRules(); len(got) != 1 || &got[0] != &fullStruct.VnetRules[0] {
+			t.Error("GetVnetRules() expected to return field")
+		}
+	})
+	t.Run("FirewallRules", func(t *testing.T) {
+		fullStruct := &Params{FirewallRules: make([]FirewallRule, 1)}
+		if nilStruct.GetFirewallRules() != nil {
+			t.Error("GetFirewallRules() expected to return nil")
+		}
+		if got := emptyStruct.GetFirewallRules(); got == nil || len(got) != 0 {
+			t.Error("GetFirewallRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetFirewallRules(); len(got) != 1 || &got[0] != &fullStruct.FirewallRules[0] {
+			t.Error("GetFirewallRules() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("ServerName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{ServerName: &v}
+		if nilStruct.GetServerName() != nil || emptyStruct.GetServerName() != nil {
+			t.Error("GetServerName() expected to return nil")
+		}
+		if fullStruct.GetServerName() != &v {
+			t.Error("GetServerName() expected to return field")
+		}
+		if nilStruct.GetServerNameV() != "" || emptyStruct.GetServerNameV() != "" {
+			t.Error("GetServerNameV() expected to return zero value")
+		}
+		if fullStruct.GetServerNameV() != v {
+			t.Error("GetServerNameV() expected to return field value")
+		}
+		if nilStruct.GetServerNameOr(v) != v || emptyStruct.GetServerNameOr(v) != v {
+			t.Error("GetServerNameOr() expected to return default value")
+		}
+		if fullStruct.GetServerNameOr("") != v {
+			t.Error("GetServerNameOr() expected to return field value")
+		}
+	})
+	t.Run("Fqdn", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{Fqdn: &v}
+		if nilStruct.GetFqdn() != nil || emptyStruct.GetFqdn() != nil {
+			t.Error("GetFqdn() expected to return nil")
+		}
+		if fullStruct.GetFqdn() != &v {
+			t.Error("GetFqdn() expected to return field")
+		}
+		if nilStruct.GetFqdnV() != "" || emptyStruct.GetFqdnV() != "" {
+			t.Error("GetFqdnV() expected to return zero value")
+		}
+		if fullStruct.GetFqdnV() != v {
+			t.Error("GetFqdnV() expected to return field value")
+		}
+		if nilStruct.GetFqdnOr(v) != v || emptyStruct.GetFqdnOr(v) != v {
+			t.Error("GetFqdnOr() expected to return default value")
+		}
+		if fullStruct.GetFqdnOr("") != v {
+			t.Error("GetFqdnOr() expected to return field value")
+		}
+	})
+	t.Run("AdminLogin", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{AdminLogin: &v}
+		if nilStruct.GetAdminLogin() != nil || emptyStruct.GetAdminLogin() != nil {
+			t.Error("GetAdminLogin() expected to return nil")
+		}
+		if fullStruct.GetAdminLogin() != &v {
+			t.Error("GetAdminLogin() expected to return field")
+		}
+		if nilStruct.GetAdminLoginV() != "" || emptyStruct.GetAdminLoginV() != "" {
+			t.Error("GetAdminLoginV() expected to return zero value")
+		}
+		if fullStruct.GetAdminLoginV() != v {
+			t.Error("GetAdminLoginV() expected to return field value")
+		}
+		if nilStruct.GetAdminLoginOr(v) != v || emptyStruct.GetAdminLoginOr(v) != v {
+			t.Error("GetAdminLoginOr() expected to return default value")
+		}
+		if fullStruct.GetAdminLoginOr("") != v {
+			t.Error("GetAdminLoginOr() expected to return field value")
+		}
+	})
+	t.Run("AdminPassword", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{AdminPassword: &v}
+		if nilStruct.GetAdminPassword() != nil || emptyStruct.GetAdminPassword() != nil {
+			t.Error("GetAdminPassword() expected to return nil")
+		}
+		if fullStruct.GetAdminPassword() != &v {
+			t.Error("GetAdminPassword() expected to return field")
+		}
+		if nilStruct.GetAdminPasswordV() != "" || emptyStruct.GetAdminPasswordV() != "" {
+			t.Error("GetAdminPasswordV() expected to return zero value")
+		}
+		if fullStruct.GetAdminPasswordV() != v {
+			t.Error("GetAdminPasswordV() expected to return field value")
+		}
+		if nilStruct.GetAdminPasswordOr(v) != v || emptyStruct.GetAdminPasswordOr(v) != v {
+			t.Error("GetAdminPasswordOr() expected to return default value")
+		}
+		if fullStruct.GetAdminPasswordOr("") != v {
+			t.Error("GetAdminPasswordOr() expected to return field value")
+		}
+	})
+}
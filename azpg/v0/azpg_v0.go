@@ -0,0 +1,313 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/encryption"
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "azpg"
+	version = "v0.0.1"
+
+	// https://docs.microsoft.com/en-us/azure/postgresql/quickstart-create-server-database-portal
+	minPasswordLength = 8
+	maxPasswordLength = 128
+)
+
+var (
+	// https://docs.microsoft.com/en-us/azure/azure-resource-manager/management/resource-name-rules#microsoftdbforpostgresql
+	serverNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+	// https://docs.microsoft.com/en-us/azure/postgresql/quickstart-create-server-database-portal
+	reservedAdminLogins = map[string]bool{
+		"azure_superuser": true,
+		"azure_pg_admin":  true,
+		"admin":           true,
+		"administrator":   true,
+		"root":            true,
+		"guest":           true,
+		"public":          true,
+		"postgres":        true,
+	}
+)
+
+type Sku struct {
+	Name      *string `json:"name" validate:"required,min=1"`                       // i.e. GP_Gen5_2, see https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/resources/postgresql_server#sku_name
+	StorageMb *int    `json:"storage_mb" validate:"required,min=5120,max=16777216"` // https://docs.microsoft.com/en-us/azure/postgresql/concepts-pricing-tiers#storage
+}
+
+type Backup struct {
+	RetentionDays   *int  `json:"retention_days" validate:"required,min=7,max=35"`
+	GeoRedundant    *bool `json:"geo_redundant" validate:"required"`
+	AutoGrowEnabled *bool `json:"auto_grow_enabled" validate:"required"`
+}
+
+// VnetRule allows access to server from subnet created by azbi module.
+type VnetRule struct {
+	Name       *string `json:"name" validate:"required,min=1"`
+	VnetName   *string `json:"vnet_name" validate:"required,min=1"`
+	SubnetName *string `json:"subnet_name" validate:"required,min=1"`
+}
+
+type FirewallRule struct {
+	Name    *string `json:"name" validate:"required,min=1"`
+	StartIp *string `json:"start_ip" validate:"required,ipv4"`
+	EndIp   *string `json:"end_ip" validate:"required,ipv4"`
+}
+
+type Params struct {
+	Name            *string        `json:"name" validate:"required,min=1"`
+	Location        *string        `json:"location" validate:"required,min=1"`
+	RgName          *string        `json:"rg_name" validate:"required,min=1"`
+	ServerName      *string        `json:"server_name" validate:"required,min=3,max=63"`
+	PostgresVersion *string        `json:"postgres_version" validate:"required,eq=9.5|eq=9.6|eq=10|eq=11"`
+	Sku             *Sku           `json:"sku" validate:"required"`
+	Backup          *Backup        `json:"backup" validate:"required"`
+	SslEnforcement  *bool          `json:"ssl_enforcement" validate:"required"`
+	AdminLogin      *string        `json:"admin_login" validate:"required,min=1"`               // reserved names checked in AzPGParamsValidation
+	AdminPassword   *string        `json:"admin_password" validate:"required" sensitive:"true"` // length checked in AzPGParamsValidation
+	VnetRules       []VnetRule     `json:"vnet_rules" validate:"omitempty,dive"`
+	FirewallRules   []FirewallRule `json:"firewall_rules" validate:"omitempty,dive"`
+}
+
+// UseAzBIOutput adds VnetRule for each of subnetNames, pointing to virtual network created by
+// azbi module.
+func (p *Params) UseAzBIOutput(o *azbi.Output, subnetNames ...string) {
+	if p == nil || o == nil {
+		return
+	}
+	for _, sn := range subnetNames {
+		p.VnetRules = append(p.VnetRules, VnetRule{
+			Name:       to.StrPtr(fmt.Sprintf("%s-%s", o.GetVnetNameV(), sn)),
+			VnetName:   to.StrPtr(o.GetVnetNameV()),
+			SubnetName: to.StrPtr(sn),
+		})
+	}
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=azpg"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:            to.StrPtr("epiphany"),
+			Location:        to.StrPtr("northeurope"),
+			RgName:          to.StrPtr("epiphany-rg"),
+			ServerName:      to.StrPtr("epiphany-pg"),
+			PostgresVersion: to.StrPtr("11"),
+			Sku: &Sku{
+				Name:      to.StrPtr("GP_Gen5_2"),
+				StorageMb: to.IntPtr(5120),
+			},
+			Backup: &Backup{
+				RetentionDays:   to.IntPtr(7),
+				GeoRedundant:    to.BooPtr(false),
+				AutoGrowEnabled: to.BooPtr(true),
+			},
+			SslEnforcement: to.BooPtr(true),
+			AdminLogin:     to.StrPtr("operations"),
+			AdminPassword:  to.StrPtr("env://AZPG_ADMIN_PASSWORD"),
+			VnetRules: []VnetRule{
+				{
+					Name:       to.StrPtr("epiphany-vnet-main"),
+					VnetName:   to.StrPtr("epiphany-vnet"),
+					SubnetName: to.StrPtr("main"),
+				},
+			},
+			FirewallRules: []FirewallRule{},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("azpg config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(AzPGParamsValidation, Params{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// AzPGParamsValidation checks server name against Azure naming rules, that admin login is not
+// reserved by Azure, admin password length, that names of VNet and firewall rules are
+// unique and that firewall rules have start IP not greater than end IP. Length of secret references
+// and encrypted passwords is not checked, as it is not length of password itself.
+func AzPGParamsValidation(sl validator.StructLevel) {
+	params := sl.Current().Interface().(Params)
+	if params.ServerName != nil && !serverNameRegexp.MatchString(*params.ServerName) {
+		sl.ReportError(params.ServerName, "ServerName", "ServerName", "servername", "")
+	}
+	if l := params.AdminLogin; l != nil {
+		if login := strings.ToLower(*l); reservedAdminLogins[login] || strings.HasPrefix(login, "pg_") {
+			sl.ReportError(params.AdminLogin, "AdminLogin", "AdminLogin", "adminlogin", "")
+		}
+	}
+	if p := params.AdminPassword; p != nil && !sensitive.IsReference(*p) && !encryption.IsEncrypted(*p) {
+		if len(*p) < minPasswordLength {
+			sl.ReportError(params.AdminPassword, "AdminPassword", "AdminPassword", "min", "")
+		} else if len(*p) > maxPasswordLength {
+			sl.ReportError(params.AdminPassword, "AdminPassword", "AdminPassword", "max", "")
+		}
+	}
+	vnetRules := make(map[string]bool)
+	for i, r := range params.VnetRules {
+		if r.Name == nil {
+			continue
+		}
+		if vnetRules[*r.Name] {
+			sl.ReportError(
+				params.VnetRules[i].Name,
+				fmt.Sprintf("VnetRules[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		vnetRules[*r.Name] = true
+	}
+	firewallRules := make(map[string]bool)
+	for i, r := range params.FirewallRules {
+		if r.Name != nil {
+			if firewallRules[*r.Name] {
+				sl.ReportError(
+					params.FirewallRules[i].Name,
+					fmt.Sprintf("FirewallRules[%d].Name", i),
+					"Name",
+					"unique",
+					"")
+			}
+			firewallRules[*r.Name] = true
+		}
+		if r.StartIp == nil || r.EndIp == nil {
+			continue
+		}
+		start, end := net.ParseIP(*r.StartIp).To4(), net.ParseIP(*r.EndIp).To4()
+		if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+			sl.ReportError(
+				params.FirewallRules[i].EndIp,
+				fmt.Sprintf("FirewallRules[%d].EndIp", i),
+				"EndIp",
+				"gteip",
+				"")
+		}
+	}
+}
+
+type Output struct {
+	ServerName    *string `json:"server_name" validate:"required,min=1"`
+	Fqdn          *string `json:"fqdn" validate:"required,fqdn"`
+	AdminLogin    *string `json:"admin_login" validate:"required,min=1"`
+	AdminPassword *string `json:"admin_password" validate:"required,min=1" sensitive:"true"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
+
+// Redacted returns copy of Output with values of sensitive fields replaced, so it can be logged.
+func (o *Output) Redacted() *Output {
+	r := o.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
@@ -0,0 +1,708 @@
+package v0
+
+import (
+	"strings"
+	"testing"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "azpg",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"server_name": "epiphany-pg",
+		"postgres_version": "11",
+		"sku": {
+			"name": "GP_Gen5_2",
+			"storage_mb": 5120
+		},
+		"backup": {
+			"retention_days": 7,
+			"geo_redundant": false,
+			"auto_grow_enabled": true
+		},
+		"ssl_enforcement": true,
+		"admin_login": "operations",
+		"admin_password": "env://AZPG_ADMIN_PASSWORD",
+		"vnet_rules": [
+			{
+				"name": "epiphany-vnet-main",
+				"vnet_name": "epiphany-vnet",
+				"subnet_name": "main"
+			}
+		],
+		"firewall_rules": [
+			{
+				"name": "office",
+				"start_ip": "192.168.0.1",
+				"end_ip": "192.168.0.255"
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azpg"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:            to.StrPtr("epiphany"),
+					Location:        to.StrPtr("northeurope"),
+					RgName:          to.StrPtr("epiphany-rg"),
+					ServerName:      to.StrPtr("epiphany-pg"),
+					PostgresVersion: to.StrPtr("11"),
+					Sku: &Sku{
+						Name:      to.StrPtr("GP_Gen5_2"),
+						StorageMb: to.IntPtr(5120),
+					},
+					Backup: &Backup{
+						RetentionDays:   to.IntPtr(7),
+						GeoRedundant:    to.BooPtr(false),
+						AutoGrowEnabled: to.BooPtr(true),
+					},
+					SslEnforcement: to.BooPtr(true),
+					AdminLogin:     to.StrPtr("operations"),
+					AdminPassword:  to.StrPtr("env://AZPG_ADMIN_PASSWORD"),
+					VnetRules: []VnetRule{
+						{
+							Name:       to.StrPtr("epiphany-vnet-main"),
+							VnetName:   to.StrPtr("epiphany-vnet"),
+							SubnetName: to.StrPtr("main"),
+						},
+					},
+					FirewallRules: []FirewallRule{
+						{
+							Name:    to.StrPtr("office"),
+							StartIp: to.StrPtr("192.168.0.1"),
+							EndIp:   to.StrPtr("192.168.0.255"),
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "azpg",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"server_name": "epiphany-pg",
+		"postgres_version": "11",
+		"sku": {
+			"name": "GP_Gen5_2",
+			"storage_mb": 5120,
+			"extra_sku_field": "extra_sku_value"
+		},
+		"backup": {
+			"retention_days": 7,
+			"geo_redundant": false,
+			"auto_grow_enabled": true
+		},
+		"ssl_enforcement": true,
+		"admin_login": "operations",
+		"admin_password": "env://AZPG_ADMIN_PASSWORD",
+		"extra_inner_field": "extra_inner_value"
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azpg"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:            to.StrPtr("epiphany"),
+					Location:        to.StrPtr("northeurope"),
+					RgName:          to.StrPtr("epiphany-rg"),
+					ServerName:      to.StrPtr("epiphany-pg"),
+					PostgresVersion: to.StrPtr("11"),
+					Sku: &Sku{
+						Name:      to.StrPtr("GP_Gen5_2"),
+						StorageMb: to.IntPtr(5120),
+					},
+					Backup: &Backup{
+						RetentionDays:   to.IntPtr(7),
+						GeoRedundant:    to.BooPtr(false),
+						AutoGrowEnabled: to.BooPtr(true),
+					},
+					SslEnforcement: to.BooPtr(true),
+					AdminLogin:     to.StrPtr("operations"),
+					AdminPassword:  to.StrPtr("env://AZPG_ADMIN_PASSWORD"),
+				},
+				Unused: []string{"params.extra_inner_field", "params.sku.extra_sku_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"server_name": "epiphany-pg",
+		"postgres_version": "11",
+		"sku": {
+			"name": "GP_Gen5_2",
+			"storage_mb": 5120
+		},
+		"backup": {
+			"retention_days": 7,
+			"geo_redundant": false,
+			"auto_grow_enabled": true
+		},
+		"ssl_enforcement": true,
+		"admin_login": "operations",
+		"admin_password": "env://AZPG_ADMIN_PASSWORD",
+		"vnet_rules": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "azpg",
+	"version": "v0.0.1",
+	"params": {}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Location",
+					Field: "Location",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.RgName",
+					Field: "RgName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ServerName",
+					Field: "ServerName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.PostgresVersion",
+					Field: "PostgresVersion",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Sku",
+					Field: "Sku",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Backup",
+					Field: "Backup",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.SslEnforcement",
+					Field: "SslEnforcement",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AdminLogin",
+					Field: "AdminLogin",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AdminPassword",
+					Field: "AdminPassword",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect params values",
+			json: []byte(`{
+	"kind": "azpg",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"server_name": "pg",
+		"postgres_version": "12",
+		"sku": {
+			"name": "",
+			"storage_mb": 1024
+		},
+		"backup": {
+			"retention_days": 36,
+			"geo_redundant": true,
+			"auto_grow_enabled": true
+		},
+		"ssl_enforcement": true,
+		"admin_login": "postgres",
+		"admin_password": "short"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.ServerName",
+					Field: "ServerName",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.PostgresVersion",
+					Field: "PostgresVersion",
+					Tag:   "eq=9.5|eq=9.6|eq=10|eq=11",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Sku.Name",
+					Field: "Name",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Sku.StorageMb",
+					Field: "StorageMb",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Backup.RetentionDays",
+					Field: "RetentionDays",
+					Tag:   "max",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AdminLogin",
+					Field: "AdminLogin",
+					Tag:   "adminlogin",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AdminPassword",
+					Field: "AdminPassword",
+					Tag:   "min",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Rules contains all scenarios related to validation of VnetRule and FirewallRule structures.
+func TestConfig_Load_Rules(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect vnet rules",
+			json: []byte(`{
+	"kind": "azpg",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"server_name": "epiphany-pg",
+		"postgres_version": "11",
+		"sku": {
+			"name": "GP_Gen5_2",
+			"storage_mb": 5120
+		},
+		"backup": {
+			"retention_days": 7,
+			"geo_redundant": false,
+			"auto_grow_enabled": true
+		},
+		"ssl_enforcement": true,
+		"admin_login": "operations",
+		"admin_password": "env://AZPG_ADMIN_PASSWORD",
+		"vnet_rules": [
+			{
+				"name": "rule0",
+				"vnet_name": "epiphany-vnet",
+				"subnet_name": "main"
+			},
+			{
+				"name": "rule0",
+				"vnet_name": "epiphany-vnet",
+				"subnet_name": "other"
+			},
+			{
+				"name": "rule1"
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.VnetRules[1].Name",
+					Field: "VnetRules[1].Name",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VnetRules[2].VnetName",
+					Field: "VnetName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VnetRules[2].SubnetName",
+					Field: "SubnetName",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect firewall rules",
+			json: []byte(`{
+	"kind": "azpg",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"server_name": "epiphany-pg",
+		"postgres_version": "11",
+		"sku": {
+			"name": "GP_Gen5_2",
+			"storage_mb": 5120
+		},
+		"backup": {
+			"retention_days": 7,
+			"geo_redundant": false,
+			"auto_grow_enabled": true
+		},
+		"ssl_enforcement": true,
+		"admin_login": "operations",
+		"admin_password": "env://AZPG_ADMIN_PASSWORD",
+		"firewall_rules": [
+			{
+				"name": "office",
+				"start_ip": "10.0.0.1",
+				"end_ip": "10.0.0"
+			},
+			{
+				"name": "office",
+				"start_ip": "10.0.1.10",
+				"end_ip": "10.0.1.9"
+			},
+			{
+				"name": "ipv6",
+				"start_ip": "::1",
+				"end_ip": "::1"
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].EndIp",
+					Field: "EndIp",
+					Tag:   "ipv4",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[1].Name",
+					Field: "FirewallRules[1].Name",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[1].EndIp",
+					Field: "FirewallRules[1].EndIp",
+					Tag:   "gteip",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[2].StartIp",
+					Field: "StartIp",
+					Tag:   "ipv4",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[2].EndIp",
+					Field: "EndIp",
+					Tag:   "ipv4",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParams_AdminPasswordLength(t *testing.T) {
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{
+			name:     "too short",
+			password: "short",
+			wantErr:  true,
+		},
+		{
+			name:     "too long",
+			password: strings.Repeat("p", 129),
+			wantErr:  true,
+		},
+		{
+			name:     "longest allowed",
+			password: strings.Repeat("p", 128),
+			wantErr:  false,
+		},
+		{
+			name:     "secret reference",
+			password: "env://PG",
+			wantErr:  false,
+		},
+		{
+			name:     "encrypted value",
+			password: "enc:v1:" + strings.Repeat("A", 256),
+			wantErr:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewConfig()
+			c.Params.AdminPassword = to.StrPtr(tt.password)
+			if _, err := c.Marshal(); (err != nil) != tt.wantErr {
+				t.Errorf("Marshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_AdminLogin(t *testing.T) {
+	tests := []struct {
+		name    string
+		login   string
+		wantErr bool
+	}{
+		{
+			name:    "allowed",
+			login:   "operations",
+			wantErr: false,
+		},
+		{
+			name:    "reserved",
+			login:   "azure_superuser",
+			wantErr: true,
+		},
+		{
+			name:    "reserved in upper case",
+			login:   "Administrator",
+			wantErr: true,
+		},
+		{
+			name:    "pg_ prefix",
+			login:   "pg_operations",
+			wantErr: true,
+		},
+		{
+			name:    "pg_ inside",
+			login:   "operations_pg_",
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewConfig()
+			c.Params.AdminLogin = to.StrPtr(tt.login)
+			if _, err := c.Marshal(); (err != nil) != tt.wantErr {
+				t.Errorf("Marshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_ServerName(t *testing.T) {
+	tests := []struct {
+		name       string
+		serverName string
+		wantErr    bool
+	}{
+		{
+			name:       "allowed",
+			serverName: "epiphany-pg-1",
+			wantErr:    false,
+		},
+		{
+			name:       "upper case",
+			serverName: "Epiphany-pg",
+			wantErr:    true,
+		},
+		{
+			name:       "leading hyphen",
+			serverName: "-epiphany-pg",
+			wantErr:    true,
+		},
+		{
+			name:       "trailing hyphen",
+			serverName: "epiphany-pg-",
+			wantErr:    true,
+		},
+		{
+			name:       "underscore",
+			serverName: "epiphany_pg",
+			wantErr:    true,
+		},
+		{
+			name:       "too long",
+			serverName: strings.Repeat("p", 64),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewConfig()
+			c.Params.ServerName = to.StrPtr(tt.serverName)
+			if _, err := c.Marshal(); (err != nil) != tt.wantErr {
+				t.Errorf("Marshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParams_UseAzBIOutput(t *testing.T) {
+	p := &Params{}
+	p.UseAzBIOutput(&azbi.Output{
+		RgName:   to.StrPtr("epiphany-rg"),
+		VnetName: to.StrPtr("epiphany-vnet"),
+	}, "main", "db")
+	want := []VnetRule{
+		{
+			Name:       to.StrPtr("epiphany-vnet-main"),
+			VnetName:   to.StrPtr("epiphany-vnet"),
+			SubnetName: to.StrPtr("main"),
+		},
+		{
+			Name:       to.StrPtr("epiphany-vnet-db"),
+			VnetName:   to.StrPtr("epiphany-vnet"),
+			SubnetName: to.StrPtr("db"),
+		},
+	}
+	if diff := cmp.Diff(want, p.VnetRules); diff != "" {
+		t.Errorf("UseAzBIOutput() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfig_Redacted(t *testing.T) {
+	c := NewConfig()
+	c.Params.AdminPassword = to.StrPtr("s3cr3t-p4ssw0rd")
+	r := c.Redacted()
+	if got := r.Params.GetAdminPasswordV(); got == "s3cr3t-p4ssw0rd" {
+		t.Errorf("Redacted() left admin password in place")
+	}
+	if got := c.Params.GetAdminPasswordV(); got != "s3cr3t-p4ssw0rd" {
+		t.Errorf("Redacted() modified original config, got %s", got)
+	}
+}
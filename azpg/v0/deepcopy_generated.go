@@ -0,0 +1,348 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Sku or nil if Sku is nil.
+func (s *Sku) DeepCopy() *Sku {
+	if s == nil {
+		return nil
+	}
+	out := new(Sku)
+	if s.Name != nil {
+		x := *s.Name
+		out.Name = &x
+	}
+	if s.StorageMb != nil {
+		x := *s.StorageMb
+		out.StorageMb = &x
+	}
+	return out
+}
+
+// Equal reports whether Sku and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *Sku) Equal(other *Sku) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Name == nil) != (other.Name == nil) || s.Name != nil && *s.Name != *other.Name {
+		return false
+	}
+	if (s.StorageMb == nil) != (other.StorageMb == nil) || s.StorageMb != nil && *s.StorageMb != *other.StorageMb {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Backup or nil if Backup is nil.
+func (b *Backup) DeepCopy() *Backup {
+	if b == nil {
+		return nil
+	}
+	out := new(Backup)
+	if b.RetentionDays != nil {
+		x := *b.RetentionDays
+		out.RetentionDays = &x
+	}
+	if b.GeoRedundant != nil {
+		x := *b.GeoRedundant
+		out.GeoRedundant = &x
+	}
+	if b.AutoGrowEnabled != nil {
+		x := *b.AutoGrowEnabled
+		out.AutoGrowEnabled = &x
+	}
+	return out
+}
+
+// Equal reports whether Backup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (b *Backup) Equal(other *Backup) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if (b.RetentionDays == nil) != (other.RetentionDays == nil) || b.RetentionDays != nil && *b.RetentionDays != *other.RetentionDays {
+		return false
+	}
+	if (b.GeoRedundant == nil) != (other.GeoRedundant == nil) || b.GeoRedundant != nil && *b.GeoRedundant != *other.GeoRedundant {
+		return false
+	}
+	if (b.AutoGrowEnabled == nil) != (other.AutoGrowEnabled == nil) || b.AutoGrowEnabled != nil && *b.AutoGrowEnabled != *other.AutoGrowEnabled {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VnetRule or nil if VnetRule is nil.
+func (v *VnetRule) DeepCopy() *VnetRule {
+	if v == nil {
+		return nil
+	}
+	out := new(VnetRule)
+	if v.Name != nil {
+		x := *v.Name
+		out.Name = &x
+	}
+	if v.VnetName != nil {
+		x := *v.VnetName
+		out.VnetName = &x
+	}
+	if v.SubnetName != nil {
+		x := *v.SubnetName
+		out.SubnetName = &x
+	}
+	return out
+}
+
+// Equal reports whether VnetRule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VnetRule) Equal(other *VnetRule) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Name == nil) != (other.Name == nil) || v.Name != nil && *v.Name != *other.Name {
+		return false
+	}
+	if (v.VnetName == nil) != (other.VnetName == nil) || v.VnetName != nil && *v.VnetName != *other.VnetName {
+		return false
+	}
+	if (v.SubnetName == nil) != (other.SubnetName == nil) || v.SubnetName != nil && *v.SubnetName != *other.SubnetName {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) DeepCopy() *FirewallRule {
+	if f == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	if f.Name != nil {
+		x := *f.Name
+		out.Name = &x
+	}
+	if f.StartIp != nil {
+		x := *f.StartIp
+		out.StartIp = &x
+	}
+	if f.EndIp != nil {
+		x := *f.EndIp
+		out.EndIp = &x
+	}
+	return out
+}
+
+// Equal reports whether FirewallRule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (f *FirewallRule) Equal(other *FirewallRule) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if (f.Name == nil) != (other.Name == nil) || f.Name != nil && *f.Name != *other.Name {
+		return false
+	}
+	if (f.StartIp == nil) != (other.StartIp == nil) || f.StartIp != nil && *f.StartIp != *other.StartIp {
+		return false
+	}
+	if (f.EndIp == nil) != (other.EndIp == nil) || f.EndIp != nil && *f.EndIp != *other.EndIp {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Location != nil {
+		x := *p.Location
+		out.Location = &x
+	}
+	if p.RgName != nil {
+		x := *p.RgName
+		out.RgName = &x
+	}
+	if p.ServerName != nil {
+		x := *p.ServerName
+		out.ServerName = &x
+	}
+	if p.PostgresVersion != nil {
+		x := *p.PostgresVersion
+		out.PostgresVersion = &x
+	}
+	out.Sku = p.Sku.DeepCopy()
+	out.Backup = p.Backup.DeepCopy()
+	if p.SslEnforcement != nil {
+		x := *p.SslEnforcement
+		out.SslEnforcement = &x
+	}
+	if p.AdminLogin != nil {
+		x := *p.AdminLogin
+		out.AdminLogin = &x
+	}
+	if p.AdminPassword != nil {
+		x := *p.AdminPassword
+		out.AdminPassword = &x
+	}
+	if p.VnetRules != nil {
+		out.VnetRules = make([]VnetRule, len(p.VnetRules))
+		for i := range p.VnetRules {
+			out.VnetRules[i] = *p.VnetRules[i].DeepCopy()
+		}
+	}
+	if p.FirewallRules != nil {
+		out.FirewallRules = make([]FirewallRule, len(p.FirewallRules))
+		for i := range p.FirewallRules {
+			out.FirewallRules[i] = *p.FirewallRules[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Location == nil) != (other.Location == nil) || p.Location != nil && *p.Location != *other.Location {
+		return false
+	}
+	if (p.RgName == nil) != (other.RgName == nil) || p.RgName != nil && *p.RgName != *other.RgName {
+		return false
+	}
+	if (p.ServerName == nil) != (other.ServerName == nil) || p.ServerName != nil && *p.ServerName != *other.ServerName {
+		return false
+	}
+	if (p.PostgresVersion == nil) != (other.PostgresVersion == nil) || p.PostgresVersion != nil && *p.PostgresVersion != *other.PostgresVersion {
+		return false
+	}
+	if !p.Sku.Equal(other.Sku) {
+		return false
+	}
+	if !p.Backup.Equal(other.Backup) {
+		return false
+	}
+	if (p.SslEnforcement == nil) != (other.SslEnforcement == nil) || p.SslEnforcement != nil && *p.SslEnforcement != *other.SslEnforcement {
+		return false
+	}
+	if (p.AdminLogin == nil) != (other.AdminLogin == nil) || p.AdminLogin != nil && *p.AdminLogin != *other.AdminLogin {
+		return false
+	}
+	if (p.AdminPassword == nil) != (other.AdminPassword == nil) || p.AdminPassword != nil && *p.AdminPassword != *other.AdminPassword {
+		return false
+	}
+	if (p.VnetRules == nil) != (other.VnetRules == nil) || len(p.VnetRules) != len(other.VnetRules) {
+		return false
+	}
+	for i := range p.VnetRules {
+		if !p.VnetRules[i].Equal(&other.VnetRules[i]) {
+			return false
+		}
+	}
+	if (p.FirewallRules == nil) != (other.FirewallRules == nil) || len(p.FirewallRules) != len(other.FirewallRules) {
+		return false
+	}
+	for i := range p.FirewallRules {
+		if !p.FirewallRules[i].Equal(&other.FirewallRules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.ServerName != nil {
+		x := *o.ServerName
+		out.ServerName = &x
+	}
+	if o.Fqdn != nil {
+		x := *o.Fqdn
+		out.Fqdn = &x
+	}
+	if o.AdminLogin != nil {
+		x := *o.AdminLogin
+		out.AdminLogin = &x
+	}
+	if o.AdminPassword != nil {
+		x := *o.AdminPassword
+		out.AdminPassword = &x
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.ServerName == nil) != (other.ServerName == nil) || o.ServerName != nil && *o.ServerName != *other.ServerName {
+		return false
+	}
+	if (o.Fqdn == nil) != (other.Fqdn == nil) || o.Fqdn != nil && *o.Fqdn != *other.Fqdn {
+		return false
+	}
+	if (o.AdminLogin == nil) != (other.AdminLogin == nil) || o.AdminLogin != nil && *o.AdminLogin != *other.AdminLogin {
+		return false
+	}
+	if (o.AdminPassword == nil) != (other.AdminPassword == nil) || o.AdminPassword != nil && *o.AdminPassword != *other.AdminPassword {
+		return false
+	}
+	return true
+}
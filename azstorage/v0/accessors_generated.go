@@ -0,0 +1,811 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetName returns Name field of Container or nil if Container is nil.
+func (c *Container) GetName() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Name
+}
+
+// GetNameV returns value of Name field of Container or zero value if either Container or field is nil.
+func (c *Container) GetNameV() string {
+	if c == nil || c.Name == nil {
+		return ""
+	}
+	return *c.Name
+}
+
+// GetNameOr returns value of Name field of Container or def if either Container or field is nil.
+func (c *Container) GetNameOr(def string) string {
+	if c == nil || c.Name == nil {
+		return def
+	}
+	return *c.Name
+}
+
+// GetAccessType returns AccessType field of Container or nil if Container is nil.
+func (c *Container) GetAccessType() *string {
+	if c == nil {
+		return nil
+	}
+	return c.AccessType
+}
+
+// GetAccessTypeV returns value of AccessType field of Container or zero value if either Container or field is nil.
+func (c *Container) GetAccessTypeV() string {
+	if c == nil || c.AccessType == nil {
+		return ""
+	}
+	return *c.AccessType
+}
+
+// GetAccessTypeOr returns value of AccessType field of Container or def if either Container or field is nil.
+func (c *Container) GetAccessTypeOr(def string) string {
+	if c == nil || c.AccessType == nil {
+		return def
+	}
+	return *c.AccessType
+}
+
+// GetDefaultAction returns DefaultAction field of NetworkRules or nil if NetworkRules is nil.
+func (n *NetworkRules) GetDefaultAction() *string {
+	if n == nil {
+		return nil
+	}
+	return n.DefaultAction
+}
+
+// GetDefaultActionV returns value of DefaultAction field of NetworkRules or zero value if either NetworkRules or field is nil.
+func (n *NetworkRules) GetDefaultActionV() string {
+	if n == nil || n.DefaultAction == nil {
+		return ""
+	}
+	return *n.DefaultAction
+}
+
+// GetDefaultActionOr returns value of DefaultAction field of NetworkRules or def if either NetworkRules or field is nil.
+func (n *NetworkRules) GetDefaultActionOr(def string) string {
+	if n == nil || n.DefaultAction == nil {
+		return def
+	}
+	return *n.DefaultAction
+}
+
+// GetBypass returns Bypass field of NetworkRules, nil if NetworkRules is nil or empty slice if field is nil.
+func (n *NetworkRules) GetBypass() []string {
+	if n == nil {
+		return nil
+	}
+	if len(n.Bypass) == 0 {
+		return []string{}
+	}
+	return n.Bypass
+}
+
+// GetIpRules returns IpRules field of NetworkRules, nil if NetworkRules is nil or empty slice if field is nil.
+func (n *NetworkRules) GetIpRules() []string {
+	if n == nil {
+		return nil
+	}
+	if len(n.IpRules) == 0 {
+		return []string{}
+	}
+	return n.IpRules
+}
+
+// GetVnetName returns VnetName field of NetworkRules or nil if NetworkRules is nil.
+func (n *NetworkRules) GetVnetName() *string {
+	if n == nil {
+		return nil
+	}
+	return n.VnetName
+}
+
+// GetVnetNameV returns value of VnetName field of NetworkRules or zero value if either NetworkRules or field is nil.
+func (n *NetworkRules) GetVnetNameV() string {
+	if n == nil || n.VnetName == nil {
+		return ""
+	}
+	return *n.VnetName
+}
+
+// GetVnetNameOr returns value of VnetName field of NetworkRules or def if either NetworkRules or field is nil.
+func (n *NetworkRules) GetVnetNameOr(def string) string {
+	if n == nil || n.VnetName == nil {
+		return def
+	}
+	return *n.VnetName
+}
+
+// GetSubnetNames returns SubnetNames field of NetworkRules, nil if NetworkRules is nil or empty slice if field is nil.
+func (n *NetworkRules) GetSubnetNames() []string {
+	if n == nil {
+		return nil
+	}
+	if len(n.SubnetNames) == 0 {
+		return []string{}
+	}
+	return n.SubnetNames
+}
+
+// GetName returns Name field of LifecycleRule or nil if LifecycleRule is nil.
+func (l *LifecycleRule) GetName() *string {
+	if l == nil {
+		return nil
+	}
+	return l.Name
+}
+
+// GetNameV returns value of Name field of LifecycleRule or zero value if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetNameV() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNameOr returns value of Name field of LifecycleRule or def if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetNameOr(def string) string {
+	if l == nil || l.Name == nil {
+		return def
+	}
+	return *l.Name
+}
+
+// GetEnabled returns Enabled field of LifecycleRule or nil if LifecycleRule is nil.
+func (l *LifecycleRule) GetEnabled() *bool {
+	if l == nil {
+		return nil
+	}
+	return l.Enabled
+}
+
+// GetEnabledV returns value of Enabled field of LifecycleRule or zero value if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetEnabledV() bool {
+	if l == nil || l.Enabled == nil {
+		return false
+	}
+	return *l.Enabled
+}
+
+// GetEnabledOr returns value of Enabled field of LifecycleRule or def if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetEnabledOr(def bool) bool {
+	if l == nil || l.Enabled == nil {
+		return def
+	}
+	return *l.Enabled
+}
+
+// GetPrefixMatch returns PrefixMatch field of LifecycleRule, nil if LifecycleRule is nil or empty slice if field is nil.
+func (l *LifecycleRule) GetPrefixMatch() []string {
+	if l == nil {
+		return nil
+	}
+	if len(l.PrefixMatch) == 0 {
+		return []string{}
+	}
+	return l.PrefixMatch
+}
+
+// GetTierToCoolAfterDays returns TierToCoolAfterDays field of LifecycleRule or nil if LifecycleRule is nil.
+func (l *LifecycleRule) GetTierToCoolAfterDays() *int {
+	if l == nil {
+		return nil
+	}
+	return l.TierToCoolAfterDays
+}
+
+// GetTierToCoolAfterDaysV returns value of TierToCoolAfterDays field of LifecycleRule or zero value if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetTierToCoolAfterDaysV() int {
+	if l == nil || l.TierToCoolAfterDays == nil {
+		return 0
+	}
+	return *l.TierToCoolAfterDays
+}
+
+// GetTierToCoolAfterDaysOr returns value of TierToCoolAfterDays field of LifecycleRule or def if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetTierToCoolAfterDaysOr(def int) int {
+	if l == nil || l.TierToCoolAfterDays == nil {
+		return def
+	}
+	return *l.TierToCoolAfterDays
+}
+
+// GetTierToArchiveAfterDays returns TierToArchiveAfterDays field of LifecycleRule or nil if LifecycleRule is nil.
+func (l *LifecycleRule) GetTierToArchiveAfterDays() *int {
+	if l == nil {
+		return nil
+	}
+	return l.TierToArchiveAfterDays
+}
+
+// GetTierToArchiveAfterDaysV returns value of TierToArchiveAfterDays field of LifecycleRule or zero value if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetTierToArchiveAfterDaysV() int {
+	if l == nil || l.TierToArchiveAfterDays == nil {
+		return 0
+	}
+	return *l.TierToArchiveAfterDays
+}
+
+// GetTierToArchiveAfterDaysOr returns value of TierToArchiveAfterDays field of LifecycleRule or def if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetTierToArchiveAfterDaysOr(def int) int {
+	if l == nil || l.TierToArchiveAfterDays == nil {
+		return def
+	}
+	return *l.TierToArchiveAfterDays
+}
+
+// GetDeleteAfterDays returns DeleteAfterDays field of LifecycleRule or nil if LifecycleRule is nil.
+func (l *LifecycleRule) GetDeleteAfterDays() *int {
+	if l == nil {
+		return nil
+	}
+	return l.DeleteAfterDays
+}
+
+// GetDeleteAfterDaysV returns value of DeleteAfterDays field of LifecycleRule or zero value if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetDeleteAfterDaysV() int {
+	if l == nil || l.DeleteAfterDays == nil {
+		return 0
+	}
+	return *l.DeleteAfterDays
+}
+
+// GetDeleteAfterDaysOr returns value of DeleteAfterDays field of LifecycleRule or def if either LifecycleRule or field is nil.
+func (l *LifecycleRule) GetDeleteAfterDaysOr(def int) int {
+	if l == nil || l.DeleteAfterDays == nil {
+		return def
+	}
+	return *l.DeleteAfterDays
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetLocation returns Location field of Params or nil if Params is nil.
+func (p *Params) GetLocation() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Location
+}
+
+// GetLocationV returns value of Location field of Params or zero value if either Params or field is nil.
+func (p *Params) GetLocationV() string {
+	if p == nil || p.Location == nil {
+		return ""
+	}
+	return *p.Location
+}
+
+// GetLocationOr returns value of Location field of Params or def if either Params or field is nil.
+func (p *Params) GetLocationOr(def string) string {
+	if p == nil || p.Location == nil {
+		return def
+	}
+	return *p.Location
+}
+
+// GetRgName returns RgName field of Params or nil if Params is nil.
+func (p *Params) GetRgName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RgName
+}
+
+// GetRgNameV returns value of RgName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRgNameV() string {
+	if p == nil || p.RgName == nil {
+		return ""
+	}
+	return *p.RgName
+}
+
+// GetRgNameOr returns value of RgName field of Params or def if either Params or field is nil.
+func (p *Params) GetRgNameOr(def string) string {
+	if p == nil || p.RgName == nil {
+		return def
+	}
+	return *p.RgName
+}
+
+// GetAccountName returns AccountName field of Params or nil if Params is nil.
+func (p *Params) GetAccountName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.AccountName
+}
+
+// GetAccountNameV returns value of AccountName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAccountNameV() string {
+	if p == nil || p.AccountName == nil {
+		return ""
+	}
+	return *p.AccountName
+}
+
+// GetAccountNameOr returns value of AccountName field of Params or def if either Params or field is nil.
+func (p *Params) GetAccountNameOr(def string) string {
+	if p == nil || p.AccountName == nil {
+		return def
+	}
+	return *p.AccountName
+}
+
+// GetAccountTier returns AccountTier field of Params or nil if Params is nil.
+func (p *Params) GetAccountTier() *string {
+	if p == nil {
+		return nil
+	}
+	return p.AccountTier
+}
+
+// GetAccountTierV returns value of AccountTier field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAccountTierV() string {
+	if p == nil || p.AccountTier == nil {
+		return ""
+	}
+	return *p.AccountTier
+}
+
+// GetAccountTierOr returns value of AccountTier field of Params or def if either Params or field is nil.
+func (p *Params) GetAccountTierOr(def string) string {
+	if p == nil || p.AccountTier == nil {
+		return def
+	}
+	return *p.AccountTier
+}
+
+// GetReplicationType returns ReplicationType field of Params or nil if Params is nil.
+func (p *Params) GetReplicationType() *string {
+	if p == nil {
+		return nil
+	}
+	return p.ReplicationType
+}
+
+// GetReplicationTypeV returns value of ReplicationType field of Params or zero value if either Params or field is nil.
+func (p *Params) GetReplicationTypeV() string {
+	if p == nil || p.ReplicationType == nil {
+		return ""
+	}
+	return *p.ReplicationType
+}
+
+// GetReplicationTypeOr returns value of ReplicationType field of Params or def if either Params or field is nil.
+func (p *Params) GetReplicationTypeOr(def string) string {
+	if p == nil || p.ReplicationType == nil {
+		return def
+	}
+	return *p.ReplicationType
+}
+
+// GetEnableHttpsTrafficOnly returns EnableHttpsTrafficOnly field of Params or nil if Params is nil.
+func (p *Params) GetEnableHttpsTrafficOnly() *bool {
+	if p == nil {
+		return nil
+	}
+	return p.EnableHttpsTrafficOnly
+}
+
+// GetEnableHttpsTrafficOnlyV returns value of EnableHttpsTrafficOnly field of Params or zero value if either Params or field is nil.
+func (p *Params) GetEnableHttpsTrafficOnlyV() bool {
+	if p == nil || p.EnableHttpsTrafficOnly == nil {
+		return false
+	}
+	return *p.EnableHttpsTrafficOnly
+}
+
+// GetEnableHttpsTrafficOnlyOr returns value of EnableHttpsTrafficOnly field of Params or def if either Params or field is nil.
+func (p *Params) GetEnableHttpsTrafficOnlyOr(def bool) bool {
+	if p == nil || p.EnableHttpsTrafficOnly == nil {
+		return def
+	}
+	return *p.EnableHttpsTrafficOnly
+}
+
+// GetMinTlsVersion returns MinTlsVersion field of Params or nil if Params is nil.
+func (p *Params) GetMinTlsVersion() *string {
+	if p == nil {
+		return nil
+	}
+	return p.MinTlsVersion
+}
+
+// GetMinTlsVersionV returns value of MinTlsVersion field of Params or zero value if either Params or field is nil.
+func (p *Params) GetMinTlsVersionV() string {
+	if p == nil || p.MinTlsVersion == nil {
+		return ""
+	}
+	return *p.MinTlsVersion
+}
+
+// GetMinTlsVersionOr returns value of MinTlsVersion field of Params or def if either Params or field is nil.
+func (p *Params) GetMinTlsVersionOr(def string) string {
+	if p == nil || p.MinTlsVersion == nil {
+		return def
+	}
+	return *p.MinTlsVersion
+}
+
+// GetContainers returns Containers field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetContainers() []Container {
+	if p == nil {
+		return nil
+	}
+	if len(p.Containers) == 0 {
+		return []Container{}
+	}
+	return p.Containers
+}
+
+// GetNetworkRules returns NetworkRules field of Params or nil if Params is nil.
+func (p *Params) GetNetworkRules() *NetworkRules {
+	if p == nil {
+		return nil
+	}
+	return p.NetworkRules
+}
+
+// GetNetworkRulesV returns value of NetworkRules field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNetworkRulesV() NetworkRules {
+	if p == nil || p.NetworkRules == nil {
+		return NetworkRules{}
+	}
+	return *p.NetworkRules
+}
+
+// GetNetworkRulesOr returns value of NetworkRules field of Params or def if either Params or field is nil.
+func (p *Params) GetNetworkRulesOr(def NetworkRules) NetworkRules {
+	if p == nil || p.NetworkRules == nil {
+		return def
+	}
+	return *p.NetworkRules
+}
+
+// GetLifecycleRules returns LifecycleRules field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetLifecycleRules() []LifecycleRule {
+	if p == nil {
+		return nil
+	}
+	if len(p.LifecycleRules) == 0 {
+		return []LifecycleRule{}
+	}
+	return p.LifecycleRules
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetBlob returns Blob field of OutputEndpoints or nil if OutputEndpoints is nil.
+func (o *OutputEndpoints) GetBlob() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Blob
+}
+
+// GetBlobV returns value of Blob field of OutputEndpoints or zero value if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetBlobV() string {
+	if o == nil || o.Blob == nil {
+		return ""
+	}
+	return *o.Blob
+}
+
+// GetBlobOr returns value of Blob field of OutputEndpoints or def if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetBlobOr(def string) string {
+	if o == nil || o.Blob == nil {
+		return def
+	}
+	return *o.Blob
+}
+
+// GetFile returns File field of OutputEndpoints or nil if OutputEndpoints is nil.
+func (o *OutputEndpoints) GetFile() *string {
+	if o == nil {
+		return nil
+	}
+	return o.File
+}
+
+// GetFileV returns value of File field of OutputEndpoints or zero value if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetFileV() string {
+	if o == nil || o.File == nil {
+		return ""
+	}
+	return *o.File
+}
+
+// GetFileOr returns value of File field of OutputEndpoints or def if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetFileOr(def string) string {
+	if o == nil || o.File == nil {
+		return def
+	}
+	return *o.File
+}
+
+// GetQueue returns Queue field of OutputEndpoints or nil if OutputEndpoints is nil.
+func (o *OutputEndpoints) GetQueue() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Queue
+}
+
+// GetQueueV returns value of Queue field of OutputEndpoints or zero value if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetQueueV() string {
+	if o == nil || o.Queue == nil {
+		return ""
+	}
+	return *o.Queue
+}
+
+// GetQueueOr returns value of Queue field of OutputEndpoints or def if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetQueueOr(def string) string {
+	if o == nil || o.Queue == nil {
+		return def
+	}
+	return *o.Queue
+}
+
+// GetTable returns Table field of OutputEndpoints or nil if OutputEndpoints is nil.
+func (o *OutputEndpoints) GetTable() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Table
+}
+
+// GetTableV returns value of Table field of OutputEndpoints or zero value if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetTableV() string {
+	if o == nil || o.Table == nil {
+		return ""
+	}
+	return *o.Table
+}
+
+// GetTableOr returns value of Table field of OutputEndpoints or def if either OutputEndpoints or field is nil.
+func (o *OutputEndpoints) GetTableOr(def string) string {
+	if o == nil || o.Table == nil {
+		return def
+	}
+	return *o.Table
+}
+
+// GetName returns Name field of OutputContainer or nil if OutputContainer is nil.
+func (o *OutputContainer) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputContainer or zero value if either OutputContainer or field is nil.
+func (o *OutputContainer) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputContainer or def if either OutputContainer or field is nil.
+func (o *OutputContainer) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetUrl returns Url field of OutputContainer or nil if OutputContainer is nil.
+func (o *OutputContainer) GetUrl() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Url
+}
+
+// GetUrlV returns value of Url field of OutputContainer or zero value if either OutputContainer or field is nil.
+func (o *OutputContainer) GetUrlV() string {
+	if o == nil || o.Url == nil {
+		return ""
+	}
+	return *o.Url
+}
+
+// GetUrlOr returns value of Url field of OutputContainer or def if either OutputContainer or field is nil.
+func (o *OutputContainer) GetUrlOr(def string) string {
+	if o == nil || o.Url == nil {
+		return def
+	}
+	return *o.Url
+}
+
+// GetAccountName returns AccountName field of Output or nil if Output is nil.
+func (o *Output) GetAccountName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.AccountName
+}
+
+// GetAccountNameV returns value of AccountName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetAccountNameV() string {
+	if o == nil || o.AccountName == nil {
+		return ""
+	}
+	return *o.AccountName
+}
+
+// GetAccountNameOr returns value of AccountName field of Output or def if either Output or field is nil.
+func (o *Output) GetAccountNameOr(def string) string {
+	if o == nil || o.AccountName == nil {
+		return def
+	}
+	return *o.AccountName
+}
+
+// GetPrimaryAccessKey returns PrimaryAccessKey field of Output or nil if Output is nil.
+func (o *Output) GetPrimaryAccessKey() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PrimaryAccessKey
+}
+
+// GetPrimaryAccessKeyV returns value of PrimaryAccessKey field of Output or zero value if either Output or field is nil.
+func (o *Output) GetPrimaryAccessKeyV() string {
+	if o == nil || o.PrimaryAccessKey == nil {
+		return ""
+	}
+	return *o.PrimaryAccessKey
+}
+
+// GetPrimaryAccessKeyOr returns value of PrimaryAccessKey field of Output or def if either Output or field is nil.
+func (o *Output) GetPrimaryAccessKeyOr(def string) string {
+	if o == nil || o.PrimaryAccessKey == nil {
+		return def
+	}
+	return *o.PrimaryAccessKey
+}
+
+// GetEndpoints returns Endpoints field of Output or nil if Output is nil.
+func (o *Output) GetEndpoints() *OutputEndpoints {
+	if o == nil {
+		return nil
+	}
+	return o.Endpoints
+}
+
+// GetEndpointsV returns value of Endpoints field of Output or zero value if either Output or field is nil.
+func (o *Output) GetEndpointsV() OutputEndpoints {
+	if o == nil || o.Endpoints == nil {
+		return OutputEndpoints{}
+	}
+	return *o.Endpoints
+}
+
+// GetEndpointsOr returns value of Endpoints field of Output or def if either Output or field is nil.
+func (o *Output) GetEndpointsOr(def OutputEndpoints) OutputEndpoints {
+	if o == nil || o.Endpoints == nil {
+		return def
+	}
+	return *o.Endpoints
+}
+
+// GetContainers returns Containers field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetContainers() []OutputContainer {
+	if o == nil {
+		return nil
+	}
+	if len(o.Containers) == 0 {
+		return []OutputContainer{}
+	}
+	return o.Containers
+}
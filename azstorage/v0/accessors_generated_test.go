@@ -0,0 +1,795 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestContainer_Accessors(t *testing.T) {
+	var nilStruct *Container
+	emptyStruct := &Container{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Container{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("AccessType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Container{AccessType: &v}
+		if nilStruct.GetAccessType() != nil || emptyStruct.GetAccessType() != nil {
+			t.Error("GetAccessType() expected to return nil")
+		}
+		if fullStruct.GetAccessType() != &v {
+			t.Error("GetAccessType() expected to return field")
+		}
+		if nilStruct.GetAccessTypeV() != "" || emptyStruct.GetAccessTypeV() != "" {
+			t.Error("GetAccessTypeV() expected to return zero value")
+		}
+		if fullStruct.GetAccessTypeV() != v {
+			t.Error("GetAccessTypeV() expected to return field value")
+		}
+		if nilStruct.GetAccessTypeOr(v) != v || emptyStruct.GetAccessTypeOr(v) != v {
+			t.Error("GetAccessTypeOr() expected to return default value")
+		}
+		if fullStruct.GetAccessTypeOr("") != v {
+			t.Error("GetAccessTypeOr() expected to return field value")
+		}
+	})
+}
+
+func TestNetworkRules_Accessors(t *testing.T) {
+	var nilStruct *NetworkRules
+	emptyStruct := &NetworkRules{}
+	t.Run("DefaultAction", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NetworkRules{DefaultAction: &v}
+		if nilStruct.GetDefaultAction() != nil || emptyStruct.GetDefaultAction() != nil {
+			t.Error("GetDefaultAction() expected to return nil")
+		}
+		if fullStruct.GetDefaultAction() != &v {
+			t.Error("GetDefaultAction() expected to return field")
+		}
+		if nilStruct.GetDefaultActionV() != "" || emptyStruct.GetDefaultActionV() != "" {
+			t.Error("GetDefaultActionV() expected to return zero value")
+		}
+		if fullStruct.GetDefaultActionV() != v {
+			t.Error("GetDefaultActionV() expected to return field value")
+		}
+		if nilStruct.GetDefaultActionOr(v) != v || emptyStruct.GetDefaultActionOr(v) != v {
+			t.Error("GetDefaultActionOr() expected to return default value")
+		}
+		if fullStruct.GetDefaultActionOr("") != v {
+			t.Error("GetDefaultActionOr() expected to return field value")
+		}
+	})
+	t.Run("Bypass", func(t *testing.T) {
+		fullStruct := &NetworkRules{Bypass: make([]string, 1)}
+		if nilStruct.GetBypass() != nil {
+			t.Error("GetBypass() expected to return nil")
+		}
+		if got := emptyStruct.GetBypass(); got == nil || len(got) != 0 {
+			t.Error("GetBypass() expected to return empty slice")
+		}
+		if got := fullStruct.GetBypass(); len(got) != 1 || &got[0] != &fullStruct.Bypass[0] {
+			t.Error("GetBypass() expected to return field")
+		}
+	})
+	t.Run("IpRules", func(t *testing.T) {
+		fullStruct := &NetworkRules{IpRules: make([]string, 1)}
+		if nilStruct.GetIpRules() != nil {
+			t.Error("GetIpRules() expected to return nil")
+		}
+		if got := emptyStruct.GetIpRules(); got == nil || len(got) != 0 {
+			t.Error("GetIpRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetIpRules(); len(got) != 1 || &got[0] != &fullStruct.IpRules[0] {
+			t.Error("GetIpRules() expected to return field")
+		}
+	})
+	t.Run("VnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NetworkRules{VnetName: &v}
+		if nilStruct.GetVnetName() != nil || emptyStruct.GetVnetName() != nil {
+			t.Error("GetVnetName() expected to return nil")
+		}
+		if fullStruct.GetVnetName() != &v {
+			t.Error("GetVnetName() expected to return field")
+		}
+		if nilStruct.GetVnetNameV() != "" || emptyStruct.GetVnetNameV() != "" {
+			t.Error("GetVnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetVnetNameV() != v {
+			t.Error("GetVnetNameV() expected to return field value")
+		}
+		if nilStruct.GetVnetNameOr(v) != v || emptyStruct.GetVnetNameOr(v) != v {
+			t.Error("GetVnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetVnetNameOr("") != v {
+			t.Error("GetVnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetNames", func(t *testing.T) {
+		fullStruct := &NetworkRules{SubnetNames: make([]string, 1)}
+		if nilStruct.GetSubnetNames() != nil {
+			t.Error("GetSubnetNames() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnetNames(); got == nil || len(got) != 0 {
+			t.Error("GetSubnetNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnetNames(); len(got) != 1 || &got[0] != &fullStruct.SubnetNames[0] {
+			t.Error("GetSubnetNames() expected to return field")
+		}
+	})
+}
+
+func TestLifecycleRule_Accessors(t *testing.T) {
+	var nilStruct *LifecycleRule
+	emptyStruct := &LifecycleRule{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &LifecycleRule{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		v := true
+		fullStruct := &LifecycleRule{Enabled: &v}
+		if nilStruct.GetEnabled() != nil || emptyStruct.GetEnabled() != nil {
+			t.Error("GetEnabled() expected to return nil")
+		}
+		if fullStruct.GetEnabled() != &v {
+			t.Error("GetEnabled() expected to return field")
+		}
+		if nilStruct.GetEnabledV() != false || emptyStruct.GetEnabledV() != false {
+			t.Error("GetEnabledV() expected to return zero value")
+		}
+		if fullStruct.GetEnabledV() != v {
+			t.Error("GetEnabledV() expected to return field value")
+		}
+		if nilStruct.GetEnabledOr(v) != v || emptyStruct.GetEnabledOr(v) != v {
+			t.Error("GetEnabledOr() expected to return default value")
+		}
+		if fullStruct.GetEnabledOr(false) != v {
+			t.Error("GetEnabledOr() expected to return field value")
+		}
+	})
+	t.Run("PrefixMatch", func(t *testing.T) {
+		fullStruct := &LifecycleRule{PrefixMatch: make([]string, 1)}
+		if nilStruct.GetPrefixMatch() != nil {
+			t.Error("GetPrefixMatch() expected to return nil")
+		}
+		if got := emptyStruct.GetPrefixMatch(); got == nil || len(got) != 0 {
+			t.Error("GetPrefixMatch() expected to return empty slice")
+		}
+		if got := fullStruct.GetPrefixMatch(); len(got) != 1 || &got[0] != &fullStruct.PrefixMatch[0] {
+			t.Error("GetPrefixMatch() expected to return field")
+		}
+	})
+	t.Run("TierToCoolAfterDays", func(t *testing.T) {
+		v := 1
+		fullStruct := &LifecycleRule{TierToCoolAfterDays: &v}
+		if nilStruct.GetTierToCoolAfterDays() != nil || emptyStruct.GetTierToCoolAfterDays() != nil {
+			t.Error("GetTierToCoolAfterDays() expected to return nil")
+		}
+		if fullStruct.GetTierToCoolAfterDays() != &v {
+			t.Error("GetTierToCoolAfterDays() expected to return field")
+		}
+		if nilStruct.GetTierToCoolAfterDaysV() != 0 || emptyStruct.GetTierToCoolAfterDaysV() != 0 {
+			t.Error("GetTierToCoolAfterDaysV() expected to return zero value")
+		}
+		if fullStruct.GetTierToCoolAfterDaysV() != v {
+			t.Error("GetTierToCoolAfterDaysV() expected to return field value")
+		}
+		if nilStruct.GetTierToCoolAfterDaysOr(v) != v || emptyStruct.GetTierToCoolAfterDaysOr(v) != v {
+			t.Error("GetTierToCoolAfterDaysOr() expected to return default value")
+		}
+		if fullStruct.GetTierToCoolAfterDaysOr(0) != v {
+			t.Error("GetTierToCoolAfterDaysOr() expected to return field value")
+		}
+	})
+	t.Run("TierToArchiveAfterDays", func(t *testing.T) {
+		v := 1
+		fullStruct := &LifecycleRule{TierToArchiveAfterDays: &v}
+		if nilStruct.GetTierToArchiveAfterDays() != nil || emptyStruct.GetTierToArchiveAfterDays() != nil {
+			t.Error("GetTierToArchiveAfterDays() expected to return nil")
+		}
+		if fullStruct.GetTierToArchiveAfterDays() != &v {
+			t.Error("GetTierToArchiveAfterDays() expected to return field")
+		}
+		if nilStruct.GetTierToArchiveAfterDaysV() != 0 || emptyStruct.GetTierToArchiveAfterDaysV() != 0 {
+			t.Error("GetTierToArchiveAfterDaysV() expected to return zero value")
+		}
+		if fullStruct.GetTierToArchiveAfterDaysV() != v {
+			t.Error("GetTierToArchiveAfterDaysV() expected to return field value")
+		}
+		if nilStruct.GetTierToArchiveAfterDaysOr(v) != v || emptyStruct.GetTierToArchiveAfterDaysOr(v) != v {
+			t.Error("GetTierToArchiveAfterDaysOr() expected to return default value")
+		}
+		if fullStruct.GetTierToArchiveAfterDaysOr(0) != v {
+			t.Error("GetTierToArchiveAfterDaysOr() expected to return field value")
+		}
+	})
+	t.Run("DeleteAfterDays", func(t *testing.T) {
+		v := 1
+		fullStruct := &LifecycleRule{DeleteAfterDays: &v}
+		if nilStruct.GetDeleteAfterDays() != nil || emptyStruct.GetDeleteAfterDays() != nil {
+			t.Error("GetDeleteAfterDays() expected to return nil")
+		}
+		if fullStruct.GetDeleteAfterDays() != &v {
+			t.Error("GetDeleteAfterDays() expected to return field")
+		}
+		if nilStruct.GetDeleteAfterDaysV() != 0 || emptyStruct.GetDeleteAfterDaysV() != 0 {
+			t.Error("GetDeleteAfterDaysV() expected to return zero value")
+		}
+		if fullStruct.GetDeleteAfterDaysV() != v {
+			t.Error("GetDeleteAfterDaysV() expected to return field value")
+		}
+		if nilStruct.GetDeleteAfterDaysOr(v) != v || emptyStruct.GetDeleteAfterDaysOr(v) != v {
+			t.Error("GetDeleteAfterDaysOr() expected to return default value")
+		}
+		if fullStruct.GetDeleteAfterDaysOr(0) != v {
+			t.Error("GetDeleteAfterDaysOr() expected to return field value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Location", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Location: &v}
+		if nilStruct.GetLocation() != nil || emptyStruct.GetLocation() != nil {
+			t.Error("GetLocation() expected to return nil")
+		}
+		if fullStruct.GetLocation() != &v {
+			t.Error("GetLocation() expected to return field")
+		}
+		if nilStruct.GetLocationV() != "" || emptyStruct.GetLocationV() != "" {
+			t.Error("GetLocationV() expected to return zero value")
+		}
+		if fullStruct.GetLocationV() != v {
+			t.Error("GetLocationV() expected to return field value")
+		}
+		if nilStruct.GetLocationOr(v) != v || emptyStruct.GetLocationOr(v) != v {
+			t.Error("GetLocationOr() expected to return default value")
+		}
+		if fullStruct.GetLocationOr("") != v {
+			t.Error("GetLocationOr() expected to return field value")
+		}
+	})
+	t.Run("RgName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RgName: &v}
+		if nilStruct.GetRgName() != nil || emptyStruct.GetRgName() != nil {
+			t.Error("GetRgName() expected to return nil")
+		}
+		if fullStruct.GetRgName() != &v {
+			t.Error("GetRgName() expected to return field")
+		}
+		if nilStruct.GetRgNameV() != "" || emptyStruct.GetRgNameV() != "" {
+			t.Error("GetRgNameV() expected to return zero value")
+		}
+		if fullStruct.GetRgNameV() != v {
+			t.Error("GetRgNameV() expected to return field value")
+		}
+		if nilStruct.GetRgNameOr(v) != v || emptyStruct.GetRgNameOr(v) != v {
+			t.Error("GetRgNameOr() expected to return default value")
+		}
+		if fullStruct.GetRgNameOr("") != v {
+			t.Error("GetRgNameOr() expected to return field value")
+		}
+	})
+	t.Run("AccountName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{AccountName: &v}
+		if nilStruct.GetAccountName() != nil || emptyStruct.GetAccountName() != nil {
+			t.Error("GetAccountName() expected to return nil")
+		}
+		if fullStruct.GetAccountName() != &v {
+			t.Error("GetAccountName() expected to return field")
+		}
+		if nilStruct.GetAccountNameV() != "" || emptyStruct.GetAccountNameV() != "" {
+			t.Error("GetAccountNameV() expected to return zero value")
+		}
+		if fullStruct.GetAccountNameV() != v {
+			t.Error("GetAccountNameV() expected to return field value")
+		}
+		if nilStruct.GetAccountNameOr(v) != v || emptyStruct.GetAccountNameOr(v) != v {
+			t.Error("GetAccountNameOr() expected to return default value")
+		}
+		if fullStruct.GetAccountNameOr("") != v {
+			t.Error("GetAccountNameOr() expected to return field value")
+		}
+	})
+	t.Run("AccountTier", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{AccountTier: &v}
+		if nilStruct.GetAccountTier() != nil || emptyStruct.GetAccountTier() != nil {
+			t.Error("GetAccountTier() expected to return nil")
+		}
+		if fullStruct.GetAccountTier() != &v {
+			t.Error("GetAccountTier() expected to return field")
+		}
+		if nilStruct.GetAccountTierV() != "" || emptyStruct.GetAccountTierV() != "" {
+			t.Error("GetAccountTierV() expected to return zero value")
+		}
+		if fullStruct.GetAccountTierV() != v {
+			t.Error("GetAccountTierV() expected to return field value")
+		}
+		if nilStruct.GetAccountTierOr(v) != v || emptyStruct.GetAccountTierOr(v) != v {
+			t.Error("GetAccountTierOr() expected to return default value")
+		}
+		if fullStruct.GetAccountTierOr("") != v {
+			t.Error("GetAccountTierOr() expected to return field value")
+		}
+	})
+	t.Run("ReplicationType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{ReplicationType: &v}
+		if nilStruct.GetReplicationType() != nil || emptyStruct.GetReplicationType() != nil {
+			t.Error("GetReplicationType() expected to return nil")
+		}
+		if fullStruct.GetReplicationType() != &v {
+			t.Error("GetReplicationType() expected to return field")
+		}
+		if nilStruct.GetReplicationTypeV() != "" || emptyStruct.GetReplicationTypeV() != "" {
+			t.Error("GetReplicationTypeV() expected to return zero value")
+		}
+		if fullStruct.GetReplicationTypeV() != v {
+			t.Error("GetReplicationTypeV() expected to return field value")
+		}
+		if nilStruct.GetReplicationTypeOr(v) != v || emptyStruct.GetReplicationTypeOr(v) != v {
+			t.Error("GetReplicationTypeOr() expected to return default value")
+		}
+		if fullStruct.GetReplicationTypeOr("") != v {
+			t.Error("GetReplicationTypeOr() expected to return field value")
+		}
+	})
+	t.Run("EnableHttpsTrafficOnly", func(t *testing.T) {
+		v := true
+		fullStruct := &Params{EnableHttpsTrafficOnly: &v}
+		if nilStruct.GetEnableHttpsTrafficOnly() != nil || emptyStruct.GetEnableHttpsTrafficOnly() != nil {
+			t.Error("GetEnableHttpsTrafficOnly() expected to return nil")
+		}
+		if fullStruct.GetEnableHttpsTrafficOnly() != &v {
+			t.Error("GetEnableHttpsTrafficOnly() expected to return field")
+		}
+		if nilStruct.GetEnableHttpsTrafficOnlyV() != false || emptyStruct.GetEnableHttpsTrafficOnlyV() != false {
+			t.Error("GetEnableHttpsTrafficOnlyV() expected to return zero value")
+		}
+		if fullStruct.GetEnableHttpsTrafficOnlyV() != v {
+			t.Error("GetEnableHttpsTrafficOnlyV() expected to return field value")
+		}
+		if nilStruct.GetEnableHttpsTrafficOnlyOr(v) != v || emptyStruct.GetEnableHttpsTrafficOnlyOr(v) != v {
+			t.Error("GetEnableHttpsTrafficOnlyOr() expected to return default value")
+		}
+		if fullStruct.GetEnableHttpsTrafficOnlyOr(false) != v {
+			t.Error("GetEnableHttpsTrafficOnlyOr() expected to return field value")
+		}
+	})
+	t.Run("MinTlsVersion", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{MinTlsVersion: &v}
+		if nilStruct.GetMinTlsVersion() != nil || emptyStruct.GetMinTlsVersion() != nil {
+			t.Error("GetMinTlsVersion() expected to return nil")
+		}
+		if fullStruct.GetMinTlsVersion() != &v {
+			t.Error("GetMinTlsVersion() expected to return field")
+		}
+		if nilStruct.GetMinTlsVersionV() != "" || emptyStruct.GetMinTlsVersionV() != "" {
+			t.Error("GetMinTlsVersionV() expected to return zero value")
+		}
+		if fullStruct.GetMinTlsVersionV() != v {
+			t.Error("GetMinTlsVersionV() expected to return field value")
+		}
+		if nilStruct.GetMinTlsVersionOr(v) != v || emptyStruct.GetMinTlsVersionOr(v) != v {
+			t.Error("GetMinTlsVersionOr() expected to return default value")
+		}
+		if fullStruct.GetMinTlsVersionOr("") != v {
+			t.Error("GetMinTlsVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Containers", func(t *testing.T) {
+		fullStruct := &Params{Containers: make([]Container, 1)}
+		if nilStruct.GetContainers() != nil {
+			t.Error("GetContainers() expected to return nil")
+		}
+		if got := emptyStruct.GetContainers(); got == nil || len(got) != 0 {
+			t.Error("GetContainers() expected to return empty slice")
+		}
+		if got := fullStruct.GetContainers(); len(got) != 1 || &got[0] != &fullStruct.Containers[0] {
+			t.Error("GetContainers() expected to return field")
+		}
+	})
+	t.Run("NetworkRules", func(t *testing.T) {
+		v := NetworkRules{}
+		fullStruct := &Params{NetworkRules: &v}
+		if nilStruct.GetNetworkRules() != nil || emptyStruct.GetNetworkRules() != nil {
+			t.Error("GetNetworkRules() expected to return nil")
+		}
+		if fullStruct.GetNetworkRules() != &v {
+			t.Error("GetNetworkRules() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetNetworkRulesV(), NetworkRules{}) || !reflect.DeepEqual(emptyStruct.GetNetworkRulesV(), NetworkRules{}) {
+			t.Error("GetNetworkRulesV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetNetworkRulesV(), v) {
+			t.Error("GetNetworkRulesV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetNetworkRulesOr(v), v) || !reflect.DeepEqual(emptyStruct.GetNetworkRulesOr(v), v) {
+			t.Error("GetNetworkRulesOr() expected to return default value")
+		}
+	})
+	t.Run("LifecycleRules", func(t *testing.T) {
+		fullStruct := &Params{LifecycleRules: make([]LifecycleRule, 1)}
+		if nilStruct.GetLifecycleRules() != nil {
+			t.Error("GetLifecycleRules() expected to return nil")
+		}
+		if got := emptyStruct.GetLifecycleRules(); got == nil || len(got) != 0 {
+			t.Error("GetLifecycleRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetLifecycleRules(); len(got) != 1 || &got[0] != &fullStruct.LifecycleRules[0] {
+			t.Error("GetLifecycleRules() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputEndpoints_Accessors(t *testing.T) {
+	var nilStruct *OutputEndpoints
+	emptyStruct := &OutputEndpoints{}
+	t.Run("Blob", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputEndpoints{Blob: &v}
+		if nilStruct.GetBlob() != nil || emptyStruct.GetBlob() != nil {
+			t.Error("GetBlob() expected to return nil")
+		}
+		if fullStruct.GetBlob() != &v {
+			t.Error("GetBlob() expected to return field")
+		}
+		if nilStruct.GetBlobV() != "" || emptyStruct.GetBlobV() != "" {
+			t.Error("GetBlobV() expected to return zero value")
+		}
+		if fullStruct.GetBlobV() != v {
+			t.Error("GetBlobV() expected to return field value")
+		}
+		if nilStruct.GetBlobOr(v) != v || emptyStruct.GetBlobOr(v) != v {
+			t.Error("GetBlobOr() expected to return default value")
+		}
+		if fullStruct.GetBlobOr("") != v {
+			t.Error("GetBlobOr() expected to return field value")
+		}
+	})
+	t.Run("File", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputEndpoints{File: &v}
+		if nilStruct.GetFile() != nil || emptyStruct.GetFile() != nil {
+			t.Error("GetFile() expected to return nil")
+		}
+		if fullStruct.GetFile() != &v {
+			t.Error("GetFile() expected to return field")
+		}
+		if nilStruct.GetFileV() != "" || emptyStruct.GetFileV() != "" {
+			t.Error("GetFileV() expected to return zero value")
+		}
+		if fullStruct.GetFileV() != v {
+			t.Error("GetFileV() expected to return field value")
+		}
+		if nilStruct.GetFileOr(v) != v || emptyStruct.GetFileOr(v) != v {
+			t.Error("GetFileOr() expected to return default value")
+		}
+		if fullStruct.GetFileOr("") != v {
+			t.Error("GetFileOr() expected to return field value")
+		}
+	})
+	t.Run("Queue", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputEndpoints{Queue: &v}
+		if nilStruct.GetQueue() != nil || emptyStruct.GetQueue() != nil {
+			t.Error("GetQueue() expected to return nil")
+		}
+		if fullStruct.GetQueue() != &v {
+			t.Error("GetQueue() expected to return field")
+		}
+		if nilStruct.GetQueueV() != "" || emptyStruct.GetQueueV() != "" {
+			t.Error("GetQueueV() expected to return zero value")
+		}
+		if fullStruct.GetQueueV() != v {
+			t.Error("GetQueueV() expected to return field value")
+		}
+		if nilStruct.GetQueueOr(v) != v || emptyStruct.GetQueueOr(v) != v {
+			t.Error("GetQueueOr() expected to return default value")
+		}
+		if fullStruct.GetQueueOr("") != v {
+			t.Error("GetQueueOr() expected to return field value")
+		}
+	})
+	t.Run("Table", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputEndpoints{Table: &v}
+		if nilStruct.GetTable() != nil || emptyStruct.GetTable() != nil {
+			t.Error("GetTable() expected to return nil")
+		}
+		if fullStruct.GetTable() != &v {
+			t.Error("GetTable() expected to return field")
+		}
+		if nilStruct.GetTableV() != "" || emptyStruct.GetTableV() != "" {
+			t.Error("GetTableV() expected to return zero value")
+		}
+		if fullStruct.GetTableV() != v {
+			t.Error("GetTableV() expected to return field value")
+		}
+		if nilStruct.GetTableOr(v) != v || emptyStruct.GetTableOr(v) != v {
+			t.Error("GetTableOr() expected to return default value")
+		}
+		if fullStruct.GetTableOr("") != v {
+			t.Error("GetTableOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutputContainer_Accessors(t *testing.T) {
+	var nilStruct *OutputContainer
+	emptyStruct := &OutputContainer{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputContainer{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Url", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputContainer{Url: &v}
+		if nilStruct.GetUrl() != nil || emptyStruct.GetUrl() != nil {
+			t.Error("GetUrl() expected to return nil")
+		}
+		if fullStruct.GetUrl() != &v {
+			t.Error("GetUrl() expected to return field")
+		}
+		if nilStruct.GetUrlV() != "" || emptyStruct.GetUrlV() != "" {
+			t.Error("GetUrlV() expected to return zero value")
+		}
+		if fullStruct.GetUrlV() != v {
+			t.Error("GetUrlV() expected to return field value")
+		}
+		if nilStruct.GetUrlOr(v) != v || emptyStruct.GetUrlOr(v) != v {
+			t.Error("GetUrlOr() expected to return default value")
+		}
+		if fullStruct.GetUrlOr("") != v {
+			t.Error("GetUrlOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("AccountName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{AccountName: &v}
+		if nilStruct.GetAccountName() != nil || emptyStruct.GetAccountName() != nil {
+			t.Error("GetAccountName() expected to return nil")
+		}
+		if fullStruct.GetAccountName() != &v {
+			t.Error("GetAccountName() expected to return field")
+		}
+		if nilStruct.GetAccountNameV() != "" || emptyStruct.GetAccountNameV() != "" {
+			t.Error("GetAccountNameV() expected to return zero value")
+		}
+		if fullStruct.GetAccountNameV() != v {
+			t.Error("GetAccountNameV() expected to return field value")
+		}
+		if nilStruct.GetAccountNameOr(v) != v || emptyStruct.GetAccountNameOr(v) != v {
+			t.Error("GetAccountNameOr() expected to return default value")
+		}
+		if fullStruct.GetAccountNameOr("") != v {
+			t.Error("GetAccountNameOr() expected to return field value")
+		}
+	})
+	t.Run("PrimaryAccessKey", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{PrimaryAccessKey: &v}
+		if nilStruct.GetPrimaryAccessKey() != nil || emptyStruct.GetPrimaryAccessKey() != nil {
+			t.Error("GetPrimaryAccessKey() expected to return nil")
+		}
+		if fullStruct.GetPrimaryAccessKey() != &v {
+			t.Error("GetPrimaryAccessKey() expected to return field")
+		}
+		if nilStruct.GetPrimaryAccessKeyV() != "" || emptyStruct.GetPrimaryAccessKeyV() != "" {
+			t.Error("GetPrimaryAccessKeyV() expected to return zero value")
+		}
+		if fullStruct.GetPrimaryAccessKeyV() != v {
+			t.Error("GetPrimaryAccessKeyV() expected to return field value")
+		}
+		if nilStruct.GetPrimaryAccessKeyOr(v) != v || emptyStruct.GetPrimaryAccessKeyOr(v) != v {
+			t.Error("GetPrimaryAccessKeyOr() expected to return default value")
+		}
+		if fullStruct.GetPrimaryAccessKeyOr("") != v {
+			t.Error("GetPrimaryAccessKeyOr() expected to return field value")
+		}
+	})
+	t.Run("Endpoints", func(t *testing.T) {
+		v := OutputEndpoints{}
+		fullStruct := &Output{Endpoints: &v}
+		if nilStruct.GetEndpoints() != nil || emptyStruct.GetEndpoints() != nil {
+			t.Error("GetEndpoints() expected to return nil")
+		}
+		if fullStruct.GetEndpoints() != &v {
+			t.Error("GetEndpoints() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetEndpointsV(), OutputEndpoints{}) || !reflect.DeepEqual(emptyStruct.GetEndpointsV(), OutputEndpoints{}) {
+			t.Error("GetEndpointsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetEndpointsV(), v) {
+			t.Error("GetEndpointsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetEndpointsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetEndpointsOr(v), v) {
+			t.Error("GetEndpointsOr() expected to return default value")
+		}
+	})
+	t.Run("Containers", func(t *testing.T) {
+		fullStruct := &Output{Containers: make([]OutputContainer, 1)}
+		if nilStruct.GetContainers() != nil {
+			t.Error("GetContainers() expected to return nil")
+		}
+		if got := emptyStruct.GetContainers(); got == nil || len(got) != 0 {
+			t.Error("GetContainers() expected to return empty slice")
+		}
+		if got := fullStruct.GetContainers(); len(got) != 1 || &got[0] != &fullStruct.Containers[0] {
+			t.Error("GetContainers() expected to return field")
+		}
+	})
+}
@@ -0,0 +1,308 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "azstorage"
+	version = "v0.0.1"
+)
+
+// containerNameRegexp matches lowercase letters, numbers and single dashes not at the start or end.
+var containerNameRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type Container struct {
+	Name       *string `json:"name" validate:"required,min=3,max=63"`                           // https://docs.microsoft.com/en-us/rest/api/storageservices/naming-and-referencing-containers--blobs--and-metadata#container-names
+	AccessType *string `json:"access_type" validate:"required,eq=private|eq=blob|eq=container"` // https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/resources/storage_container#container_access_type
+}
+
+// NetworkRules limit access to storage account. SubnetNames have to be names of subnets created
+// by azbi module in VnetName virtual network.
+type NetworkRules struct {
+	DefaultAction *string  `json:"default_action" validate:"required,eq=Allow|eq=Deny"`
+	Bypass        []string `json:"bypass" validate:"omitempty,dive,eq=AzureServices|eq=Logging|eq=Metrics|eq=None"`
+	IpRules       []string `json:"ip_rules" validate:"omitempty,dive,required,cidr|ipv4"`
+	VnetName      *string  `json:"vnet_name" validate:"required_with=SubnetNames,omitempty,min=1"`
+	SubnetNames   []string `json:"subnet_names" validate:"omitempty,dive,required"`
+}
+
+// LifecycleRule moves or deletes blobs matching PrefixMatch after given number of days since last
+// modification. At least one action has to be set.
+type LifecycleRule struct {
+	Name                   *string  `json:"name" validate:"required,min=1"`
+	Enabled                *bool    `json:"enabled" validate:"required"`
+	PrefixMatch            []string `json:"prefix_match" validate:"omitempty,dive,required"`
+	TierToCoolAfterDays    *int     `json:"tier_to_cool_after_days" validate:"omitempty,min=0"`
+	TierToArchiveAfterDays *int     `json:"tier_to_archive_after_days" validate:"omitempty,min=0"`
+	DeleteAfterDays        *int     `json:"delete_after_days" validate:"required_without_all=TierToCoolAfterDays TierToArchiveAfterDays,omitempty,min=0"`
+}
+
+type Params struct {
+	Name                   *string         `json:"name" validate:"required,min=1"`
+	Location               *string         `json:"location" validate:"required,min=1"`
+	RgName                 *string         `json:"rg_name" validate:"required,min=1"`
+	AccountName            *string         `json:"account_name" validate:"required,min=3,max=24,lowercase,alphanum"` // https://docs.microsoft.com/en-us/azure/azure-resource-manager/management/resource-name-rules#microsoftstorage
+	AccountTier            *string         `json:"account_tier" validate:"required,eq=Standard|eq=Premium"`
+	ReplicationType        *string         `json:"replication_type" validate:"required,eq=LRS|eq=GRS|eq=ZRS"`
+	EnableHttpsTrafficOnly *bool           `json:"enable_https_traffic_only" validate:"required"`
+	MinTlsVersion          *string         `json:"min_tls_version" validate:"required,eq=TLS1_0|eq=TLS1_1|eq=TLS1_2"`
+	Containers             []Container     `json:"containers" validate:"omitempty,dive"`
+	NetworkRules           *NetworkRules   `json:"network_rules" validate:"omitempty"`
+	LifecycleRules         []LifecycleRule `json:"lifecycle_rules" validate:"omitempty,dive"`
+}
+
+// UseAzBIOutput limits access to storage account to subnetNames of virtual network created by
+// azbi module.
+func (p *Params) UseAzBIOutput(o *azbi.Output, subnetNames ...string) {
+	if p == nil || o == nil {
+		return
+	}
+	if p.NetworkRules == nil {
+		p.NetworkRules = &NetworkRules{
+			DefaultAction: to.StrPtr("Deny"),
+			Bypass:        []string{"AzureServices"},
+		}
+	}
+	p.NetworkRules.VnetName = to.StrPtr(o.GetVnetNameV())
+	p.NetworkRules.SubnetNames = append([]string{}, subnetNames...)
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=azstorage"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:                   to.StrPtr("epiphany"),
+			Location:               to.StrPtr("northeurope"),
+			RgName:                 to.StrPtr("epiphany-rg"),
+			AccountName:            to.StrPtr("epiphanystorage"),
+			AccountTier:            to.StrPtr("Standard"),
+			ReplicationType:        to.StrPtr("LRS"),
+			EnableHttpsTrafficOnly: to.BooPtr(true),
+			MinTlsVersion:          to.StrPtr("TLS1_2"),
+			Containers: []Container{
+				{
+					Name:       to.StrPtr("tfstate"),
+					AccessType: to.StrPtr("private"),
+				},
+				{
+					Name:       to.StrPtr("backups"),
+					AccessType: to.StrPtr("private"),
+				},
+			},
+			NetworkRules: nil,
+			LifecycleRules: []LifecycleRule{
+				{
+					Name:                   to.StrPtr("backups"),
+					Enabled:                to.BooPtr(true),
+					PrefixMatch:            []string{"backups/"},
+					TierToCoolAfterDays:    to.IntPtr(30),
+					TierToArchiveAfterDays: to.IntPtr(90),
+					DeleteAfterDays:        to.IntPtr(365),
+				},
+			},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("azstorage config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(AzStorageParamsValidation, Params{})
+	validate.RegisterStructValidation(AzStorageLifecycleRuleValidation, LifecycleRule{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// AzStorageParamsValidation checks that names of containers are correct and that names of
+// containers and lifecycle rules are unique.
+func AzStorageParamsValidation(sl validator.StructLevel) {
+	params := sl.Current().Interface().(Params)
+	containers := make(map[string]bool)
+	for i, c := range params.Containers {
+		if c.Name == nil {
+			continue
+		}
+		if !containerNameRegexp.MatchString(*c.Name) {
+			sl.ReportError(
+				params.Containers[i].Name,
+				fmt.Sprintf("Containers[%d].Name", i),
+				"Name",
+				"containername",
+				"")
+		}
+		if containers[*c.Name] {
+			sl.ReportError(
+				params.Containers[i].Name,
+				fmt.Sprintf("Containers[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		containers[*c.Name] = true
+	}
+	rules := make(map[string]bool)
+	for i, r := range params.LifecycleRules {
+		if r.Name == nil {
+			continue
+		}
+		if rules[*r.Name] {
+			sl.ReportError(
+				params.LifecycleRules[i].Name,
+				fmt.Sprintf("LifecycleRules[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		rules[*r.Name] = true
+	}
+}
+
+// AzStorageLifecycleRuleValidation checks that blobs are moved to archive tier after cool tier and
+// deleted after both.
+func AzStorageLifecycleRuleValidation(sl validator.StructLevel) {
+	r := sl.Current().Interface().(LifecycleRule)
+	if r.TierToCoolAfterDays != nil && r.TierToArchiveAfterDays != nil && *r.TierToArchiveAfterDays < *r.TierToCoolAfterDays {
+		sl.ReportError(r.TierToArchiveAfterDays, "TierToArchiveAfterDays", "TierToArchiveAfterDays", "gtefield", "TierToCoolAfterDays")
+	}
+	if r.DeleteAfterDays == nil {
+		return
+	}
+	for _, days := range []*int{r.TierToCoolAfterDays, r.TierToArchiveAfterDays} {
+		if days != nil && *r.DeleteAfterDays < *days {
+			sl.ReportError(r.DeleteAfterDays, "DeleteAfterDays", "DeleteAfterDays", "gtefield", "")
+			return
+		}
+	}
+}
+
+type OutputEndpoints struct {
+	Blob  *string `json:"blob" validate:"required,url"`
+	File  *string `json:"file" validate:"omitempty,url"`
+	Queue *string `json:"queue" validate:"omitempty,url"`
+	Table *string `json:"table" validate:"omitempty,url"`
+}
+
+type OutputContainer struct {
+	Name *string `json:"name" validate:"required,min=1"`
+	Url  *string `json:"url" validate:"required,url"`
+}
+
+type Output struct {
+	AccountName      *string           `json:"account_name" validate:"required,min=1"`
+	PrimaryAccessKey *string           `json:"primary_access_key" validate:"omitempty,min=1" sensitive:"true"`
+	Endpoints        *OutputEndpoints  `json:"endpoints" validate:"required"`
+	Containers       []OutputContainer `json:"containers" validate:"omitempty,dive"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
+
+// Redacted returns copy of Output with values of sensitive fields replaced, so it can be logged.
+func (o *Output) Redacted() *Output {
+	r := o.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
@@ -0,0 +1,631 @@
+package v0
+
+import (
+	"testing"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "epiphanystorage",
+		"account_tier": "Standard",
+		"replication_type": "LRS",
+		"enable_https_traffic_only": true,
+		"min_tls_version": "TLS1_2",
+		"containers": [
+			{
+				"name": "tfstate",
+				"access_type": "private"
+			}
+		],
+		"network_rules": {
+			"default_action": "Deny",
+			"bypass": ["AzureServices"],
+			"ip_rules": ["100.0.0.1", "100.0.1.0/24"],
+			"vnet_name": "epiphany-vnet",
+			"subnet_names": ["main"]
+		},
+		"lifecycle_rules": [
+			{
+				"name": "backups",
+				"enabled": true,
+				"prefix_match": ["backups/"],
+				"tier_to_cool_after_days": 30,
+				"delete_after_days": 365
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azstorage"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:                   to.StrPtr("epiphany"),
+					Location:               to.StrPtr("northeurope"),
+					RgName:                 to.StrPtr("epiphany-rg"),
+					AccountName:            to.StrPtr("epiphanystorage"),
+					AccountTier:            to.StrPtr("Standard"),
+					ReplicationType:        to.StrPtr("LRS"),
+					EnableHttpsTrafficOnly: to.BooPtr(true),
+					MinTlsVersion:          to.StrPtr("TLS1_2"),
+					Containers: []Container{
+						{
+							Name:       to.StrPtr("tfstate"),
+							AccessType: to.StrPtr("private"),
+						},
+					},
+					NetworkRules: &NetworkRules{
+						DefaultAction: to.StrPtr("Deny"),
+						Bypass:        []string{"AzureServices"},
+						IpRules:       []string{"100.0.0.1", "100.0.1.0/24"},
+						VnetName:      to.StrPtr("epiphany-vnet"),
+						SubnetNames:   []string{"main"},
+					},
+					LifecycleRules: []LifecycleRule{
+						{
+							Name:                to.StrPtr("backups"),
+							Enabled:             to.BooPtr(true),
+							PrefixMatch:         []string{"backups/"},
+							TierToCoolAfterDays: to.IntPtr(30),
+							DeleteAfterDays:     to.IntPtr(365),
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"extra_outer_field": "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "epiphanystorage",
+		"account_tier": "Standard",
+		"replication_type": "LRS",
+		"enable_https_traffic_only": true,
+		"min_tls_version": "TLS1_2",
+		"extra_inner_field": "extra_inner_value",
+		"containers": [
+			{
+				"name": "tfstate",
+				"access_type": "private",
+				"extra_container_field": "extra_container_value"
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("azstorage"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:                   to.StrPtr("epiphany"),
+					Location:               to.StrPtr("northeurope"),
+					RgName:                 to.StrPtr("epiphany-rg"),
+					AccountName:            to.StrPtr("epiphanystorage"),
+					AccountTier:            to.StrPtr("Standard"),
+					ReplicationType:        to.StrPtr("LRS"),
+					EnableHttpsTrafficOnly: to.BooPtr(true),
+					MinTlsVersion:          to.StrPtr("TLS1_2"),
+					Containers: []Container{
+						{
+							Name:       to.StrPtr("tfstate"),
+							AccessType: to.StrPtr("private"),
+						},
+					},
+				},
+				Unused: []string{"extra_outer_field", "params.containers[0].extra_container_field", "params.extra_inner_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "epiphanystorage",
+		"account_tier": "Standard",
+		"replication_type": "LRS",
+		"enable_https_traffic_only": true,
+		"min_tls_version": "TLS1_2"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"params": {}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Location",
+					Field: "Location",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.RgName",
+					Field: "RgName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AccountName",
+					Field: "AccountName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AccountTier",
+					Field: "AccountTier",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ReplicationType",
+					Field: "ReplicationType",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.EnableHttpsTrafficOnly",
+					Field: "EnableHttpsTrafficOnly",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.MinTlsVersion",
+					Field: "MinTlsVersion",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect params values",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "Epiphany-Storage",
+		"account_tier": "Basic",
+		"replication_type": "RAGRS",
+		"enable_https_traffic_only": false,
+		"min_tls_version": "TLS1_3"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.AccountName",
+					Field: "AccountName",
+					Tag:   "lowercase",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AccountTier",
+					Field: "AccountTier",
+					Tag:   "eq=Standard|eq=Premium",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ReplicationType",
+					Field: "ReplicationType",
+					Tag:   "eq=LRS|eq=GRS|eq=ZRS",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.MinTlsVersion",
+					Field: "MinTlsVersion",
+					Tag:   "eq=TLS1_0|eq=TLS1_1|eq=TLS1_2",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Containers contains all scenarios related to validation of Container structures.
+func TestConfig_Load_Containers(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect containers",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "epiphanystorage",
+		"account_tier": "Standard",
+		"replication_type": "LRS",
+		"enable_https_traffic_only": true,
+		"min_tls_version": "TLS1_2",
+		"containers": [
+			{
+				"name": "TfState",
+				"access_type": "public"
+			},
+			{
+				"name": "backups",
+				"access_type": "private"
+			},
+			{
+				"name": "backups",
+				"access_type": "blob"
+			},
+			{
+				"name": "tf--state",
+				"access_type": "private"
+			},
+			{
+				"name": "-tfstate",
+				"access_type": "private"
+			},
+			{
+				"name": "tfstate-",
+				"access_type": "private"
+			},
+			{
+				"name": "tf_state",
+				"access_type": "private"
+			},
+			{
+				"name": "tf-state-2",
+				"access_type": "private"
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Containers[0].Name",
+					Field: "Containers[0].Name",
+					Tag:   "containername",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Containers[0].AccessType",
+					Field: "AccessType",
+					Tag:   "eq=private|eq=blob|eq=container",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Containers[2].Name",
+					Field: "Containers[2].Name",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Containers[3].Name",
+					Field: "Containers[3].Name",
+					Tag:   "containername",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Containers[4].Name",
+					Field: "Containers[4].Name",
+					Tag:   "containername",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Containers[5].Name",
+					Field: "Containers[5].Name",
+					Tag:   "containername",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Containers[6].Name",
+					Field: "Containers[6].Name",
+					Tag:   "containername",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_NetworkRules contains all scenarios related to validation of NetworkRules structure.
+func TestConfig_Load_NetworkRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect network rules",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "epiphanystorage",
+		"account_tier": "Standard",
+		"replication_type": "LRS",
+		"enable_https_traffic_only": true,
+		"min_tls_version": "TLS1_2",
+		"network_rules": {
+			"default_action": "Block",
+			"bypass": ["Everything"],
+			"ip_rules": ["100.0.0"],
+			"subnet_names": [""]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkRules.DefaultAction",
+					Field: "DefaultAction",
+					Tag:   "eq=Allow|eq=Deny",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkRules.Bypass[0]",
+					Field: "Bypass[0]",
+					Tag:   "eq=AzureServices|eq=Logging|eq=Metrics|eq=None",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkRules.IpRules[0]",
+					Field: "IpRules[0]",
+					Tag:   "cidr|ipv4",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkRules.VnetName",
+					Field: "VnetName",
+					Tag:   "required_with",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.NetworkRules.SubnetNames[0]",
+					Field: "SubnetNames[0]",
+					Tag:   "required",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_LifecycleRules contains all scenarios related to validation of LifecycleRule structures.
+func TestConfig_Load_LifecycleRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "rule without actions",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "epiphanystorage",
+		"account_tier": "Standard",
+		"replication_type": "LRS",
+		"enable_https_traffic_only": true,
+		"min_tls_version": "TLS1_2",
+		"lifecycle_rules": [
+			{
+				"name": "backups",
+				"enabled": true
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.LifecycleRules[0].DeleteAfterDays",
+					Field: "DeleteAfterDays",
+					Tag:   "required_without_all",
+				},
+			},
+		},
+		{
+			name: "incorrect rules order and names",
+			json: []byte(`{
+	"kind": "azstorage",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rg_name": "epiphany-rg",
+		"account_name": "epiphanystorage",
+		"account_tier": "Standard",
+		"replication_type": "LRS",
+		"enable_https_traffic_only": true,
+		"min_tls_version": "TLS1_2",
+		"lifecycle_rules": [
+			{
+				"name": "backups",
+				"enabled": true,
+				"tier_to_cool_after_days": 30,
+				"tier_to_archive_after_days": 10,
+				"delete_after_days": 20
+			},
+			{
+				"name": "backups",
+				"enabled": false,
+				"delete_after_days": -1
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.LifecycleRules[0].TierToArchiveAfterDays",
+					Field: "TierToArchiveAfterDays",
+					Tag:   "gtefield",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.LifecycleRules[0].DeleteAfterDays",
+					Field: "DeleteAfterDays",
+					Tag:   "gtefield",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.LifecycleRules[1].DeleteAfterDays",
+					Field: "DeleteAfterDays",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.LifecycleRules[1].Name",
+					Field: "LifecycleRules[1].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParams_UseAzBIOutput(t *testing.T) {
+	p := NewConfig().Params
+	p.UseAzBIOutput(&azbi.Output{
+		RgName:   to.StrPtr("epiphany-rg"),
+		VnetName: to.StrPtr("epiphany-vnet"),
+	}, "main")
+	want := &NetworkRules{
+		DefaultAction: to.StrPtr("Deny"),
+		Bypass:        []string{"AzureServices"},
+		VnetName:      to.StrPtr("epiphany-vnet"),
+		SubnetNames:   []string{"main"},
+	}
+	if diff := cmp.Diff(want, p.NetworkRules); diff != "" {
+		t.Errorf("UseAzBIOutput() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,442 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Container or nil if Container is nil.
+func (c *Container) DeepCopy() *Container {
+	if c == nil {
+		return nil
+	}
+	out := new(Container)
+	if c.Name != nil {
+		x := *c.Name
+		out.Name = &x
+	}
+	if c.AccessType != nil {
+		x := *c.AccessType
+		out.AccessType = &x
+	}
+	return out
+}
+
+// Equal reports whether Container and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Container) Equal(other *Container) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Name == nil) != (other.Name == nil) || c.Name != nil && *c.Name != *other.Name {
+		return false
+	}
+	if (c.AccessType == nil) != (other.AccessType == nil) || c.AccessType != nil && *c.AccessType != *other.AccessType {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of NetworkRules or nil if NetworkRules is nil.
+func (n *NetworkRules) DeepCopy() *NetworkRules {
+	if n == nil {
+		return nil
+	}
+	out := new(NetworkRules)
+	if n.DefaultAction != nil {
+		x := *n.DefaultAction
+		out.DefaultAction = &x
+	}
+	if n.Bypass != nil {
+		out.Bypass = make([]string, len(n.Bypass))
+		copy(out.Bypass, n.Bypass)
+	}
+	if n.IpRules != nil {
+		out.IpRules = make([]string, len(n.IpRules))
+		copy(out.IpRules, n.IpRules)
+	}
+	if n.VnetName != nil {
+		x := *n.VnetName
+		out.VnetName = &x
+	}
+	if n.SubnetNames != nil {
+		out.SubnetNames = make([]string, len(n.SubnetNames))
+		copy(out.SubnetNames, n.SubnetNames)
+	}
+	return out
+}
+
+// Equal reports whether NetworkRules and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (n *NetworkRules) Equal(other *NetworkRules) bool {
+	if n == nil || other == nil {
+		return n == other
+	}
+	if (n.DefaultAction == nil) != (other.DefaultAction == nil) || n.DefaultAction != nil && *n.DefaultAction != *other.DefaultAction {
+		return false
+	}
+	if (n.Bypass == nil) != (other.Bypass == nil) || len(n.Bypass) != len(other.Bypass) {
+		return false
+	}
+	for i := range n.Bypass {
+		if n.Bypass[i] != other.Bypass[i] {
+			return false
+		}
+	}
+	if (n.IpRules == nil) != (other.IpRules == nil) || len(n.IpRules) != len(other.IpRules) {
+		return false
+	}
+	for i := range n.IpRules {
+		if n.IpRules[i] != other.IpRules[i] {
+			return false
+		}
+	}
+	if (n.VnetName == nil) != (other.VnetName == nil) || n.VnetName != nil && *n.VnetName != *other.VnetName {
+		return false
+	}
+	if (n.SubnetNames == nil) != (other.SubnetNames == nil) || len(n.SubnetNames) != len(other.SubnetNames) {
+		return false
+	}
+	for i := range n.SubnetNames {
+		if n.SubnetNames[i] != other.SubnetNames[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of LifecycleRule or nil if LifecycleRule is nil.
+func (l *LifecycleRule) DeepCopy() *LifecycleRule {
+	if l == nil {
+		return nil
+	}
+	out := new(LifecycleRule)
+	if l.Name != nil {
+		x := *l.Name
+		out.Name = &x
+	}
+	if l.Enabled != nil {
+		x := *l.Enabled
+		out.Enabled = &x
+	}
+	if l.PrefixMatch != nil {
+		out.PrefixMatch = make([]string, len(l.PrefixMatch))
+		copy(out.PrefixMatch, l.PrefixMatch)
+	}
+	if l.TierToCoolAfterDays != nil {
+		x := *l.TierToCoolAfterDays
+		out.TierToCoolAfterDays = &x
+	}
+	if l.TierToArchiveAfterDays != nil {
+		x := *l.TierToArchiveAfterDays
+		out.TierToArchiveAfterDays = &x
+	}
+	if l.DeleteAfterDays != nil {
+		x := *l.DeleteAfterDays
+		out.DeleteAfterDays = &x
+	}
+	return out
+}
+
+// Equal reports whether LifecycleRule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (l *LifecycleRule) Equal(other *LifecycleRule) bool {
+	if l == nil || other == nil {
+		return l == other
+	}
+	if (l.Name == nil) != (other.Name == nil) || l.Name != nil && *l.Name != *other.Name {
+		return false
+	}
+	if (l.Enabled == nil) != (other.Enabled == nil) || l.Enabled != nil && *l.Enabled != *other.Enabled {
+		return false
+	}
+	if (l.PrefixMatch == nil) != (other.PrefixMatch == nil) || len(l.PrefixMatch) != len(other.PrefixMatch) {
+		return false
+	}
+	for i := range l.PrefixMatch {
+		if l.PrefixMatch[i] != other.PrefixMatch[i] {
+			return false
+		}
+	}
+	if (l.TierToCoolAfterDays == nil) != (other.TierToCoolAfterDays == nil) || l.TierToCoolAfterDays != nil && *l.TierToCoolAfterDays != *other.TierToCoolAfterDays {
+		return false
+	}
+	if (l.TierToArchiveAfterDays == nil) != (other.TierToArchiveAfterDays == nil) || l.TierToArchiveAfterDays != nil && *l.TierToArchiveAfterDays != *other.TierToArchiveAfterDays {
+		return false
+	}
+	if (l.DeleteAfterDays == nil) != (other.DeleteAfterDays == nil) || l.DeleteAfterDays != nil && *l.DeleteAfterDays != *other.DeleteAfterDays {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Location != nil {
+		x := *p.Location
+		out.Location = &x
+	}
+	if p.RgName != nil {
+		x := *p.RgName
+		out.RgName = &x
+	}
+	if p.AccountName != nil {
+		x := *p.AccountName
+		out.AccountName = &x
+	}
+	if p.AccountTier != nil {
+		x := *p.AccountTier
+		out.AccountTier = &x
+	}
+	if p.ReplicationType != nil {
+		x := *p.ReplicationType
+		out.ReplicationType = &x
+	}
+	if p.EnableHttpsTrafficOnly != nil {
+		x := *p.EnableHttpsTrafficOnly
+		out.EnableHttpsTrafficOnly = &x
+	}
+	if p.MinTlsVersion != nil {
+		x := *p.MinTlsVersion
+		out.MinTlsVersion = &x
+	}
+	if p.Containers != nil {
+		out.Containers = make([]Container, len(p.Containers))
+		for i := range p.Containers {
+			out.Containers[i] = *p.Containers[i].DeepCopy()
+		}
+	}
+	out.NetworkRules = p.NetworkRules.DeepCopy()
+	if p.LifecycleRules != nil {
+		out.LifecycleRules = make([]LifecycleRule, len(p.LifecycleRules))
+		for i := range p.LifecycleRules {
+			out.LifecycleRules[i] = *p.LifecycleRules[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Location == nil) != (other.Location == nil) || p.Location != nil && *p.Location != *other.Location {
+		return false
+	}
+	if (p.RgName == nil) != (other.RgName == nil) || p.RgName != nil && *p.RgName != *other.RgName {
+		return false
+	}
+	if (p.AccountName == nil) != (other.AccountName == nil) || p.AccountName != nil && *p.AccountName != *other.AccountName {
+		return false
+	}
+	if (p.AccountTier == nil) != (other.AccountTier == nil) || p.AccountTier != nil && *p.AccountTier != *other.AccountTier {
+		return false
+	}
+	if (p.ReplicationType == nil) != (other.ReplicationType == nil) || p.ReplicationType != nil && *p.ReplicationType != *other.ReplicationType {
+		return false
+	}
+	if (p.EnableHttpsTrafficOnly == nil) != (other.EnableHttpsTrafficOnly == nil) || p.EnableHttpsTrafficOnly != nil && *p.EnableHttpsTrafficOnly != *other.EnableHttpsTrafficOnly {
+		return false
+	}
+	if (p.MinTlsVersion == nil) != (other.MinTlsVersion == nil) || p.MinTlsVersion != nil && *p.MinTlsVersion != *other.MinTlsVersion {
+		return false
+	}
+	if (p.Containers == nil) != (other.Containers == nil) || len(p.Containers) != len(other.Containers) {
+		return false
+	}
+	for i := range p.Containers {
+		if !p.Containers[i].Equal(&other.Containers[i]) {
+			return false
+		}
+	}
+	if !p.NetworkRules.Equal(other.NetworkRules) {
+		return false
+	}
+	if (p.LifecycleRules == nil) != (other.LifecycleRules == nil) || len(p.LifecycleRules) != len(other.LifecycleRules) {
+		return false
+	}
+	for i := range p.LifecycleRules {
+		if !p.LifecycleRules[i].Equal(&other.LifecycleRules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputEndpoints or nil if OutputEndpoints is nil.
+func (o *OutputEndpoints) DeepCopy() *OutputEndpoints {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputEndpoints)
+	if o.Blob != nil {
+		x := *o.Blob
+		out.Blob = &x
+	}
+	if o.File != nil {
+		x := *o.File
+		out.File = &x
+	}
+	if o.Queue != nil {
+		x := *o.Queue
+		out.Queue = &x
+	}
+	if o.Table != nil {
+		x := *o.Table
+		out.Table = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputEndpoints and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputEndpoints) Equal(other *OutputEndpoints) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Blob == nil) != (other.Blob == nil) || o.Blob != nil && *o.Blob != *other.Blob {
+		return false
+	}
+	if (o.File == nil) != (other.File == nil) || o.File != nil && *o.File != *other.File {
+		return false
+	}
+	if (o.Queue == nil) != (other.Queue == nil) || o.Queue != nil && *o.Queue != *other.Queue {
+		return false
+	}
+	if (o.Table == nil) != (other.Table == nil) || o.Table != nil && *o.Table != *other.Table {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputContainer or nil if OutputContainer is nil.
+func (o *OutputContainer) DeepCopy() *OutputContainer {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputContainer)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Url != nil {
+		x := *o.Url
+		out.Url = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputContainer and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputContainer) Equal(other *OutputContainer) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Url == nil) != (other.Url == nil) || o.Url != nil && *o.Url != *other.Url {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.AccountName != nil {
+		x := *o.AccountName
+		out.AccountName = &x
+	}
+	if o.PrimaryAccessKey != nil {
+		x := *o.PrimaryAccessKey
+		out.PrimaryAccessKey = &x
+	}
+	out.Endpoints = o.Endpoints.DeepCopy()
+	if o.Containers != nil {
+		out.Containers = make([]OutputContainer, len(o.Containers))
+		for i := range o.Containers {
+			out.Containers[i] = *o.Containers[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.AccountName == nil) != (other.AccountName == nil) || o.AccountName != nil && *o.AccountName != *other.AccountName {
+		return false
+	}
+	if (o.PrimaryAccessKey == nil) != (other.PrimaryAccessKey == nil) || o.PrimaryAccessKey != nil && *o.PrimaryAccessKey != *other.PrimaryAccessKey {
+		return false
+	}
+	if !o.Endpoints.Equal(other.Endpoints) {
+		return false
+	}
+	if (o.Containers == nil) != (other.Containers == nil) || len(o.Containers) != len(other.Containers) {
+		return false
+	}
+	for i := range o.Containers {
+		if !o.Containers[i].Equal(&other.Containers[i]) {
+			return false
+		}
+	}
+	return true
+}
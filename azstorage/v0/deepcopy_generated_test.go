@@ -0,0 +1,330 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+import (
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestContainer_DeepCopy(t *testing.T) {
+	var nilStruct *Container
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Container{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestContainer_Equal(t *testing.T) {
+	var nilStruct *Container
+	original := &Container{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Container{}).Equal(&Container{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestNetworkRules_DeepCopy(t *testing.T) {
+	var nilStruct *NetworkRules
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &NetworkRules{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestNetworkRules_Equal(t *testing.T) {
+	var nilStruct *NetworkRules
+	original := &NetworkRules{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&NetworkRules{}).Equal(&NetworkRules{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestLifecycleRule_DeepCopy(t *testing.T) {
+	var nilStruct *LifecycleRule
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &LifecycleRule{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestLifecycleRule_Equal(t *testing.T) {
+	var nilStruct *LifecycleRule
+	original := &LifecycleRule{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&LifecycleRule{}).Equal(&LifecycleRule{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestParams_DeepCopy(t *testing.T) {
+	var nilStruct *Params
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Params{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestParams_Equal(t *testing.T) {
+	var nilStruct *Params
+	original := &Params{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Params{}).Equal(&Params{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestConfig_DeepCopy(t *testing.T) {
+	var nilStruct *Config
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Config{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestConfig_Equal(t *testing.T) {
+	var nilStruct *Config
+	original := &Config{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Config{}).Equal(&Config{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputEndpoints_DeepCopy(t *testing.T) {
+	var nilStruct *OutputEndpoints
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputEndpoints{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputEndpoints_Equal(t *testing.T) {
+	var nilStruct *OutputEndpoints
+	original := &OutputEndpoints{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputEndpoints{}).Equal(&OutputEndpoints{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputContainer_DeepCopy(t *testing.T) {
+	var nilStruct *OutputContainer
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputContainer{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputContainer_Equal(t *testing.T) {
+	var nilStruct *OutputContainer
+	original := &OutputContainer{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputContainer{}).Equal(&OutputContainer{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutput_DeepCopy(t *testing.T) {
+	var nilStruct *Output
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Output{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutput_Equal(t *testing.T) {
+	var nilStruct *Output
+	original := &Output{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Output{}).Equal(&Output{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
@@ -0,0 +1,503 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetProvider returns Provider field of Params or nil if Params is nil.
+func (p *Params) GetProvider() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Provider
+}
+
+// GetProviderV returns value of Provider field of Params or zero value if either Params or field is nil.
+func (p *Params) GetProviderV() string {
+	if p == nil || p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+// GetProviderOr returns value of Provider field of Params or def if either Params or field is nil.
+func (p *Params) GetProviderOr(def string) string {
+	if p == nil || p.Provider == nil {
+		return def
+	}
+	return *p.Provider
+}
+
+// GetSubnetName returns SubnetName field of Params or nil if Params is nil.
+func (p *Params) GetSubnetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.SubnetName
+}
+
+// GetSubnetNameV returns value of SubnetName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetSubnetNameV() string {
+	if p == nil || p.SubnetName == nil {
+		return ""
+	}
+	return *p.SubnetName
+}
+
+// GetSubnetNameOr returns value of SubnetName field of Params or def if either Params or field is nil.
+func (p *Params) GetSubnetNameOr(def string) string {
+	if p == nil || p.SubnetName == nil {
+		return def
+	}
+	return *p.SubnetName
+}
+
+// GetAllowedCidrs returns AllowedCidrs field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetAllowedCidrs() []string {
+	if p == nil {
+		return nil
+	}
+	if len(p.AllowedCidrs) == 0 {
+		return []string{}
+	}
+	return p.AllowedCidrs
+}
+
+// GetVmSize returns VmSize field of Params or nil if Params is nil.
+func (p *Params) GetVmSize() *string {
+	if p == nil {
+		return nil
+	}
+	return p.VmSize
+}
+
+// GetVmSizeV returns value of VmSize field of Params or zero value if either Params or field is nil.
+func (p *Params) GetVmSizeV() string {
+	if p == nil || p.VmSize == nil {
+		return ""
+	}
+	return *p.VmSize
+}
+
+// GetVmSizeOr returns value of VmSize field of Params or def if either Params or field is nil.
+func (p *Params) GetVmSizeOr(def string) string {
+	if p == nil || p.VmSize == nil {
+		return def
+	}
+	return *p.VmSize
+}
+
+// GetRsaPublicKeyPath returns RsaPublicKeyPath field of Params or nil if Params is nil.
+func (p *Params) GetRsaPublicKeyPath() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathV returns value of RsaPublicKeyPath field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathV() string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return ""
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathOr returns value of RsaPublicKeyPath field of Params or def if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathOr(def string) string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return def
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetName returns Name field of NsgRule or nil if NsgRule is nil.
+func (n *NsgRule) GetName() *string {
+	if n == nil {
+		return nil
+	}
+	return n.Name
+}
+
+// GetNameV returns value of Name field of NsgRule or zero value if either NsgRule or field is nil.
+func (n *NsgRule) GetNameV() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetNameOr returns value of Name field of NsgRule or def if either NsgRule or field is nil.
+func (n *NsgRule) GetNameOr(def string) string {
+	if n == nil || n.Name == nil {
+		return def
+	}
+	return *n.Name
+}
+
+// GetPriority returns Priority field of NsgRule or nil if NsgRule is nil.
+func (n *NsgRule) GetPriority() *int {
+	if n == nil {
+		return nil
+	}
+	return n.Priority
+}
+
+// GetPriorityV returns value of Priority field of NsgRule or zero value if either NsgRule or field is nil.
+func (n *NsgRule) GetPriorityV() int {
+	if n == nil || n.Priority == nil {
+		return 0
+	}
+	return *n.Priority
+}
+
+// GetPriorityOr returns value of Priority field of NsgRule or def if either NsgRule or field is nil.
+func (n *NsgRule) GetPriorityOr(def int) int {
+	if n == nil || n.Priority == nil {
+		return def
+	}
+	return *n.Priority
+}
+
+// GetDirection returns Direction field of NsgRule or nil if NsgRule is nil.
+func (n *NsgRule) GetDirection() *string {
+	if n == nil {
+		return nil
+	}
+	return n.Direction
+}
+
+// GetDirectionV returns value of Direction field of NsgRule or zero value if either NsgRule or field is nil.
+func (n *NsgRule) GetDirectionV() string {
+	if n == nil || n.Direction == nil {
+		return ""
+	}
+	return *n.Direction
+}
+
+// GetDirectionOr returns value of Direction field of NsgRule or def if either NsgRule or field is nil.
+func (n *NsgRule) GetDirectionOr(def string) string {
+	if n == nil || n.Direction == nil {
+		return def
+	}
+	return *n.Direction
+}
+
+// GetAccess returns Access field of NsgRule or nil if NsgRule is nil.
+func (n *NsgRule) GetAccess() *string {
+	if n == nil {
+		return nil
+	}
+	return n.Access
+}
+
+// GetAccessV returns value of Access field of NsgRule or zero value if either NsgRule or field is nil.
+func (n *NsgRule) GetAccessV() string {
+	if n == nil || n.Access == nil {
+		return ""
+	}
+	return *n.Access
+}
+
+// GetAccessOr returns value of Access field of NsgRule or def if either NsgRule or field is nil.
+func (n *NsgRule) GetAccessOr(def string) string {
+	if n == nil || n.Access == nil {
+		return def
+	}
+	return *n.Access
+}
+
+// GetProtocol returns Protocol field of NsgRule or nil if NsgRule is nil.
+func (n *NsgRule) GetProtocol() *string {
+	if n == nil {
+		return nil
+	}
+	return n.Protocol
+}
+
+// GetProtocolV returns value of Protocol field of NsgRule or zero value if either NsgRule or field is nil.
+func (n *NsgRule) GetProtocolV() string {
+	if n == nil || n.Protocol == nil {
+		return ""
+	}
+	return *n.Protocol
+}
+
+// GetProtocolOr returns value of Protocol field of NsgRule or def if either NsgRule or field is nil.
+func (n *NsgRule) GetProtocolOr(def string) string {
+	if n == nil || n.Protocol == nil {
+		return def
+	}
+	return *n.Protocol
+}
+
+// GetSourceAddressPrefixes returns SourceAddressPrefixes field of NsgRule, nil if NsgRule is nil or empty slice if field is nil.
+func (n *NsgRule) GetSourceAddressPrefixes() []string {
+	if n == nil {
+		return nil
+	}
+	if len(n.SourceAddressPrefixes) == 0 {
+		return []string{}
+	}
+	return n.SourceAddressPrefixes
+}
+
+// GetDestinationPortRange returns DestinationPortRange field of NsgRule or nil if NsgRule is nil.
+func (n *NsgRule) GetDestinationPortRange() *string {
+	if n == nil {
+		return nil
+	}
+	return n.DestinationPortRange
+}
+
+// GetDestinationPortRangeV returns value of DestinationPortRange field of NsgRule or zero value if either NsgRule or field is nil.
+func (n *NsgRule) GetDestinationPortRangeV() string {
+	if n == nil || n.DestinationPortRange == nil {
+		return ""
+	}
+	return *n.DestinationPortRange
+}
+
+// GetDestinationPortRangeOr returns value of DestinationPortRange field of NsgRule or def if either NsgRule or field is nil.
+func (n *NsgRule) GetDestinationPortRangeOr(def string) string {
+	if n == nil || n.DestinationPortRange == nil {
+		return def
+	}
+	return *n.DestinationPortRange
+}
+
+// GetName returns Name field of Nsg or nil if Nsg is nil.
+func (n *Nsg) GetName() *string {
+	if n == nil {
+		return nil
+	}
+	return n.Name
+}
+
+// GetNameV returns value of Name field of Nsg or zero value if either Nsg or field is nil.
+func (n *Nsg) GetNameV() string {
+	if n == nil || n.Name == nil {
+		return ""
+	}
+	return *n.Name
+}
+
+// GetNameOr returns value of Name field of Nsg or def if either Nsg or field is nil.
+func (n *Nsg) GetNameOr(def string) string {
+	if n == nil || n.Name == nil {
+		return def
+	}
+	return *n.Name
+}
+
+// GetSubnetName returns SubnetName field of Nsg or nil if Nsg is nil.
+func (n *Nsg) GetSubnetName() *string {
+	if n == nil {
+		return nil
+	}
+	return n.SubnetName
+}
+
+// GetSubnetNameV returns value of SubnetName field of Nsg or zero value if either Nsg or field is nil.
+func (n *Nsg) GetSubnetNameV() string {
+	if n == nil || n.SubnetName == nil {
+		return ""
+	}
+	return *n.SubnetName
+}
+
+// GetSubnetNameOr returns value of SubnetName field of Nsg or def if either Nsg or field is nil.
+func (n *Nsg) GetSubnetNameOr(def string) string {
+	if n == nil || n.SubnetName == nil {
+		return def
+	}
+	return *n.SubnetName
+}
+
+// GetRules returns Rules field of Nsg, nil if Nsg is nil or empty slice if field is nil.
+func (n *Nsg) GetRules() []NsgRule {
+	if n == nil {
+		return nil
+	}
+	if len(n.Rules) == 0 {
+		return []NsgRule{}
+	}
+	return n.Rules
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetVmName returns VmName field of Output or nil if Output is nil.
+func (o *Output) GetVmName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.VmName
+}
+
+// GetVmNameV returns value of VmName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetVmNameV() string {
+	if o == nil || o.VmName == nil {
+		return ""
+	}
+	return *o.VmName
+}
+
+// GetVmNameOr returns value of VmName field of Output or def if either Output or field is nil.
+func (o *Output) GetVmNameOr(def string) string {
+	if o == nil || o.VmName == nil {
+		return def
+	}
+	return *o.VmName
+}
+
+// GetPublicIp returns PublicIp field of Output or nil if Output is nil.
+func (o *Output) GetPublicIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PublicIp
+}
+
+// GetPublicIpV returns value of PublicIp field of Output or zero value if either Output or field is nil.
+func (o *Output) GetPublicIpV() string {
+	if o == nil || o.PublicIp == nil {
+		return ""
+	}
+	return *o.PublicIp
+}
+
+// GetPublicIpOr returns value of PublicIp field of Output or def if either Output or field is nil.
+func (o *Output) GetPublicIpOr(def string) string {
+	if o == nil || o.PublicIp == nil {
+		return def
+	}
+	return *o.PublicIp
+}
+
+// GetPrivateIp returns PrivateIp field of Output or nil if Output is nil.
+func (o *Output) GetPrivateIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PrivateIp
+}
+
+// GetPrivateIpV returns value of PrivateIp field of Output or zero value if either Output or field is nil.
+func (o *Output) GetPrivateIpV() string {
+	if o == nil || o.PrivateIp == nil {
+		return ""
+	}
+	return *o.PrivateIp
+}
+
+// GetPrivateIpOr returns value of PrivateIp field of Output or def if either Output or field is nil.
+func (o *Output) GetPrivateIpOr(def string) string {
+	if o == nil || o.PrivateIp == nil {
+		return def
+	}
+	return *o.PrivateIp
+}
@@ -0,0 +1,496 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Provider", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Provider: &v}
+		if nilStruct.GetProvider() != nil || emptyStruct.GetProvider() != nil {
+			t.Error("GetProvider() expected to return nil")
+		}
+		if fullStruct.GetProvider() != &v {
+			t.Error("GetProvider() expected to return field")
+		}
+		if nilStruct.GetProviderV() != "" || emptyStruct.GetProviderV() != "" {
+			t.Error("GetProviderV() expected to return zero value")
+		}
+		if fullStruct.GetProviderV() != v {
+			t.Error("GetProviderV() expected to return field value")
+		}
+		if nilStruct.GetProviderOr(v) != v || emptyStruct.GetProviderOr(v) != v {
+			t.Error("GetProviderOr() expected to return default value")
+		}
+		if fullStruct.GetProviderOr("") != v {
+			t.Error("GetProviderOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{SubnetName: &v}
+		if nilStruct.GetSubnetName() != nil || emptyStruct.GetSubnetName() != nil {
+			t.Error("GetSubnetName() expected to return nil")
+		}
+		if fullStruct.GetSubnetName() != &v {
+			t.Error("GetSubnetName() expected to return field")
+		}
+		if nilStruct.GetSubnetNameV() != "" || emptyStruct.GetSubnetNameV() != "" {
+			t.Error("GetSubnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetSubnetNameV() != v {
+			t.Error("GetSubnetNameV() expected to return field value")
+		}
+		if nilStruct.GetSubnetNameOr(v) != v || emptyStruct.GetSubnetNameOr(v) != v {
+			t.Error("GetSubnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetSubnetNameOr("") != v {
+			t.Error("GetSubnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("AllowedCidrs", func(t *testing.T) {
+		fullStruct := &Params{AllowedCidrs: make([]string, 1)}
+		if nilStruct.GetAllowedCidrs() != nil {
+			t.Error("GetAllowedCidrs() expected to return nil")
+		}
+		if got := emptyStruct.GetAllowedCidrs(); got == nil || len(got) != 0 {
+			t.Error("GetAllowedCidrs() expected to return empty slice")
+		}
+		if got := fullStruct.GetAllowedCidrs(); len(got) != 1 || &got[0] != &fullStruct.AllowedCidrs[0] {
+			t.Error("GetAllowedCidrs() expected to return field")
+		}
+	})
+	t.Run("VmSize", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{VmSize: &v}
+		if nilStruct.GetVmSize() != nil || emptyStruct.GetVmSize() != nil {
+			t.Error("GetVmSize() expected to return nil")
+		}
+		if fullStruct.GetVmSize() != &v {
+			t.Error("GetVmSize() expected to return field")
+		}
+		if nilStruct.GetVmSizeV() != "" || emptyStruct.GetVmSizeV() != "" {
+			t.Error("GetVmSizeV() expected to return zero value")
+		}
+		if fullStruct.GetVmSizeV() != v {
+			t.Error("GetVmSizeV() expected to return field value")
+		}
+		if nilStruct.GetVmSizeOr(v) != v || emptyStruct.GetVmSizeOr(v) != v {
+			t.Error("GetVmSizeOr() expected to return default value")
+		}
+		if fullStruct.GetVmSizeOr("") != v {
+			t.Error("GetVmSizeOr() expected to return field value")
+		}
+	})
+	t.Run("RsaPublicKeyPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RsaPublicKeyPath: &v}
+		if nilStruct.GetRsaPublicKeyPath() != nil || emptyStruct.GetRsaPublicKeyPath() != nil {
+			t.Error("GetRsaPublicKeyPath() expected to return nil")
+		}
+		if fullStruct.GetRsaPublicKeyPath() != &v {
+			t.Error("GetRsaPublicKeyPath() expected to return field")
+		}
+		if nilStruct.GetRsaPublicKeyPathV() != "" || emptyStruct.GetRsaPublicKeyPathV() != "" {
+			t.Error("GetRsaPublicKeyPathV() expected to return zero value")
+		}
+		if fullStruct.GetRsaPublicKeyPathV() != v {
+			t.Error("GetRsaPublicKeyPathV() expected to return field value")
+		}
+		if nilStruct.GetRsaPublicKeyPathOr(v) != v || emptyStruct.GetRsaPublicKeyPathOr(v) != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return default value")
+		}
+		if fullStruct.GetRsaPublicKeyPathOr("") != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return field value")
+		}
+	})
+}
+
+func TestNsgRule_Accessors(t *testing.T) {
+	var nilStruct *NsgRule
+	emptyStruct := &NsgRule{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NsgRule{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Priority", func(t *testing.T) {
+		v := 1
+		fullStruct := &NsgRule{Priority: &v}
+		if nilStruct.GetPriority() != nil || emptyStruct.GetPriority() != nil {
+			t.Error("GetPriority() expected to return nil")
+		}
+		if fullStruct.GetPriority() != &v {
+			t.Error("GetPriority() expected to return field")
+		}
+		if nilStruct.GetPriorityV() != 0 || emptyStruct.GetPriorityV() != 0 {
+			t.Error("GetPriorityV() expected to return zero value")
+		}
+		if fullStruct.GetPriorityV() != v {
+			t.Error("GetPriorityV() expected to return field value")
+		}
+		if nilStruct.GetPriorityOr(v) != v || emptyStruct.GetPriorityOr(v) != v {
+			t.Error("GetPriorityOr() expected to return default value")
+		}
+		if fullStruct.GetPriorityOr(0) != v {
+			t.Error("GetPriorityOr() expected to return field value")
+		}
+	})
+	t.Run("Direction", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NsgRule{Direction: &v}
+		if nilStruct.GetDirection() != nil || emptyStruct.GetDirection() != nil {
+			t.Error("GetDirection() expected to return nil")
+		}
+		if fullStruct.GetDirection() != &v {
+			t.Error("GetDirection() expected to return field")
+		}
+		if nilStruct.GetDirectionV() != "" || emptyStruct.GetDirectionV() != "" {
+			t.Error("GetDirectionV() expected to return zero value")
+		}
+		if fullStruct.GetDirectionV() != v {
+			t.Error("GetDirectionV() expected to return field value")
+		}
+		if nilStruct.GetDirectionOr(v) != v || emptyStruct.GetDirectionOr(v) != v {
+			t.Error("GetDirectionOr() expected to return default value")
+		}
+		if fullStruct.GetDirectionOr("") != v {
+			t.Error("GetDirectionOr() expected to return field value")
+		}
+	})
+	t.Run("Access", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NsgRule{Access: &v}
+		if nilStruct.GetAccess() != nil || emptyStruct.GetAccess() != nil {
+			t.Error("GetAccess() expected to return nil")
+		}
+		if fullStruct.GetAccess() != &v {
+			t.Error("GetAccess() expected to return field")
+		}
+		if nilStruct.GetAccessV() != "" || emptyStruct.GetAccessV() != "" {
+			t.Error("GetAccessV() expected to return zero value")
+		}
+		if fullStruct.GetAccessV() != v {
+			t.Error("GetAccessV() expected to return field value")
+		}
+		if nilStruct.GetAccessOr(v) != v || emptyStruct.GetAccessOr(v) != v {
+			t.Error("GetAccessOr() expected to return default value")
+		}
+		if fullStruct.GetAccessOr("") != v {
+			t.Error("GetAccessOr() expected to return field value")
+		}
+	})
+	t.Run("Protocol", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NsgRule{Protocol: &v}
+		if nilStruct.GetProtocol() != nil || emptyStruct.GetProtocol() != nil {
+			t.Error("GetProtocol() expected to return nil")
+		}
+		if fullStruct.GetProtocol() != &v {
+			t.Error("GetProtocol() expected to return field")
+		}
+		if nilStruct.GetProtocolV() != "" || emptyStruct.GetProtocolV() != "" {
+			t.Error("GetProtocolV() expected to return zero value")
+		}
+		if fullStruct.GetProtocolV() != v {
+			t.Error("GetProtocolV() expected to return field value")
+		}
+		if nilStruct.GetProtocolOr(v) != v || emptyStruct.GetProtocolOr(v) != v {
+			t.Error("GetProtocolOr() expected to return default value")
+		}
+		if fullStruct.GetProtocolOr("") != v {
+			t.Error("GetProtocolOr() expected to return field value")
+		}
+	})
+	t.Run("SourceAddressPrefixes", func(t *testing.T) {
+		fullStruct := &NsgRule{SourceAddressPrefixes: make([]string, 1)}
+		if nilStruct.GetSourceAddressPrefixes() != nil {
+			t.Error("GetSourceAddressPrefixes() expected to return nil")
+		}
+		if got := emptyStruct.GetSourceAddressPrefixes(); got == nil || len(got) != 0 {
+			t.Error("GetSourceAddressPrefixes() expected to return empty slice")
+		}
+		if got := fullStruct.GetSourceAddressPrefixes(); len(got) != 1 || &got[0] != &fullStruct.SourceAddressPrefixes[0] {
+			t.Error("GetSourceAddressPrefixes() expected to return field")
+		}
+	})
+	t.Run("DestinationPortRange", func(t *testing.T) {
+		v := "value"
+		fullStruct := &NsgRule{DestinationPortRange: &v}
+		if nilStruct.GetDestinationPortRange() != nil || emptyStruct.GetDestinationPortRange() != nil {
+			t.Error("GetDestinationPortRange() expected to return nil")
+		}
+		if fullStruct.GetDestinationPortRange() != &v {
+			t.Error("GetDestinationPortRange() expected to return field")
+		}
+		if nilStruct.GetDestinationPortRangeV() != "" || emptyStruct.GetDestinationPortRangeV() != "" {
+			t.Error("GetDestinationPortRangeV() expected to return zero value")
+		}
+		if fullStruct.GetDestinationPortRangeV() != v {
+			t.Error("GetDestinationPortRangeV() expected to return field value")
+		}
+		if nilStruct.GetDestinationPortRangeOr(v) != v || emptyStruct.GetDestinationPortRangeOr(v) != v {
+			t.Error("GetDestinationPortRangeOr() expected to return default value")
+		}
+		if fullStruct.GetDestinationPortRangeOr("") != v {
+			t.Error("GetDestinationPortRangeOr() expected to return field value")
+		}
+	})
+}
+
+func TestNsg_Accessors(t *testing.T) {
+	var nilStruct *Nsg
+	emptyStruct := &Nsg{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Nsg{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Nsg{SubnetName: &v}
+		if nilStruct.GetSubnetName() != nil || emptyStruct.GetSubnetName() != nil {
+			t.Error("GetSubnetName() expected to return nil")
+		}
+		if fullStruct.GetSubnetName() != &v {
+			t.Error("GetSubnetName() expected to return field")
+		}
+		if nilStruct.GetSubnetNameV() != "" || emptyStruct.GetSubnetNameV() != "" {
+			t.Error("GetSubnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetSubnetNameV() != v {
+			t.Error("GetSubnetNameV() expected to return field value")
+		}
+		if nilStruct.GetSubnetNameOr(v) != v || emptyStruct.GetSubnetNameOr(v) != v {
+			t.Error("GetSubnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetSubnetNameOr("") != v {
+			t.Error("GetSubnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Rules", func(t *testing.T) {
+		fullStruct := &Nsg{Rules: make([]NsgRule, 1)}
+		if nilStruct.GetRules() != nil {
+			t.Error("GetRules() expected to return nil")
+		}
+		if got := emptyStruct.GetRules(); got == nil || len(got) != 0 {
+			t.Error("GetRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetRules(); len(got) != 1 || &got[0] != &fullStruct.Rules[0] {
+			t.Error("GetRules() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("VmName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{VmName: &v}
+		if nilStruct.GetVmName() != nil || emptyStruct.GetVmName() != nil {
+			t.Error("GetVmName() expected to return nil")
+		}
+		if fullStruct.GetVmName() != &v {
+			t.Error("GetVmName() expected to return field")
+		}
+		if nilStruct.GetVmNameV() != "" || emptyStruct.GetVmNameV() != "" {
+			t.Error("GetVmNameV() expected to return zero value")
+		}
+		if fullStruct.GetVmNameV() != v {
+			t.Error("GetVmNameV() expected to return field value")
+		}
+		if nilStruct.GetVmNameOr(v) != v || emptyStruct.GetVmNameOr(v) != v {
+			t.Error("GetVmNameOr() expected to return default value")
+		}
+		if fullStruct.GetVmNameOr("") != v {
+			t.Error("GetVmNameOr() expected to return field value")
+		}
+	})
+	t.Run("PublicIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{PublicIp: &v}
+		if nilStruct.GetPublicIp() != nil || emptyStruct.GetPublicIp() != nil {
+			t.Error("GetPublicIp() expected to return nil")
+		}
+		if fullStruct.GetPublicIp() != &v {
+			t.Error("GetPublicIp() expected to return field")
+		}
+		if nilStruct.GetPublicIpV() != "" || emptyStruct.GetPublicIpV() != "" {
+			t.Error("GetPublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetPublicIpV() != v {
+			t.Error("GetPublicIpV() expected to return field value")
+		}
+		if nilStruct.GetPublicIpOr(v) != v || emptyStruct.GetPublicIpOr(v) != v {
+			t.Error("GetPublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetPublicIpOr("") != v {
+			t.Error("GetPublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("PrivateIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{PrivateIp: &v}
+		if nilStruct.GetPrivateIp() != nil || emptyStruct.GetPrivateIp() != nil {
+			t.Error("GetPrivateIp() expected to return nil")
+		}
+		if fullStruct.GetPrivateIp() != &v {
+			t.Error("GetPrivateIp() expected to return field")
+		}
+		if nilStruct.GetPrivateIpV() != "" || emptyStruct.GetPrivateIpV() != "" {
+			t.Error("GetPrivateIpV() expected to return zero value")
+		}
+		if fullStruct.GetPrivateIpV() != v {
+			t.Error("GetPrivateIpV() expected to return field value")
+		}
+		if nilStruct.GetPrivateIpOr(v) != v || emptyStruct.GetPrivateIpOr(v) != v {
+			t.Error("GetPrivateIpOr() expected to return default value")
+		}
+		if fullStruct.GetPrivateIpOr("") != v {
+			t.Error("GetPrivateIpOr() expected to return field value")
+		}
+	})
+}
@@ -0,0 +1,337 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "bastion"
+	version = "v0.0.1"
+
+	sshPort = 22
+)
+
+type Params struct {
+	Name     *string `json:"name" validate:"required,min=1"`
+	Provider *string `json:"provider" validate:"required,eq=aws|eq=azure"`
+	// SubnetName is name of awsbi public subnet or azbi subnet bastion is created in. Other VMs
+	// accept SSH connections from whole subnet, so it cannot be used by awsbi or azbi VM groups.
+	SubnetName *string `json:"subnet_name" validate:"required,min=1"`
+	// AllowedCidrs are only source ranges SSH connections to bastion are accepted from.
+	AllowedCidrs     []string `json:"allowed_cidrs" validate:"required,min=1,unique,dive,required,cidr"`
+	VmSize           *string  `json:"vm_size" validate:"required,min=1"`
+	RsaPublicKeyPath *string  `json:"rsa_pub_path" validate:"required,min=1"`
+}
+
+// BastionSecurityGroupName returns name of security group attached to bastion.
+func (p *Params) BastionSecurityGroupName() string {
+	return p.GetNameV() + "-bastion"
+}
+
+// PrivateSecurityGroupName returns name of security group attached to VMs reachable only through
+// bastion.
+func (p *Params) PrivateSecurityGroupName() string {
+	return p.GetNameV() + "-from-bastion"
+}
+
+// AwsSecurityGroups returns awsbi security groups allowing SSH to bastion only from AllowedCidrs
+// and to other VMs only from bastion subnet. Bastion subnet has to be public subnet of c not used
+// by any of its VM groups.
+func (p *Params) AwsSecurityGroups(c *awsbi.Params) ([]awsbi.SecurityGroup, error) {
+	if p == nil || c == nil {
+		return nil, errors.New("bastion or awsbi params are nil")
+	}
+	var bastionCidr string
+	for _, s := range c.GetSubnets().Public {
+		if s.GetNameV() == p.GetSubnetNameV() {
+			bastionCidr = s.GetAddressPrefixesV()
+		}
+	}
+	if bastionCidr == "" {
+		return nil, fmt.Errorf("public subnet %s not found in awsbi params", p.GetSubnetNameV())
+	}
+	for _, g := range c.VmGroups {
+		for _, n := range g.SubnetNames {
+			if n == p.GetSubnetNameV() {
+				return nil, fmt.Errorf("bastion subnet %s is used by awsbi VM group %s", n, g.GetNameV())
+			}
+		}
+	}
+	return []awsbi.SecurityGroup{
+		{
+			Name: to.StrPtr(p.BastionSecurityGroupName()),
+			Rules: &awsbi.Rules{
+				Ingress: []awsbi.SecurityRule{sshRule(p.AllowedCidrs...)},
+				Egress:  []awsbi.SecurityRule{sshRule(c.GetVpcAddressSpaceV())},
+			},
+		},
+		{
+			Name: to.StrPtr(p.PrivateSecurityGroupName()),
+			Rules: &awsbi.Rules{
+				Ingress: []awsbi.SecurityRule{sshRule(bastionCidr)},
+			},
+		},
+	}, nil
+}
+
+func sshRule(cidrs ...string) awsbi.SecurityRule {
+	return awsbi.SecurityRule{
+		Protocol:   to.StrPtr("tcp"),
+		FromPort:   to.IntPtr(sshPort),
+		ToPort:     to.IntPtr(sshPort),
+		CidrBlocks: append([]string{}, cidrs...),
+	}
+}
+
+// AddToAwsBI adds security groups returned by AwsSecurityGroups to c, replacing groups with the
+// same names, and attaches private security group to VM groups without public IPs.
+func (p *Params) AddToAwsBI(c *awsbi.Params) error {
+	groups, err := p.AwsSecurityGroups(c)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups {
+		replaced := false
+		for i, existing := range c.SecurityGroups {
+			if existing.GetNameV() == g.GetNameV() {
+				c.SecurityGroups[i] = g
+				replaced = true
+			}
+		}
+		if !replaced {
+			c.SecurityGroups = append(c.SecurityGroups, g)
+		}
+	}
+	for i, g := range c.VmGroups {
+		if g.UsePublicIp == nil || *g.UsePublicIp {
+			continue
+		}
+		attached := false
+		for _, n := range g.SecurityGroupNames {
+			attached = attached || n == p.PrivateSecurityGroupName()
+		}
+		if !attached {
+			c.VmGroups[i].SecurityGroupNames = append(c.VmGroups[i].SecurityGroupNames, p.PrivateSecurityGroupName())
+		}
+	}
+	return nil
+}
+
+// NsgRule is Azure network security rule. It is defined here as azbi does not describe network
+// security groups. "*" in SourceAddressPrefixes means any source.
+type NsgRule struct {
+	Name                  *string  `json:"name" validate:"required,min=1"`
+	Priority              *int     `json:"priority" validate:"required,min=100,max=4096"`
+	Direction             *string  `json:"direction" validate:"required,eq=Inbound|eq=Outbound"`
+	Access                *string  `json:"access" validate:"required,eq=Allow|eq=Deny"`
+	Protocol              *string  `json:"protocol" validate:"required,eq=Tcp|eq=Udp|eq=Icmp|eq=*"`
+	SourceAddressPrefixes []string `json:"source_address_prefixes" validate:"required,min=1,dive,required"`
+	DestinationPortRange  *string  `json:"destination_port_range" validate:"required,min=1"`
+}
+
+// Nsg is Azure network security group associated with azbi subnet.
+type Nsg struct {
+	Name       *string   `json:"name" validate:"required,min=1"`
+	SubnetName *string   `json:"subnet_name" validate:"required,min=1"`
+	Rules      []NsgRule `json:"rules" validate:"required,min=1,dive"`
+}
+
+// AzureNsgs returns network security groups allowing SSH to bastion subnet only from AllowedCidrs
+// and to subnets of VM groups without public IPs only from bastion subnet. Bastion subnet has to
+// be subnet of c not used by any of its VM groups.
+func (p *Params) AzureNsgs(c *azbi.Params) ([]Nsg, error) {
+	if p == nil || c == nil {
+		return nil, errors.New("bastion or azbi params are nil")
+	}
+	var bastionPrefixes []string
+	for _, s := range c.Subnets {
+		if s.GetNameV() == p.GetSubnetNameV() {
+			bastionPrefixes = s.AddressPrefixes
+		}
+	}
+	if len(bastionPrefixes) == 0 {
+		return nil, fmt.Errorf("subnet %s not found in azbi params", p.GetSubnetNameV())
+	}
+	for _, g := range c.VmGroups {
+		for _, n := range g.SubnetNames {
+			if n == p.GetSubnetNameV() {
+				return nil, fmt.Errorf("bastion subnet %s is used by azbi VM group %s", n, g.GetNameV())
+			}
+		}
+	}
+	result := []Nsg{
+		{
+			Name:       to.StrPtr(p.BastionSecurityGroupName()),
+			SubnetName: to.StrPtr(p.GetSubnetNameV()),
+			Rules: []NsgRule{
+				nsgSshRule("allow-ssh", 100, "Allow", p.AllowedCidrs),
+				nsgSshRule("deny-ssh", 4096, "Deny", []string{"*"}),
+			},
+		},
+	}
+	private := make(map[string]bool)
+	for _, g := range c.VmGroups {
+		if g.UsePublicIP == nil || *g.UsePublicIP {
+			continue
+		}
+		for _, n := range g.SubnetNames {
+			if private[n] {
+				continue
+			}
+			private[n] = true
+			result = append(result, Nsg{
+				Name:       to.StrPtr(p.PrivateSecurityGroupName() + "-" + n),
+				SubnetName: to.StrPtr(n),
+				Rules: []NsgRule{
+					nsgSshRule("allow-ssh-from-bastion", 100, "Allow", bastionPrefixes),
+					nsgSshRule("deny-ssh", 4096, "Deny", []string{"*"}),
+				},
+			})
+		}
+	}
+	return result, nil
+}
+
+func nsgSshRule(name string, priority int, access string, sources []string) NsgRule {
+	return NsgRule{
+		Name:                  to.StrPtr(name),
+		Priority:              to.IntPtr(priority),
+		Direction:             to.StrPtr("Inbound"),
+		Access:                to.StrPtr(access),
+		Protocol:              to.StrPtr("Tcp"),
+		SourceAddressPrefixes: append([]string{}, sources...),
+		DestinationPortRange:  to.StrPtr(fmt.Sprintf("%d", sshPort)),
+	}
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=bastion"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:             to.StrPtr("epiphany"),
+			Provider:         to.StrPtr("aws"),
+			SubnetName:       to.StrPtr("first_public_subnet"),
+			AllowedCidrs:     []string{"10.0.0.0/8"},
+			VmSize:           to.StrPtr("t3.micro"),
+			RsaPublicKeyPath: to.StrPtr("/shared/vms_rsa.pub"),
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("bastion config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+type Output struct {
+	VmName    *string `json:"vm_name" validate:"required,min=1"`
+	PublicIp  *string `json:"public_ip" validate:"required,ip"`
+	PrivateIp *string `json:"private_ip" validate:"required,ip"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
@@ -0,0 +1,453 @@
+package v0
+
+import (
+	"testing"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "bastion",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"subnet_name": "bastion",
+		"allowed_cidrs": ["203.0.113.0/24", "198.51.100.7/32"],
+		"vm_size": "Standard_B1s",
+		"rsa_pub_path": "/shared/vms_rsa.pub"
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("bastion"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:             to.StrPtr("epiphany"),
+					Provider:         to.StrPtr("azure"),
+					SubnetName:       to.StrPtr("bastion"),
+					AllowedCidrs:     []string{"203.0.113.0/24", "198.51.100.7/32"},
+					VmSize:           to.StrPtr("Standard_B1s"),
+					RsaPublicKeyPath: to.StrPtr("/shared/vms_rsa.pub"),
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "bastion",
+	"version": "v0.0.1",
+	"extra_outer_field": "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"provider": "aws",
+		"subnet_name": "first_public_subnet",
+		"allowed_cidrs": ["203.0.113.0/24"],
+		"vm_size": "t3.micro",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"extra_inner_field": "extra_inner_value"
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("bastion"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:             to.StrPtr("epiphany"),
+					Provider:         to.StrPtr("aws"),
+					SubnetName:       to.StrPtr("first_public_subnet"),
+					AllowedCidrs:     []string{"203.0.113.0/24"},
+					VmSize:           to.StrPtr("t3.micro"),
+					RsaPublicKeyPath: to.StrPtr("/shared/vms_rsa.pub"),
+				},
+				Unused: []string{"extra_outer_field", "params.extra_inner_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "awsbi",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"provider": "aws",
+		"subnet_name": "first_public_subnet",
+		"allowed_cidrs": ["203.0.113.0/24"],
+		"vm_size": "t3.micro",
+		"rsa_pub_path": "/shared/vms_rsa.pub"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "bastion",
+	"version": "v0.0.1",
+	"params": {
+
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Provider",
+					Field: "Provider",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.SubnetName",
+					Field: "SubnetName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AllowedCidrs",
+					Field: "AllowedCidrs",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmSize",
+					Field: "VmSize",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.RsaPublicKeyPath",
+					Field: "RsaPublicKeyPath",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect params values",
+			json: []byte(`{
+	"kind": "bastion",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "gcp",
+		"subnet_name": "",
+		"allowed_cidrs": ["203.0.113.0/24", "203.0.113.7", ""],
+		"vm_size": "",
+		"rsa_pub_path": "/shared/vms_rsa.pub"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Provider",
+					Field: "Provider",
+					Tag:   "eq=aws|eq=azure",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.SubnetName",
+					Field: "SubnetName",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AllowedCidrs[1]",
+					Field: "AllowedCidrs[1]",
+					Tag:   "cidr",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AllowedCidrs[2]",
+					Field: "AllowedCidrs[2]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmSize",
+					Field: "VmSize",
+					Tag:   "min",
+				},
+			},
+		},
+		{
+			name: "empty allowed cidrs",
+			json: []byte(`{
+	"kind": "bastion",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "aws",
+		"subnet_name": "first_public_subnet",
+		"allowed_cidrs": [],
+		"vm_size": "t3.micro",
+		"rsa_pub_path": "/shared/vms_rsa.pub"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.AllowedCidrs",
+					Field: "AllowedCidrs",
+					Tag:   "min",
+				},
+			},
+		},
+		{
+			name: "duplicated allowed cidrs",
+			json: []byte(`{
+	"kind": "bastion",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "aws",
+		"subnet_name": "first_public_subnet",
+		"allowed_cidrs": ["203.0.113.0/24", "203.0.113.0/24"],
+		"vm_size": "t3.micro",
+		"rsa_pub_path": "/shared/vms_rsa.pub"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.AllowedCidrs",
+					Field: "AllowedCidrs",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParams_AddToAwsBI(t *testing.T) {
+	p := NewConfig().Params
+	c := awsbi.NewConfig().Params
+	c.VmGroups[0].UsePublicIp = to.BooPtr(false)
+	c.VmGroups = append(c.VmGroups, awsbi.VmGroup{
+		Name:        to.StrPtr("public-group"),
+		UsePublicIp: to.BooPtr(true),
+	})
+	existing := len(c.SecurityGroups)
+	for i := 0; i < 2; i++ {
+		if err := p.AddToAwsBI(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := len(c.SecurityGroups); got != existing+2 {
+		t.Errorf("AddToAwsBI() resulted in %d security groups, want %d", got, existing+2)
+	}
+	wantGroups := []awsbi.SecurityGroup{
+		{
+			Name: to.StrPtr("epiphany-bastion"),
+			Rules: &awsbi.Rules{
+				Ingress: []awsbi.SecurityRule{
+					{
+						Protocol:   to.StrPtr("tcp"),
+						FromPort:   to.IntPtr(22),
+						ToPort:     to.IntPtr(22),
+						CidrBlocks: []string{"10.0.0.0/8"},
+					},
+				},
+				Egress: []awsbi.SecurityRule{
+					{
+						Protocol:   to.StrPtr("tcp"),
+						FromPort:   to.IntPtr(22),
+						ToPort:     to.IntPtr(22),
+						CidrBlocks: []string{"10.1.0.0/20"},
+					},
+				},
+			},
+		},
+		{
+			Name: to.StrPtr("epiphany-from-bastion"),
+			Rules: &awsbi.Rules{
+				Ingress: []awsbi.SecurityRule{
+					{
+						Protocol:   to.StrPtr("tcp"),
+						FromPort:   to.IntPtr(22),
+						ToPort:     to.IntPtr(22),
+						CidrBlocks: []string{"10.1.2.0/24"},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(wantGroups, c.SecurityGroups[existing:]); diff != "" {
+		t.Errorf("AddToAwsBI() security groups mismatch (-want +got):\n%s", diff)
+	}
+	if got := c.VmGroups[0].SecurityGroupNames; got[len(got)-1] != "epiphany-from-bastion" || len(got) != len(awsbi.NewConfig().Params.VmGroups[0].SecurityGroupNames)+1 {
+		t.Errorf("AddToAwsBI() private VM group security groups = %v", got)
+	}
+	if got := c.VmGroups[1].SecurityGroupNames; len(got) != 0 {
+		t.Errorf("AddToAwsBI() public VM group security groups = %v, want none", got)
+	}
+	p.SubnetName = to.StrPtr("first_private_subnet")
+	if err := p.AddToAwsBI(c); err == nil {
+		t.Error("AddToAwsBI() expected to fail for bastion in private subnet")
+	}
+	p.SubnetName = to.StrPtr("first_public_subnet")
+	c.VmGroups[1].SubnetNames = []string{"first_public_subnet"}
+	if err := p.AddToAwsBI(c); err == nil {
+		t.Error("AddToAwsBI() expected to fail for bastion subnet used by VM group")
+	}
+}
+
+func TestParams_AzureNsgs(t *testing.T) {
+	p := NewConfig().Params
+	p.Provider = to.StrPtr("azure")
+	p.SubnetName = to.StrPtr("bastion")
+	c := &azbi.Params{
+		Subnets: []azbi.Subnet{
+			{
+				Name:            to.StrPtr("bastion"),
+				AddressPrefixes: []string{"10.0.0.0/28"},
+			},
+			{
+				Name:            to.StrPtr("main"),
+				AddressPrefixes: []string{"10.0.1.0/24"},
+			},
+		},
+		VmGroups: []azbi.VmGroup{
+			{
+				Name:        to.StrPtr("private"),
+				UsePublicIP: to.BooPtr(false),
+				SubnetNames: []string{"main"},
+			},
+			{
+				Name:        to.StrPtr("other-private"),
+				UsePublicIP: to.BooPtr(false),
+				SubnetNames: []string{"main"},
+			},
+		},
+	}
+	got, err := p.AzureNsgs(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Nsg{
+		{
+			Name:       to.StrPtr("epiphany-bastion"),
+			SubnetName: to.StrPtr("bastion"),
+			Rules: []NsgRule{
+				nsgSshRule("allow-ssh", 100, "Allow", []string{"10.0.0.0/8"}),
+				nsgSshRule("deny-ssh", 4096, "Deny", []string{"*"}),
+			},
+		},
+		{
+			Name:       to.StrPtr("epiphany-from-bastion-main"),
+			SubnetName: to.StrPtr("main"),
+			Rules: []NsgRule{
+				nsgSshRule("allow-ssh-from-bastion", 100, "Allow", []string{"10.0.0.0/28"}),
+				nsgSshRule("deny-ssh", 4096, "Deny", []string{"*"}),
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("AzureNsgs() mismatch (-want +got):\n%s", diff)
+	}
+	p.SubnetName = to.StrPtr("unknown")
+	if _, err = p.AzureNsgs(c); err == nil {
+		t.Error("AzureNsgs() expected to fail for unknown subnet")
+	}
+	p.SubnetName = to.StrPtr("bastion")
+	c.VmGroups[1].SubnetNames = []string{"main", "bastion"}
+	if _, err = p.AzureNsgs(c); err == nil {
+		t.Error("AzureNsgs() expected to fail for bastion subnet used by VM group")
+	}
+}
@@ -0,0 +1,265 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Provider != nil {
+		x := *p.Provider
+		out.Provider = &x
+	}
+	if p.SubnetName != nil {
+		x := *p.SubnetName
+		out.SubnetName = &x
+	}
+	if p.AllowedCidrs != nil {
+		out.AllowedCidrs = make([]string, len(p.AllowedCidrs))
+		copy(out.AllowedCidrs, p.AllowedCidrs)
+	}
+	if p.VmSize != nil {
+		x := *p.VmSize
+		out.VmSize = &x
+	}
+	if p.RsaPublicKeyPath != nil {
+		x := *p.RsaPublicKeyPath
+		out.RsaPublicKeyPath = &x
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Provider == nil) != (other.Provider == nil) || p.Provider != nil && *p.Provider != *other.Provider {
+		return false
+	}
+	if (p.SubnetName == nil) != (other.SubnetName == nil) || p.SubnetName != nil && *p.SubnetName != *other.SubnetName {
+		return false
+	}
+	if (p.AllowedCidrs == nil) != (other.AllowedCidrs == nil) || len(p.AllowedCidrs) != len(other.AllowedCidrs) {
+		return false
+	}
+	for i := range p.AllowedCidrs {
+		if p.AllowedCidrs[i] != other.AllowedCidrs[i] {
+			return false
+		}
+	}
+	if (p.VmSize == nil) != (other.VmSize == nil) || p.VmSize != nil && *p.VmSize != *other.VmSize {
+		return false
+	}
+	if (p.RsaPublicKeyPath == nil) != (other.RsaPublicKeyPath == nil) || p.RsaPublicKeyPath != nil && *p.RsaPublicKeyPath != *other.RsaPublicKeyPath {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of NsgRule or nil if NsgRule is nil.
+func (n *NsgRule) DeepCopy() *NsgRule {
+	if n == nil {
+		return nil
+	}
+	out := new(NsgRule)
+	if n.Name != nil {
+		x := *n.Name
+		out.Name = &x
+	}
+	if n.Priority != nil {
+		x := *n.Priority
+		out.Priority = &x
+	}
+	if n.Direction != nil {
+		x := *n.Direction
+		out.Direction = &x
+	}
+	if n.Access != nil {
+		x := *n.Access
+		out.Access = &x
+	}
+	if n.Protocol != nil {
+		x := *n.Protocol
+		out.Protocol = &x
+	}
+	if n.SourceAddressPrefixes != nil {
+		out.SourceAddressPrefixes = make([]string, len(n.SourceAddressPrefixes))
+		copy(out.SourceAddressPrefixes, n.SourceAddressPrefixes)
+	}
+	if n.DestinationPortRange != nil {
+		x := *n.DestinationPortRange
+		out.DestinationPortRange = &x
+	}
+	return out
+}
+
+// Equal reports whether NsgRule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (n *NsgRule) Equal(other *NsgRule) bool {
+	if n == nil || other == nil {
+		return n == other
+	}
+	if (n.Name == nil) != (other.Name == nil) || n.Name != nil && *n.Name != *other.Name {
+		return false
+	}
+	if (n.Priority == nil) != (other.Priority == nil) || n.Priority != nil && *n.Priority != *other.Priority {
+		return false
+	}
+	if (n.Direction == nil) != (other.Direction == nil) || n.Direction != nil && *n.Direction != *other.Direction {
+		return false
+	}
+	if (n.Access == nil) != (other.Access == nil) || n.Access != nil && *n.Access != *other.Access {
+		return false
+	}
+	if (n.Protocol == nil) != (other.Protocol == nil) || n.Protocol != nil && *n.Protocol != *other.Protocol {
+		return false
+	}
+	if (n.SourceAddressPrefixes == nil) != (other.SourceAddressPrefixes == nil) || len(n.SourceAddressPrefixes) != len(other.SourceAddressPrefixes) {
+		return false
+	}
+	for i := range n.SourceAddressPrefixes {
+		if n.SourceAddressPrefixes[i] != other.SourceAddressPrefixes[i] {
+			return false
+		}
+	}
+	if (n.DestinationPortRange == nil) != (other.DestinationPortRange == nil) || n.DestinationPortRange != nil && *n.DestinationPortRange != *other.DestinationPortRange {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Nsg or nil if Nsg is nil.
+func (n *Nsg) DeepCopy() *Nsg {
+	if n == nil {
+		return nil
+	}
+	out := new(Nsg)
+	if n.Name != nil {
+		x := *n.Name
+		out.Name = &x
+	}
+	if n.SubnetName != nil {
+		x := *n.SubnetName
+		out.SubnetName = &x
+	}
+	if n.Rules != nil {
+		out.Rules = make([]NsgRule, len(n.Rules))
+		for i := range n.Rules {
+			out.Rules[i] = *n.Rules[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Nsg and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (n *Nsg) Equal(other *Nsg) bool {
+	if n == nil || other == nil {
+		return n == other
+	}
+	if (n.Name == nil) != (other.Name == nil) || n.Name != nil && *n.Name != *other.Name {
+		return false
+	}
+	if (n.SubnetName == nil) != (other.SubnetName == nil) || n.SubnetName != nil && *n.SubnetName != *other.SubnetName {
+		return false
+	}
+	if (n.Rules == nil) != (other.Rules == nil) || len(n.Rules) != len(other.Rules) {
+		return false
+	}
+	for i := range n.Rules {
+		if !n.Rules[i].Equal(&other.Rules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.VmName != nil {
+		x := *o.VmName
+		out.VmName = &x
+	}
+	if o.PublicIp != nil {
+		x := *o.PublicIp
+		out.PublicIp = &x
+	}
+	if o.PrivateIp != nil {
+		x := *o.PrivateIp
+		out.PrivateIp = &x
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.VmName == nil) != (other.VmName == nil) || o.VmName != nil && *o.VmName != *other.VmName {
+		return false
+	}
+	if (o.PublicIp == nil) != (other.PublicIp == nil) || o.PublicIp != nil && *o.PublicIp != *other.PublicIp {
+		return false
+	}
+	if (o.PrivateIp == nil) != (other.PrivateIp == nil) || o.PrivateIp != nil && *o.PrivateIp != *other.PrivateIp {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,352 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	st "github.com/epiphany-platform/e-structures/state/v0"
+	"github.com/epiphany-platform/e-structures/utils/kinds"
+	"github.com/epiphany-platform/e-structures/utils/position"
+)
+
+func newFlagSet(name string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	return fs
+}
+
+// parse parses flags and ensures that exactly one positional argument was provided.
+func parse(fs *flag.FlagSet, args []string, stderr io.Writer) (string, bool) {
+	if err := fs.Parse(args); err != nil {
+		return "", false
+	}
+	if fs.NArg() != 1 {
+		fmt.Fprintf(stderr, "%s: expected exactly one argument, got %d\n", fs.Name(), fs.NArg())
+		return "", false
+	}
+	return fs.Arg(0), true
+}
+
+func runInit(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("init", stderr)
+	output := fs.String("o", "", "write document to `file` instead of standard output")
+	name, ok := parse(fs, args, stderr)
+	if !ok {
+		return exitUsage
+	}
+	k, err := kinds.Get(name)
+	if err != nil {
+		fmt.Fprintf(stderr, "init: %v\n", err)
+		return exitUsage
+	}
+	b, err := k.Default().Marshal()
+	if err != nil {
+		fmt.Fprintf(stderr, "init: %v\n", err)
+		return exitInvalid
+	}
+	return writeOutput("init", *output, b, stdout, stderr)
+}
+
+// validationEntry is JSON representation of single validation problem.
+type validationEntry struct {
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Message string `json:"message"`
+}
+
+// validationResult is JSON representation of validate command result.
+type validationResult struct {
+	File   string            `json:"file"`
+	Kind   string            `json:"kind,omitempty"`
+	Valid  bool              `json:"valid"`
+	Errors []validationEntry `json:"errors"`
+}
+
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("validate", stderr)
+	strictMode := fs.Bool("strict", false, "treat unknown keys as errors")
+	format := fs.String("format", "text", "output `format`: text or json")
+	path, ok := parse(fs, args, stderr)
+	if !ok || !checkFormat(fs.Name(), *format, stderr) {
+		return exitUsage
+	}
+	b, err := readInput(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "validate: %v\n", err)
+		return exitUsage
+	}
+
+	result := validationResult{
+		File:   path,
+		Valid:  true,
+		Errors: []validationEntry{},
+	}
+	k, d, err := decode(path, b, *strictMode)
+	result.Kind = k.Name
+	if err != nil {
+		result.Valid = false
+		result.Errors = entries(err)
+	}
+
+	if *format == "json" {
+		printJSON(stdout, result)
+	} else if result.Valid {
+		fmt.Fprintf(stdout, "%s: valid %s document\n", path, k.Name)
+	} else {
+		for _, e := range result.Errors {
+			if e.Line > 0 {
+				fmt.Fprintf(stdout, "%s:%d:%d: %s\n", path, e.Line, e.Column, e.Message)
+			} else {
+				fmt.Fprintf(stdout, "%s: %s\n", path, e.Message)
+			}
+		}
+	}
+	if !result.Valid || d == nil {
+		return exitInvalid
+	}
+	return exitOk
+}
+
+func runFmt(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("fmt", stderr)
+	write := fs.Bool("w", false, "write result to source file instead of standard output")
+	path, ok := parse(fs, args, stderr)
+	if !ok {
+		return exitUsage
+	}
+	b, err := readInput(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "fmt: %v\n", err)
+		return exitUsage
+	}
+	// strict mode is used because unknown keys would be silently dropped from formatted document
+	_, d, err := decode(path, b, true)
+	if err != nil {
+		fmt.Fprintf(stderr, "fmt: %v\n", err)
+		return exitInvalid
+	}
+	out, err := d.Marshal()
+	if err != nil {
+		fmt.Fprintf(stderr, "fmt: %v\n", err)
+		return exitInvalid
+	}
+	if *write {
+		if path == "-" {
+			fmt.Fprintln(stderr, "fmt: cannot write document read from standard input")
+			return exitUsage
+		}
+		return writeOutput("fmt", path, out, stdout, stderr)
+	}
+	return writeOutput("fmt", "", out, stdout, stderr)
+}
+
+func runShowState(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("show-state", stderr)
+	path, ok := parse(fs, args, stderr)
+	if !ok {
+		return exitUsage
+	}
+	b, err := readInput(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "show-state: %v\n", err)
+		return exitUsage
+	}
+	s := &st.State{}
+	if err = position.Annotate(path, b, s, s.Unmarshal(b)); err != nil {
+		fmt.Fprintf(stderr, "show-state: %v\n", err)
+		return exitInvalid
+	}
+
+	fmt.Fprintf(stdout, "State version: %s\n\n", s.GetVersionV())
+	tw := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tSTATUS\tVERSION\tCHANGED\tOUTPUT")
+	rows := 0
+	row := func(module string, status st.Status, version string, changed func() (bool, error), output string) {
+		c := "-"
+		if ch, err := changed(); err == nil {
+			c = yesNo(ch)
+		}
+		if version == "" {
+			version = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", module, status, version, c, output)
+		rows++
+	}
+	if m := s.GetAzBI(); m != nil {
+		row("azbi", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetAzKS(); m != nil {
+		row("azks", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetAwsBI(); m != nil {
+		row("awsbi", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetAwsKS(); m != nil {
+		row("awsks", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetGcpBI(); m != nil {
+		row("gcpbi", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetAzPG(); m != nil {
+		row("azpg", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetAzStorage(); m != nil {
+		row("azstorage", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetAzLB(); m != nil {
+		row("azlb", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetDns(); m != nil {
+		row("dns", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetAzKV(); m != nil {
+		row("azkv", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetK8sAddons(); m != nil {
+		row("k8saddons", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetMonitoring(); m != nil {
+		row("monitoring", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetBastion(); m != nil {
+		row("bastion", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), yesNo(m.Output != nil))
+	}
+	if m := s.GetHi(); m != nil {
+		row("hi", m.Status, m.GetConfig().GetVersionV(), changed(m.Config, m.AppliedFingerprint), "-")
+	}
+	if rows == 0 {
+		fmt.Fprintln(stdout, "No modules recorded in state.")
+		return exitOk
+	}
+	_ = tw.Flush()
+	return exitOk
+}
+
+// changed returns function reporting config changes, failing if no fingerprint was ever recorded.
+func changed(config st.Fingerprinter, fingerprint *string) func() (bool, error) {
+	return func() (bool, error) {
+		if fingerprint == nil {
+			return false, errors.New("no applied fingerprint")
+		}
+		return st.ConfigChanged(config, fingerprint)
+	}
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+// decode detects kind of document and unmarshals it. Returned error contains source positions
+// if they could be determined.
+func decode(path string, b []byte, strictMode bool) (kinds.Kind, kinds.Document, error) {
+	k, err := kinds.Detect(b)
+	if err != nil {
+		return k, nil, position.Annotate(path, b, nil, err)
+	}
+	d := k.New()
+	if strictMode {
+		err = d.UnmarshalStrict(b)
+	} else {
+		err = d.Unmarshal(b)
+	}
+	if err != nil {
+		return k, nil, position.Annotate(path, b, d, err)
+	}
+	return k, d, nil
+}
+
+func entries(err error) []validationEntry {
+	var perr *position.Error
+	if !errors.As(err, &perr) {
+		return []validationEntry{{Message: err.Error()}}
+	}
+	result := make([]validationEntry, 0, len(perr.Entries))
+	for _, e := range perr.Entries {
+		result = append(result, validationEntry{
+			Line:    e.Line,
+			Column:  e.Column,
+			Key:     e.Key,
+			Message: e.Message,
+		})
+	}
+	return result
+}
+
+// readInput reads file from path or standard input if path is "-".
+func readInput(path string) ([]byte, error) {
+	if path == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	return ioutil.ReadFile(path)
+}
+
+// writeOutput writes b to path or to stdout if path is empty.
+func writeOutput(name, path string, b []byte, stdout, stderr io.Writer) int {
+	if path == "" {
+		fmt.Fprintln(stdout, string(b))
+		return exitOk
+	}
+	if err := writeFile(path, append(b, '\n')); err != nil {
+		fmt.Fprintf(stderr, "%s: %v\n", name, err)
+		return exitInvalid
+	}
+	return exitOk
+}
+
+// writeFile replaces content of file at path atomically, so file is never left partially written.
+// Permissions of existing file are preserved.
+func writeFile(path string, b []byte) (err error) {
+	mode := os.FileMode(0644)
+	if fi, err := os.Stat(path); err == nil {
+		mode = fi.Mode().Perm()
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = f.Close()
+			_ = os.Remove(f.Name())
+		}
+	}()
+	if _, err = f.Write(b); err != nil {
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		return err
+	}
+	if err = f.Chmod(mode); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func checkFormat(name, format string, stderr io.Writer) bool {
+	if format != "text" && format != "json" {
+		fmt.Fprintf(stderr, "%s: unknown format '%s'\n", name, format)
+		return false
+	}
+	return true
+}
+
+func printJSON(w io.Writer, v interface{}) {
+	e := json.NewEncoder(w)
+	e.SetEscapeHTML(false)
+	e.SetIndent("", "\t")
+	_ = e.Encode(v)
+}
@@ -0,0 +1,116 @@
+// Command e-structures validates, initializes and inspects documents (module configs and state)
+// defined in this repository.
+//
+// Usage:
+//
+//	e-structures init [-o file] <kind>
+//	e-structures validate [-strict] [-format text|json] <file>
+//	e-structures fmt [-w] <file>
+//	e-structures show-state <file>
+//	e-structures upgrade [-to version] [-dry-run] [-format text|json] <file>
+//	e-structures diff [-format text|json] <a> <b>
+//
+// File "-" means standard input. Exit code is 0 on success, 1 if document is invalid (or
+// documents differ in case of diff) and 2 if command was used incorrectly or input could not
+// be read. Files are always written atomically.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/epiphany-platform/e-structures/utils/kinds"
+)
+
+const (
+	exitOk      = 0
+	exitInvalid = 1
+	exitUsage   = 2
+)
+
+type command struct {
+	name        string
+	usage       string
+	description string
+	run         func(args []string, stdout, stderr io.Writer) int
+}
+
+var commands []command
+
+func init() {
+	commands = []command{
+		{
+			name:        "init",
+			usage:       "init [-o file] <kind>",
+			description: "prints or writes document of provided kind with default values",
+			run:         runInit,
+		},
+		{
+			name:        "validate",
+			usage:       "validate [-strict] [-format text|json] <file>",
+			description: "validates document, detecting its kind automatically",
+			run:         runValidate,
+		},
+		{
+			name:        "fmt",
+			usage:       "fmt [-w] <file>",
+			description: "prints or rewrites document in canonical form",
+			run:         runFmt,
+		},
+		{
+			name:        "show-state",
+			usage:       "show-state <file>",
+			description: "prints summary of modules recorded in state file",
+			run:         runShowState,
+		},
+		{
+			name:        "upgrade",
+			usage:       "upgrade [-to version] [-dry-run] [-format text|json] <file>",
+			description: "upgrades document to current or provided version",
+			run:         runUpgrade,
+		},
+		{
+			name:        "diff",
+			usage:       "diff [-format text|json] <a> <b>",
+			description: "prints structural differences between documents, hiding sensitive values",
+			run:         runDiff,
+		},
+	}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+	switch args[0] {
+	case "help", "-h", "-help", "--help":
+		usage(stdout)
+		return exitOk
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			return c.run(args[1:], stdout, stderr)
+		}
+	}
+	fmt.Fprintf(stderr, "unknown command '%s'\n\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: e-structures <command> [arguments]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-60s %s\n", c.usage, c.description)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Known kinds: %s\n", strings.Join(kinds.Names(), ", "))
+}
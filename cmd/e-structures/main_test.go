@@ -0,0 +1,168 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{name: "no-args", args: []string{}},
+		{name: "help", args: []string{"help"}},
+		{name: "unknown-command", args: []string{"unknown"}},
+		{name: "init-azbi", args: []string{"init", "azbi"}},
+		{name: "init-state", args: []string{"init", "state"}},
+		{name: "init-unknown-kind", args: []string{"init", "unknown"}},
+		{name: "init-no-kind", args: []string{"init"}},
+		{name: "validate-valid", args: []string{"validate", "testdata/azbi-valid.json"}},
+		{name: "validate-valid-json", args: []string{"validate", "-format", "json", "testdata/azbi-valid.json"}},
+		{name: "validate-invalid", args: []string{"validate", "testdata/azbi-invalid.json"}},
+		{name: "validate-invalid-json", args: []string{"validate", "-format", "json", "testdata/azbi-invalid.json"}},
+		{name: "validate-typo", args: []string{"validate", "testdata/azbi-typo.json"}},
+		{name: "validate-typo-strict", args: []string{"validate", "-strict", "testdata/azbi-typo.json"}},
+		{name: "validate-syntax-error", args: []string{"validate", "testdata/syntax-error.json"}},
+		{name: "validate-unknown-kind", args: []string{"validate", "testdata/unknown-kind.json"}},
+		{name: "validate-state", args: []string{"validate", "testdata/state.json"}},
+		{name: "validate-missing-file", args: []string{"validate", "testdata/missing.json"}},
+		{name: "validate-unknown-format", args: []string{"validate", "-format", "yaml", "testdata/azbi-valid.json"}},
+		{name: "fmt-unformatted", args: []string{"fmt", "testdata/azbi-unformatted.json"}},
+		{name: "fmt-typo", args: []string{"fmt", "testdata/azbi-typo.json"}},
+		{name: "show-state", args: []string{"show-state", "testdata/state.json"}},
+		{name: "show-state-changed", args: []string{"show-state", "testdata/state-changed.json"}},
+		{name: "show-state-empty", args: []string{"show-state", "testdata/state-empty.json"}},
+		{name: "show-state-not-state", args: []string{"show-state", "testdata/azbi-valid.json"}},
+		{name: "upgrade-dry-run", args: []string{"upgrade", "-dry-run", "testdata/azbi-old.json"}},
+		{name: "upgrade-dry-run-to", args: []string{"upgrade", "-dry-run", "-to", "v0.1.2", "testdata/azbi-old.json"}},
+		{name: "upgrade-dry-run-state-json", args: []string{"upgrade", "-dry-run", "-format", "json", "testdata/state-old.json"}},
+		{name: "upgrade-current", args: []string{"upgrade", "testdata/azbi-valid.json"}},
+		{name: "upgrade-downgrade", args: []string{"upgrade", "-to", "v0.0.1", "testdata/azbi-valid.json"}},
+		{name: "upgrade-unknown-version", args: []string{"upgrade", "-dry-run", "-to", "v1.0.0", "testdata/azbi-old.json"}},
+		{name: "upgrade-typo", args: []string{"upgrade", "-dry-run", "testdata/azbi-typo.json"}},
+		{name: "diff-same", args: []string{"diff", "testdata/azbi-valid.json", "testdata/azbi-unformatted.json"}},
+		{name: "diff-changed", args: []string{"diff", "testdata/state.json", "testdata/state-changed.json"}},
+		{name: "diff-changed-json", args: []string{"diff", "-format", "json", "testdata/azbi-valid.json", "testdata/azbi-old.json"}},
+		{name: "diff-sensitive", args: []string{"diff", "testdata/hi.json", "testdata/hi-changed.json"}},
+		{name: "diff-one-arg", args: []string{"diff", "testdata/azbi-valid.json"}},
+		{name: "diff-unknown-kind", args: []string{"diff", "testdata/azbi-valid.json", "testdata/unknown-kind.json"}},
+		{name: "diff-different-kinds", args: []string{"diff", "testdata/state.json", "testdata/azbi-valid.json"}},
+		{name: "diff-stdin-twice", args: []string{"diff", "-", "-"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			code := run(tt.args, stdout, stderr)
+			got := fmt.Sprintf("$ e-structures %s\nexit code: %d\n--- stdout\n%s--- stderr\n%s", strings.Join(tt.args, " "), code, stdout, stderr)
+
+			golden := filepath.Join("testdata", tt.name+".golden")
+			if *update {
+				if err := ioutil.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := ioutil.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(string(want), got); diff != "" {
+				t.Errorf("run() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRun_FmtWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "e-structures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "azbi-unformatted.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "azbi.json")
+	if err = ioutil.WriteFile(path, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if code := run([]string{"fmt", "-w", path}, stdout, stderr); code != exitOk {
+		t.Fatalf("run() returned %d, stderr: %s", code, stderr)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("run() expected to write nothing to stdout, got %s", stdout)
+	}
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile(filepath.Join("testdata", "azbi-valid.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("formatted file mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRun_UpgradeWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "e-structures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "azbi-old.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "azbi.json")
+	if err = ioutil.WriteFile(path, b, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	if code := run([]string{"upgrade", path}, stdout, stderr); code != exitOk {
+		t.Fatalf("run() returned %d, stderr: %s", code, stderr)
+	}
+	got, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile(filepath.Join("testdata", "azbi-valid.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(string(want), string(got)); diff != "" {
+		t.Errorf("upgraded file mismatch (-want +got):\n%s", diff)
+	}
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fi.Mode().Perm() != 0600 {
+		t.Errorf("upgraded file has mode %s, want %s", fi.Mode().Perm(), os.FileMode(0600))
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("expected only upgraded file in directory, got %d files", len(files))
+	}
+}
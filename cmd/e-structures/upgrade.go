@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/epiphany-platform/e-structures/utils/diff"
+	"github.com/epiphany-platform/e-structures/utils/kinds"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/upgrade"
+)
+
+// upgradeReport is JSON representation of upgrade command result.
+type upgradeReport struct {
+	File     string        `json:"file"`
+	Kind     string        `json:"kind"`
+	From     string        `json:"from"`
+	To       string        `json:"to"`
+	Upgraded bool          `json:"upgraded"`
+	Written  bool          `json:"written"`
+	Steps    []string      `json:"steps"`
+	Changes  []diff.Change `json:"changes"`
+}
+
+// diffReport is JSON representation of diff command result.
+type diffReport struct {
+	A       string        `json:"a"`
+	B       string        `json:"b"`
+	Changes []diff.Change `json:"changes"`
+}
+
+func runUpgrade(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("upgrade", stderr)
+	to := fs.String("to", "", "target `version`, current version of document kind by default")
+	dryRun := fs.Bool("dry-run", false, "only print report, do not write upgraded file")
+	format := fs.String("format", "text", "output `format`: text or json")
+	path, ok := parse(fs, args, stderr)
+	if !ok || !checkFormat(fs.Name(), *format, stderr) {
+		return exitUsage
+	}
+	b, err := readInput(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "upgrade: %v\n", err)
+		return exitUsage
+	}
+	result, err := upgrade.Upgrade(b, *to)
+	if err != nil {
+		fmt.Fprintf(stderr, "upgrade: %s: %v\n", path, err)
+		return exitInvalid
+	}
+	out, err := result.Document.Marshal()
+	if err != nil {
+		fmt.Fprintf(stderr, "upgrade: %s: %v\n", path, err)
+		return exitInvalid
+	}
+	changes, err := diff.Compare(b, out)
+	if err != nil {
+		fmt.Fprintf(stderr, "upgrade: %s: %v\n", path, err)
+		return exitInvalid
+	}
+	redact(result.Document, changes)
+
+	report := upgradeReport{
+		File:     path,
+		Kind:     result.Kind,
+		From:     result.From,
+		To:       result.To,
+		Upgraded: result.Upgraded(),
+		Steps:    result.Steps,
+		Changes:  changes,
+	}
+	if report.Upgraded && !*dryRun {
+		if path == "-" {
+			fmt.Fprintln(stderr, "upgrade: cannot write upgraded document read from standard input, use -dry-run")
+			return exitUsage
+		}
+		if err = writeFile(path, append(out, '\n')); err != nil {
+			fmt.Fprintf(stderr, "upgrade: %v\n", err)
+			return exitInvalid
+		}
+		report.Written = true
+	}
+
+	if *format == "json" {
+		if report.Steps == nil {
+			report.Steps = []string{}
+		}
+		if report.Changes == nil {
+			report.Changes = []diff.Change{}
+		}
+		printJSON(stdout, report)
+		return exitOk
+	}
+	if !report.Upgraded {
+		fmt.Fprintf(stdout, "%s: %s document is already at version %s\n", path, report.Kind, report.From)
+		return exitOk
+	}
+	fmt.Fprintf(stdout, "%s: %s document upgraded from %s to %s\n", path, report.Kind, report.From, report.To)
+	if len(report.Steps) > 0 {
+		fmt.Fprintln(stdout, "Steps:")
+		for _, s := range report.Steps {
+			fmt.Fprintf(stdout, "  %s\n", s)
+		}
+	}
+	if len(report.Changes) > 0 {
+		fmt.Fprintln(stdout, "Changes:")
+		for _, c := range report.Changes {
+			fmt.Fprintf(stdout, "  %s\n", c)
+		}
+	}
+	if !report.Written {
+		fmt.Fprintln(stdout, "Dry run, file was not written.")
+	}
+	return exitOk
+}
+
+func runDiff(args []string, stdout, stderr io.Writer) int {
+	fs := newFlagSet("diff", stderr)
+	format := fs.String("format", "text", "output `format`: text or json")
+	if err := fs.Parse(args); err != nil {
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		fmt.Fprintf(stderr, "diff: expected exactly two arguments, got %d\n", fs.NArg())
+		return exitUsage
+	}
+	if !checkFormat(fs.Name(), *format, stderr) {
+		return exitUsage
+	}
+	a, b := fs.Arg(0), fs.Arg(1)
+	if a == "-" && b == "-" {
+		fmt.Fprintln(stderr, "diff: standard input can be used for only one argument")
+		return exitUsage
+	}
+	var (
+		documents [2][]byte
+		k         [2]kinds.Kind
+	)
+	for i, path := range []string{a, b} {
+		bytes, err := readInput(path)
+		if err != nil {
+			fmt.Fprintf(stderr, "diff: %v\n", err)
+			return exitUsage
+		}
+		if k[i], err = kinds.Detect(bytes); err != nil {
+			fmt.Fprintf(stderr, "diff: %s: %v\n", path, err)
+			return exitUsage
+		}
+		documents[i] = bytes
+	}
+	// sensitive values are found using structure of compared kind, so both documents have to share it
+	if k[0].Name != k[1].Name {
+		fmt.Fprintf(stderr, "diff: cannot compare documents of different kinds: %s is %s and %s is %s\n", a, k[0].Name, b, k[1].Name)
+		return exitUsage
+	}
+	changes, err := diff.Compare(documents[0], documents[1])
+	if err != nil {
+		fmt.Fprintf(stderr, "diff: %v\n", err)
+		return exitUsage
+	}
+	redact(k[0].New(), changes)
+
+	if *format == "json" {
+		if changes == nil {
+			changes = []diff.Change{}
+		}
+		printJSON(stdout, diffReport{A: a, B: b, Changes: changes})
+	} else if len(changes) == 0 {
+		fmt.Fprintln(stdout, "No differences.")
+	} else {
+		fmt.Fprintf(stdout, "--- %s\n+++ %s\n%s\n", a, b, diff.String(changes))
+	}
+	if len(changes) > 0 {
+		return exitInvalid
+	}
+	return exitOk
+}
+
+// redact hides values of sensitive fields of document d in changes.
+func redact(d kinds.Document, changes []diff.Change) {
+	t := reflect.TypeOf(d)
+	for i, c := range changes {
+		changes[i].Old = sensitive.RedactJSON(t, c.Path, c.Old)
+		changes[i].New = sensitive.RedactJSON(t, c.Path, c.New)
+	}
+}
@@ -0,0 +1,593 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetRgName returns RgName field of Azure or nil if Azure is nil.
+func (a *Azure) GetRgName() *string {
+	if a == nil {
+		return nil
+	}
+	return a.RgName
+}
+
+// GetRgNameV returns value of RgName field of Azure or zero value if either Azure or field is nil.
+func (a *Azure) GetRgNameV() string {
+	if a == nil || a.RgName == nil {
+		return ""
+	}
+	return *a.RgName
+}
+
+// GetRgNameOr returns value of RgName field of Azure or def if either Azure or field is nil.
+func (a *Azure) GetRgNameOr(def string) string {
+	if a == nil || a.RgName == nil {
+		return def
+	}
+	return *a.RgName
+}
+
+// GetRegion returns Region field of Aws or nil if Aws is nil.
+func (a *Aws) GetRegion() *string {
+	if a == nil {
+		return nil
+	}
+	return a.Region
+}
+
+// GetRegionV returns value of Region field of Aws or zero value if either Aws or field is nil.
+func (a *Aws) GetRegionV() string {
+	if a == nil || a.Region == nil {
+		return ""
+	}
+	return *a.Region
+}
+
+// GetRegionOr returns value of Region field of Aws or def if either Aws or field is nil.
+func (a *Aws) GetRegionOr(def string) string {
+	if a == nil || a.Region == nil {
+		return def
+	}
+	return *a.Region
+}
+
+// GetName returns Name field of Record or nil if Record is nil.
+func (r *Record) GetName() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Name
+}
+
+// GetNameV returns value of Name field of Record or zero value if either Record or field is nil.
+func (r *Record) GetNameV() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNameOr returns value of Name field of Record or def if either Record or field is nil.
+func (r *Record) GetNameOr(def string) string {
+	if r == nil || r.Name == nil {
+		return def
+	}
+	return *r.Name
+}
+
+// GetType returns Type field of Record or nil if Record is nil.
+func (r *Record) GetType() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Type
+}
+
+// GetTypeV returns value of Type field of Record or zero value if either Record or field is nil.
+func (r *Record) GetTypeV() string {
+	if r == nil || r.Type == nil {
+		return ""
+	}
+	return *r.Type
+}
+
+// GetTypeOr returns value of Type field of Record or def if either Record or field is nil.
+func (r *Record) GetTypeOr(def string) string {
+	if r == nil || r.Type == nil {
+		return def
+	}
+	return *r.Type
+}
+
+// GetTtl returns Ttl field of Record or nil if Record is nil.
+func (r *Record) GetTtl() *int {
+	if r == nil {
+		return nil
+	}
+	return r.Ttl
+}
+
+// GetTtlV returns value of Ttl field of Record or zero value if either Record or field is nil.
+func (r *Record) GetTtlV() int {
+	if r == nil || r.Ttl == nil {
+		return 0
+	}
+	return *r.Ttl
+}
+
+// GetTtlOr returns value of Ttl field of Record or def if either Record or field is nil.
+func (r *Record) GetTtlOr(def int) int {
+	if r == nil || r.Ttl == nil {
+		return def
+	}
+	return *r.Ttl
+}
+
+// GetValues returns Values field of Record, nil if Record is nil or empty slice if field is nil.
+func (r *Record) GetValues() []string {
+	if r == nil {
+		return nil
+	}
+	if len(r.Values) == 0 {
+		return []string{}
+	}
+	return r.Values
+}
+
+// GetTemplate returns Template field of VmRecords or nil if VmRecords is nil.
+func (v *VmRecords) GetTemplate() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Template
+}
+
+// GetTemplateV returns value of Template field of VmRecords or zero value if either VmRecords or field is nil.
+func (v *VmRecords) GetTemplateV() string {
+	if v == nil || v.Template == nil {
+		return ""
+	}
+	return *v.Template
+}
+
+// GetTemplateOr returns value of Template field of VmRecords or def if either VmRecords or field is nil.
+func (v *VmRecords) GetTemplateOr(def string) string {
+	if v == nil || v.Template == nil {
+		return def
+	}
+	return *v.Template
+}
+
+// GetVmGroupNames returns VmGroupNames field of VmRecords, nil if VmRecords is nil or empty slice if field is nil.
+func (v *VmRecords) GetVmGroupNames() []string {
+	if v == nil {
+		return nil
+	}
+	if len(v.VmGroupNames) == 0 {
+		return []string{}
+	}
+	return v.VmGroupNames
+}
+
+// GetUsePublicIp returns UsePublicIp field of VmRecords or nil if VmRecords is nil.
+func (v *VmRecords) GetUsePublicIp() *bool {
+	if v == nil {
+		return nil
+	}
+	return v.UsePublicIp
+}
+
+// GetUsePublicIpV returns value of UsePublicIp field of VmRecords or zero value if either VmRecords or field is nil.
+func (v *VmRecords) GetUsePublicIpV() bool {
+	if v == nil || v.UsePublicIp == nil {
+		return false
+	}
+	return *v.UsePublicIp
+}
+
+// GetUsePublicIpOr returns value of UsePublicIp field of VmRecords or def if either VmRecords or field is nil.
+func (v *VmRecords) GetUsePublicIpOr(def bool) bool {
+	if v == nil || v.UsePublicIp == nil {
+		return def
+	}
+	return *v.UsePublicIp
+}
+
+// GetTtl returns Ttl field of VmRecords or nil if VmRecords is nil.
+func (v *VmRecords) GetTtl() *int {
+	if v == nil {
+		return nil
+	}
+	return v.Ttl
+}
+
+// GetTtlV returns value of Ttl field of VmRecords or zero value if either VmRecords or field is nil.
+func (v *VmRecords) GetTtlV() int {
+	if v == nil || v.Ttl == nil {
+		return 0
+	}
+	return *v.Ttl
+}
+
+// GetTtlOr returns value of Ttl field of VmRecords or def if either VmRecords or field is nil.
+func (v *VmRecords) GetTtlOr(def int) int {
+	if v == nil || v.Ttl == nil {
+		return def
+	}
+	return *v.Ttl
+}
+
+// GetName returns Name field of Zone or nil if Zone is nil.
+func (z *Zone) GetName() *string {
+	if z == nil {
+		return nil
+	}
+	return z.Name
+}
+
+// GetNameV returns value of Name field of Zone or zero value if either Zone or field is nil.
+func (z *Zone) GetNameV() string {
+	if z == nil || z.Name == nil {
+		return ""
+	}
+	return *z.Name
+}
+
+// GetNameOr returns value of Name field of Zone or def if either Zone or field is nil.
+func (z *Zone) GetNameOr(def string) string {
+	if z == nil || z.Name == nil {
+		return def
+	}
+	return *z.Name
+}
+
+// GetPrivate returns Private field of Zone or nil if Zone is nil.
+func (z *Zone) GetPrivate() *bool {
+	if z == nil {
+		return nil
+	}
+	return z.Private
+}
+
+// GetPrivateV returns value of Private field of Zone or zero value if either Zone or field is nil.
+func (z *Zone) GetPrivateV() bool {
+	if z == nil || z.Private == nil {
+		return false
+	}
+	return *z.Private
+}
+
+// GetPrivateOr returns value of Private field of Zone or def if either Zone or field is nil.
+func (z *Zone) GetPrivateOr(def bool) bool {
+	if z == nil || z.Private == nil {
+		return def
+	}
+	return *z.Private
+}
+
+// GetRecords returns Records field of Zone, nil if Zone is nil or empty slice if field is nil.
+func (z *Zone) GetRecords() []Record {
+	if z == nil {
+		return nil
+	}
+	if len(z.Records) == 0 {
+		return []Record{}
+	}
+	return z.Records
+}
+
+// GetVmRecords returns VmRecords field of Zone, nil if Zone is nil or empty slice if field is nil.
+func (z *Zone) GetVmRecords() []VmRecords {
+	if z == nil {
+		return nil
+	}
+	if len(z.VmRecords) == 0 {
+		return []VmRecords{}
+	}
+	return z.VmRecords
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetProvider returns Provider field of Params or nil if Params is nil.
+func (p *Params) GetProvider() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Provider
+}
+
+// GetProviderV returns value of Provider field of Params or zero value if either Params or field is nil.
+func (p *Params) GetProviderV() string {
+	if p == nil || p.Provider == nil {
+		return ""
+	}
+	return *p.Provider
+}
+
+// GetProviderOr returns value of Provider field of Params or def if either Params or field is nil.
+func (p *Params) GetProviderOr(def string) string {
+	if p == nil || p.Provider == nil {
+		return def
+	}
+	return *p.Provider
+}
+
+// GetAzure returns Azure field of Params or nil if Params is nil.
+func (p *Params) GetAzure() *Azure {
+	if p == nil {
+		return nil
+	}
+	return p.Azure
+}
+
+// GetAzureV returns value of Azure field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAzureV() Azure {
+	if p == nil || p.Azure == nil {
+		return Azure{}
+	}
+	return *p.Azure
+}
+
+// GetAzureOr returns value of Azure field of Params or def if either Params or field is nil.
+func (p *Params) GetAzureOr(def Azure) Azure {
+	if p == nil || p.Azure == nil {
+		return def
+	}
+	return *p.Azure
+}
+
+// GetAws returns Aws field of Params or nil if Params is nil.
+func (p *Params) GetAws() *Aws {
+	if p == nil {
+		return nil
+	}
+	return p.Aws
+}
+
+// GetAwsV returns value of Aws field of Params or zero value if either Params or field is nil.
+func (p *Params) GetAwsV() Aws {
+	if p == nil || p.Aws == nil {
+		return Aws{}
+	}
+	return *p.Aws
+}
+
+// GetAwsOr returns value of Aws field of Params or def if either Params or field is nil.
+func (p *Params) GetAwsOr(def Aws) Aws {
+	if p == nil || p.Aws == nil {
+		return def
+	}
+	return *p.Aws
+}
+
+// GetZones returns Zones field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetZones() []Zone {
+	if p == nil {
+		return nil
+	}
+	if len(p.Zones) == 0 {
+		return []Zone{}
+	}
+	return p.Zones
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetFqdn returns Fqdn field of OutputRecord or nil if OutputRecord is nil.
+func (o *OutputRecord) GetFqdn() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Fqdn
+}
+
+// GetFqdnV returns value of Fqdn field of OutputRecord or zero value if either OutputRecord or field is nil.
+func (o *OutputRecord) GetFqdnV() string {
+	if o == nil || o.Fqdn == nil {
+		return ""
+	}
+	return *o.Fqdn
+}
+
+// GetFqdnOr returns value of Fqdn field of OutputRecord or def if either OutputRecord or field is nil.
+func (o *OutputRecord) GetFqdnOr(def string) string {
+	if o == nil || o.Fqdn == nil {
+		return def
+	}
+	return *o.Fqdn
+}
+
+// GetType returns Type field of OutputRecord or nil if OutputRecord is nil.
+func (o *OutputRecord) GetType() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Type
+}
+
+// GetTypeV returns value of Type field of OutputRecord or zero value if either OutputRecord or field is nil.
+func (o *OutputRecord) GetTypeV() string {
+	if o == nil || o.Type == nil {
+		return ""
+	}
+	return *o.Type
+}
+
+// GetTypeOr returns value of Type field of OutputRecord or def if either OutputRecord or field is nil.
+func (o *OutputRecord) GetTypeOr(def string) string {
+	if o == nil || o.Type == nil {
+		return def
+	}
+	return *o.Type
+}
+
+// GetValues returns Values field of OutputRecord, nil if OutputRecord is nil or empty slice if field is nil.
+func (o *OutputRecord) GetValues() []string {
+	if o == nil {
+		return nil
+	}
+	if len(o.Values) == 0 {
+		return []string{}
+	}
+	return o.Values
+}
+
+// GetName returns Name field of OutputZone or nil if OutputZone is nil.
+func (o *OutputZone) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputZone or zero value if either OutputZone or field is nil.
+func (o *OutputZone) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputZone or def if either OutputZone or field is nil.
+func (o *OutputZone) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetNameServers returns NameServers field of OutputZone, nil if OutputZone is nil or empty slice if field is nil.
+func (o *OutputZone) GetNameServers() []string {
+	if o == nil {
+		return nil
+	}
+	if len(o.NameServers) == 0 {
+		return []string{}
+	}
+	return o.NameServers
+}
+
+// GetRecords returns Records field of OutputZone, nil if OutputZone is nil or empty slice if field is nil.
+func (o *OutputZone) GetRecords() []OutputRecord {
+	if o == nil {
+		return nil
+	}
+	if len(o.Records) == 0 {
+		return []OutputRecord{}
+	}
+	return o.Records
+}
+
+// GetZones returns Zones field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetZones() []OutputZone {
+	if o == nil {
+		return nil
+	}
+	if len(o.Zones) == 0 {
+		return []OutputZone{}
+	}
+	return o.Zones
+}
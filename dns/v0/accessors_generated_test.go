@@ -0,0 +1,609 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAzure_Accessors(t *testing.T) {
+	var nilStruct *Azure
+	emptyStruct := &Azure{}
+	t.Run("RgName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Azure{RgName: &v}
+		if nilStruct.GetRgName() != nil || emptyStruct.GetRgName() != nil {
+			t.Error("GetRgName() expected to return nil")
+		}
+		if fullStruct.GetRgName() != &v {
+			t.Error("GetRgName() expected to return field")
+		}
+		if nilStruct.GetRgNameV() != "" || emptyStruct.GetRgNameV() != "" {
+			t.Error("GetRgNameV() expected to return zero value")
+		}
+		if fullStruct.GetRgNameV() != v {
+			t.Error("GetRgNameV() expected to return field value")
+		}
+		if nilStruct.GetRgNameOr(v) != v || emptyStruct.GetRgNameOr(v) != v {
+			t.Error("GetRgNameOr() expected to return default value")
+		}
+		if fullStruct.GetRgNameOr("") != v {
+			t.Error("GetRgNameOr() expected to return field value")
+		}
+	})
+}
+
+func TestAws_Accessors(t *testing.T) {
+	var nilStruct *Aws
+	emptyStruct := &Aws{}
+	t.Run("Region", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Aws{Region: &v}
+		if nilStruct.GetRegion() != nil || emptyStruct.GetRegion() != nil {
+			t.Error("GetRegion() expected to return nil")
+		}
+		if fullStruct.GetRegion() != &v {
+			t.Error("GetRegion() expected to return field")
+		}
+		if nilStruct.GetRegionV() != "" || emptyStruct.GetRegionV() != "" {
+			t.Error("GetRegionV() expected to return zero value")
+		}
+		if fullStruct.GetRegionV() != v {
+			t.Error("GetRegionV() expected to return field value")
+		}
+		if nilStruct.GetRegionOr(v) != v || emptyStruct.GetRegionOr(v) != v {
+			t.Error("GetRegionOr() expected to return default value")
+		}
+		if fullStruct.GetRegionOr("") != v {
+			t.Error("GetRegionOr() expected to return field value")
+		}
+	})
+}
+
+func TestRecord_Accessors(t *testing.T) {
+	var nilStruct *Record
+	emptyStruct := &Record{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Record{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Type", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Record{Type: &v}
+		if nilStruct.GetType() != nil || emptyStruct.GetType() != nil {
+			t.Error("GetType() expected to return nil")
+		}
+		if fullStruct.GetType() != &v {
+			t.Error("GetType() expected to return field")
+		}
+		if nilStruct.GetTypeV() != "" || emptyStruct.GetTypeV() != "" {
+			t.Error("GetTypeV() expected to return zero value")
+		}
+		if fullStruct.GetTypeV() != v {
+			t.Error("GetTypeV() expected to return field value")
+		}
+		if nilStruct.GetTypeOr(v) != v || emptyStruct.GetTypeOr(v) != v {
+			t.Error("GetTypeOr() expected to return default value")
+		}
+		if fullStruct.GetTypeOr("") != v {
+			t.Error("GetTypeOr() expected to return field value")
+		}
+	})
+	t.Run("Ttl", func(t *testing.T) {
+		v := 1
+		fullStruct := &Record{Ttl: &v}
+		if nilStruct.GetTtl() != nil || emptyStruct.GetTtl() != nil {
+			t.Error("GetTtl() expected to return nil")
+		}
+		if fullStruct.GetTtl() != &v {
+			t.Error("GetTtl() expected to return field")
+		}
+		if nilStruct.GetTtlV() != 0 || emptyStruct.GetTtlV() != 0 {
+			t.Error("GetTtlV() expected to return zero value")
+		}
+		if fullStruct.GetTtlV() != v {
+			t.Error("GetTtlV() expected to return field value")
+		}
+		if nilStruct.GetTtlOr(v) != v || emptyStruct.GetTtlOr(v) != v {
+			t.Error("GetTtlOr() expected to return default value")
+		}
+		if fullStruct.GetTtlOr(0) != v {
+			t.Error("GetTtlOr() expected to return field value")
+		}
+	})
+	t.Run("Values", func(t *testing.T) {
+		fullStruct := &Record{Values: make([]string, 1)}
+		if nilStruct.GetValues() != nil {
+			t.Error("GetValues() expected to return nil")
+		}
+		if got := emptyStruct.GetValues(); got == nil || len(got) != 0 {
+			t.Error("GetValues() expected to return empty slice")
+		}
+		if got := fullStruct.GetValues(); len(got) != 1 || &got[0] != &fullStruct.Values[0] {
+			t.Error("GetValues() expected to return field")
+		}
+	})
+}
+
+func TestVmRecords_Accessors(t *testing.T) {
+	var nilStruct *VmRecords
+	emptyStruct := &VmRecords{}
+	t.Run("Template", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmRecords{Template: &v}
+		if nilStruct.GetTemplate() != nil || emptyStruct.GetTemplate() != nil {
+			t.Error("GetTemplate() expected to return nil")
+		}
+		if fullStruct.GetTemplate() != &v {
+			t.Error("GetTemplate() expected to return field")
+		}
+		if nilStruct.GetTemplateV() != "" || emptyStruct.GetTemplateV() != "" {
+			t.Error("GetTemplateV() expected to return zero value")
+		}
+		if fullStruct.GetTemplateV() != v {
+			t.Error("GetTemplateV() expected to return field value")
+		}
+		if nilStruct.GetTemplateOr(v) != v || emptyStruct.GetTemplateOr(v) != v {
+			t.Error("GetTemplateOr() expected to return default value")
+		}
+		if fullStruct.GetTemplateOr("") != v {
+			t.Error("GetTemplateOr() expected to return field value")
+		}
+	})
+	t.Run("VmGroupNames", func(t *testing.T) {
+		fullStruct := &VmRecords{VmGroupNames: make([]string, 1)}
+		if nilStruct.GetVmGroupNames() != nil {
+			t.Error("GetVmGroupNames() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroupNames(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroupNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroupNames(); len(got) != 1 || &got[0] != &fullStruct.VmGroupNames[0] {
+			t.Error("GetVmGroupNames() expected to return field")
+		}
+	})
+	t.Run("UsePublicIp", func(t *testing.T) {
+		v := true
+		fullStruct := &VmRecords{UsePublicIp: &v}
+		if nilStruct.GetUsePublicIp() != nil || emptyStruct.GetUsePublicIp() != nil {
+			t.Error("GetUsePublicIp() expected to return nil")
+		}
+		if fullStruct.GetUsePublicIp() != &v {
+			t.Error("GetUsePublicIp() expected to return field")
+		}
+		if nilStruct.GetUsePublicIpV() != false || emptyStruct.GetUsePublicIpV() != false {
+			t.Error("GetUsePublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetUsePublicIpV() != v {
+			t.Error("GetUsePublicIpV() expected to return field value")
+		}
+		if nilStruct.GetUsePublicIpOr(v) != v || emptyStruct.GetUsePublicIpOr(v) != v {
+			t.Error("GetUsePublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetUsePublicIpOr(false) != v {
+			t.Error("GetUsePublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("Ttl", func(t *testing.T) {
+		v := 1
+		fullStruct := &VmRecords{Ttl: &v}
+		if nilStruct.GetTtl() != nil || emptyStruct.GetTtl() != nil {
+			t.Error("GetTtl() expected to return nil")
+		}
+		if fullStruct.GetTtl() != &v {
+			t.Error("GetTtl() expected to return field")
+		}
+		if nilStruct.GetTtlV() != 0 || emptyStruct.GetTtlV() != 0 {
+			t.Error("GetTtlV() expected to return zero value")
+		}
+		if fullStruct.GetTtlV() != v {
+			t.Error("GetTtlV() expected to return field value")
+		}
+		if nilStruct.GetTtlOr(v) != v || emptyStruct.GetTtlOr(v) != v {
+			t.Error("GetTtlOr() expected to return default value")
+		}
+		if fullStruct.GetTtlOr(0) != v {
+			t.Error("GetTtlOr() expected to return field value")
+		}
+	})
+}
+
+func TestZone_Accessors(t *testing.T) {
+	var nilStruct *Zone
+	emptyStruct := &Zone{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Zone{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Private", func(t *testing.T) {
+		v := true
+		fullStruct := &Zone{Private: &v}
+		if nilStruct.GetPrivate() != nil || emptyStruct.GetPrivate() != nil {
+			t.Error("GetPrivate() expected to return nil")
+		}
+		if fullStruct.GetPrivate() != &v {
+			t.Error("GetPrivate() expected to return field")
+		}
+		if nilStruct.GetPrivateV() != false || emptyStruct.GetPrivateV() != false {
+			t.Error("GetPrivateV() expected to return zero value")
+		}
+		if fullStruct.GetPrivateV() != v {
+			t.Error("GetPrivateV() expected to return field value")
+		}
+		if nilStruct.GetPrivateOr(v) != v || emptyStruct.GetPrivateOr(v) != v {
+			t.Error("GetPrivateOr() expected to return default value")
+		}
+		if fullStruct.GetPrivateOr(false) != v {
+			t.Error("GetPrivateOr() expected to return field value")
+		}
+	})
+	t.Run("Records", func(t *testing.T) {
+		fullStruct := &Zone{Records: make([]Record, 1)}
+		if nilStruct.GetRecords() != nil {
+			t.Error("GetRecords() expected to return nil")
+		}
+		if got := emptyStruct.GetRecords(); got == nil || len(got) != 0 {
+			t.Error("GetRecords() expected to return empty slice")
+		}
+		if got := fullStruct.GetRecords(); len(got) != 1 || &got[0] != &fullStruct.Records[0] {
+			t.Error("GetRecords() expected to return field")
+		}
+	})
+	t.Run("VmRecords", func(t *testing.T) {
+		fullStruct := &Zone{VmRecords: make([]VmRecords, 1)}
+		if nilStruct.GetVmRecords() != nil {
+			t.Error("GetVmRecords() expected to return nil")
+		}
+		if got := emptyStruct.GetVmRecords(); got == nil || len(got) != 0 {
+			t.Error("GetVmRecords() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmRecords(); len(got) != 1 || &got[0] != &fullStruct.VmRecords[0] {
+			t.Error("GetVmRecords() expected to return field")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Provider", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Provider: &v}
+		if nilStruct.GetProvider() != nil || emptyStruct.GetProvider() != nil {
+			t.Error("GetProvider() expected to return nil")
+		}
+		if fullStruct.GetProvider() != &v {
+			t.Error("GetProvider() expected to return field")
+		}
+		if nilStruct.GetProviderV() != "" || emptyStruct.GetProviderV() != "" {
+			t.Error("GetProviderV() expected to return zero value")
+		}
+		if fullStruct.GetProviderV() != v {
+			t.Error("GetProviderV() expected to return field value")
+		}
+		if nilStruct.GetProviderOr(v) != v || emptyStruct.GetProviderOr(v) != v {
+			t.Error("GetProviderOr() expected to return default value")
+		}
+		if fullStruct.GetProviderOr("") != v {
+			t.Error("GetProviderOr() expected to return field value")
+		}
+	})
+	t.Run("Azure", func(t *testing.T) {
+		v := Azure{}
+		fullStruct := &Params{Azure: &v}
+		if nilStruct.GetAzure() != nil || emptyStruct.GetAzure() != nil {
+			t.Error("GetAzure() expected to return nil")
+		}
+		if fullStruct.GetAzure() != &v {
+			t.Error("GetAzure() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzureV(), Azure{}) || !reflect.DeepEqual(emptyStruct.GetAzureV(), Azure{}) {
+			t.Error("GetAzureV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzureV(), v) {
+			t.Error("GetAzureV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzureOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzureOr(v), v) {
+			t.Error("GetAzureOr() expected to return default value")
+		}
+	})
+	t.Run("Aws", func(t *testing.T) {
+		v := Aws{}
+		fullStruct := &Params{Aws: &v}
+		if nilStruct.GetAws() != nil || emptyStruct.GetAws() != nil {
+			t.Error("GetAws() expected to return nil")
+		}
+		if fullStruct.GetAws() != &v {
+			t.Error("GetAws() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAwsV(), Aws{}) || !reflect.DeepEqual(emptyStruct.GetAwsV(), Aws{}) {
+			t.Error("GetAwsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAwsV(), v) {
+			t.Error("GetAwsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAwsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAwsOr(v), v) {
+			t.Error("GetAwsOr() expected to return default value")
+		}
+	})
+	t.Run("Zones", func(t *testing.T) {
+		fullStruct := &Params{Zones: make([]Zone, 1)}
+		if nilStruct.GetZones() != nil {
+			t.Error("GetZones() expected to return nil")
+		}
+		if got := emptyStruct.GetZones(); got == nil || len(got) != 0 {
+			t.Error("GetZones() expected to return empty slice")
+		}
+		if got := fullStruct.GetZones(); len(got) != 1 || &got[0] != &fullStruct.Zones[0] {
+			t.Error("GetZones() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputRecord_Accessors(t *testing.T) {
+	var nilStruct *OutputRecord
+	emptyStruct := &OutputRecord{}
+	t.Run("Fqdn", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRecord{Fqdn: &v}
+		if nilStruct.GetFqdn() != nil || emptyStruct.GetFqdn() != nil {
+			t.Error("GetFqdn() expected to return nil")
+		}
+		if fullStruct.GetFqdn() != &v {
+			t.Error("GetFqdn() expected to return field")
+		}
+		if nilStruct.GetFqdnV() != "" || emptyStruct.GetFqdnV() != "" {
+			t.Error("GetFqdnV() expected to return zero value")
+		}
+		if fullStruct.GetFqdnV() != v {
+			t.Error("GetFqdnV() expected to return field value")
+		}
+		if nilStruct.GetFqdnOr(v) != v || emptyStruct.GetFqdnOr(v) != v {
+			t.Error("GetFqdnOr() expected to return default value")
+		}
+		if fullStruct.GetFqdnOr("") != v {
+			t.Error("GetFqdnOr() expected to return field value")
+		}
+	})
+	t.Run("Type", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRecord{Type: &v}
+		if nilStruct.GetType() != nil || emptyStruct.GetType() != nil {
+			t.Error("GetType() expected to return nil")
+		}
+		if fullStruct.GetType() != &v {
+			t.Error("GetType() expected to return field")
+		}
+		if nilStruct.GetTypeV() != "" || emptyStruct.GetTypeV() != "" {
+			t.Error("GetTypeV() expected to return zero value")
+		}
+		if fullStruct.GetTypeV() != v {
+			t.Error("GetTypeV() expected to return field value")
+		}
+		if nilStruct.GetTypeOr(v) != v || emptyStruct.GetTypeOr(v) != v {
+			t.Error("GetTypeOr() expected to return default value")
+		}
+		if fullStruct.GetTypeOr("") != v {
+			t.Error("GetTypeOr() expected to return field value")
+		}
+	})
+	t.Run("Values", func(t *testing.T) {
+		fullStruct := &OutputRecord{Values: make([]string, 1)}
+		if nilStruct.GetValues() != nil {
+			t.Error("GetValues() expected to return nil")
+		}
+		if got := emptyStruct.GetValues(); got == nil || len(got) != 0 {
+			t.Error("GetValues() expected to return empty slice")
+		}
+		if got := fullStruct.GetValues(); len(got) != 1 || &got[0] != &fullStruct.Values[0] {
+			t.Error("GetValues() expected to return field")
+		}
+	})
+}
+
+func TestOutputZone_Accessors(t *testing.T) {
+	var nilStruct *OutputZone
+	emptyStruct := &OutputZone{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputZone{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("NameServers", func(t *testing.T) {
+		fullStruct := &OutputZone{NameServers: make([]string, 1)}
+		if nilStruct.GetNameServers() != nil {
+			t.Error("GetNameServers() expected to return nil")
+		}
+		if got := emptyStruct.GetNameServers(); got == nil || len(got) != 0 {
+			t.Error("GetNameServers() expected to return empty slice")
+		}
+		if got := fullStruct.GetNameServers(); len(got) != 1 || &got[0] != &fullStruct.NameServers[0] {
+			t.Error("GetNameServers() expected to return field")
+		}
+	})
+	t.Run("Records", func(t *testing.T) {
+		fullStruct := &OutputZone{Records: make([]OutputRecord, 1)}
+		if nilStruct.GetRecords() != nil {
+			t.Error("GetRecords() expected to return nil")
+		}
+		if got := emptyStruct.GetRecords(); got == nil || len(got) != 0 {
+			t.Error("GetRecords() expected to return empty slice")
+		}
+		if got := fullStruct.GetRecords(); len(got) != 1 || &got[0] != &fullStruct.Records[0] {
+			t.Error("GetRecords() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("Zones", func(t *testing.T) {
+		fullStruct := &Output{Zones: make([]OutputZone, 1)}
+		if nilStruct.GetZones() != nil {
+			t.Error("GetZones() expected to return nil")
+		}
+		if got := emptyStruct.GetZones(); got == nil || len(got) != 0 {
+			t.Error("GetZones() expected to return empty slice")
+		}
+		if got := fullStruct.GetZones(); len(got) != 1 || &got[0] != &fullStruct.Zones[0] {
+			t.Error("GetZones() expected to return field")
+		}
+	})
+}
@@ -0,0 +1,470 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Azure or nil if Azure is nil.
+func (a *Azure) DeepCopy() *Azure {
+	if a == nil {
+		return nil
+	}
+	out := new(Azure)
+	if a.RgName != nil {
+		x := *a.RgName
+		out.RgName = &x
+	}
+	return out
+}
+
+// Equal reports whether Azure and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *Azure) Equal(other *Azure) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.RgName == nil) != (other.RgName == nil) || a.RgName != nil && *a.RgName != *other.RgName {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Aws or nil if Aws is nil.
+func (a *Aws) DeepCopy() *Aws {
+	if a == nil {
+		return nil
+	}
+	out := new(Aws)
+	if a.Region != nil {
+		x := *a.Region
+		out.Region = &x
+	}
+	return out
+}
+
+// Equal reports whether Aws and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *Aws) Equal(other *Aws) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.Region == nil) != (other.Region == nil) || a.Region != nil && *a.Region != *other.Region {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Record or nil if Record is nil.
+func (r *Record) DeepCopy() *Record {
+	if r == nil {
+		return nil
+	}
+	out := new(Record)
+	if r.Name != nil {
+		x := *r.Name
+		out.Name = &x
+	}
+	if r.Type != nil {
+		x := *r.Type
+		out.Type = &x
+	}
+	if r.Ttl != nil {
+		x := *r.Ttl
+		out.Ttl = &x
+	}
+	if r.Values != nil {
+		out.Values = make([]string, len(r.Values))
+		copy(out.Values, r.Values)
+	}
+	return out
+}
+
+// Equal reports whether Record and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (r *Record) Equal(other *Record) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.Type == nil) != (other.Type == nil) || r.Type != nil && *r.Type != *other.Type {
+		return false
+	}
+	if (r.Ttl == nil) != (other.Ttl == nil) || r.Ttl != nil && *r.Ttl != *other.Ttl {
+		return false
+	}
+	if (r.Values == nil) != (other.Values == nil) || len(r.Values) != len(other.Values) {
+		return false
+	}
+	for i := range r.Values {
+		if r.Values[i] != other.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmRecords or nil if VmRecords is nil.
+func (v *VmRecords) DeepCopy() *VmRecords {
+	if v == nil {
+		return nil
+	}
+	out := new(VmRecords)
+	if v.Template != nil {
+		x := *v.Template
+		out.Template = &x
+	}
+	if v.VmGroupNames != nil {
+		out.VmGroupNames = make([]string, len(v.VmGroupNames))
+		copy(out.VmGroupNames, v.VmGroupNames)
+	}
+	if v.UsePublicIp != nil {
+		x := *v.UsePublicIp
+		out.UsePublicIp = &x
+	}
+	if v.Ttl != nil {
+		x := *v.Ttl
+		out.Ttl = &x
+	}
+	return out
+}
+
+// Equal reports whether VmRecords and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmRecords) Equal(other *VmRecords) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Template == nil) != (other.Template == nil) || v.Template != nil && *v.Template != *other.Template {
+		return false
+	}
+	if (v.VmGroupNames == nil) != (other.VmGroupNames == nil) || len(v.VmGroupNames) != len(other.VmGroupNames) {
+		return false
+	}
+	for i := range v.VmGroupNames {
+		if v.VmGroupNames[i] != other.VmGroupNames[i] {
+			return false
+		}
+	}
+	if (v.UsePublicIp == nil) != (other.UsePublicIp == nil) || v.UsePublicIp != nil && *v.UsePublicIp != *other.UsePublicIp {
+		return false
+	}
+	if (v.Ttl == nil) != (other.Ttl == nil) || v.Ttl != nil && *v.Ttl != *other.Ttl {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Zone or nil if Zone is nil.
+func (z *Zone) DeepCopy() *Zone {
+	if z == nil {
+		return nil
+	}
+	out := new(Zone)
+	if z.Name != nil {
+		x := *z.Name
+		out.Name = &x
+	}
+	if z.Private != nil {
+		x := *z.Private
+		out.Private = &x
+	}
+	if z.Records != nil {
+		out.Records = make([]Record, len(z.Records))
+		for i := range z.Records {
+			out.Records[i] = *z.Records[i].DeepCopy()
+		}
+	}
+	if z.VmRecords != nil {
+		out.VmRecords = make([]VmRecords, len(z.VmRecords))
+		for i := range z.VmRecords {
+			out.VmRecords[i] = *z.VmRecords[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Zone and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (z *Zone) Equal(other *Zone) bool {
+	if z == nil || other == nil {
+		return z == other
+	}
+	if (z.Name == nil) != (other.Name == nil) || z.Name != nil && *z.Name != *other.Name {
+		return false
+	}
+	if (z.Private == nil) != (other.Private == nil) || z.Private != nil && *z.Private != *other.Private {
+		return false
+	}
+	if (z.Records == nil) != (other.Records == nil) || len(z.Records) != len(other.Records) {
+		return false
+	}
+	for i := range z.Records {
+		if !z.Records[i].Equal(&other.Records[i]) {
+			return false
+		}
+	}
+	if (z.VmRecords == nil) != (other.VmRecords == nil) || len(z.VmRecords) != len(other.VmRecords) {
+		return false
+	}
+	for i := range z.VmRecords {
+		if !z.VmRecords[i].Equal(&other.VmRecords[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Vm or nil if Vm is nil.
+func (v *Vm) DeepCopy() *Vm {
+	if v == nil {
+		return nil
+	}
+	out := new(Vm)
+	out.Name = v.Name
+	out.VmGroupName = v.VmGroupName
+	out.PrivateIp = v.PrivateIp
+	out.PublicIp = v.PublicIp
+	return out
+}
+
+// Equal reports whether Vm and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *Vm) Equal(other *Vm) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if v.Name != other.Name {
+		return false
+	}
+	if v.VmGroupName != other.VmGroupName {
+		return false
+	}
+	if v.PrivateIp != other.PrivateIp {
+		return false
+	}
+	if v.PublicIp != other.PublicIp {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Provider != nil {
+		x := *p.Provider
+		out.Provider = &x
+	}
+	out.Azure = p.Azure.DeepCopy()
+	out.Aws = p.Aws.DeepCopy()
+	if p.Zones != nil {
+		out.Zones = make([]Zone, len(p.Zones))
+		for i := range p.Zones {
+			out.Zones[i] = *p.Zones[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Provider == nil) != (other.Provider == nil) || p.Provider != nil && *p.Provider != *other.Provider {
+		return false
+	}
+	if !p.Azure.Equal(other.Azure) {
+		return false
+	}
+	if !p.Aws.Equal(other.Aws) {
+		return false
+	}
+	if (p.Zones == nil) != (other.Zones == nil) || len(p.Zones) != len(other.Zones) {
+		return false
+	}
+	for i := range p.Zones {
+		if !p.Zones[i].Equal(&other.Zones[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputRecord or nil if OutputRecord is nil.
+func (o *OutputRecord) DeepCopy() *OutputRecord {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputRecord)
+	if o.Fqdn != nil {
+		x := *o.Fqdn
+		out.Fqdn = &x
+	}
+	if o.Type != nil {
+		x := *o.Type
+		out.Type = &x
+	}
+	if o.Values != nil {
+		out.Values = make([]string, len(o.Values))
+		copy(out.Values, o.Values)
+	}
+	return out
+}
+
+// Equal reports whether OutputRecord and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputRecord) Equal(other *OutputRecord) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Fqdn == nil) != (other.Fqdn == nil) || o.Fqdn != nil && *o.Fqdn != *other.Fqdn {
+		return false
+	}
+	if (o.Type == nil) != (other.Type == nil) || o.Type != nil && *o.Type != *other.Type {
+		return false
+	}
+	if (o.Values == nil) != (other.Values == nil) || len(o.Values) != len(other.Values) {
+		return false
+	}
+	for i := range o.Values {
+		if o.Values[i] != other.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputZone or nil if OutputZone is nil.
+func (o *OutputZone) DeepCopy() *OutputZone {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputZone)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.NameServers != nil {
+		out.NameServers = make([]string, len(o.NameServers))
+		copy(out.NameServers, o.NameServers)
+	}
+	if o.Records != nil {
+		out.Records = make([]OutputRecord, len(o.Records))
+		for i := range o.Records {
+			out.Records[i] = *o.Records[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether OutputZone and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputZone) Equal(other *OutputZone) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.NameServers == nil) != (other.NameServers == nil) || len(o.NameServers) != len(other.NameServers) {
+		return false
+	}
+	for i := range o.NameServers {
+		if o.NameServers[i] != other.NameServers[i] {
+			return false
+		}
+	}
+	if (o.Records == nil) != (other.Records == nil) || len(o.Records) != len(other.Records) {
+		return false
+	}
+	for i := range o.Records {
+		if !o.Records[i].Equal(&other.Records[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.Zones != nil {
+		out.Zones = make([]OutputZone, len(o.Zones))
+		for i := range o.Zones {
+			out.Zones[i] = *o.Zones[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Zones == nil) != (other.Zones == nil) || len(o.Zones) != len(other.Zones) {
+		return false
+	}
+	for i := range o.Zones {
+		if !o.Zones[i].Equal(&other.Zones[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,450 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+import (
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAzure_DeepCopy(t *testing.T) {
+	var nilStruct *Azure
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Azure{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestAzure_Equal(t *testing.T) {
+	var nilStruct *Azure
+	original := &Azure{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Azure{}).Equal(&Azure{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestAws_DeepCopy(t *testing.T) {
+	var nilStruct *Aws
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Aws{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestAws_Equal(t *testing.T) {
+	var nilStruct *Aws
+	original := &Aws{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Aws{}).Equal(&Aws{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestRecord_DeepCopy(t *testing.T) {
+	var nilStruct *Record
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Record{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestRecord_Equal(t *testing.T) {
+	var nilStruct *Record
+	original := &Record{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Record{}).Equal(&Record{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestVmRecords_DeepCopy(t *testing.T) {
+	var nilStruct *VmRecords
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &VmRecords{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestVmRecords_Equal(t *testing.T) {
+	var nilStruct *VmRecords
+	original := &VmRecords{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&VmRecords{}).Equal(&VmRecords{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestZone_DeepCopy(t *testing.T) {
+	var nilStruct *Zone
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Zone{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestZone_Equal(t *testing.T) {
+	var nilStruct *Zone
+	original := &Zone{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Zone{}).Equal(&Zone{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestVm_DeepCopy(t *testing.T) {
+	var nilStruct *Vm
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Vm{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestVm_Equal(t *testing.T) {
+	var nilStruct *Vm
+	original := &Vm{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Vm{}).Equal(&Vm{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestParams_DeepCopy(t *testing.T) {
+	var nilStruct *Params
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Params{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestParams_Equal(t *testing.T) {
+	var nilStruct *Params
+	original := &Params{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Params{}).Equal(&Params{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestConfig_DeepCopy(t *testing.T) {
+	var nilStruct *Config
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Config{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestConfig_Equal(t *testing.T) {
+	var nilStruct *Config
+	original := &Config{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Config{}).Equal(&Config{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputRecord_DeepCopy(t *testing.T) {
+	var nilStruct *OutputRecord
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputRecord{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputRecord_Equal(t *testing.T) {
+	var nilStruct *OutputRecord
+	original := &OutputRecord{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputRecord{}).Equal(&OutputRecord{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutputZone_DeepCopy(t *testing.T) {
+	var nilStruct *OutputZone
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &OutputZone{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutputZone_Equal(t *testing.T) {
+	var nilStruct *OutputZone
+	original := &OutputZone{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&OutputZone{}).Equal(&OutputZone{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
+
+func TestOutput_DeepCopy(t *testing.T) {
+	var nilStruct *Output
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &Output{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %s", path)
+	}
+}
+
+func TestOutput_Equal(t *testing.T) {
+	var nilStruct *Output
+	original := &Output{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&Output{}).Equal(&Output{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %s", path)
+		}
+	})
+}
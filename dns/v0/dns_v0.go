@@ -0,0 +1,419 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"strings"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "dns"
+	version = "v0.0.1"
+
+	// Placeholders available in VmRecords.Template.
+	VmNamePlaceholder      = "{{vm_name}}"
+	VmGroupNamePlaceholder = "{{vm_group_name}}"
+
+	apex = "@"
+)
+
+var (
+	// rfc1123Regexp matches host names built from RFC 1123 labels, with optional trailing dot.
+	rfc1123Regexp     = regexp.MustCompile(`^(?i)[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*\.?$`)
+	placeholderRegexp = regexp.MustCompile(`{{[^}]*}}`)
+)
+
+type Azure struct {
+	RgName *string `json:"rg_name" validate:"required,min=1"`
+}
+
+type Aws struct {
+	Region *string `json:"region" validate:"required,min=1"`
+}
+
+// Record is DNS record with Name relative to zone, "@" stands for zone apex. A records require
+// IPv4 addresses as Values and CNAME records single domain name.
+type Record struct {
+	Name   *string  `json:"name" validate:"required,dnsname"`
+	Type   *string  `json:"type" validate:"required,eq=A|eq=CNAME|eq=TXT"`
+	Ttl    *int     `json:"ttl" validate:"required,min=1,max=2147483647"` // https://tools.ietf.org/html/rfc2181#section-8
+	Values []string `json:"values" validate:"required,min=1,dive,required"`
+}
+
+// VmRecords describes A records created for each VM found in azbi or awsbi Output. Template is
+// record name with VmNamePlaceholder and VmGroupNamePlaceholder, i.e. "{{vm_name}}.env" or
+// "{{vm_name}}.env.example.com" in example.com zone. If VmGroupNames is empty, all VM groups
+// are used.
+type VmRecords struct {
+	Template     *string  `json:"template" validate:"required,dnstemplate"`
+	VmGroupNames []string `json:"vm_group_names" validate:"omitempty,dive,required"`
+	UsePublicIp  *bool    `json:"use_public_ip" validate:"required"`
+	Ttl          *int     `json:"ttl" validate:"required,min=1,max=2147483647"`
+}
+
+type Zone struct {
+	Name      *string     `json:"name" validate:"required,fqdn"`
+	Private   *bool       `json:"private" validate:"required"`
+	Records   []Record    `json:"records" validate:"omitempty,dive"`
+	VmRecords []VmRecords `json:"vm_records" validate:"omitempty,dive"`
+}
+
+// Vm is VM for which records are rendered from VmRecords templates. Use VmsFromAzBIOutput or
+// VmsFromAwsBIOutput to get VMs created by other modules.
+type Vm struct {
+	Name        string
+	VmGroupName string
+	PrivateIp   string
+	PublicIp    string
+}
+
+// VmsFromAzBIOutput returns VMs of all VM groups from azbi Output. First private IP of each VM
+// is used.
+func VmsFromAzBIOutput(o *azbi.Output) []Vm {
+	if o == nil {
+		return nil
+	}
+	var result []Vm
+	for _, g := range o.VmGroups {
+		for _, vm := range g.Vms {
+			privateIp := ""
+			if len(vm.PrivateIps) > 0 {
+				privateIp = vm.PrivateIps[0]
+			}
+			result = append(result, Vm{
+				Name:        vm.GetNameV(),
+				VmGroupName: g.GetNameV(),
+				PrivateIp:   privateIp,
+				PublicIp:    vm.GetPublicIpV(),
+			})
+		}
+	}
+	return result
+}
+
+// VmsFromAwsBIOutput returns VMs of all VM groups from awsbi Output.
+func VmsFromAwsBIOutput(o *awsbi.Output) []Vm {
+	if o == nil {
+		return nil
+	}
+	var result []Vm
+	for _, g := range o.VmGroups {
+		for _, vm := range g.Vms {
+			result = append(result, Vm{
+				Name:        vm.GetNameV(),
+				VmGroupName: g.GetNameV(),
+				PrivateIp:   vm.GetPrivateIpV(),
+				PublicIp:    vm.GetPublicIpV(),
+			})
+		}
+	}
+	return result
+}
+
+// RenderRecords returns Records of zone followed by records rendered from VmRecords for vms.
+func (z *Zone) RenderRecords(vms []Vm) ([]Record, error) {
+	if z == nil {
+		return nil, nil
+	}
+	result := make([]Record, 0, len(z.Records))
+	result = append(result, z.Records...)
+	for _, vr := range z.VmRecords {
+		groups := make(map[string]bool)
+		for _, g := range vr.VmGroupNames {
+			groups[g] = true
+		}
+		for _, vm := range vms {
+			if len(groups) > 0 && !groups[vm.VmGroupName] {
+				continue
+			}
+			ip := vm.PrivateIp
+			if vr.GetUsePublicIpV() {
+				ip = vm.PublicIp
+			}
+			if ip == "" {
+				return nil, fmt.Errorf("vm '%s' has no ip address for record '%s'", vm.Name, vr.GetTemplateV())
+			}
+			name := strings.NewReplacer(
+				VmNamePlaceholder, vm.Name,
+				VmGroupNamePlaceholder, vm.VmGroupName,
+			).Replace(vr.GetTemplateV())
+			name = z.relative(strings.ToLower(name))
+			if name != apex && !isDnsName(name) {
+				return nil, fmt.Errorf("rendered record name '%s' is not valid RFC 1123 name", name)
+			}
+			result = append(result, Record{
+				Name:   to.StrPtr(name),
+				Type:   to.StrPtr("A"),
+				Ttl:    to.IntPtr(vr.GetTtlV()),
+				Values: []string{ip},
+			})
+		}
+	}
+	return result, nil
+}
+
+// relative strips zone name from fully qualified name.
+func (z *Zone) relative(name string) string {
+	zone := strings.ToLower(strings.TrimSuffix(z.GetNameV(), "."))
+	name = strings.TrimSuffix(name, ".")
+	if name == zone {
+		return apex
+	}
+	return strings.TrimSuffix(name, "."+zone)
+}
+
+type Params struct {
+	Name     *string `json:"name" validate:"required,min=1"`
+	Provider *string `json:"provider" validate:"required,eq=azure|eq=aws"`
+	Azure    *Azure  `json:"azure" validate:"required_if=Provider azure,omitempty"`
+	Aws      *Aws    `json:"aws" validate:"required_if=Provider aws,omitempty"`
+	Zones    []Zone  `json:"zones" validate:"required,min=1,dive"`
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=dns"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:     to.StrPtr("epiphany"),
+			Provider: to.StrPtr("azure"),
+			Azure: &Azure{
+				RgName: to.StrPtr("epiphany-rg"),
+			},
+			Zones: []Zone{
+				{
+					Name:    to.StrPtr("env.example.com"),
+					Private: to.BooPtr(false),
+					Records: []Record{},
+					VmRecords: []VmRecords{
+						{
+							Template:    to.StrPtr(VmNamePlaceholder),
+							UsePublicIp: to.BooPtr(true),
+							Ttl:         to.IntPtr(300),
+						},
+					},
+				},
+			},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("dns config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("dnsname", IsDnsName)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("dnstemplate", IsDnsTemplate)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(DnsRecordValidation, Record{})
+	validate.RegisterStructValidation(DnsZoneValidation, Zone{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// IsDnsName checks if field is "@" or record name built from RFC 1123 labels.
+func IsDnsName(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+	return field.String() == apex || isDnsName(field.String())
+}
+
+// IsDnsTemplate checks if field is record name template using only known placeholders, which
+// gives RFC 1123 name when placeholders are replaced.
+func IsDnsTemplate(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+	s := strings.NewReplacer(VmNamePlaceholder, "vm", VmGroupNamePlaceholder, "group").Replace(field.String())
+	if placeholderRegexp.MatchString(s) {
+		return false
+	}
+	return isDnsName(s)
+}
+
+func isDnsName(s string) bool {
+	return len(s) <= 253 && rfc1123Regexp.MatchString(s)
+}
+
+// DnsRecordValidation checks that Values match record Type.
+func DnsRecordValidation(sl validator.StructLevel) {
+	r := sl.Current().Interface().(Record)
+	if r.Type == nil {
+		return
+	}
+	switch *r.Type {
+	case "A":
+		for i, v := range r.Values {
+			if ip := net.ParseIP(v); ip == nil || ip.To4() == nil {
+				sl.ReportError(r.Values[i], fmt.Sprintf("Values[%d]", i), fmt.Sprintf("Values[%d]", i), "ipv4", "")
+			}
+		}
+	case "CNAME":
+		if len(r.Values) > 1 {
+			sl.ReportError(r.Values, "Values", "Values", "len", "1")
+		}
+		for i, v := range r.Values {
+			if !isDnsName(v) {
+				sl.ReportError(r.Values[i], fmt.Sprintf("Values[%d]", i), fmt.Sprintf("Values[%d]", i), "dnsname", "")
+			}
+		}
+	case "TXT":
+		for i, v := range r.Values {
+			if len(v) > 255 {
+				sl.ReportError(r.Values[i], fmt.Sprintf("Values[%d]", i), fmt.Sprintf("Values[%d]", i), "max", "255")
+			}
+		}
+	}
+}
+
+// DnsZoneValidation checks that there is single record set for each name and type and that CNAME
+// records don't share name with other records.
+func DnsZoneValidation(sl validator.StructLevel) {
+	z := sl.Current().Interface().(Zone)
+	types := make(map[string]map[string]bool)
+	for i, r := range z.Records {
+		if r.Name == nil || r.Type == nil {
+			continue
+		}
+		name := z.relative(strings.ToLower(*r.Name))
+		if types[name] == nil {
+			types[name] = make(map[string]bool)
+		}
+		switch {
+		case types[name][*r.Type]:
+			sl.ReportError(z.Records[i].Name, fmt.Sprintf("Records[%d].Name", i), "Name", "unique", "")
+		case *r.Type == "CNAME" && len(types[name]) > 0, types[name]["CNAME"]:
+			sl.ReportError(z.Records[i].Name, fmt.Sprintf("Records[%d].Name", i), "Name", "cnameconflict", "")
+		}
+		types[name][*r.Type] = true
+	}
+}
+
+type OutputRecord struct {
+	Fqdn   *string  `json:"fqdn" validate:"required,fqdn"`
+	Type   *string  `json:"type" validate:"required,eq=A|eq=CNAME|eq=TXT"`
+	Values []string `json:"values" validate:"omitempty,dive,required"`
+}
+
+type OutputZone struct {
+	Name        *string        `json:"name" validate:"required,fqdn"`
+	NameServers []string       `json:"name_servers" validate:"omitempty,dive,required,fqdn"`
+	Records     []OutputRecord `json:"records" validate:"omitempty,dive"`
+}
+
+type Output struct {
+	Zones []OutputZone `json:"zones" validate:"omitempty,dive"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
@@ -0,0 +1,887 @@
+package v0
+
+import (
+	"testing"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"azure": {
+			"rg_name": "epiphany-rg"
+		},
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "@",
+						"type": "A",
+						"ttl": 300,
+						"values": ["20.0.0.1"]
+					},
+					{
+						"name": "www",
+						"type": "CNAME",
+						"ttl": 300,
+						"values": ["env.example.com"]
+					},
+					{
+						"name": "acme",
+						"type": "TXT",
+						"ttl": 300,
+						"values": ["token"]
+					}
+				],
+				"vm_records": [
+					{
+						"template": "{{vm_name}}.env.example.com",
+						"vm_group_names": ["vm-group0"],
+						"use_public_ip": false,
+						"ttl": 60
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("dns"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:     to.StrPtr("epiphany"),
+					Provider: to.StrPtr("azure"),
+					Azure: &Azure{
+						RgName: to.StrPtr("epiphany-rg"),
+					},
+					Zones: []Zone{
+						{
+							Name:    to.StrPtr("env.example.com"),
+							Private: to.BooPtr(false),
+							Records: []Record{
+								{
+									Name:   to.StrPtr("@"),
+									Type:   to.StrPtr("A"),
+									Ttl:    to.IntPtr(300),
+									Values: []string{"20.0.0.1"},
+								},
+								{
+									Name:   to.StrPtr("www"),
+									Type:   to.StrPtr("CNAME"),
+									Ttl:    to.IntPtr(300),
+									Values: []string{"env.example.com"},
+								},
+								{
+									Name:   to.StrPtr("acme"),
+									Type:   to.StrPtr("TXT"),
+									Ttl:    to.IntPtr(300),
+									Values: []string{"token"},
+								},
+							},
+							VmRecords: []VmRecords{
+								{
+									Template:     to.StrPtr("{{vm_name}}.env.example.com"),
+									VmGroupNames: []string{"vm-group0"},
+									UsePublicIp:  to.BooPtr(false),
+									Ttl:          to.IntPtr(60),
+								},
+							},
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "aws provider and unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"extra_outer_field": "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"provider": "aws",
+		"aws": {
+			"region": "eu-central-1",
+			"extra_aws_field": "extra_aws_value"
+		},
+		"extra_inner_field": "extra_inner_value",
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.1"]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("dns"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:     to.StrPtr("epiphany"),
+					Provider: to.StrPtr("aws"),
+					Aws: &Aws{
+						Region: to.StrPtr("eu-central-1"),
+					},
+					Zones: []Zone{
+						{
+							Name:    to.StrPtr("env.example.com"),
+							Private: to.BooPtr(false),
+							Records: []Record{
+								{
+									Name:   to.StrPtr("www"),
+									Type:   to.StrPtr("A"),
+									Ttl:    to.IntPtr(300),
+									Values: []string{"10.0.0.1"},
+								},
+							},
+						},
+					},
+				},
+				Unused: []string{"extra_outer_field", "params.aws.extra_aws_field", "params.extra_inner_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"azure": {
+			"rg_name": "epiphany-rg"
+		},
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.1"]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Provider",
+					Field: "Provider",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones",
+					Field: "Zones",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "missing provider section",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"aws": {
+			"region": "eu-central-1"
+		},
+		"zones": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Azure",
+					Field: "Azure",
+					Tag:   "required_if",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones",
+					Field: "Zones",
+					Tag:   "min",
+				},
+			},
+		},
+		{
+			name: "unknown provider",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "gcp",
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.1"]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Provider",
+					Field: "Provider",
+					Tag:   "eq=azure|eq=aws",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Zones contains all scenarios related to validation of Zone and Record structures.
+func TestConfig_Load_Zones(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect zone name",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"azure": {
+			"rg_name": "epiphany-rg"
+		},
+		"zones": [
+			{
+				"name": "not a zone",
+				"private": false,
+				"records": [
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.1"]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Name",
+					Field: "Name",
+					Tag:   "fqdn",
+				},
+			},
+		},
+		{
+			name: "incorrect record names and ttls",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"azure": {
+			"rg_name": "epiphany-rg"
+		},
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "-www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.1"]
+					},
+					{
+						"name": "api_server",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.2"]
+					},
+					{
+						"name": "mail",
+						"type": "A",
+						"ttl": 0,
+						"values": ["10.0.0.3"]
+					},
+					{
+						"name": "web",
+						"type": "A",
+						"ttl": 2147483648,
+						"values": ["10.0.0.4"]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[0].Name",
+					Field: "Name",
+					Tag:   "dnsname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[1].Name",
+					Field: "Name",
+					Tag:   "dnsname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[2].Ttl",
+					Field: "Ttl",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[3].Ttl",
+					Field: "Ttl",
+					Tag:   "max",
+				},
+			},
+		},
+		{
+			name: "values not matching record type",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"azure": {
+			"rg_name": "epiphany-rg"
+		},
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0", "::1"]
+					},
+					{
+						"name": "api",
+						"type": "CNAME",
+						"ttl": 300,
+						"values": ["a.example.com", "b.example.com"]
+					},
+					{
+						"name": "app",
+						"type": "CNAME",
+						"ttl": 300,
+						"values": ["not_a_name"]
+					},
+					{
+						"name": "txt",
+						"type": "TXT",
+						"ttl": 300,
+						"values": ["xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx"]
+					},
+					{
+						"name": "empty",
+						"type": "TXT",
+						"ttl": 300,
+						"values": [""]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[0].Values[0]",
+					Field: "Values[0]",
+					Tag:   "ipv4",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[0].Values[1]",
+					Field: "Values[1]",
+					Tag:   "ipv4",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[1].Values",
+					Field: "Values",
+					Tag:   "len",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[2].Values[0]",
+					Field: "Values[0]",
+					Tag:   "dnsname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[3].Values[0]",
+					Field: "Values[0]",
+					Tag:   "max",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[4].Values[0]",
+					Field: "Values[0]",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "conflicting records",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"azure": {
+			"rg_name": "epiphany-rg"
+		},
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.1"]
+					},
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.2"]
+					},
+					{
+						"name": "www",
+						"type": "CNAME",
+						"ttl": 300,
+						"values": ["env.example.com"]
+					},
+					{
+						"name": "api",
+						"type": "CNAME",
+						"ttl": 300,
+						"values": ["env.example.com"]
+					},
+					{
+						"name": "api",
+						"type": "TXT",
+						"ttl": 300,
+						"values": ["token"]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[1].Name",
+					Field: "Records[1].Name",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[2].Name",
+					Field: "Records[2].Name",
+					Tag:   "cnameconflict",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].Records[4].Name",
+					Field: "Records[4].Name",
+					Tag:   "cnameconflict",
+				},
+			},
+		},
+		{
+			name: "incorrect vm records templates",
+			json: []byte(`{
+	"kind": "dns",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"provider": "azure",
+		"azure": {
+			"rg_name": "epiphany-rg"
+		},
+		"zones": [
+			{
+				"name": "env.example.com",
+				"private": false,
+				"records": [
+					{
+						"name": "www",
+						"type": "A",
+						"ttl": 300,
+						"values": ["10.0.0.1"]
+					}
+				],
+				"vm_records": [
+					{
+						"template": "{{vm_id}}.env",
+						"use_public_ip": false,
+						"ttl": 60
+					},
+					{
+						"template": "{{vm_name}}..env",
+						"vm_group_names": [""],
+						"use_public_ip": true,
+						"ttl": 60
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].VmRecords[0].Template",
+					Field: "Template",
+					Tag:   "dnstemplate",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].VmRecords[1].Template",
+					Field: "Template",
+					Tag:   "dnstemplate",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Zones[0].VmRecords[1].VmGroupNames[0]",
+					Field: "VmGroupNames[0]",
+					Tag:   "required",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestZone_RenderRecords(t *testing.T) {
+	tests := []struct {
+		name    string
+		zone    *Zone
+		vms     []Vm
+		want    []Record
+		wantErr bool
+	}{
+		{
+			name: "records from azbi output",
+			zone: &Zone{
+				Name: to.StrPtr("env.example.com"),
+				Records: []Record{
+					{
+						Name:   to.StrPtr("www"),
+						Type:   to.StrPtr("CNAME"),
+						Ttl:    to.IntPtr(300),
+						Values: []string{"vm-group0-1.env.example.com"},
+					},
+				},
+				VmRecords: []VmRecords{
+					{
+						Template:    to.StrPtr("{{vm_name}}.env.example.com"),
+						UsePublicIp: to.BooPtr(true),
+						Ttl:         to.IntPtr(60),
+					},
+					{
+						Template:     to.StrPtr("{{vm_name}}.{{vm_group_name}}.internal"),
+						VmGroupNames: []string{"vm-group1"},
+						UsePublicIp:  to.BooPtr(false),
+						Ttl:          to.IntPtr(60),
+					},
+				},
+			},
+			vms: VmsFromAzBIOutput(&azbi.Output{
+				VmGroups: []azbi.OutputVmGroup{
+					{
+						Name: to.StrPtr("vm-group0"),
+						Vms: []azbi.OutputVm{
+							{
+								Name:       to.StrPtr("vm-group0-1"),
+								PrivateIps: []string{"10.0.1.4", "10.0.1.5"},
+								PublicIp:   to.StrPtr("20.0.0.1"),
+							},
+						},
+					},
+					{
+						Name: to.StrPtr("vm-group1"),
+						Vms: []azbi.OutputVm{
+							{
+								Name:       to.StrPtr("VM-Group1-1"),
+								PrivateIps: []string{"10.0.2.4"},
+								PublicIp:   to.StrPtr("20.0.0.2"),
+							},
+						},
+					},
+				},
+			}),
+			want: []Record{
+				{
+					Name:   to.StrPtr("www"),
+					Type:   to.StrPtr("CNAME"),
+					Ttl:    to.IntPtr(300),
+					Values: []string{"vm-group0-1.env.example.com"},
+				},
+				{
+					Name:   to.StrPtr("vm-group0-1"),
+					Type:   to.StrPtr("A"),
+					Ttl:    to.IntPtr(60),
+					Values: []string{"20.0.0.1"},
+				},
+				{
+					Name:   to.StrPtr("vm-group1-1"),
+					Type:   to.StrPtr("A"),
+					Ttl:    to.IntPtr(60),
+					Values: []string{"20.0.0.2"},
+				},
+				{
+					Name:   to.StrPtr("vm-group1-1.vm-group1.internal"),
+					Type:   to.StrPtr("A"),
+					Ttl:    to.IntPtr(60),
+					Values: []string{"10.0.2.4"},
+				},
+			},
+		},
+		{
+			name: "records from awsbi output without public ips",
+			zone: &Zone{
+				Name: to.StrPtr("env.example.com."),
+				VmRecords: []VmRecords{
+					{
+						Template:    to.StrPtr("{{vm_name}}"),
+						UsePublicIp: to.BooPtr(true),
+						Ttl:         to.IntPtr(60),
+					},
+				},
+			},
+			vms: VmsFromAwsBIOutput(&awsbi.Output{
+				VmGroups: []awsbi.OutputVmGroup{
+					{
+						Name: to.StrPtr("vm-group0"),
+						Vms: []awsbi.OutputVm{
+							{
+								Name:      to.StrPtr("vm0"),
+								PrivateIp: to.StrPtr("10.1.1.4"),
+							},
+						},
+					},
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "rendered name not matching rfc 1123",
+			zone: &Zone{
+				Name: to.StrPtr("env.example.com"),
+				VmRecords: []VmRecords{
+					{
+						Template:    to.StrPtr("{{vm_name}}"),
+						UsePublicIp: to.BooPtr(false),
+						Ttl:         to.IntPtr(60),
+					},
+				},
+			},
+			vms: []Vm{
+				{
+					Name:        "vm_0",
+					VmGroupName: "vm-group0",
+					PrivateIp:   "10.1.1.4",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zone apex",
+			zone: &Zone{
+				Name: to.StrPtr("env.example.com"),
+				VmRecords: []VmRecords{
+					{
+						Template:    to.StrPtr("env.example.com"),
+						UsePublicIp: to.BooPtr(false),
+						Ttl:         to.IntPtr(60),
+					},
+				},
+			},
+			vms: []Vm{
+				{
+					Name:        "vm0",
+					VmGroupName: "vm-group0",
+					PrivateIp:   "10.1.1.4",
+				},
+			},
+			want: []Record{
+				{
+					Name:   to.StrPtr("@"),
+					Type:   to.StrPtr("A"),
+					Ttl:    to.IntPtr(60),
+					Values: []string{"10.1.1.4"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.zone.RenderRecords(tt.vms)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenderRecords() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("RenderRecords() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,842 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetGbSize returns GbSize field of Disk or nil if Disk is nil.
+func (d *Disk) GetGbSize() *int {
+	if d == nil {
+		return nil
+	}
+	return d.GbSize
+}
+
+// GetGbSizeV returns value of GbSize field of Disk or zero value if either Disk or field is nil.
+func (d *Disk) GetGbSizeV() int {
+	if d == nil || d.GbSize == nil {
+		return 0
+	}
+	return *d.GbSize
+}
+
+// GetGbSizeOr returns value of GbSize field of Disk or def if either Disk or field is nil.
+func (d *Disk) GetGbSizeOr(def int) int {
+	if d == nil || d.GbSize == nil {
+		return def
+	}
+	return *d.GbSize
+}
+
+// GetType returns Type field of Disk or nil if Disk is nil.
+func (d *Disk) GetType() *string {
+	if d == nil {
+		return nil
+	}
+	return d.Type
+}
+
+// GetTypeV returns value of Type field of Disk or zero value if either Disk or field is nil.
+func (d *Disk) GetTypeV() string {
+	if d == nil || d.Type == nil {
+		return ""
+	}
+	return *d.Type
+}
+
+// GetTypeOr returns value of Type field of Disk or def if either Disk or field is nil.
+func (d *Disk) GetTypeOr(def string) string {
+	if d == nil || d.Type == nil {
+		return def
+	}
+	return *d.Type
+}
+
+// GetProject returns Project field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetProject() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Project
+}
+
+// GetProjectV returns value of Project field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetProjectV() string {
+	if v == nil || v.Project == nil {
+		return ""
+	}
+	return *v.Project
+}
+
+// GetProjectOr returns value of Project field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetProjectOr(def string) string {
+	if v == nil || v.Project == nil {
+		return def
+	}
+	return *v.Project
+}
+
+// GetFamily returns Family field of VmImage or nil if VmImage is nil.
+func (v *VmImage) GetFamily() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Family
+}
+
+// GetFamilyV returns value of Family field of VmImage or zero value if either VmImage or field is nil.
+func (v *VmImage) GetFamilyV() string {
+	if v == nil || v.Family == nil {
+		return ""
+	}
+	return *v.Family
+}
+
+// GetFamilyOr returns value of Family field of VmImage or def if either VmImage or field is nil.
+func (v *VmImage) GetFamilyOr(def string) string {
+	if v == nil || v.Family == nil {
+		return def
+	}
+	return *v.Family
+}
+
+// GetName returns Name field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Name
+}
+
+// GetNameV returns value of Name field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetNameV() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
+// GetNameOr returns value of Name field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetNameOr(def string) string {
+	if v == nil || v.Name == nil {
+		return def
+	}
+	return *v.Name
+}
+
+// GetVmCount returns VmCount field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmCount() *int {
+	if v == nil {
+		return nil
+	}
+	return v.VmCount
+}
+
+// GetVmCountV returns value of VmCount field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmCountV() int {
+	if v == nil || v.VmCount == nil {
+		return 0
+	}
+	return *v.VmCount
+}
+
+// GetVmCountOr returns value of VmCount field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmCountOr(def int) int {
+	if v == nil || v.VmCount == nil {
+		return def
+	}
+	return *v.VmCount
+}
+
+// GetMachineType returns MachineType field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetMachineType() *string {
+	if v == nil {
+		return nil
+	}
+	return v.MachineType
+}
+
+// GetMachineTypeV returns value of MachineType field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetMachineTypeV() string {
+	if v == nil || v.MachineType == nil {
+		return ""
+	}
+	return *v.MachineType
+}
+
+// GetMachineTypeOr returns value of MachineType field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetMachineTypeOr(def string) string {
+	if v == nil || v.MachineType == nil {
+		return def
+	}
+	return *v.MachineType
+}
+
+// GetUsePublicIp returns UsePublicIp field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetUsePublicIp() *bool {
+	if v == nil {
+		return nil
+	}
+	return v.UsePublicIp
+}
+
+// GetUsePublicIpV returns value of UsePublicIp field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetUsePublicIpV() bool {
+	if v == nil || v.UsePublicIp == nil {
+		return false
+	}
+	return *v.UsePublicIp
+}
+
+// GetUsePublicIpOr returns value of UsePublicIp field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetUsePublicIpOr(def bool) bool {
+	if v == nil || v.UsePublicIp == nil {
+		return def
+	}
+	return *v.UsePublicIp
+}
+
+// GetSubnetName returns SubnetName field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetSubnetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.SubnetName
+}
+
+// GetSubnetNameV returns value of SubnetName field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetSubnetNameV() string {
+	if v == nil || v.SubnetName == nil {
+		return ""
+	}
+	return *v.SubnetName
+}
+
+// GetSubnetNameOr returns value of SubnetName field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetSubnetNameOr(def string) string {
+	if v == nil || v.SubnetName == nil {
+		return def
+	}
+	return *v.SubnetName
+}
+
+// GetZones returns Zones field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetZones() []string {
+	if v == nil {
+		return nil
+	}
+	if len(v.Zones) == 0 {
+		return []string{}
+	}
+	return v.Zones
+}
+
+// GetTags returns Tags field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetTags() []string {
+	if v == nil {
+		return nil
+	}
+	if len(v.Tags) == 0 {
+		return []string{}
+	}
+	return v.Tags
+}
+
+// GetVmImage returns VmImage field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetVmImage() *VmImage {
+	if v == nil {
+		return nil
+	}
+	return v.VmImage
+}
+
+// GetVmImageV returns value of VmImage field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetVmImageV() VmImage {
+	if v == nil || v.VmImage == nil {
+		return VmImage{}
+	}
+	return *v.VmImage
+}
+
+// GetVmImageOr returns value of VmImage field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetVmImageOr(def VmImage) VmImage {
+	if v == nil || v.VmImage == nil {
+		return def
+	}
+	return *v.VmImage
+}
+
+// GetBootDisk returns BootDisk field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetBootDisk() *Disk {
+	if v == nil {
+		return nil
+	}
+	return v.BootDisk
+}
+
+// GetBootDiskV returns value of BootDisk field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetBootDiskV() Disk {
+	if v == nil || v.BootDisk == nil {
+		return Disk{}
+	}
+	return *v.BootDisk
+}
+
+// GetBootDiskOr returns value of BootDisk field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetBootDiskOr(def Disk) Disk {
+	if v == nil || v.BootDisk == nil {
+		return def
+	}
+	return *v.BootDisk
+}
+
+// GetDataDisks returns DataDisks field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetDataDisks() []Disk {
+	if v == nil {
+		return nil
+	}
+	if len(v.DataDisks) == 0 {
+		return []Disk{}
+	}
+	return v.DataDisks
+}
+
+// GetName returns Name field of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) GetName() *string {
+	if f == nil {
+		return nil
+	}
+	return f.Name
+}
+
+// GetNameV returns value of Name field of FirewallRule or zero value if either FirewallRule or field is nil.
+func (f *FirewallRule) GetNameV() string {
+	if f == nil || f.Name == nil {
+		return ""
+	}
+	return *f.Name
+}
+
+// GetNameOr returns value of Name field of FirewallRule or def if either FirewallRule or field is nil.
+func (f *FirewallRule) GetNameOr(def string) string {
+	if f == nil || f.Name == nil {
+		return def
+	}
+	return *f.Name
+}
+
+// GetDirection returns Direction field of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) GetDirection() *string {
+	if f == nil {
+		return nil
+	}
+	return f.Direction
+}
+
+// GetDirectionV returns value of Direction field of FirewallRule or zero value if either FirewallRule or field is nil.
+func (f *FirewallRule) GetDirectionV() string {
+	if f == nil || f.Direction == nil {
+		return ""
+	}
+	return *f.Direction
+}
+
+// GetDirectionOr returns value of Direction field of FirewallRule or def if either FirewallRule or field is nil.
+func (f *FirewallRule) GetDirectionOr(def string) string {
+	if f == nil || f.Direction == nil {
+		return def
+	}
+	return *f.Direction
+}
+
+// GetProtocol returns Protocol field of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) GetProtocol() *string {
+	if f == nil {
+		return nil
+	}
+	return f.Protocol
+}
+
+// GetProtocolV returns value of Protocol field of FirewallRule or zero value if either FirewallRule or field is nil.
+func (f *FirewallRule) GetProtocolV() string {
+	if f == nil || f.Protocol == nil {
+		return ""
+	}
+	return *f.Protocol
+}
+
+// GetProtocolOr returns value of Protocol field of FirewallRule or def if either FirewallRule or field is nil.
+func (f *FirewallRule) GetProtocolOr(def string) string {
+	if f == nil || f.Protocol == nil {
+		return def
+	}
+	return *f.Protocol
+}
+
+// GetPorts returns Ports field of FirewallRule, nil if FirewallRule is nil or empty slice if field is nil.
+func (f *FirewallRule) GetPorts() []string {
+	if f == nil {
+		return nil
+	}
+	if len(f.Ports) == 0 {
+		return []string{}
+	}
+	return f.Ports
+}
+
+// GetRanges returns Ranges field of FirewallRule, nil if FirewallRule is nil or empty slice if field is nil.
+func (f *FirewallRule) GetRanges() []string {
+	if f == nil {
+		return nil
+	}
+	if len(f.Ranges) == 0 {
+		return []string{}
+	}
+	return f.Ranges
+}
+
+// GetTargetTags returns TargetTags field of FirewallRule, nil if FirewallRule is nil or empty slice if field is nil.
+func (f *FirewallRule) GetTargetTags() []string {
+	if f == nil {
+		return nil
+	}
+	if len(f.TargetTags) == 0 {
+		return []string{}
+	}
+	return f.TargetTags
+}
+
+// GetName returns Name field of Subnet or nil if Subnet is nil.
+func (s *Subnet) GetName() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Name
+}
+
+// GetNameV returns value of Name field of Subnet or zero value if either Subnet or field is nil.
+func (s *Subnet) GetNameV() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+// GetNameOr returns value of Name field of Subnet or def if either Subnet or field is nil.
+func (s *Subnet) GetNameOr(def string) string {
+	if s == nil || s.Name == nil {
+		return def
+	}
+	return *s.Name
+}
+
+// GetAddressPrefix returns AddressPrefix field of Subnet or nil if Subnet is nil.
+func (s *Subnet) GetAddressPrefix() *string {
+	if s == nil {
+		return nil
+	}
+	return s.AddressPrefix
+}
+
+// GetAddressPrefixV returns value of AddressPrefix field of Subnet or zero value if either Subnet or field is nil.
+func (s *Subnet) GetAddressPrefixV() string {
+	if s == nil || s.AddressPrefix == nil {
+		return ""
+	}
+	return *s.AddressPrefix
+}
+
+// GetAddressPrefixOr returns value of AddressPrefix field of Subnet or def if either Subnet or field is nil.
+func (s *Subnet) GetAddressPrefixOr(def string) string {
+	if s == nil || s.AddressPrefix == nil {
+		return def
+	}
+	return *s.AddressPrefix
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetProject returns Project field of Params or nil if Params is nil.
+func (p *Params) GetProject() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Project
+}
+
+// GetProjectV returns value of Project field of Params or zero value if either Params or field is nil.
+func (p *Params) GetProjectV() string {
+	if p == nil || p.Project == nil {
+		return ""
+	}
+	return *p.Project
+}
+
+// GetProjectOr returns value of Project field of Params or def if either Params or field is nil.
+func (p *Params) GetProjectOr(def string) string {
+	if p == nil || p.Project == nil {
+		return def
+	}
+	return *p.Project
+}
+
+// GetRegion returns Region field of Params or nil if Params is nil.
+func (p *Params) GetRegion() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Region
+}
+
+// GetRegionV returns value of Region field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRegionV() string {
+	if p == nil || p.Region == nil {
+		return ""
+	}
+	return *p.Region
+}
+
+// GetRegionOr returns value of Region field of Params or def if either Params or field is nil.
+func (p *Params) GetRegionOr(def string) string {
+	if p == nil || p.Region == nil {
+		return def
+	}
+	return *p.Region
+}
+
+// GetRsaPublicKeyPath returns RsaPublicKeyPath field of Params or nil if Params is nil.
+func (p *Params) GetRsaPublicKeyPath() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathV returns value of RsaPublicKeyPath field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathV() string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return ""
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetRsaPublicKeyPathOr returns value of RsaPublicKeyPath field of Params or def if either Params or field is nil.
+func (p *Params) GetRsaPublicKeyPathOr(def string) string {
+	if p == nil || p.RsaPublicKeyPath == nil {
+		return def
+	}
+	return *p.RsaPublicKeyPath
+}
+
+// GetSubnets returns Subnets field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetSubnets() []Subnet {
+	if p == nil {
+		return nil
+	}
+	if len(p.Subnets) == 0 {
+		return []Subnet{}
+	}
+	return p.Subnets
+}
+
+// GetFirewallRules returns FirewallRules field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetFirewallRules() []FirewallRule {
+	if p == nil {
+		return nil
+	}
+	if len(p.FirewallRules) == 0 {
+		return []FirewallRule{}
+	}
+	return p.FirewallRules
+}
+
+// GetVmGroups returns VmGroups field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetVmGroups() []VmGroup {
+	if p == nil {
+		return nil
+	}
+	if len(p.VmGroups) == 0 {
+		return []VmGroup{}
+	}
+	return p.VmGroups
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetName returns Name field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetZone returns Zone field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetZone() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Zone
+}
+
+// GetZoneV returns value of Zone field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetZoneV() string {
+	if o == nil || o.Zone == nil {
+		return ""
+	}
+	return *o.Zone
+}
+
+// GetZoneOr returns value of Zone field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetZoneOr(def string) string {
+	if o == nil || o.Zone == nil {
+		return def
+	}
+	return *o.Zone
+}
+
+// GetPublicIp returns PublicIp field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetPublicIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PublicIp
+}
+
+// GetPublicIpV returns value of PublicIp field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetPublicIpV() string {
+	if o == nil || o.PublicIp == nil {
+		return ""
+	}
+	return *o.PublicIp
+}
+
+// GetPublicIpOr returns value of PublicIp field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetPublicIpOr(def string) string {
+	if o == nil || o.PublicIp == nil {
+		return def
+	}
+	return *o.PublicIp
+}
+
+// GetPrivateIp returns PrivateIp field of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) GetPrivateIp() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PrivateIp
+}
+
+// GetPrivateIpV returns value of PrivateIp field of OutputVm or zero value if either OutputVm or field is nil.
+func (o *OutputVm) GetPrivateIpV() string {
+	if o == nil || o.PrivateIp == nil {
+		return ""
+	}
+	return *o.PrivateIp
+}
+
+// GetPrivateIpOr returns value of PrivateIp field of OutputVm or def if either OutputVm or field is nil.
+func (o *OutputVm) GetPrivateIpOr(def string) string {
+	if o == nil || o.PrivateIp == nil {
+		return def
+	}
+	return *o.PrivateIp
+}
+
+// GetName returns Name field of OutputVmGroup or nil if OutputVmGroup is nil.
+func (o *OutputVmGroup) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputVmGroup or zero value if either OutputVmGroup or field is nil.
+func (o *OutputVmGroup) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputVmGroup or def if either OutputVmGroup or field is nil.
+func (o *OutputVmGroup) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetVms returns Vms field of OutputVmGroup, nil if OutputVmGroup is nil or empty slice if field is nil.
+func (o *OutputVmGroup) GetVms() []OutputVm {
+	if o == nil {
+		return nil
+	}
+	if len(o.Vms) == 0 {
+		return []OutputVm{}
+	}
+	return o.Vms
+}
+
+// GetNetworkName returns NetworkName field of Output or nil if Output is nil.
+func (o *Output) GetNetworkName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.NetworkName
+}
+
+// GetNetworkNameV returns value of NetworkName field of Output or zero value if either Output or field is nil.
+func (o *Output) GetNetworkNameV() string {
+	if o == nil || o.NetworkName == nil {
+		return ""
+	}
+	return *o.NetworkName
+}
+
+// GetNetworkNameOr returns value of NetworkName field of Output or def if either Output or field is nil.
+func (o *Output) GetNetworkNameOr(def string) string {
+	if o == nil || o.NetworkName == nil {
+		return def
+	}
+	return *o.NetworkName
+}
+
+// GetSubnetNames returns SubnetNames field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetSubnetNames() []string {
+	if o == nil {
+		return nil
+	}
+	if len(o.SubnetNames) == 0 {
+		return []string{}
+	}
+	return o.SubnetNames
+}
+
+// GetVmGroups returns VmGroups field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetVmGroups() []OutputVmGroup {
+	if o == nil {
+		return nil
+	}
+	if len(o.VmGroups) == 0 {
+		return []OutputVmGroup{}
+	}
+	return o.VmGroups
+}
@@ -0,0 +1,843 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDisk_Accessors(t *testing.T) {
+	var nilStruct *Disk
+	emptyStruct := &Disk{}
+	t.Run("GbSize", func(t *testing.T) {
+		v := 1
+		fullStruct := &Disk{GbSize: &v}
+		if nilStruct.GetGbSize() != nil || emptyStruct.GetGbSize() != nil {
+			t.Error("GetGbSize() expected to return nil")
+		}
+		if fullStruct.GetGbSize() != &v {
+			t.Error("GetGbSize() expected to return field")
+		}
+		if nilStruct.GetGbSizeV() != 0 || emptyStruct.GetGbSizeV() != 0 {
+			t.Error("GetGbSizeV() expected to return zero value")
+		}
+		if fullStruct.GetGbSizeV() != v {
+			t.Error("GetGbSizeV() expected to return field value")
+		}
+		if nilStruct.GetGbSizeOr(v) != v || emptyStruct.GetGbSizeOr(v) != v {
+			t.Error("GetGbSizeOr() expected to return default value")
+		}
+		if fullStruct.GetGbSizeOr(0) != v {
+			t.Error("GetGbSizeOr() expected to return field value")
+		}
+	})
+	t.Run("Type", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Disk{Type: &v}
+		if nilStruct.GetType() != nil || emptyStruct.GetType() != nil {
+			t.Error("GetType() expected to return nil")
+		}
+		if fullStruct.GetType() != &v {
+			t.Error("GetType() expected to return field")
+		}
+		if nilStruct.GetTypeV() != "" || emptyStruct.GetTypeV() != "" {
+			t.Error("GetTypeV() expected to return zero value")
+		}
+		if fullStruct.GetTypeV() != v {
+			t.Error("GetTypeV() expected to return field value")
+		}
+		if nilStruct.GetTypeOr(v) != v || emptyStruct.GetTypeOr(v) != v {
+			t.Error("GetTypeOr() expected to return default value")
+		}
+		if fullStruct.GetTypeOr("") != v {
+			t.Error("GetTypeOr() expected to return field value")
+		}
+	})
+}
+
+func TestVmImage_Accessors(t *testing.T) {
+	var nilStruct *VmImage
+	emptyStruct := &VmImage{}
+	t.Run("Project", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{Project: &v}
+		if nilStruct.GetProject() != nil || emptyStruct.GetProject() != nil {
+			t.Error("GetProject() expected to return nil")
+		}
+		if fullStruct.GetProject() != &v {
+			t.Error("GetProject() expected to return field")
+		}
+		if nilStruct.GetProjectV() != "" || emptyStruct.GetProjectV() != "" {
+			t.Error("GetProjectV() expected to return zero value")
+		}
+		if fullStruct.GetProjectV() != v {
+			t.Error("GetProjectV() expected to return field value")
+		}
+		if nilStruct.GetProjectOr(v) != v || emptyStruct.GetProjectOr(v) != v {
+			t.Error("GetProjectOr() expected to return default value")
+		}
+		if fullStruct.GetProjectOr("") != v {
+			t.Error("GetProjectOr() expected to return field value")
+		}
+	})
+	t.Run("Family", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmImage{Family: &v}
+		if nilStruct.GetFamily() != nil || emptyStruct.GetFamily() != nil {
+			t.Error("GetFamily() expected to return nil")
+		}
+		if fullStruct.GetFamily() != &v {
+			t.Error("GetFamily() expected to return field")
+		}
+		if nilStruct.GetFamilyV() != "" || emptyStruct.GetFamilyV() != "" {
+			t.Error("GetFamilyV() expected to return zero value")
+		}
+		if fullStruct.GetFamilyV() != v {
+			t.Error("GetFamilyV() expected to return field value")
+		}
+		if nilStruct.GetFamilyOr(v) != v || emptyStruct.GetFamilyOr(v) != v {
+			t.Error("GetFamilyOr() expected to return default value")
+		}
+		if fullStruct.GetFamilyOr("") != v {
+			t.Error("GetFamilyOr() expected to return field value")
+		}
+	})
+}
+
+func TestVmGroup_Accessors(t *testing.T) {
+	var nilStruct *VmGroup
+	emptyStruct := &VmGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VmCount", func(t *testing.T) {
+		v := 1
+		fullStruct := &VmGroup{VmCount: &v}
+		if nilStruct.GetVmCount() != nil || emptyStruct.GetVmCount() != nil {
+			t.Error("GetVmCount() expected to return nil")
+		}
+		if fullStruct.GetVmCount() != &v {
+			t.Error("GetVmCount() expected to return field")
+		}
+		if nilStruct.GetVmCountV() != 0 || emptyStruct.GetVmCountV() != 0 {
+			t.Error("GetVmCountV() expected to return zero value")
+		}
+		if fullStruct.GetVmCountV() != v {
+			t.Error("GetVmCountV() expected to return field value")
+		}
+		if nilStruct.GetVmCountOr(v) != v || emptyStruct.GetVmCountOr(v) != v {
+			t.Error("GetVmCountOr() expected to return default value")
+		}
+		if fullStruct.GetVmCountOr(0) != v {
+			t.Error("GetVmCountOr() expected to return field value")
+		}
+	})
+	t.Run("MachineType", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{MachineType: &v}
+		if nilStruct.GetMachineType() != nil || emptyStruct.GetMachineType() != nil {
+			t.Error("GetMachineType() expected to return nil")
+		}
+		if fullStruct.GetMachineType() != &v {
+			t.Error("GetMachineType() expected to return field")
+		}
+		if nilStruct.GetMachineTypeV() != "" || emptyStruct.GetMachineTypeV() != "" {
+			t.Error("GetMachineTypeV() expected to return zero value")
+		}
+		if fullStruct.GetMachineTypeV() != v {
+			t.Error("GetMachineTypeV() expected to return field value")
+		}
+		if nilStruct.GetMachineTypeOr(v) != v || emptyStruct.GetMachineTypeOr(v) != v {
+			t.Error("GetMachineTypeOr() expected to return default value")
+		}
+		if fullStruct.GetMachineTypeOr("") != v {
+			t.Error("GetMachineTypeOr() expected to return field value")
+		}
+	})
+	t.Run("UsePublicIp", func(t *testing.T) {
+		v := true
+		fullStruct := &VmGroup{UsePublicIp: &v}
+		if nilStruct.GetUsePublicIp() != nil || emptyStruct.GetUsePublicIp() != nil {
+			t.Error("GetUsePublicIp() expected to return nil")
+		}
+		if fullStruct.GetUsePublicIp() != &v {
+			t.Error("GetUsePublicIp() expected to return field")
+		}
+		if nilStruct.GetUsePublicIpV() != false || emptyStruct.GetUsePublicIpV() != false {
+			t.Error("GetUsePublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetUsePublicIpV() != v {
+			t.Error("GetUsePublicIpV() expected to return field value")
+		}
+		if nilStruct.GetUsePublicIpOr(v) != v || emptyStruct.GetUsePublicIpOr(v) != v {
+			t.Error("GetUsePublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetUsePublicIpOr(false) != v {
+			t.Error("GetUsePublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{SubnetName: &v}
+		if nilStruct.GetSubnetName() != nil || emptyStruct.GetSubnetName() != nil {
+			t.Error("GetSubnetName() expected to return nil")
+		}
+		if fullStruct.GetSubnetName() != &v {
+			t.Error("GetSubnetName() expected to return field")
+		}
+		if nilStruct.GetSubnetNameV() != "" || emptyStruct.GetSubnetNameV() != "" {
+			t.Error("GetSubnetNameV() expected to return zero value")
+		}
+		if fullStruct.GetSubnetNameV() != v {
+			t.Error("GetSubnetNameV() expected to return field value")
+		}
+		if nilStruct.GetSubnetNameOr(v) != v || emptyStruct.GetSubnetNameOr(v) != v {
+			t.Error("GetSubnetNameOr() expected to return default value")
+		}
+		if fullStruct.GetSubnetNameOr("") != v {
+			t.Error("GetSubnetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Zones", func(t *testing.T) {
+		fullStruct := &VmGroup{Zones: make([]string, 1)}
+		if nilStruct.GetZones() != nil {
+			t.Error("GetZones() expected to return nil")
+		}
+		if got := emptyStruct.GetZones(); got == nil || len(got) != 0 {
+			t.Error("GetZones() expected to return empty slice")
+		}
+		if got := fullStruct.GetZones(); len(got) != 1 || &got[0] != &fullStruct.Zones[0] {
+			t.Error("GetZones() expected to return field")
+		}
+	})
+	t.Run("Tags", func(t *testing.T) {
+		fullStruct := &VmGroup{Tags: make([]string, 1)}
+		if nilStruct.GetTags() != nil {
+			t.Error("GetTags() expected to return nil")
+		}
+		if got := emptyStruct.GetTags(); got == nil || len(got) != 0 {
+			t.Error("GetTags() expected to return empty slice")
+		}
+		if got := fullStruct.GetTags(); len(got) != 1 || &got[0] != &fullStruct.Tags[0] {
+			t.Error("GetTags() expected to return field")
+		}
+	})
+	t.Run("VmImage", func(t *testing.T) {
+		v := VmImage{}
+		fullStruct := &VmGroup{VmImage: &v}
+		if nilStruct.GetVmImage() != nil || emptyStruct.GetVmImage() != nil {
+			t.Error("GetVmImage() expected to return nil")
+		}
+		if fullStruct.GetVmImage() != &v {
+			t.Error("GetVmImage() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetVmImageV(), VmImage{}) || !reflect.DeepEqual(emptyStruct.GetVmImageV(), VmImage{}) {
+			t.Error("GetVmImageV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetVmImageV(), v) {
+			t.Error("GetVmImageV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetVmImageOr(v), v) || !reflect.DeepEqual(emptyStruct.GetVmImageOr(v), v) {
+			t.Error("GetVmImageOr() expected to return default value")
+		}
+	})
+	t.Run("BootDisk", func(t *testing.T) {
+		v := Disk{}
+		fullStruct := &VmGroup{BootDisk: &v}
+		if nilStruct.GetBootDisk() != nil || emptyStruct.GetBootDisk() != nil {
+			t.Error("GetBootDisk() expected to return nil")
+		}
+		if fullStruct.GetBootDisk() != &v {
+			t.Error("GetBootDisk() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetBootDiskV(), Disk{}) || !reflect.DeepEqual(emptyStruct.GetBootDiskV(), Disk{}) {
+			t.Error("GetBootDiskV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetBootDiskV(), v) {
+			t.Error("GetBootDiskV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetBootDiskOr(v), v) || !reflect.DeepEqual(emptyStruct.GetBootDiskOr(v), v) {
+			t.Error("GetBootDiskOr() expected to return default value")
+		}
+	})
+	t.Run("DataDisks", func(t *testing.T) {
+		fullStruct := &VmGroup{DataDisks: make([]Disk, 1)}
+		if nilStruct.GetDataDisks() != nil {
+			t.Error("GetDataDisks() expected to return nil")
+		}
+		if got := emptyStruct.GetDataDisks(); got == nil || len(got) != 0 {
+			t.Error("GetDataDisks() expected to return empty slice")
+		}
+		if got := fullStruct.GetDataDisks(); len(got) != 1 || &got[0] != &fullStruct.DataDisks[0] {
+			t.Error("GetDataDisks() expected to return field")
+		}
+	})
+}
+
+func TestFirewallRule_Accessors(t *testing.T) {
+	var nilStruct *FirewallRule
+	emptyStruct := &FirewallRule{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &FirewallRule{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Direction", func(t *testing.T) {
+		v := "value"
+		fullStruct := &FirewallRule{Direction: &v}
+		if nilStruct.GetDirection() != nil || emptyStruct.GetDirection() != nil {
+			t.Error("GetDirection() expected to return nil")
+		}
+		if fullStruct.GetDirection() != &v {
+			t.Error("GetDirection() expected to return field")
+		}
+		if nilStruct.GetDirectionV() != "" || emptyStruct.GetDirectionV() != "" {
+			t.Error("GetDirectionV() expected to return zero value")
+		}
+		if fullStruct.GetDirectionV() != v {
+			t.Error("GetDirectionV() expected to return field value")
+		}
+		if nilStruct.GetDirectionOr(v) != v || emptyStruct.GetDirectionOr(v) != v {
+			t.Error("GetDirectionOr() expected to return default value")
+		}
+		if fullStruct.GetDirectionOr("") != v {
+			t.Error("GetDirectionOr() expected to return field value")
+		}
+	})
+	t.Run("Protocol", func(t *testing.T) {
+		v := "value"
+		fullStruct := &FirewallRule{Protocol: &v}
+		if nilStruct.GetProtocol() != nil || emptyStruct.GetProtocol() != nil {
+			t.Error("GetProtocol() expected to return nil")
+		}
+		if fullStruct.GetProtocol() != &v {
+			t.Error("GetProtocol() expected to return field")
+		}
+		if nilStruct.GetProtocolV() != "" || emptyStruct.GetProtocolV() != "" {
+			t.Error("GetProtocolV() expected to return zero value")
+		}
+		if fullStruct.GetProtocolV() != v {
+			t.Error("GetProtocolV() expected to return field value")
+		}
+		if nilStruct.GetProtocolOr(v) != v || emptyStruct.GetProtocolOr(v) != v {
+			t.Error("GetProtocolOr() expected to return default value")
+		}
+		if fullStruct.GetProtocolOr("") != v {
+			t.Error("GetProtocolOr() expected to return field value")
+		}
+	})
+	t.Run("Ports", func(t *testing.T) {
+		fullStruct := &FirewallRule{Ports: make([]string, 1)}
+		if nilStruct.GetPorts() != nil {
+			t.Error("GetPorts() expected to return nil")
+		}
+		if got := emptyStruct.GetPorts(); got == nil || len(got) != 0 {
+			t.Error("GetPorts() expected to return empty slice")
+		}
+		if got := fullStruct.GetPorts(); len(got) != 1 || &got[0] != &fullStruct.Ports[0] {
+			t.Error("GetPorts() expected to return field")
+		}
+	})
+	t.Run("Ranges", func(t *testing.T) {
+		fullStruct := &FirewallRule{Ranges: make([]string, 1)}
+		if nilStruct.GetRanges() != nil {
+			t.Error("GetRanges() expected to return nil")
+		}
+		if got := emptyStruct.GetRanges(); got == nil || len(got) != 0 {
+			t.Error("GetRanges() expected to return empty slice")
+		}
+		if got := fullStruct.GetRanges(); len(got) != 1 || &got[0] != &fullStruct.Ranges[0] {
+			t.Error("GetRanges() expected to return field")
+		}
+	})
+	t.Run("TargetTags", func(t *testing.T) {
+		fullStruct := &FirewallRule{TargetTags: make([]string, 1)}
+		if nilStruct.GetTargetTags() != nil {
+			t.Error("GetTargetTags() expected to return nil")
+		}
+		if got := emptyStruct.GetTargetTags(); got == nil || len(got) != 0 {
+			t.Error("GetTargetTags() expected to return empty slice")
+		}
+		if got := fullStruct.GetTargetTags(); len(got) != 1 || &got[0] != &fullStruct.TargetTags[0] {
+			t.Error("GetTargetTags() expected to return field")
+		}
+	})
+}
+
+func TestSubnet_Accessors(t *testing.T) {
+	var nilStruct *Subnet
+	emptyStruct := &Subnet{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Subnet{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("AddressPrefix", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Subnet{AddressPrefix: &v}
+		if nilStruct.GetAddressPrefix() != nil || emptyStruct.GetAddressPrefix() != nil {
+			t.Error("GetAddressPrefix() expected to return nil")
+		}
+		if fullStruct.GetAddressPrefix() != &v {
+			t.Error("GetAddressPrefix() expected to return field")
+		}
+		if nilStruct.GetAddressPrefixV() != "" || emptyStruct.GetAddressPrefixV() != "" {
+			t.Error("GetAddressPrefixV() expected to return zero value")
+		}
+		if fullStruct.GetAddressPrefixV() != v {
+			t.Error("GetAddressPrefixV() expected to return field value")
+		}
+		if nilStruct.GetAddressPrefixOr(v) != v || emptyStruct.GetAddressPrefixOr(v) != v {
+			t.Error("GetAddressPrefixOr() expected to return default value")
+		}
+		if fullStruct.GetAddressPrefixOr("") != v {
+			t.Error("GetAddressPrefixOr() expected to return field value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Project", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Project: &v}
+		if nilStruct.GetProject() != nil || emptyStruct.GetProject() != nil {
+			t.Error("GetProject() expected to return nil")
+		}
+		if fullStruct.GetProject() != &v {
+			t.Error("GetProject() expected to return field")
+		}
+		if nilStruct.GetProjectV() != "" || emptyStruct.GetProjectV() != "" {
+			t.Error("GetProjectV() expected to return zero value")
+		}
+		if fullStruct.GetProjectV() != v {
+			t.Error("GetProjectV() expected to return field value")
+		}
+		if nilStruct.GetProjectOr(v) != v || emptyStruct.GetProjectOr(v) != v {
+			t.Error("GetProjectOr() expected to return default value")
+		}
+		if fullStruct.GetProjectOr("") != v {
+			t.Error("GetProjectOr() expected to return field value")
+		}
+	})
+	t.Run("Region", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Region: &v}
+		if nilStruct.GetRegion() != nil || emptyStruct.GetRegion() != nil {
+			t.Error("GetRegion() expected to return nil")
+		}
+		if fullStruct.GetRegion() != &v {
+			t.Error("GetRegion() expected to return field")
+		}
+		if nilStruct.GetRegionV() != "" || emptyStruct.GetRegionV() != "" {
+			t.Error("GetRegionV() expected to return zero value")
+		}
+		if fullStruct.GetRegionV() != v {
+			t.Error("GetRegionV() expected to return field value")
+		}
+		if nilStruct.GetRegionOr(v) != v || emptyStruct.GetRegionOr(v) != v {
+			t.Error("GetRegionOr() expected to return default value")
+		}
+		if fullStruct.GetRegionOr("") != v {
+			t.Error("GetRegionOr() expected to return field value")
+		}
+	})
+	t.Run("RsaPublicKeyPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RsaPublicKeyPath: &v}
+		if nilStruct.GetRsaPublicKeyPath() != nil || emptyStruct.GetRsaPublicKeyPath() != nil {
+			t.Error("GetRsaPublicKeyPath() expected to return nil")
+		}
+		if fullStruct.GetRsaPublicKeyPath() != &v {
+			t.Error("GetRsaPublicKeyPath() expected to return field")
+		}
+		if nilStruct.GetRsaPublicKeyPathV() != "" || emptyStruct.GetRsaPublicKeyPathV() != "" {
+			t.Error("GetRsaPublicKeyPathV() expected to return zero value")
+		}
+		if fullStruct.GetRsaPublicKeyPathV() != v {
+			t.Error("GetRsaPublicKeyPathV() expected to return field value")
+		}
+		if nilStruct.GetRsaPublicKeyPathOr(v) != v || emptyStruct.GetRsaPublicKeyPathOr(v) != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return default value")
+		}
+		if fullStruct.GetRsaPublicKeyPathOr("") != v {
+			t.Error("GetRsaPublicKeyPathOr() expected to return field value")
+		}
+	})
+	t.Run("Subnets", func(t *testing.T) {
+		fullStruct := &Params{Subnets: make([]Subnet, 1)}
+		if nilStruct.GetSubnets() != nil {
+			t.Error("GetSubnets() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnets(); got == nil || len(got) != 0 {
+			t.Error("GetSubnets() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnets(); len(got) != 1 || &got[0] != &fullStruct.Subnets[0] {
+			t.Error("GetSubnets() expected to return field")
+		}
+	})
+	t.Run("FirewallRules", func(t *testing.T) {
+		fullStruct := &Params{FirewallRules: make([]FirewallRule, 1)}
+		if nilStruct.GetFirewallRules() != nil {
+			t.Error("GetFirewallRules() expected to return nil")
+		}
+		if got := emptyStruct.GetFirewallRules(); got == nil || len(got) != 0 {
+			t.Error("GetFirewallRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetFirewallRules(); len(got) != 1 || &got[0] != &fullStruct.FirewallRules[0] {
+			t.Error("GetFirewallRules() expected to return field")
+		}
+	})
+	t.Run("VmGroups", func(t *testing.T) {
+		fullStruct := &Params{VmGroups: make([]VmGroup, 1)}
+		if nilStruct.GetVmGroups() != nil {
+			t.Error("GetVmGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroups(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroups(); len(got) != 1 || &got[0] != &fullStruct.VmGroups[0] {
+			t.Error("GetVmGroups() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputVm_Accessors(t *testing.T) {
+	var nilStruct *OutputVm
+	emptyStruct := &OutputVm{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Zone", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{Zone: &v}
+		if nilStruct.GetZone() != nil || emptyStruct.GetZone() != nil {
+			t.Error("GetZone() expected to return nil")
+		}
+		if fullStruct.GetZone() != &v {
+			t.Error("GetZone() expected to return field")
+		}
+		if nilStruct.GetZoneV() != "" || emptyStruct.GetZoneV() != "" {
+			t.Error("GetZoneV() expected to return zero value")
+		}
+		if fullStruct.GetZoneV() != v {
+			t.Error("GetZoneV() expected to return field value")
+		}
+		if nilStruct.GetZoneOr(v) != v || emptyStruct.GetZoneOr(v) != v {
+			t.Error("GetZoneOr() expected to return default value")
+		}
+		if fullStruct.GetZoneOr("") != v {
+			t.Error("GetZoneOr() expected to return field value")
+		}
+	})
+	t.Run("PublicIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{PublicIp: &v}
+		if nilStruct.GetPublicIp() != nil || emptyStruct.GetPublicIp() != nil {
+			t.Error("GetPublicIp() expected to return nil")
+		}
+		if fullStruct.GetPublicIp() != &v {
+			t.Error("GetPublicIp() expected to return field")
+		}
+		if nilStruct.GetPublicIpV() != "" || emptyStruct.GetPublicIpV() != "" {
+			t.Error("GetPublicIpV() expected to return zero value")
+		}
+		if fullStruct.GetPublicIpV() != v {
+			t.Error("GetPublicIpV() expected to return field value")
+		}
+		if nilStruct.GetPublicIpOr(v) != v || emptyStruct.GetPublicIpOr(v) != v {
+			t.Error("GetPublicIpOr() expected to return default value")
+		}
+		if fullStruct.GetPublicIpOr("") != v {
+			t.Error("GetPublicIpOr() expected to return field value")
+		}
+	})
+	t.Run("PrivateIp", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVm{PrivateIp: &v}
+		if nilStruct.GetPrivateIp() != nil || emptyStruct.GetPrivateIp() != nil {
+			t.Error("GetPrivateIp() expected to return nil")
+		}
+		if fullStruct.GetPrivateIp() != &v {
+			t.Error("GetPrivateIp() expected to return field")
+		}
+		if nilStruct.GetPrivateIpV() != "" || emptyStruct.GetPrivateIpV() != "" {
+			t.Error("GetPrivateIpV() expected to return zero value")
+		}
+		if fullStruct.GetPrivateIpV() != v {
+			t.Error("GetPrivateIpV() expected to return field value")
+		}
+		if nilStruct.GetPrivateIpOr(v) != v || emptyStruct.GetPrivateIpOr(v) != v {
+			t.Error("GetPrivateIpOr() expected to return default value")
+		}
+		if fullStruct.GetPrivateIpOr("") != v {
+			t.Error("GetPrivateIpOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutputVmGroup_Accessors(t *testing.T) {
+	var nilStruct *OutputVmGroup
+	emptyStruct := &OutputVmGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputVmGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Vms", func(t *testing.T) {
+		fullStruct := &OutputVmGroup{Vms: make([]OutputVm, 1)}
+		if nilStruct.GetVms() != nil {
+			t.Error("GetVms() expected to return nil")
+		}
+		if got := emptyStruct.GetVms(); got == nil || len(got) != 0 {
+			t.Error("GetVms() expected to return empty slice")
+		}
+		if got := fullStruct.GetVms(); len(got) != 1 || &got[0] != &fullStruct.Vms[0] {
+			t.Error("GetVms() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("NetworkName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{NetworkName: &v}
+		if nilStruct.GetNetworkName() != nil || emptyStruct.GetNetworkName() != nil {
+			t.Error("GetNetworkName() expected to return nil")
+		}
+		if fullStruct.GetNetworkName() != &v {
+			t.Error("GetNetworkName() expected to return field")
+		}
+		if nilStruct.GetNetworkNameV() != "" || emptyStruct.GetNetworkNameV() != "" {
+			t.Error("GetNetworkNameV() expected to return zero value")
+		}
+		if fullStruct.GetNetworkNameV() != v {
+			t.Error("GetNetworkNameV() expected to return field value")
+		}
+		if nilStruct.GetNetworkNameOr(v) != v || emptyStruct.GetNetworkNameOr(v) != v {
+			t.Error("GetNetworkNameOr() expected to return default value")
+		}
+		if fullStruct.GetNetworkNameOr("") != v {
+			t.Error("GetNetworkNameOr() expected to return field value")
+		}
+	})
+	t.Run("SubnetNames", func(t *testing.T) {
+		fullStruct := &Output{SubnetNames: make([]string, 1)}
+		if nilStruct.GetSubnetNames() != nil {
+			t.Error("GetSubnetNames() expected to return nil")
+		}
+		if got := emptyStruct.GetSubnetNames(); got == nil || len(got) != 0 {
+			t.Error("GetSubnetNames() expected to return empty slice")
+		}
+		if got := fullStruct.GetSubnetNames(); len(got) != 1 || &got[0] != &fullStruct.SubnetNames[0] {
+			t.Error("GetSubnetNames() expected to return field")
+		}
+	})
+	t.Run("VmGroups", func(t *testing.T) {
+		fullStruct := &Output{VmGroups: make([]OutputVmGroup, 1)}
+		if nilStruct.GetVmGroups() != nil {
+			t.Error("GetVmGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroups(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroups(); len(got) != 1 || &got[0] != &fullStruct.VmGroups[0] {
+			t.Error("GetVmGroups() expected to return field")
+		}
+	})
+}
@@ -0,0 +1,537 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Disk or nil if Disk is nil.
+func (d *Disk) DeepCopy() *Disk {
+	if d == nil {
+		return nil
+	}
+	out := new(Disk)
+	if d.GbSize != nil {
+		x := *d.GbSize
+		out.GbSize = &x
+	}
+	if d.Type != nil {
+		x := *d.Type
+		out.Type = &x
+	}
+	return out
+}
+
+// Equal reports whether Disk and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (d *Disk) Equal(other *Disk) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if (d.GbSize == nil) != (other.GbSize == nil) || d.GbSize != nil && *d.GbSize != *other.GbSize {
+		return false
+	}
+	if (d.Type == nil) != (other.Type == nil) || d.Type != nil && *d.Type != *other.Type {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmImage or nil if VmImage is nil.
+func (v *VmImage) DeepCopy() *VmImage {
+	if v == nil {
+		return nil
+	}
+	out := new(VmImage)
+	if v.Project != nil {
+		x := *v.Project
+		out.Project = &x
+	}
+	if v.Family != nil {
+		x := *v.Family
+		out.Family = &x
+	}
+	return out
+}
+
+// Equal reports whether VmImage and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmImage) Equal(other *VmImage) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Project == nil) != (other.Project == nil) || v.Project != nil && *v.Project != *other.Project {
+		return false
+	}
+	if (v.Family == nil) != (other.Family == nil) || v.Family != nil && *v.Family != *other.Family {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) DeepCopy() *VmGroup {
+	if v == nil {
+		return nil
+	}
+	out := new(VmGroup)
+	if v.Name != nil {
+		x := *v.Name
+		out.Name = &x
+	}
+	if v.VmCount != nil {
+		x := *v.VmCount
+		out.VmCount = &x
+	}
+	if v.MachineType != nil {
+		x := *v.MachineType
+		out.MachineType = &x
+	}
+	if v.UsePublicIp != nil {
+		x := *v.UsePublicIp
+		out.UsePublicIp = &x
+	}
+	if v.SubnetName != nil {
+		x := *v.SubnetName
+		out.SubnetName = &x
+	}
+	if v.Zones != nil {
+		out.Zones = make([]string, len(v.Zones))
+		copy(out.Zones, v.Zones)
+	}
+	if v.Tags != nil {
+		out.Tags = make([]string, len(v.Tags))
+		copy(out.Tags, v.Tags)
+	}
+	out.VmImage = v.VmImage.DeepCopy()
+	out.BootDisk = v.BootDisk.DeepCopy()
+	if v.DataDisks != nil {
+		out.DataDisks = make([]Disk, len(v.DataDisks))
+		for i := range v.DataDisks {
+			out.DataDisks[i] = *v.DataDisks[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether VmGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmGroup) Equal(other *VmGroup) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Name == nil) != (other.Name == nil) || v.Name != nil && *v.Name != *other.Name {
+		return false
+	}
+	if (v.VmCount == nil) != (other.VmCount == nil) || v.VmCount != nil && *v.VmCount != *other.VmCount {
+		return false
+	}
+	if (v.MachineType == nil) != (other.MachineType == nil) || v.MachineType != nil && *v.MachineType != *other.MachineType {
+		return false
+	}
+	if (v.UsePublicIp == nil) != (other.UsePublicIp == nil) || v.UsePublicIp != nil && *v.UsePublicIp != *other.UsePublicIp {
+		return false
+	}
+	if (v.SubnetName == nil) != (other.SubnetName == nil) || v.SubnetName != nil && *v.SubnetName != *other.SubnetName {
+		return false
+	}
+	if (v.Zones == nil) != (other.Zones == nil) || len(v.Zones) != len(other.Zones) {
+		return false
+	}
+	for i := range v.Zones {
+		if v.Zones[i] != other.Zones[i] {
+			return false
+		}
+	}
+	if (v.Tags == nil) != (other.Tags == nil) || len(v.Tags) != len(other.Tags) {
+		return false
+	}
+	for i := range v.Tags {
+		if v.Tags[i] != other.Tags[i] {
+			return false
+		}
+	}
+	if !v.VmImage.Equal(other.VmImage) {
+		return false
+	}
+	if !v.BootDisk.Equal(other.BootDisk) {
+		return false
+	}
+	if (v.DataDisks == nil) != (other.DataDisks == nil) || len(v.DataDisks) != len(other.DataDisks) {
+		return false
+	}
+	for i := range v.DataDisks {
+		if !v.DataDisks[i].Equal(&other.DataDisks[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of FirewallRule or nil if FirewallRule is nil.
+func (f *FirewallRule) DeepCopy() *FirewallRule {
+	if f == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	if f.Name != nil {
+		x := *f.Name
+		out.Name = &x
+	}
+	if f.Direction != nil {
+		x := *f.Direction
+		out.Direction = &x
+	}
+	if f.Protocol != nil {
+		x := *f.Protocol
+		out.Protocol = &x
+	}
+	if f.Ports != nil {
+		out.Ports = make([]string, len(f.Ports))
+		copy(out.Ports, f.Ports)
+	}
+	if f.Ranges != nil {
+		out.Ranges = make([]string, len(f.Ranges))
+		copy(out.Ranges, f.Ranges)
+	}
+	if f.TargetTags != nil {
+		out.TargetTags = make([]string, len(f.TargetTags))
+		copy(out.TargetTags, f.TargetTags)
+	}
+	return out
+}
+
+// Equal reports whether FirewallRule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (f *FirewallRule) Equal(other *FirewallRule) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if (f.Name == nil) != (other.Name == nil) || f.Name != nil && *f.Name != *other.Name {
+		return false
+	}
+	if (f.Direction == nil) != (other.Direction == nil) || f.Direction != nil && *f.Direction != *other.Direction {
+		return false
+	}
+	if (f.Protocol == nil) != (other.Protocol == nil) || f.Protocol != nil && *f.Protocol != *other.Protocol {
+		return false
+	}
+	if (f.Ports == nil) != (other.Ports == nil) || len(f.Ports) != len(other.Ports) {
+		return false
+	}
+	for i := range f.Ports {
+		if f.Ports[i] != other.Ports[i] {
+			return false
+		}
+	}
+	if (f.Ranges == nil) != (other.Ranges == nil) || len(f.Ranges) != len(other.Ranges) {
+		return false
+	}
+	for i := range f.Ranges {
+		if f.Ranges[i] != other.Ranges[i] {
+			return false
+		}
+	}
+	if (f.TargetTags == nil) != (other.TargetTags == nil) || len(f.TargetTags) != len(other.TargetTags) {
+		return false
+	}
+	for i := range f.TargetTags {
+		if f.TargetTags[i] != other.TargetTags[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Subnet or nil if Subnet is nil.
+func (s *Subnet) DeepCopy() *Subnet {
+	if s == nil {
+		return nil
+	}
+	out := new(Subnet)
+	if s.Name != nil {
+		x := *s.Name
+		out.Name = &x
+	}
+	if s.AddressPrefix != nil {
+		x := *s.AddressPrefix
+		out.AddressPrefix = &x
+	}
+	return out
+}
+
+// Equal reports whether Subnet and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *Subnet) Equal(other *Subnet) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Name == nil) != (other.Name == nil) || s.Name != nil && *s.Name != *other.Name {
+		return false
+	}
+	if (s.AddressPrefix == nil) != (other.AddressPrefix == nil) || s.AddressPrefix != nil && *s.AddressPrefix != *other.AddressPrefix {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Project != nil {
+		x := *p.Project
+		out.Project = &x
+	}
+	if p.Region != nil {
+		x := *p.Region
+		out.Region = &x
+	}
+	if p.RsaPublicKeyPath != nil {
+		x := *p.RsaPublicKeyPath
+		out.RsaPublicKeyPath = &x
+	}
+	if p.Subnets != nil {
+		out.Subnets = make([]Subnet, len(p.Subnets))
+		for i := range p.Subnets {
+			out.Subnets[i] = *p.Subnets[i].DeepCopy()
+		}
+	}
+	if p.FirewallRules != nil {
+		out.FirewallRules = make([]FirewallRule, len(p.FirewallRules))
+		for i := range p.FirewallRules {
+			out.FirewallRules[i] = *p.FirewallRules[i].DeepCopy()
+		}
+	}
+	if p.VmGroups != nil {
+		out.VmGroups = make([]VmGroup, len(p.VmGroups))
+		for i := range p.VmGroups {
+			out.VmGroups[i] = *p.VmGroups[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Project == nil) != (other.Project == nil) || p.Project != nil && *p.Project != *other.Project {
+		return false
+	}
+	if (p.Region == nil) != (other.Region == nil) || p.Region != nil && *p.Region != *other.Region {
+		return false
+	}
+	if (p.RsaPublicKeyPath == nil) != (other.RsaPublicKeyPath == nil) || p.RsaPublicKeyPath != nil && *p.RsaPublicKeyPath != *other.RsaPublicKeyPath {
+		return false
+	}
+	if (p.Subnets == nil) != (other.Subnets == nil) || len(p.Subnets) != len(other.Subnets) {
+		return false
+	}
+	for i := range p.Subnets {
+		if !p.Subnets[i].Equal(&other.Subnets[i]) {
+			return false
+		}
+	}
+	if (p.FirewallRules == nil) != (other.FirewallRules == nil) || len(p.FirewallRules) != len(other.FirewallRules) {
+		return false
+	}
+	for i := range p.FirewallRules {
+		if !p.FirewallRules[i].Equal(&other.FirewallRules[i]) {
+			return false
+		}
+	}
+	if (p.VmGroups == nil) != (other.VmGroups == nil) || len(p.VmGroups) != len(other.VmGroups) {
+		return false
+	}
+	for i := range p.VmGroups {
+		if !p.VmGroups[i].Equal(&other.VmGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputVm or nil if OutputVm is nil.
+func (o *OutputVm) DeepCopy() *OutputVm {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputVm)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Zone != nil {
+		x := *o.Zone
+		out.Zone = &x
+	}
+	if o.PublicIp != nil {
+		x := *o.PublicIp
+		out.PublicIp = &x
+	}
+	if o.PrivateIp != nil {
+		x := *o.PrivateIp
+		out.PrivateIp = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputVm and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputVm) Equal(other *OutputVm) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Zone == nil) != (other.Zone == nil) || o.Zone != nil && *o.Zone != *other.Zone {
+		return false
+	}
+	if (o.PublicIp == nil) != (other.PublicIp == nil) || o.PublicIp != nil && *o.PublicIp != *other.PublicIp {
+		return false
+	}
+	if (o.PrivateIp == nil) != (other.PrivateIp == nil) || o.PrivateIp != nil && *o.PrivateIp != *other.PrivateIp {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputVmGroup or nil if OutputVmGroup is nil.
+func (o *OutputVmGroup) DeepCopy() *OutputVmGroup {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputVmGroup)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Vms != nil {
+		out.Vms = make([]OutputVm, len(o.Vms))
+		for i := range o.Vms {
+			out.Vms[i] = *o.Vms[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether OutputVmGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputVmGroup) Equal(other *OutputVmGroup) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Vms == nil) != (other.Vms == nil) || len(o.Vms) != len(other.Vms) {
+		return false
+	}
+	for i := range o.Vms {
+		if !o.Vms[i].Equal(&other.Vms[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.NetworkName != nil {
+		x := *o.NetworkName
+		out.NetworkName = &x
+	}
+	if o.SubnetNames != nil {
+		out.SubnetNames = make([]string, len(o.SubnetNames))
+		copy(out.SubnetNames, o.SubnetNames)
+	}
+	if o.VmGroups != nil {
+		out.VmGroups = make([]OutputVmGroup, len(o.VmGroups))
+		for i := range o.VmGroups {
+			out.VmGroups[i] = *o.VmGroups[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.NetworkName == nil) != (other.NetworkName == nil) || o.NetworkName != nil && *o.NetworkName != *other.NetworkName {
+		return false
+	}
+	if (o.SubnetNames == nil) != (other.SubnetNames == nil) || len(o.SubnetNames) != len(other.SubnetNames) {
+		return false
+	}
+	for i := range o.SubnetNames {
+		if o.SubnetNames[i] != other.SubnetNames[i] {
+			return false
+		}
+	}
+	if (o.VmGroups == nil) != (other.VmGroups == nil) || len(o.VmGroups) != len(other.VmGroups) {
+		return false
+	}
+	for i := range o.VmGroups {
+		if !o.VmGroups[i].Equal(&other.VmGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
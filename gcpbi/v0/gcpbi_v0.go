@@ -0,0 +1,343 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "gcpbi"
+	version = "v0.0.1"
+
+	maxPort = 65535
+)
+
+// portsRegexp matches single port or range of ports (i.e. 8000-9000).
+var portsRegexp = regexp.MustCompile(`^([0-9]{1,5})(?:-([0-9]{1,5}))?$`)
+
+type Disk struct {
+	GbSize *int    `json:"disk_size_gb" validate:"required,min=1"`
+	Type   *string `json:"type" validate:"required,eq=pd-standard|eq=pd-balanced|eq=pd-ssd|eq=pd-extreme"` // https://registry.terraform.io/providers/hashicorp/google/latest/docs/resources/compute_disk#type
+}
+
+type VmImage struct {
+	Project *string `json:"project" validate:"required,min=1"`
+	Family  *string `json:"family" validate:"required,min=1"`
+}
+
+type VmGroup struct {
+	Name        *string  `json:"name" validate:"required,min=1"`
+	VmCount     *int     `json:"vm_count" validate:"required,min=1"`
+	MachineType *string  `json:"machine_type" validate:"required,min=1"`
+	UsePublicIp *bool    `json:"use_public_ip" validate:"required"`
+	SubnetName  *string  `json:"subnet_name" validate:"required,min=1"`
+	Zones       []string `json:"zones" validate:"omitempty,min=1,dive,required"`
+	// Tags are network tags used to select firewall rules applied to VMs.
+	Tags      []string `json:"tags" validate:"omitempty,dive,required"`
+	VmImage   *VmImage `json:"vm_image" validate:"required"`
+	BootDisk  *Disk    `json:"boot_disk" validate:"required"`
+	DataDisks []Disk   `json:"data_disks" validate:"omitempty,dive"`
+}
+
+type FirewallRule struct {
+	Name      *string `json:"name" validate:"required,min=1"`
+	Direction *string `json:"direction" validate:"required,eq=INGRESS|eq=EGRESS"`
+	Protocol  *string `json:"protocol" validate:"required,eq=tcp|eq=udp|eq=icmp|eq=all"`
+	// Ports are single ports or ranges (i.e. 8000-9000). Empty list means all ports. Ports are
+	// checked in GcpBIParamsValidation and are not allowed for icmp and all protocols.
+	Ports []string `json:"ports" validate:"omitempty,dive,required"`
+	// Ranges are source ranges of ingress rules and destination ranges of egress rules.
+	Ranges []string `json:"ranges" validate:"required,min=1,dive,required,cidr"`
+	// TargetTags limit rule to VMs with one of tags. Empty list means all VMs in network.
+	TargetTags []string `json:"target_tags" validate:"omitempty,dive,required"`
+}
+
+type Subnet struct {
+	Name          *string `json:"name" validate:"required,min=1"`
+	AddressPrefix *string `json:"address_prefix" validate:"required,cidr"`
+}
+
+type Params struct {
+	Name             *string `json:"name" validate:"required,min=1"`
+	Project          *string `json:"project" validate:"required,min=1"`
+	Region           *string `json:"region" validate:"required,min=1"`
+	RsaPublicKeyPath *string `json:"rsa_pub_path" validate:"required,min=1"`
+
+	Subnets       []Subnet       `json:"subnets" validate:"required,min=1,dive"`
+	FirewallRules []FirewallRule `json:"firewall_rules" validate:"omitempty,dive"`
+	VmGroups      []VmGroup      `json:"vm_groups" validate:"required,dive"`
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=gcpbi"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:             to.StrPtr("epiphany"),
+			Project:          to.StrPtr("epiphany-project"),
+			Region:           to.StrPtr("europe-west1"),
+			RsaPublicKeyPath: to.StrPtr("/shared/vms_rsa.pub"),
+			Subnets: []Subnet{
+				{
+					Name:          to.StrPtr("main"),
+					AddressPrefix: to.StrPtr("10.2.1.0/24"),
+				},
+			},
+			FirewallRules: []FirewallRule{
+				{
+					Name:      to.StrPtr("allow-internal"),
+					Direction: to.StrPtr("INGRESS"),
+					Protocol:  to.StrPtr("all"),
+					Ranges:    []string{"10.2.1.0/24"},
+				},
+				{
+					Name:       to.StrPtr("allow-ssh"),
+					Direction:  to.StrPtr("INGRESS"),
+					Protocol:   to.StrPtr("tcp"),
+					Ports:      []string{"22"},
+					Ranges:     []string{"0.0.0.0/0"},
+					TargetTags: []string{"ssh"},
+				},
+			},
+			VmGroups: []VmGroup{
+				{
+					Name:        to.StrPtr("vm-group0"),
+					VmCount:     to.IntPtr(1),
+					MachineType: to.StrPtr("e2-standard-2"),
+					UsePublicIp: to.BooPtr(true),
+					SubnetName:  to.StrPtr("main"),
+					Tags:        []string{"ssh"},
+					VmImage: &VmImage{
+						Project: to.StrPtr("ubuntu-os-cloud"),
+						Family:  to.StrPtr("ubuntu-1804-lts"),
+					},
+					BootDisk: &Disk{
+						GbSize: to.IntPtr(30),
+						Type:   to.StrPtr("pd-standard"),
+					},
+					DataDisks: []Disk{
+						{
+							GbSize: to.IntPtr(10),
+							Type:   to.StrPtr("pd-ssd"),
+						},
+					},
+				},
+			},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("gcpbi config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(GcpBIParamsValidation, Params{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// GcpBIParamsValidation checks that VM groups use only defined subnets, that names of subnets
+// and firewall rules are unique and that firewall rules have correct ports.
+func GcpBIParamsValidation(sl validator.StructLevel) {
+	params := sl.Current().Interface().(Params)
+	subnets := make(map[string]bool)
+	for i, s := range params.Subnets {
+		if s.Name == nil {
+			continue
+		}
+		if subnets[*s.Name] {
+			sl.ReportError(
+				params.Subnets[i].Name,
+				fmt.Sprintf("Subnets[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		subnets[*s.Name] = true
+	}
+	rules := make(map[string]bool)
+	for i, r := range params.FirewallRules {
+		if r.Name == nil {
+			continue
+		}
+		if rules[*r.Name] {
+			sl.ReportError(
+				params.FirewallRules[i].Name,
+				fmt.Sprintf("FirewallRules[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		rules[*r.Name] = true
+	}
+	for i, r := range params.FirewallRules {
+		protocol := r.GetProtocolV()
+		if len(r.Ports) > 0 && (protocol == "icmp" || protocol == "all") {
+			sl.ReportError(
+				params.FirewallRules[i].Ports,
+				fmt.Sprintf("FirewallRules[%d].Ports", i),
+				"Ports",
+				"excluded",
+				"")
+			continue
+		}
+		for j, p := range r.Ports {
+			if p != "" && !isPorts(p) {
+				sl.ReportError(
+					params.FirewallRules[i].Ports[j],
+					fmt.Sprintf("FirewallRules[%d].Ports[%d]", i, j),
+					fmt.Sprintf("Ports[%d]", j),
+					"ports",
+					"")
+			}
+		}
+	}
+	for i, vmGroup := range params.VmGroups {
+		if vmGroup.SubnetName == nil || *vmGroup.SubnetName == "" {
+			continue
+		}
+		if !subnets[*vmGroup.SubnetName] {
+			sl.ReportError(
+				params.VmGroups[i].SubnetName,
+				fmt.Sprintf("VmGroups[%d].SubnetName", i),
+				"SubnetName",
+				"insubnets",
+				"")
+		}
+	}
+}
+
+// isPorts reports whether p is single port or range of ports with lower bound not greater than
+// upper one. Port 0 is not allowed.
+func isPorts(p string) bool {
+	m := portsRegexp.FindStringSubmatch(p)
+	if m == nil {
+		return false
+	}
+	first, _ := strconv.Atoi(m[1])
+	last := first
+	if m[2] != "" {
+		last, _ = strconv.Atoi(m[2])
+	}
+	return first >= 1 && first <= last && last <= maxPort
+}
+
+type OutputVm struct {
+	Name      *string `json:"name" validate:"required,min=1"`
+	Zone      *string `json:"zone" validate:"required,min=1"`
+	PublicIp  *string `json:"public_ip" validate:"omitempty,eq=|ip"`
+	PrivateIp *string `json:"private_ip" validate:"required,ip"`
+}
+
+type OutputVmGroup struct {
+	Name *string    `json:"name" validate:"required,min=1"`
+	Vms  []OutputVm `json:"vms" validate:"omitempty,dive"`
+}
+
+type Output struct {
+	NetworkName *string         `json:"network_name" validate:"required,min=1"`
+	SubnetNames []string        `json:"subnet_names" validate:"omitempty,dive,required"`
+	VmGroups    []OutputVmGroup `json:"vm_groups" validate:"omitempty,dive"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
@@ -0,0 +1,786 @@
+package v0
+
+import (
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0/24"
+			}
+		],
+		"firewall_rules": [
+			{
+				"name": "allow-ssh",
+				"direction": "INGRESS",
+				"protocol": "tcp",
+				"ports": ["22"],
+				"ranges": ["0.0.0.0/0"],
+				"target_tags": ["ssh"]
+			}
+		],
+		"vm_groups": [
+			{
+				"name": "vm-group0",
+				"vm_count": 2,
+				"machine_type": "e2-standard-2",
+				"use_public_ip": true,
+				"subnet_name": "main",
+				"zones": ["europe-west1-b", "europe-west1-c"],
+				"tags": ["ssh"],
+				"vm_image": {
+					"project": "ubuntu-os-cloud",
+					"family": "ubuntu-1804-lts"
+				},
+				"boot_disk": {
+					"disk_size_gb": 30,
+					"type": "pd-standard"
+				},
+				"data_disks": [
+					{
+						"disk_size_gb": 10,
+						"type": "pd-ssd"
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("gcpbi"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:             to.StrPtr("epiphany"),
+					Project:          to.StrPtr("epiphany-project"),
+					Region:           to.StrPtr("europe-west1"),
+					RsaPublicKeyPath: to.StrPtr("/shared/vms_rsa.pub"),
+					Subnets: []Subnet{
+						{
+							Name:          to.StrPtr("main"),
+							AddressPrefix: to.StrPtr("10.2.1.0/24"),
+						},
+					},
+					FirewallRules: []FirewallRule{
+						{
+							Name:       to.StrPtr("allow-ssh"),
+							Direction:  to.StrPtr("INGRESS"),
+							Protocol:   to.StrPtr("tcp"),
+							Ports:      []string{"22"},
+							Ranges:     []string{"0.0.0.0/0"},
+							TargetTags: []string{"ssh"},
+						},
+					},
+					VmGroups: []VmGroup{
+						{
+							Name:        to.StrPtr("vm-group0"),
+							VmCount:     to.IntPtr(2),
+							MachineType: to.StrPtr("e2-standard-2"),
+							UsePublicIp: to.BooPtr(true),
+							SubnetName:  to.StrPtr("main"),
+							Zones:       []string{"europe-west1-b", "europe-west1-c"},
+							Tags:        []string{"ssh"},
+							VmImage: &VmImage{
+								Project: to.StrPtr("ubuntu-os-cloud"),
+								Family:  to.StrPtr("ubuntu-1804-lts"),
+							},
+							BootDisk: &Disk{
+								GbSize: to.IntPtr(30),
+								Type:   to.StrPtr("pd-standard"),
+							},
+							DataDisks: []Disk{
+								{
+									GbSize: to.IntPtr(10),
+									Type:   to.StrPtr("pd-ssd"),
+								},
+							},
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"extra_outer_field" : "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"extra_inner_field" : "extra_inner_value",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0/24",
+				"extra_subnet_field": "extra_subnet_value"
+			}
+		],
+		"vm_groups": []
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("gcpbi"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:             to.StrPtr("epiphany"),
+					Project:          to.StrPtr("epiphany-project"),
+					Region:           to.StrPtr("europe-west1"),
+					RsaPublicKeyPath: to.StrPtr("/shared/vms_rsa.pub"),
+					Subnets: []Subnet{
+						{
+							Name:          to.StrPtr("main"),
+							AddressPrefix: to.StrPtr("10.2.1.0/24"),
+						},
+					},
+					VmGroups: []VmGroup{},
+				},
+				Unused: []string{"extra_outer_field", "params.extra_inner_field", "params.subnets[0].extra_subnet_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "awsbi",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0/24"
+			}
+		],
+		"vm_groups": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "missing params",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1"
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params",
+					Field: "Params",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Project",
+					Field: "Project",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Region",
+					Field: "Region",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.RsaPublicKeyPath",
+					Field: "RsaPublicKeyPath",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Subnets",
+					Field: "Subnets",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups",
+					Field: "VmGroups",
+					Tag:   "required",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Subnets contains all scenarios related to validation of Subnet structures.
+func TestConfig_Load_Subnets(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty subnets",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [],
+		"vm_groups": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Subnets",
+					Field: "Subnets",
+					Tag:   "min",
+				},
+			},
+		},
+		{
+			name: "incorrect subnets",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0"
+			},
+			{
+				"name": "main",
+				"address_prefix": "10.2.2.0/24"
+			},
+			{
+				"address_prefix": "10.2.3.0/24"
+			}
+		],
+		"vm_groups": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Subnets[0].AddressPrefix",
+					Field: "AddressPrefix",
+					Tag:   "cidr",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Subnets[1].Name",
+					Field: "Subnets[1].Name",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Subnets[2].Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_FirewallRules contains all scenarios related to validation of FirewallRule structures.
+func TestConfig_Load_FirewallRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "incorrect firewall rules",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0/24"
+			}
+		],
+		"firewall_rules": [
+			{
+				"name": "allow-ssh",
+				"direction": "inbound",
+				"protocol": "ssh",
+				"ports": [""],
+				"ranges": ["0.0.0.0"],
+				"target_tags": [""]
+			},
+			{
+				"name": "allow-ssh",
+				"direction": "EGRESS",
+				"protocol": "all",
+				"ranges": []
+			}
+		],
+		"vm_groups": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Direction",
+					Field: "Direction",
+					Tag:   "eq=INGRESS|eq=EGRESS",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Protocol",
+					Field: "Protocol",
+					Tag:   "eq=tcp|eq=udp|eq=icmp|eq=all",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ports[0]",
+					Field: "Ports[0]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ranges[0]",
+					Field: "Ranges[0]",
+					Tag:   "cidr",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].TargetTags[0]",
+					Field: "TargetTags[0]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[1].Ranges",
+					Field: "Ranges",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[1].Name",
+					Field: "FirewallRules[1].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+		{
+			name: "incorrect firewall rule ports",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0/24"
+			}
+		],
+		"firewall_rules": [
+			{
+				"name": "allow-web",
+				"direction": "INGRESS",
+				"protocol": "tcp",
+				"ports": ["0", "0-80", "80", "8000-9000", "65535", "65536", "9000-8000", "80-", "http"],
+				"ranges": ["0.0.0.0/0"]
+			},
+			{
+				"name": "allow-ping",
+				"direction": "INGRESS",
+				"protocol": "icmp",
+				"ports": ["8"],
+				"ranges": ["0.0.0.0/0"]
+			},
+			{
+				"name": "allow-internal",
+				"direction": "INGRESS",
+				"protocol": "all",
+				"ports": ["1-65535"],
+				"ranges": ["10.2.1.0/24"]
+			}
+		],
+		"vm_groups": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ports[0]",
+					Field: "FirewallRules[0].Ports[0]",
+					Tag:   "ports",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ports[1]",
+					Field: "FirewallRules[0].Ports[1]",
+					Tag:   "ports",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ports[5]",
+					Field: "FirewallRules[0].Ports[5]",
+					Tag:   "ports",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ports[6]",
+					Field: "FirewallRules[0].Ports[6]",
+					Tag:   "ports",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ports[7]",
+					Field: "FirewallRules[0].Ports[7]",
+					Tag:   "ports",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[0].Ports[8]",
+					Field: "FirewallRules[0].Ports[8]",
+					Tag:   "ports",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[1].Ports",
+					Field: "FirewallRules[1].Ports",
+					Tag:   "excluded",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.FirewallRules[2].Ports",
+					Field: "FirewallRules[2].Ports",
+					Tag:   "excluded",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_VmGroups contains all scenarios related to validation of VmGroup structures.
+func TestIsPorts(t *testing.T) {
+	tests := []struct {
+		ports string
+		want  bool
+	}{
+		{ports: "0", want: false},
+		{ports: "1", want: true},
+		{ports: "0-80", want: false},
+		{ports: "1-65535", want: true},
+		{ports: "65535", want: true},
+		{ports: "65536", want: false},
+		{ports: "80-80", want: true},
+		{ports: "9000-8000", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ports, func(t *testing.T) {
+			if got := isPorts(tt.ports); got != tt.want {
+				t.Errorf("isPorts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Load_VmGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty vm group",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0/24"
+			}
+		],
+		"vm_groups": [
+			{}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].VmCount",
+					Field: "VmCount",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].MachineType",
+					Field: "MachineType",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].UsePublicIp",
+					Field: "UsePublicIp",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].SubnetName",
+					Field: "SubnetName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].VmImage",
+					Field: "VmImage",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].BootDisk",
+					Field: "BootDisk",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect vm group values",
+			json: []byte(`{
+	"kind": "gcpbi",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"project": "epiphany-project",
+		"region": "europe-west1",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefix": "10.2.1.0/24"
+			}
+		],
+		"vm_groups": [
+			{
+				"name": "vm-group0",
+				"vm_count": 0,
+				"machine_type": "e2-standard-2",
+				"use_public_ip": true,
+				"subnet_name": "other",
+				"zones": [],
+				"vm_image": {
+					"project": "ubuntu-os-cloud"
+				},
+				"boot_disk": {
+					"disk_size_gb": 0,
+					"type": "pd-standard"
+				},
+				"data_disks": [
+					{
+						"disk_size_gb": 10,
+						"type": "ssd"
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].VmCount",
+					Field: "VmCount",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].SubnetName",
+					Field: "VmGroups[0].SubnetName",
+					Tag:   "insubnets",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].Zones",
+					Field: "Zones",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].VmImage.Family",
+					Field: "Family",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].BootDisk.GbSize",
+					Field: "GbSize",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroups[0].DataDisks[0].Type",
+					Field: "Type",
+					Tag:   "eq=pd-standard|eq=pd-balanced|eq=pd-ssd|eq=pd-extreme",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfig_Fingerprint(t *testing.T) {
+	a, err := NewConfig().Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewConfig()
+	c.Unused = []string{"params.extra_field"}
+	b, err := c.Fingerprint()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a != b {
+		t.Errorf("Fingerprint() expected to ignore unused keys, got %s and %s", a, b)
+	}
+	c.Params.VmGroups[0].VmCount = to.IntPtr(3)
+	if b, err = c.Fingerprint(); err != nil || a == b {
+		t.Errorf("Fingerprint() expected to change after vm_count change, got %s, %v", b, err)
+	}
+}
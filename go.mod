@@ -1,6 +1,6 @@
 module github.com/epiphany-platform/e-structures
 
-go 1.15
+go 1.16
 
 require (
 	github.com/Masterminds/semver v1.5.0
@@ -8,4 +8,6 @@ require (
 	github.com/google/go-cmp v0.5.3
 	github.com/mitchellh/mapstructure v1.3.3
 	github.com/stretchr/testify v1.6.1 // indirect
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,287 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetLun returns Lun field of MountPoint or nil if MountPoint is nil.
+func (m *MountPoint) GetLun() *int {
+	if m == nil {
+		return nil
+	}
+	return m.Lun
+}
+
+// GetLunV returns value of Lun field of MountPoint or zero value if either MountPoint or field is nil.
+func (m *MountPoint) GetLunV() int {
+	if m == nil || m.Lun == nil {
+		return 0
+	}
+	return *m.Lun
+}
+
+// GetLunOr returns value of Lun field of MountPoint or def if either MountPoint or field is nil.
+func (m *MountPoint) GetLunOr(def int) int {
+	if m == nil || m.Lun == nil {
+		return def
+	}
+	return *m.Lun
+}
+
+// GetPath returns Path field of MountPoint or nil if MountPoint is nil.
+func (m *MountPoint) GetPath() *string {
+	if m == nil {
+		return nil
+	}
+	return m.Path
+}
+
+// GetPathV returns value of Path field of MountPoint or zero value if either MountPoint or field is nil.
+func (m *MountPoint) GetPathV() string {
+	if m == nil || m.Path == nil {
+		return ""
+	}
+	return *m.Path
+}
+
+// GetPathOr returns value of Path field of MountPoint or def if either MountPoint or field is nil.
+func (m *MountPoint) GetPathOr(def string) string {
+	if m == nil || m.Path == nil {
+		return def
+	}
+	return *m.Path
+}
+
+// GetName returns Name field of Host or nil if Host is nil.
+func (h *Host) GetName() *string {
+	if h == nil {
+		return nil
+	}
+	return h.Name
+}
+
+// GetNameV returns value of Name field of Host or zero value if either Host or field is nil.
+func (h *Host) GetNameV() string {
+	if h == nil || h.Name == nil {
+		return ""
+	}
+	return *h.Name
+}
+
+// GetNameOr returns value of Name field of Host or def if either Host or field is nil.
+func (h *Host) GetNameOr(def string) string {
+	if h == nil || h.Name == nil {
+		return def
+	}
+	return *h.Name
+}
+
+// GetIp returns Ip field of Host or nil if Host is nil.
+func (h *Host) GetIp() *string {
+	if h == nil {
+		return nil
+	}
+	return h.Ip
+}
+
+// GetIpV returns value of Ip field of Host or zero value if either Host or field is nil.
+func (h *Host) GetIpV() string {
+	if h == nil || h.Ip == nil {
+		return ""
+	}
+	return *h.Ip
+}
+
+// GetIpOr returns value of Ip field of Host or def if either Host or field is nil.
+func (h *Host) GetIpOr(def string) string {
+	if h == nil || h.Ip == nil {
+		return def
+	}
+	return *h.Ip
+}
+
+// GetName returns Name field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetName() *string {
+	if v == nil {
+		return nil
+	}
+	return v.Name
+}
+
+// GetNameV returns value of Name field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetNameV() string {
+	if v == nil || v.Name == nil {
+		return ""
+	}
+	return *v.Name
+}
+
+// GetNameOr returns value of Name field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetNameOr(def string) string {
+	if v == nil || v.Name == nil {
+		return def
+	}
+	return *v.Name
+}
+
+// GetAdminUser returns AdminUser field of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) GetAdminUser() *string {
+	if v == nil {
+		return nil
+	}
+	return v.AdminUser
+}
+
+// GetAdminUserV returns value of AdminUser field of VmGroup or zero value if either VmGroup or field is nil.
+func (v *VmGroup) GetAdminUserV() string {
+	if v == nil || v.AdminUser == nil {
+		return ""
+	}
+	return *v.AdminUser
+}
+
+// GetAdminUserOr returns value of AdminUser field of VmGroup or def if either VmGroup or field is nil.
+func (v *VmGroup) GetAdminUserOr(def string) string {
+	if v == nil || v.AdminUser == nil {
+		return def
+	}
+	return *v.AdminUser
+}
+
+// GetHosts returns Hosts field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetHosts() []Host {
+	if v == nil {
+		return nil
+	}
+	if len(v.Hosts) == 0 {
+		return []Host{}
+	}
+	return v.Hosts
+}
+
+// GetMountPoints returns MountPoints field of VmGroup, nil if VmGroup is nil or empty slice if field is nil.
+func (v *VmGroup) GetMountPoints() []MountPoint {
+	if v == nil {
+		return nil
+	}
+	if len(v.MountPoints) == 0 {
+		return []MountPoint{}
+	}
+	return v.MountPoints
+}
+
+// GetVmGroups returns VmGroups field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetVmGroups() []VmGroup {
+	if p == nil {
+		return nil
+	}
+	if len(p.VmGroups) == 0 {
+		return []VmGroup{}
+	}
+	return p.VmGroups
+}
+
+// GetRsaPrivateKeyPath returns RsaPrivateKeyPath field of Params or nil if Params is nil.
+func (p *Params) GetRsaPrivateKeyPath() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RsaPrivateKeyPath
+}
+
+// GetRsaPrivateKeyPathV returns value of RsaPrivateKeyPath field of Params or zero value if either Params or field is nil.
+func (p *Params) GetRsaPrivateKeyPathV() string {
+	if p == nil || p.RsaPrivateKeyPath == nil {
+		return ""
+	}
+	return *p.RsaPrivateKeyPath
+}
+
+// GetRsaPrivateKeyPathOr returns value of RsaPrivateKeyPath field of Params or def if either Params or field is nil.
+func (p *Params) GetRsaPrivateKeyPathOr(def string) string {
+	if p == nil || p.RsaPrivateKeyPath == nil {
+		return def
+	}
+	return *p.RsaPrivateKeyPath
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
@@ -0,0 +1,298 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMountPoint_Accessors(t *testing.T) {
+	var nilStruct *MountPoint
+	emptyStruct := &MountPoint{}
+	t.Run("Lun", func(t *testing.T) {
+		v := 1
+		fullStruct := &MountPoint{Lun: &v}
+		if nilStruct.GetLun() != nil || emptyStruct.GetLun() != nil {
+			t.Error("GetLun() expected to return nil")
+		}
+		if fullStruct.GetLun() != &v {
+			t.Error("GetLun() expected to return field")
+		}
+		if nilStruct.GetLunV() != 0 || emptyStruct.GetLunV() != 0 {
+			t.Error("GetLunV() expected to return zero value")
+		}
+		if fullStruct.GetLunV() != v {
+			t.Error("GetLunV() expected to return field value")
+		}
+		if nilStruct.GetLunOr(v) != v || emptyStruct.GetLunOr(v) != v {
+			t.Error("GetLunOr() expected to return default value")
+		}
+		if fullStruct.GetLunOr(0) != v {
+			t.Error("GetLunOr() expected to return field value")
+		}
+	})
+	t.Run("Path", func(t *testing.T) {
+		v := "value"
+		fullStruct := &MountPoint{Path: &v}
+		if nilStruct.GetPath() != nil || emptyStruct.GetPath() != nil {
+			t.Error("GetPath() expected to return nil")
+		}
+		if fullStruct.GetPath() != &v {
+			t.Error("GetPath() expected to return field")
+		}
+		if nilStruct.GetPathV() != "" || emptyStruct.GetPathV() != "" {
+			t.Error("GetPathV() expected to return zero value")
+		}
+		if fullStruct.GetPathV() != v {
+			t.Error("GetPathV() expected to return field value")
+		}
+		if nilStruct.GetPathOr(v) != v || emptyStruct.GetPathOr(v) != v {
+			t.Error("GetPathOr() expected to return default value")
+		}
+		if fullStruct.GetPathOr("") != v {
+			t.Error("GetPathOr() expected to return field value")
+		}
+	})
+}
+
+func TestHost_Accessors(t *testing.T) {
+	var nilStruct *Host
+	emptyStruct := &Host{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Host{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Ip", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Host{Ip: &v}
+		if nilStruct.GetIp() != nil || emptyStruct.GetIp() != nil {
+			t.Error("GetIp() expected to return nil")
+		}
+		if fullStruct.GetIp() != &v {
+			t.Error("GetIp() expected to return field")
+		}
+		if nilStruct.GetIpV() != "" || emptyStruct.GetIpV() != "" {
+			t.Error("GetIpV() expected to return zero value")
+		}
+		if fullStruct.GetIpV() != v {
+			t.Error("GetIpV() expected to return field value")
+		}
+		if nilStruct.GetIpOr(v) != v || emptyStruct.GetIpOr(v) != v {
+			t.Error("GetIpOr() expected to return default value")
+		}
+		if fullStruct.GetIpOr("") != v {
+			t.Error("GetIpOr() expected to return field value")
+		}
+	})
+}
+
+func TestVmGroup_Accessors(t *testing.T) {
+	var nilStruct *VmGroup
+	emptyStruct := &VmGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("AdminUser", func(t *testing.T) {
+		v := "value"
+		fullStruct := &VmGroup{AdminUser: &v}
+		if nilStruct.GetAdminUser() != nil || emptyStruct.GetAdminUser() != nil {
+			t.Error("GetAdminUser() expected to return nil")
+		}
+		if fullStruct.GetAdminUser() != &v {
+			t.Error("GetAdminUser() expected to return field")
+		}
+		if nilStruct.GetAdminUserV() != "" || emptyStruct.GetAdminUserV() != "" {
+			t.Error("GetAdminUserV() expected to return zero value")
+		}
+		if fullStruct.GetAdminUserV() != v {
+			t.Error("GetAdminUserV() expected to return field value")
+		}
+		if nilStruct.GetAdminUserOr(v) != v || emptyStruct.GetAdminUserOr(v) != v {
+			t.Error("GetAdminUserOr() expected to return default value")
+		}
+		if fullStruct.GetAdminUserOr("") != v {
+			t.Error("GetAdminUserOr() expected to return field value")
+		}
+	})
+	t.Run("Hosts", func(t *testing.T) {
+		fullStruct := &VmGroup{Hosts: make([]Host, 1)}
+		if nilStruct.GetHosts() != nil {
+			t.Error("GetHosts() expected to return nil")
+		}
+		if got := emptyStruct.GetHosts(); got == nil || len(got) != 0 {
+			t.Error("GetHosts() expected to return empty slice")
+		}
+		if got := fullStruct.GetHosts(); len(got) != 1 || &got[0] != &fullStruct.Hosts[0] {
+			t.Error("GetHosts() expected to return field")
+		}
+	})
+	t.Run("MountPoints", func(t *testing.T) {
+		fullStruct := &VmGroup{MountPoints: make([]MountPoint, 1)}
+		if nilStruct.GetMountPoints() != nil {
+			t.Error("GetMountPoints() expected to return nil")
+		}
+		if got := emptyStruct.GetMountPoints(); got == nil || len(got) != 0 {
+			t.Error("GetMountPoints() expected to return empty slice")
+		}
+		if got := fullStruct.GetMountPoints(); len(got) != 1 || &got[0] != &fullStruct.MountPoints[0] {
+			t.Error("GetMountPoints() expected to return field")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("VmGroups", func(t *testing.T) {
+		fullStruct := &Params{VmGroups: make([]VmGroup, 1)}
+		if nilStruct.GetVmGroups() != nil {
+			t.Error("GetVmGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetVmGroups(); got == nil || len(got) != 0 {
+			t.Error("GetVmGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetVmGroups(); len(got) != 1 || &got[0] != &fullStruct.VmGroups[0] {
+			t.Error("GetVmGroups() expected to return field")
+		}
+	})
+	t.Run("RsaPrivateKeyPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{RsaPrivateKeyPath: &v}
+		if nilStruct.GetRsaPrivateKeyPath() != nil || emptyStruct.GetRsaPrivateKeyPath() != nil {
+			t.Error("GetRsaPrivateKeyPath() expected to return nil")
+		}
+		if fullStruct.GetRsaPrivateKeyPath() != &v {
+			t.Error("GetRsaPrivateKeyPath() expected to return field")
+		}
+		if nilStruct.GetRsaPrivateKeyPathV() != "" || emptyStruct.GetRsaPrivateKeyPathV() != "" {
+			t.Error("GetRsaPrivateKeyPathV() expected to return zero value")
+		}
+		if fullStruct.GetRsaPrivateKeyPathV() != v {
+			t.Error("GetRsaPrivateKeyPathV() expected to return field value")
+		}
+		if nilStruct.GetRsaPrivateKeyPathOr(v) != v || emptyStruct.GetRsaPrivateKeyPathOr(v) != v {
+			t.Error("GetRsaPrivateKeyPathOr() expected to return default value")
+		}
+		if fullStruct.GetRsaPrivateKeyPathOr("") != v {
+			t.Error("GetRsaPrivateKeyPathOr() expected to return field value")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
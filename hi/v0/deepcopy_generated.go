@@ -0,0 +1,206 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of MountPoint or nil if MountPoint is nil.
+func (m *MountPoint) DeepCopy() *MountPoint {
+	if m == nil {
+		return nil
+	}
+	out := new(MountPoint)
+	if m.Lun != nil {
+		x := *m.Lun
+		out.Lun = &x
+	}
+	if m.Path != nil {
+		x := *m.Path
+		out.Path = &x
+	}
+	return out
+}
+
+// Equal reports whether MountPoint and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (m *MountPoint) Equal(other *MountPoint) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if (m.Lun == nil) != (other.Lun == nil) || m.Lun != nil && *m.Lun != *other.Lun {
+		return false
+	}
+	if (m.Path == nil) != (other.Path == nil) || m.Path != nil && *m.Path != *other.Path {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Host or nil if Host is nil.
+func (h *Host) DeepCopy() *Host {
+	if h == nil {
+		return nil
+	}
+	out := new(Host)
+	if h.Name != nil {
+		x := *h.Name
+		out.Name = &x
+	}
+	if h.Ip != nil {
+		x := *h.Ip
+		out.Ip = &x
+	}
+	return out
+}
+
+// Equal reports whether Host and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (h *Host) Equal(other *Host) bool {
+	if h == nil || other == nil {
+		return h == other
+	}
+	if (h.Name == nil) != (other.Name == nil) || h.Name != nil && *h.Name != *other.Name {
+		return false
+	}
+	if (h.Ip == nil) != (other.Ip == nil) || h.Ip != nil && *h.Ip != *other.Ip {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of VmGroup or nil if VmGroup is nil.
+func (v *VmGroup) DeepCopy() *VmGroup {
+	if v == nil {
+		return nil
+	}
+	out := new(VmGroup)
+	if v.Name != nil {
+		x := *v.Name
+		out.Name = &x
+	}
+	if v.AdminUser != nil {
+		x := *v.AdminUser
+		out.AdminUser = &x
+	}
+	if v.Hosts != nil {
+		out.Hosts = make([]Host, len(v.Hosts))
+		for i := range v.Hosts {
+			out.Hosts[i] = *v.Hosts[i].DeepCopy()
+		}
+	}
+	if v.MountPoints != nil {
+		out.MountPoints = make([]MountPoint, len(v.MountPoints))
+		for i := range v.MountPoints {
+			out.MountPoints[i] = *v.MountPoints[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether VmGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (v *VmGroup) Equal(other *VmGroup) bool {
+	if v == nil || other == nil {
+		return v == other
+	}
+	if (v.Name == nil) != (other.Name == nil) || v.Name != nil && *v.Name != *other.Name {
+		return false
+	}
+	if (v.AdminUser == nil) != (other.AdminUser == nil) || v.AdminUser != nil && *v.AdminUser != *other.AdminUser {
+		return false
+	}
+	if (v.Hosts == nil) != (other.Hosts == nil) || len(v.Hosts) != len(other.Hosts) {
+		return false
+	}
+	for i := range v.Hosts {
+		if !v.Hosts[i].Equal(&other.Hosts[i]) {
+			return false
+		}
+	}
+	if (v.MountPoints == nil) != (other.MountPoints == nil) || len(v.MountPoints) != len(other.MountPoints) {
+		return false
+	}
+	for i := range v.MountPoints {
+		if !v.MountPoints[i].Equal(&other.MountPoints[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.VmGroups != nil {
+		out.VmGroups = make([]VmGroup, len(p.VmGroups))
+		for i := range p.VmGroups {
+			out.VmGroups[i] = *p.VmGroups[i].DeepCopy()
+		}
+	}
+	if p.RsaPrivateKeyPath != nil {
+		x := *p.RsaPrivateKeyPath
+		out.RsaPrivateKeyPath = &x
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.VmGroups == nil) != (other.VmGroups == nil) || len(p.VmGroups) != len(other.VmGroups) {
+		return false
+	}
+	for i := range p.VmGroups {
+		if !p.VmGroups[i].Equal(&other.VmGroups[i]) {
+			return false
+		}
+	}
+	if (p.RsaPrivateKeyPath == nil) != (other.RsaPrivateKeyPath == nil) || p.RsaPrivateKeyPath != nil && *p.RsaPrivateKeyPath != *other.RsaPrivateKeyPath {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
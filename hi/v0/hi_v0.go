@@ -1,9 +1,15 @@
 package v0
 
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
 import (
 	"encoding/json"
 	"errors"
 
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
 	"github.com/epiphany-platform/e-structures/utils/to"
 	"github.com/epiphany-platform/e-structures/utils/validators"
 	"github.com/go-playground/validator/v10"
@@ -34,7 +40,7 @@ type VmGroup struct {
 
 type Params struct {
 	VmGroups          []VmGroup `json:"vm_groups" validate:"required,dive"`
-	RsaPrivateKeyPath *string   `json:"rsa_private_path" validate:"required,min=1"`
+	RsaPrivateKeyPath *string   `json:"rsa_private_path" validate:"required,min=1" sensitive:"true"`
 }
 
 type Config struct {
@@ -44,13 +50,6 @@ type Config struct {
 	Unused  []string `json:"-"`
 }
 
-func (c *Config) GetParams() *Params {
-	if c == nil {
-		return nil
-	}
-	return c.Params
-}
-
 //TODO test
 func NewConfig() *Config {
 	return &Config{
@@ -75,6 +74,7 @@ func NewConfig() *Config {
 					},
 				},
 			},
+			RsaPrivateKeyPath: to.StrPtr("/shared/vms_rsa"),
 		},
 		Unused: []string{},
 	}
@@ -88,7 +88,40 @@ func (c *Config) Marshal() ([]byte, error) {
 	return json.MarshalIndent(c, "", "\t")
 }
 
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
 func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
 	var input map[string]interface{}
 	if err = json.Unmarshal(b, &input); err != nil {
 		return
@@ -107,7 +140,6 @@ func (c *Config) Unmarshal(b []byte) (err error) {
 		return
 	}
 	c.Unused = md.Unused
-	err = c.isValid()
 	return
 }
 
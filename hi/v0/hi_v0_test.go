@@ -489,3 +489,17 @@ func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr erro
 		}
 	}
 }
+
+func TestConfig_Redacted(t *testing.T) {
+	c := NewConfig()
+	r := c.Redacted()
+	if got := r.GetParams().GetRsaPrivateKeyPathV(); got != "<redacted>" {
+		t.Errorf("Redacted() rsa_private_path = %s, want <redacted>", got)
+	}
+	if got := c.GetParams().GetRsaPrivateKeyPathV(); got != "/shared/vms_rsa" {
+		t.Errorf("Redacted() modified original config, rsa_private_path = %s", got)
+	}
+	if diff := cmp.Diff(c.GetParams().GetVmGroups(), r.GetParams().GetVmGroups()); diff != "" {
+		t.Errorf("Redacted() changed not sensitive fields (-original +redacted):\n%s", diff)
+	}
+}
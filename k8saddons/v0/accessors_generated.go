@@ -0,0 +1,496 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"github.com/epiphany-platform/e-structures/utils/values"
+)
+
+// GetName returns Name field of Release or nil if Release is nil.
+func (r *Release) GetName() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Name
+}
+
+// GetNameV returns value of Name field of Release or zero value if either Release or field is nil.
+func (r *Release) GetNameV() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// GetNameOr returns value of Name field of Release or def if either Release or field is nil.
+func (r *Release) GetNameOr(def string) string {
+	if r == nil || r.Name == nil {
+		return def
+	}
+	return *r.Name
+}
+
+// GetNamespace returns Namespace field of Release or nil if Release is nil.
+func (r *Release) GetNamespace() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Namespace
+}
+
+// GetNamespaceV returns value of Namespace field of Release or zero value if either Release or field is nil.
+func (r *Release) GetNamespaceV() string {
+	if r == nil || r.Namespace == nil {
+		return ""
+	}
+	return *r.Namespace
+}
+
+// GetNamespaceOr returns value of Namespace field of Release or def if either Release or field is nil.
+func (r *Release) GetNamespaceOr(def string) string {
+	if r == nil || r.Namespace == nil {
+		return def
+	}
+	return *r.Namespace
+}
+
+// GetCreateNamespace returns CreateNamespace field of Release or nil if Release is nil.
+func (r *Release) GetCreateNamespace() *bool {
+	if r == nil {
+		return nil
+	}
+	return r.CreateNamespace
+}
+
+// GetCreateNamespaceV returns value of CreateNamespace field of Release or zero value if either Release or field is nil.
+func (r *Release) GetCreateNamespaceV() bool {
+	if r == nil || r.CreateNamespace == nil {
+		return false
+	}
+	return *r.CreateNamespace
+}
+
+// GetCreateNamespaceOr returns value of CreateNamespace field of Release or def if either Release or field is nil.
+func (r *Release) GetCreateNamespaceOr(def bool) bool {
+	if r == nil || r.CreateNamespace == nil {
+		return def
+	}
+	return *r.CreateNamespace
+}
+
+// GetChart returns Chart field of Release or nil if Release is nil.
+func (r *Release) GetChart() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Chart
+}
+
+// GetChartV returns value of Chart field of Release or zero value if either Release or field is nil.
+func (r *Release) GetChartV() string {
+	if r == nil || r.Chart == nil {
+		return ""
+	}
+	return *r.Chart
+}
+
+// GetChartOr returns value of Chart field of Release or def if either Release or field is nil.
+func (r *Release) GetChartOr(def string) string {
+	if r == nil || r.Chart == nil {
+		return def
+	}
+	return *r.Chart
+}
+
+// GetRepository returns Repository field of Release or nil if Release is nil.
+func (r *Release) GetRepository() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Repository
+}
+
+// GetRepositoryV returns value of Repository field of Release or zero value if either Release or field is nil.
+func (r *Release) GetRepositoryV() string {
+	if r == nil || r.Repository == nil {
+		return ""
+	}
+	return *r.Repository
+}
+
+// GetRepositoryOr returns value of Repository field of Release or def if either Release or field is nil.
+func (r *Release) GetRepositoryOr(def string) string {
+	if r == nil || r.Repository == nil {
+		return def
+	}
+	return *r.Repository
+}
+
+// GetVersion returns Version field of Release or nil if Release is nil.
+func (r *Release) GetVersion() *string {
+	if r == nil {
+		return nil
+	}
+	return r.Version
+}
+
+// GetVersionV returns value of Version field of Release or zero value if either Release or field is nil.
+func (r *Release) GetVersionV() string {
+	if r == nil || r.Version == nil {
+		return ""
+	}
+	return *r.Version
+}
+
+// GetVersionOr returns value of Version field of Release or def if either Release or field is nil.
+func (r *Release) GetVersionOr(def string) string {
+	if r == nil || r.Version == nil {
+		return def
+	}
+	return *r.Version
+}
+
+// GetValues returns Values field of Release or nil if Release is nil.
+func (r *Release) GetValues() *values.Object {
+	if r == nil {
+		return nil
+	}
+	return r.Values
+}
+
+// GetValuesV returns value of Values field of Release or zero value if either Release or field is nil.
+func (r *Release) GetValuesV() values.Object {
+	if r == nil || r.Values == nil {
+		return values.Object{}
+	}
+	return *r.Values
+}
+
+// GetValuesOr returns value of Values field of Release or def if either Release or field is nil.
+func (r *Release) GetValuesOr(def values.Object) values.Object {
+	if r == nil || r.Values == nil {
+		return def
+	}
+	return *r.Values
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetCluster returns Cluster field of Params or nil if Params is nil.
+func (p *Params) GetCluster() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Cluster
+}
+
+// GetClusterV returns value of Cluster field of Params or zero value if either Params or field is nil.
+func (p *Params) GetClusterV() string {
+	if p == nil || p.Cluster == nil {
+		return ""
+	}
+	return *p.Cluster
+}
+
+// GetClusterOr returns value of Cluster field of Params or def if either Params or field is nil.
+func (p *Params) GetClusterOr(def string) string {
+	if p == nil || p.Cluster == nil {
+		return def
+	}
+	return *p.Cluster
+}
+
+// GetReleases returns Releases field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetReleases() []Release {
+	if p == nil {
+		return nil
+	}
+	if len(p.Releases) == 0 {
+		return []Release{}
+	}
+	return p.Releases
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetName returns Name field of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) GetName() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Name
+}
+
+// GetNameV returns value of Name field of OutputRelease or zero value if either OutputRelease or field is nil.
+func (o *OutputRelease) GetNameV() string {
+	if o == nil || o.Name == nil {
+		return ""
+	}
+	return *o.Name
+}
+
+// GetNameOr returns value of Name field of OutputRelease or def if either OutputRelease or field is nil.
+func (o *OutputRelease) GetNameOr(def string) string {
+	if o == nil || o.Name == nil {
+		return def
+	}
+	return *o.Name
+}
+
+// GetNamespace returns Namespace field of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) GetNamespace() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Namespace
+}
+
+// GetNamespaceV returns value of Namespace field of OutputRelease or zero value if either OutputRelease or field is nil.
+func (o *OutputRelease) GetNamespaceV() string {
+	if o == nil || o.Namespace == nil {
+		return ""
+	}
+	return *o.Namespace
+}
+
+// GetNamespaceOr returns value of Namespace field of OutputRelease or def if either OutputRelease or field is nil.
+func (o *OutputRelease) GetNamespaceOr(def string) string {
+	if o == nil || o.Namespace == nil {
+		return def
+	}
+	return *o.Namespace
+}
+
+// GetChart returns Chart field of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) GetChart() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Chart
+}
+
+// GetChartV returns value of Chart field of OutputRelease or zero value if either OutputRelease or field is nil.
+func (o *OutputRelease) GetChartV() string {
+	if o == nil || o.Chart == nil {
+		return ""
+	}
+	return *o.Chart
+}
+
+// GetChartOr returns value of Chart field of OutputRelease or def if either OutputRelease or field is nil.
+func (o *OutputRelease) GetChartOr(def string) string {
+	if o == nil || o.Chart == nil {
+		return def
+	}
+	return *o.Chart
+}
+
+// GetChartVersion returns ChartVersion field of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) GetChartVersion() *string {
+	if o == nil {
+		return nil
+	}
+	return o.ChartVersion
+}
+
+// GetChartVersionV returns value of ChartVersion field of OutputRelease or zero value if either OutputRelease or field is nil.
+func (o *OutputRelease) GetChartVersionV() string {
+	if o == nil || o.ChartVersion == nil {
+		return ""
+	}
+	return *o.ChartVersion
+}
+
+// GetChartVersionOr returns value of ChartVersion field of OutputRelease or def if either OutputRelease or field is nil.
+func (o *OutputRelease) GetChartVersionOr(def string) string {
+	if o == nil || o.ChartVersion == nil {
+		return def
+	}
+	return *o.ChartVersion
+}
+
+// GetAppVersion returns AppVersion field of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) GetAppVersion() *string {
+	if o == nil {
+		return nil
+	}
+	return o.AppVersion
+}
+
+// GetAppVersionV returns value of AppVersion field of OutputRelease or zero value if either OutputRelease or field is nil.
+func (o *OutputRelease) GetAppVersionV() string {
+	if o == nil || o.AppVersion == nil {
+		return ""
+	}
+	return *o.AppVersion
+}
+
+// GetAppVersionOr returns value of AppVersion field of OutputRelease or def if either OutputRelease or field is nil.
+func (o *OutputRelease) GetAppVersionOr(def string) string {
+	if o == nil || o.AppVersion == nil {
+		return def
+	}
+	return *o.AppVersion
+}
+
+// GetRevision returns Revision field of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) GetRevision() *int {
+	if o == nil {
+		return nil
+	}
+	return o.Revision
+}
+
+// GetRevisionV returns value of Revision field of OutputRelease or zero value if either OutputRelease or field is nil.
+func (o *OutputRelease) GetRevisionV() int {
+	if o == nil || o.Revision == nil {
+		return 0
+	}
+	return *o.Revision
+}
+
+// GetRevisionOr returns value of Revision field of OutputRelease or def if either OutputRelease or field is nil.
+func (o *OutputRelease) GetRevisionOr(def int) int {
+	if o == nil || o.Revision == nil {
+		return def
+	}
+	return *o.Revision
+}
+
+// GetStatus returns Status field of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) GetStatus() *string {
+	if o == nil {
+		return nil
+	}
+	return o.Status
+}
+
+// GetStatusV returns value of Status field of OutputRelease or zero value if either OutputRelease or field is nil.
+func (o *OutputRelease) GetStatusV() string {
+	if o == nil || o.Status == nil {
+		return ""
+	}
+	return *o.Status
+}
+
+// GetStatusOr returns value of Status field of OutputRelease or def if either OutputRelease or field is nil.
+func (o *OutputRelease) GetStatusOr(def string) string {
+	if o == nil || o.Status == nil {
+		return def
+	}
+	return *o.Status
+}
+
+// GetReleases returns Releases field of Output, nil if Output is nil or empty slice if field is nil.
+func (o *Output) GetReleases() []OutputRelease {
+	if o == nil {
+		return nil
+	}
+	if len(o.Releases) == 0 {
+		return []OutputRelease{}
+	}
+	return o.Releases
+}
@@ -0,0 +1,483 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/values"
+)
+
+func TestRelease_Accessors(t *testing.T) {
+	var nilStruct *Release
+	emptyStruct := &Release{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Release{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Release{Namespace: &v}
+		if nilStruct.GetNamespace() != nil || emptyStruct.GetNamespace() != nil {
+			t.Error("GetNamespace() expected to return nil")
+		}
+		if fullStruct.GetNamespace() != &v {
+			t.Error("GetNamespace() expected to return field")
+		}
+		if nilStruct.GetNamespaceV() != "" || emptyStruct.GetNamespaceV() != "" {
+			t.Error("GetNamespaceV() expected to return zero value")
+		}
+		if fullStruct.GetNamespaceV() != v {
+			t.Error("GetNamespaceV() expected to return field value")
+		}
+		if nilStruct.GetNamespaceOr(v) != v || emptyStruct.GetNamespaceOr(v) != v {
+			t.Error("GetNamespaceOr() expected to return default value")
+		}
+		if fullStruct.GetNamespaceOr("") != v {
+			t.Error("GetNamespaceOr() expected to return field value")
+		}
+	})
+	t.Run("CreateNamespace", func(t *testing.T) {
+		v := true
+		fullStruct := &Release{CreateNamespace: &v}
+		if nilStruct.GetCreateNamespace() != nil || emptyStruct.GetCreateNamespace() != nil {
+			t.Error("GetCreateNamespace() expected to return nil")
+		}
+		if fullStruct.GetCreateNamespace() != &v {
+			t.Error("GetCreateNamespace() expected to return field")
+		}
+		if nilStruct.GetCreateNamespaceV() != false || emptyStruct.GetCreateNamespaceV() != false {
+			t.Error("GetCreateNamespaceV() expected to return zero value")
+		}
+		if fullStruct.GetCreateNamespaceV() != v {
+			t.Error("GetCreateNamespaceV() expected to return field value")
+		}
+		if nilStruct.GetCreateNamespaceOr(v) != v || emptyStruct.GetCreateNamespaceOr(v) != v {
+			t.Error("GetCreateNamespaceOr() expected to return default value")
+		}
+		if fullStruct.GetCreateNamespaceOr(false) != v {
+			t.Error("GetCreateNamespaceOr() expected to return field value")
+		}
+	})
+	t.Run("Chart", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Release{Chart: &v}
+		if nilStruct.GetChart() != nil || emptyStruct.GetChart() != nil {
+			t.Error("GetChart() expected to return nil")
+		}
+		if fullStruct.GetChart() != &v {
+			t.Error("GetChart() expected to return field")
+		}
+		if nilStruct.GetChartV() != "" || emptyStruct.GetChartV() != "" {
+			t.Error("GetChartV() expected to return zero value")
+		}
+		if fullStruct.GetChartV() != v {
+			t.Error("GetChartV() expected to return field value")
+		}
+		if nilStruct.GetChartOr(v) != v || emptyStruct.GetChartOr(v) != v {
+			t.Error("GetChartOr() expected to return default value")
+		}
+		if fullStruct.GetChartOr("") != v {
+			t.Error("GetChartOr() expected to return field value")
+		}
+	})
+	t.Run("Repository", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Release{Repository: &v}
+		if nilStruct.GetRepository() != nil || emptyStruct.GetRepository() != nil {
+			t.Error("GetRepository() expected to return nil")
+		}
+		if fullStruct.GetRepository() != &v {
+			t.Error("GetRepository() expected to return field")
+		}
+		if nilStruct.GetRepositoryV() != "" || emptyStruct.GetRepositoryV() != "" {
+			t.Error("GetRepositoryV() expected to return zero value")
+		}
+		if fullStruct.GetRepositoryV() != v {
+			t.Error("GetRepositoryV() expected to return field value")
+		}
+		if nilStruct.GetRepositoryOr(v) != v || emptyStruct.GetRepositoryOr(v) != v {
+			t.Error("GetRepositoryOr() expected to return default value")
+		}
+		if fullStruct.GetRepositoryOr("") != v {
+			t.Error("GetRepositoryOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Release{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Values", func(t *testing.T) {
+		v := values.Object{}
+		fullStruct := &Release{Values: &v}
+		if nilStruct.GetValues() != nil || emptyStruct.GetValues() != nil {
+			t.Error("GetValues() expected to return nil")
+		}
+		if fullStruct.GetValues() != &v {
+			t.Error("GetValues() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetValuesV(), values.Object{}) || !reflect.DeepEqual(emptyStruct.GetValuesV(), values.Object{}) {
+			t.Error("GetValuesV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetValuesV(), v) {
+			t.Error("GetValuesV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetValuesOr(v), v) || !reflect.DeepEqual(emptyStruct.GetValuesOr(v), v) {
+			t.Error("GetValuesOr() expected to return default value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Cluster", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Cluster: &v}
+		if nilStruct.GetCluster() != nil || emptyStruct.GetCluster() != nil {
+			t.Error("GetCluster() expected to return nil")
+		}
+		if fullStruct.GetCluster() != &v {
+			t.Error("GetCluster() expected to return field")
+		}
+		if nilStruct.GetClusterV() != "" || emptyStruct.GetClusterV() != "" {
+			t.Error("GetClusterV() expected to return zero value")
+		}
+		if fullStruct.GetClusterV() != v {
+			t.Error("GetClusterV() expected to return field value")
+		}
+		if nilStruct.GetClusterOr(v) != v || emptyStruct.GetClusterOr(v) != v {
+			t.Error("GetClusterOr() expected to return default value")
+		}
+		if fullStruct.GetClusterOr("") != v {
+			t.Error("GetClusterOr() expected to return field value")
+		}
+	})
+	t.Run("Releases", func(t *testing.T) {
+		fullStruct := &Params{Releases: make([]Release, 1)}
+		if nilStruct.GetReleases() != nil {
+			t.Error("GetReleases() expected to return nil")
+		}
+		if got := emptyStruct.GetReleases(); got == nil || len(got) != 0 {
+			t.Error("GetReleases() expected to return empty slice")
+		}
+		if got := fullStruct.GetReleases(); len(got) != 1 || &got[0] != &fullStruct.Releases[0] {
+			t.Error("GetReleases() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutputRelease_Accessors(t *testing.T) {
+	var nilStruct *OutputRelease
+	emptyStruct := &OutputRelease{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRelease{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Namespace", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRelease{Namespace: &v}
+		if nilStruct.GetNamespace() != nil || emptyStruct.GetNamespace() != nil {
+			t.Error("GetNamespace() expected to return nil")
+		}
+		if fullStruct.GetNamespace() != &v {
+			t.Error("GetNamespace() expected to return field")
+		}
+		if nilStruct.GetNamespaceV() != "" || emptyStruct.GetNamespaceV() != "" {
+			t.Error("GetNamespaceV() expected to return zero value")
+		}
+		if fullStruct.GetNamespaceV() != v {
+			t.Error("GetNamespaceV() expected to return field value")
+		}
+		if nilStruct.GetNamespaceOr(v) != v || emptyStruct.GetNamespaceOr(v) != v {
+			t.Error("GetNamespaceOr() expected to return default value")
+		}
+		if fullStruct.GetNamespaceOr("") != v {
+			t.Error("GetNamespaceOr() expected to return field value")
+		}
+	})
+	t.Run("Chart", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRelease{Chart: &v}
+		if nilStruct.GetChart() != nil || emptyStruct.GetChart() != nil {
+			t.Error("GetChart() expected to return nil")
+		}
+		if fullStruct.GetChart() != &v {
+			t.Error("GetChart() expected to return field")
+		}
+		if nilStruct.GetChartV() != "" || emptyStruct.GetChartV() != "" {
+			t.Error("GetChartV() expected to return zero value")
+		}
+		if fullStruct.GetChartV() != v {
+			t.Error("GetChartV() expected to return field value")
+		}
+		if nilStruct.GetChartOr(v) != v || emptyStruct.GetChartOr(v) != v {
+			t.Error("GetChartOr() expected to return default value")
+		}
+		if fullStruct.GetChartOr("") != v {
+			t.Error("GetChartOr() expected to return field value")
+		}
+	})
+	t.Run("ChartVersion", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRelease{ChartVersion: &v}
+		if nilStruct.GetChartVersion() != nil || emptyStruct.GetChartVersion() != nil {
+			t.Error("GetChartVersion() expected to return nil")
+		}
+		if fullStruct.GetChartVersion() != &v {
+			t.Error("GetChartVersion() expected to return field")
+		}
+		if nilStruct.GetChartVersionV() != "" || emptyStruct.GetChartVersionV() != "" {
+			t.Error("GetChartVersionV() expected to return zero value")
+		}
+		if fullStruct.GetChartVersionV() != v {
+			t.Error("GetChartVersionV() expected to return field value")
+		}
+		if nilStruct.GetChartVersionOr(v) != v || emptyStruct.GetChartVersionOr(v) != v {
+			t.Error("GetChartVersionOr() expected to return default value")
+		}
+		if fullStruct.GetChartVersionOr("") != v {
+			t.Error("GetChartVersionOr() expected to return field value")
+		}
+	})
+	t.Run("AppVersion", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRelease{AppVersion: &v}
+		if nilStruct.GetAppVersion() != nil || emptyStruct.GetAppVersion() != nil {
+			t.Error("GetAppVersion() expected to return nil")
+		}
+		if fullStruct.GetAppVersion() != &v {
+			t.Error("GetAppVersion() expected to return field")
+		}
+		if nilStruct.GetAppVersionV() != "" || emptyStruct.GetAppVersionV() != "" {
+			t.Error("GetAppVersionV() expected to return zero value")
+		}
+		if fullStruct.GetAppVersionV() != v {
+			t.Error("GetAppVersionV() expected to return field value")
+		}
+		if nilStruct.GetAppVersionOr(v) != v || emptyStruct.GetAppVersionOr(v) != v {
+			t.Error("GetAppVersionOr() expected to return default value")
+		}
+		if fullStruct.GetAppVersionOr("") != v {
+			t.Error("GetAppVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Revision", func(t *testing.T) {
+		v := 1
+		fullStruct := &OutputRelease{Revision: &v}
+		if nilStruct.GetRevision() != nil || emptyStruct.GetRevision() != nil {
+			t.Error("GetRevision() expected to return nil")
+		}
+		if fullStruct.GetRevision() != &v {
+			t.Error("GetRevision() expected to return field")
+		}
+		if nilStruct.GetRevisionV() != 0 || emptyStruct.GetRevisionV() != 0 {
+			t.Error("GetRevisionV() expected to return zero value")
+		}
+		if fullStruct.GetRevisionV() != v {
+			t.Error("GetRevisionV() expected to return field value")
+		}
+		if nilStruct.GetRevisionOr(v) != v || emptyStruct.GetRevisionOr(v) != v {
+			t.Error("GetRevisionOr() expected to return default value")
+		}
+		if fullStruct.GetRevisionOr(0) != v {
+			t.Error("GetRevisionOr() expected to return field value")
+		}
+	})
+	t.Run("Status", func(t *testing.T) {
+		v := "value"
+		fullStruct := &OutputRelease{Status: &v}
+		if nilStruct.GetStatus() != nil || emptyStruct.GetStatus() != nil {
+			t.Error("GetStatus() expected to return nil")
+		}
+		if fullStruct.GetStatus() != &v {
+			t.Error("GetStatus() expected to return field")
+		}
+		if nilStruct.GetStatusV() != "" || emptyStruct.GetStatusV() != "" {
+			t.Error("GetStatusV() expected to return zero value")
+		}
+		if fullStruct.GetStatusV() != v {
+			t.Error("GetStatusV() expected to return field value")
+		}
+		if nilStruct.GetStatusOr(v) != v || emptyStruct.GetStatusOr(v) != v {
+			t.Error("GetStatusOr() expected to return default value")
+		}
+		if fullStruct.GetStatusOr("") != v {
+			t.Error("GetStatusOr() expected to return field value")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("Releases", func(t *testing.T) {
+		fullStruct := &Output{Releases: make([]OutputRelease, 1)}
+		if nilStruct.GetReleases() != nil {
+			t.Error("GetReleases() expected to return nil")
+		}
+		if got := emptyStruct.GetReleases(); got == nil || len(got) != 0 {
+			t.Error("GetReleases() expected to return empty slice")
+		}
+		if got := fullStruct.GetReleases(); len(got) != 1 || &got[0] != &fullStruct.Releases[0] {
+			t.Error("GetReleases() expected to return field")
+		}
+	})
+}
@@ -0,0 +1,252 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Release or nil if Release is nil.
+func (r *Release) DeepCopy() *Release {
+	if r == nil {
+		return nil
+	}
+	out := new(Release)
+	if r.Name != nil {
+		x := *r.Name
+		out.Name = &x
+	}
+	if r.Namespace != nil {
+		x := *r.Namespace
+		out.Namespace = &x
+	}
+	if r.CreateNamespace != nil {
+		x := *r.CreateNamespace
+		out.CreateNamespace = &x
+	}
+	if r.Chart != nil {
+		x := *r.Chart
+		out.Chart = &x
+	}
+	if r.Repository != nil {
+		x := *r.Repository
+		out.Repository = &x
+	}
+	if r.Version != nil {
+		x := *r.Version
+		out.Version = &x
+	}
+	out.Values = r.Values.DeepCopy()
+	return out
+}
+
+// Equal reports whether Release and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (r *Release) Equal(other *Release) bool {
+	if r == nil || other == nil {
+		return r == other
+	}
+	if (r.Name == nil) != (other.Name == nil) || r.Name != nil && *r.Name != *other.Name {
+		return false
+	}
+	if (r.Namespace == nil) != (other.Namespace == nil) || r.Namespace != nil && *r.Namespace != *other.Namespace {
+		return false
+	}
+	if (r.CreateNamespace == nil) != (other.CreateNamespace == nil) || r.CreateNamespace != nil && *r.CreateNamespace != *other.CreateNamespace {
+		return false
+	}
+	if (r.Chart == nil) != (other.Chart == nil) || r.Chart != nil && *r.Chart != *other.Chart {
+		return false
+	}
+	if (r.Repository == nil) != (other.Repository == nil) || r.Repository != nil && *r.Repository != *other.Repository {
+		return false
+	}
+	if (r.Version == nil) != (other.Version == nil) || r.Version != nil && *r.Version != *other.Version {
+		return false
+	}
+	if !r.Values.Equal(other.Values) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.Cluster != nil {
+		x := *p.Cluster
+		out.Cluster = &x
+	}
+	if p.Releases != nil {
+		out.Releases = make([]Release, len(p.Releases))
+		for i := range p.Releases {
+			out.Releases[i] = *p.Releases[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.Cluster == nil) != (other.Cluster == nil) || p.Cluster != nil && *p.Cluster != *other.Cluster {
+		return false
+	}
+	if (p.Releases == nil) != (other.Releases == nil) || len(p.Releases) != len(other.Releases) {
+		return false
+	}
+	for i := range p.Releases {
+		if !p.Releases[i].Equal(&other.Releases[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of OutputRelease or nil if OutputRelease is nil.
+func (o *OutputRelease) DeepCopy() *OutputRelease {
+	if o == nil {
+		return nil
+	}
+	out := new(OutputRelease)
+	if o.Name != nil {
+		x := *o.Name
+		out.Name = &x
+	}
+	if o.Namespace != nil {
+		x := *o.Namespace
+		out.Namespace = &x
+	}
+	if o.Chart != nil {
+		x := *o.Chart
+		out.Chart = &x
+	}
+	if o.ChartVersion != nil {
+		x := *o.ChartVersion
+		out.ChartVersion = &x
+	}
+	if o.AppVersion != nil {
+		x := *o.AppVersion
+		out.AppVersion = &x
+	}
+	if o.Revision != nil {
+		x := *o.Revision
+		out.Revision = &x
+	}
+	if o.Status != nil {
+		x := *o.Status
+		out.Status = &x
+	}
+	return out
+}
+
+// Equal reports whether OutputRelease and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *OutputRelease) Equal(other *OutputRelease) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Name == nil) != (other.Name == nil) || o.Name != nil && *o.Name != *other.Name {
+		return false
+	}
+	if (o.Namespace == nil) != (other.Namespace == nil) || o.Namespace != nil && *o.Namespace != *other.Namespace {
+		return false
+	}
+	if (o.Chart == nil) != (other.Chart == nil) || o.Chart != nil && *o.Chart != *other.Chart {
+		return false
+	}
+	if (o.ChartVersion == nil) != (other.ChartVersion == nil) || o.ChartVersion != nil && *o.ChartVersion != *other.ChartVersion {
+		return false
+	}
+	if (o.AppVersion == nil) != (other.AppVersion == nil) || o.AppVersion != nil && *o.AppVersion != *other.AppVersion {
+		return false
+	}
+	if (o.Revision == nil) != (other.Revision == nil) || o.Revision != nil && *o.Revision != *other.Revision {
+		return false
+	}
+	if (o.Status == nil) != (other.Status == nil) || o.Status != nil && *o.Status != *other.Status {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.Releases != nil {
+		out.Releases = make([]OutputRelease, len(o.Releases))
+		for i := range o.Releases {
+			out.Releases[i] = *o.Releases[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.Releases == nil) != (other.Releases == nil) || len(o.Releases) != len(other.Releases) {
+		return false
+	}
+	for i := range o.Releases {
+		if !o.Releases[i].Equal(&other.Releases[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/epiphany-platform/e-structures/utils/values"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "k8saddons"
+	version = "v0.0.1"
+)
+
+var (
+	// https://helm.sh/docs/chart_best_practices/conventions/ and Kubernetes DNS-1123 label rules
+	releaseNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	namespaceRegexp   = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+)
+
+// Release is Helm release of Chart from Repository installed in Namespace. Version is semantic
+// version constraint, i.e. "~4.0" or ">= 1.2.0, < 2.0.0", that installed chart version has to
+// satisfy. Values are passed to chart as they are.
+type Release struct {
+	Name            *string        `json:"name" validate:"required,min=1,max=53"`
+	Namespace       *string        `json:"namespace" validate:"required,min=1,max=63"`
+	CreateNamespace *bool          `json:"create_namespace" validate:"required"`
+	Chart           *string        `json:"chart" validate:"required,min=1"`
+	Repository      *string        `json:"repository" validate:"required,url"`
+	Version         *string        `json:"version" validate:"required,versionconstraint"`
+	Values          *values.Object `json:"values" validate:"omitempty"`
+}
+
+// Key returns namespace qualified name of release, as Helm release names are unique only within
+// namespace.
+func (r *Release) Key() string {
+	return r.GetNamespaceV() + "/" + r.GetNameV()
+}
+
+type Params struct {
+	Name *string `json:"name" validate:"required,min=1"`
+	// Cluster is name of module recorded in state which kubeconfig is used to install releases.
+	Cluster  *string   `json:"cluster" validate:"required,eq=azks|eq=awsks"`
+	Releases []Release `json:"releases" validate:"required,min=1,dive"`
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=k8saddons"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:    to.StrPtr("epiphany"),
+			Cluster: to.StrPtr("azks"),
+			Releases: []Release{
+				{
+					Name:            to.StrPtr("ingress-nginx"),
+					Namespace:       to.StrPtr("ingress-nginx"),
+					CreateNamespace: to.BooPtr(true),
+					Chart:           to.StrPtr("ingress-nginx"),
+					Repository:      to.StrPtr("https://kubernetes.github.io/ingress-nginx"),
+					Version:         to.StrPtr("~3.23"),
+					Values: &values.Object{
+						"controller": map[string]interface{}{
+							"service": map[string]interface{}{
+								"externalTrafficPolicy": "Local",
+							},
+						},
+					},
+				},
+				{
+					Name:            to.StrPtr("cert-manager"),
+					Namespace:       to.StrPtr("cert-manager"),
+					CreateNamespace: to.BooPtr(true),
+					Chart:           to.StrPtr("cert-manager"),
+					Repository:      to.StrPtr("https://charts.jetstack.io"),
+					Version:         to.StrPtr("~1.2"),
+					Values: &values.Object{
+						"installCRDs": true,
+					},
+				},
+			},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("k8saddons config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("versionconstraint", validators.IsVersionConstraint)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(K8sAddonsParamsValidation, Params{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// K8sAddonsParamsValidation checks release names and namespaces against Helm and Kubernetes
+// naming rules and that releases are unique within namespace.
+func K8sAddonsParamsValidation(sl validator.StructLevel) {
+	params := sl.Current().Interface().(Params)
+	releases := make(map[string]bool)
+	for i, r := range params.Releases {
+		if r.Name != nil && *r.Name != "" && !releaseNameRegexp.MatchString(*r.Name) {
+			sl.ReportError(
+				params.Releases[i].Name,
+				fmt.Sprintf("Releases[%d].Name", i),
+				"Name",
+				"releasename",
+				"")
+		}
+		if r.Namespace != nil && *r.Namespace != "" && !namespaceRegexp.MatchString(*r.Namespace) {
+			sl.ReportError(
+				params.Releases[i].Namespace,
+				fmt.Sprintf("Releases[%d].Namespace", i),
+				"Namespace",
+				"namespace",
+				"")
+		}
+		if r.Name == nil || r.Namespace == nil {
+			continue
+		}
+		if releases[r.Key()] {
+			sl.ReportError(
+				params.Releases[i].Name,
+				fmt.Sprintf("Releases[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		releases[r.Key()] = true
+	}
+}
+
+// OutputRelease is Helm release installed in cluster.
+type OutputRelease struct {
+	Name         *string `json:"name" validate:"required,min=1"`
+	Namespace    *string `json:"namespace" validate:"required,min=1"`
+	Chart        *string `json:"chart" validate:"required,min=1"`
+	ChartVersion *string `json:"chart_version" validate:"required,semver"`
+	AppVersion   *string `json:"app_version" validate:"omitempty,min=1"`
+	Revision     *int    `json:"revision" validate:"required,min=1"`
+	Status       *string `json:"status" validate:"required,eq=deployed|eq=failed|eq=pending-install|eq=pending-upgrade|eq=pending-rollback|eq=superseded|eq=uninstalling|eq=uninstalled|eq=unknown"`
+}
+
+// Key returns namespace qualified name of release, matching Release.Key.
+func (r *OutputRelease) Key() string {
+	return r.GetNamespaceV() + "/" + r.GetNameV()
+}
+
+type Output struct {
+	Releases []OutputRelease `json:"releases" validate:"omitempty,dive"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
@@ -0,0 +1,477 @@
+package v0
+
+import (
+	"testing"
+
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/values"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "k8saddons",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"cluster": "awsks",
+		"releases": [
+			{
+				"name": "ingress-nginx",
+				"namespace": "ingress-nginx",
+				"create_namespace": true,
+				"chart": "ingress-nginx",
+				"repository": "https://kubernetes.github.io/ingress-nginx",
+				"version": "~3.23",
+				"values": {
+					"controller": {
+						"replicaCount": 2,
+						"extraArgs": ["--v=2"],
+						"service": {
+							"annotations": {}
+						}
+					}
+				}
+			},
+			{
+				"name": "prometheus",
+				"namespace": "monitoring",
+				"create_namespace": false,
+				"chart": "prometheus",
+				"repository": "oci://registry.example.com/charts",
+				"version": "14.1.0"
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("k8saddons"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:    to.StrPtr("epiphany"),
+					Cluster: to.StrPtr("awsks"),
+					Releases: []Release{
+						{
+							Name:            to.StrPtr("ingress-nginx"),
+							Namespace:       to.StrPtr("ingress-nginx"),
+							CreateNamespace: to.BooPtr(true),
+							Chart:           to.StrPtr("ingress-nginx"),
+							Repository:      to.StrPtr("https://kubernetes.github.io/ingress-nginx"),
+							Version:         to.StrPtr("~3.23"),
+							Values: &values.Object{
+								"controller": map[string]interface{}{
+									"replicaCount": float64(2),
+									"extraArgs":    []interface{}{"--v=2"},
+									"service": map[string]interface{}{
+										"annotations": map[string]interface{}{},
+									},
+								},
+							},
+						},
+						{
+							Name:            to.StrPtr("prometheus"),
+							Namespace:       to.StrPtr("monitoring"),
+							CreateNamespace: to.BooPtr(false),
+							Chart:           to.StrPtr("prometheus"),
+							Repository:      to.StrPtr("oci://registry.example.com/charts"),
+							Version:         to.StrPtr("14.1.0"),
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places but not in values",
+			json: []byte(`{
+	"kind": "k8saddons",
+	"version": "v0.0.1",
+	"extra_outer_field": "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"cluster": "azks",
+		"extra_inner_field": "extra_inner_value",
+		"releases": [
+			{
+				"name": "cert-manager",
+				"namespace": "cert-manager",
+				"create_namespace": true,
+				"chart": "cert-manager",
+				"repository": "https://charts.jetstack.io",
+				"version": "~1.2",
+				"extra_release_field": "extra_release_value",
+				"values": {
+					"installCRDs": true
+				}
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("k8saddons"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:    to.StrPtr("epiphany"),
+					Cluster: to.StrPtr("azks"),
+					Releases: []Release{
+						{
+							Name:            to.StrPtr("cert-manager"),
+							Namespace:       to.StrPtr("cert-manager"),
+							CreateNamespace: to.BooPtr(true),
+							Chart:           to.StrPtr("cert-manager"),
+							Repository:      to.StrPtr("https://charts.jetstack.io"),
+							Version:         to.StrPtr("~1.2"),
+							Values: &values.Object{
+								"installCRDs": true,
+							},
+						},
+					},
+				},
+				Unused: []string{"extra_outer_field", "params.extra_inner_field", "params.releases[0].extra_release_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "azks",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"cluster": "azks",
+		"releases": [
+			{
+				"name": "ingress-nginx",
+				"namespace": "ingress-nginx",
+				"create_namespace": true,
+				"chart": "ingress-nginx",
+				"repository": "https://kubernetes.github.io/ingress-nginx",
+				"version": ">= 3.23.0, < 4.0.0"
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params structure.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "k8saddons",
+	"version": "v0.0.1",
+	"params": {
+
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Cluster",
+					Field: "Cluster",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases",
+					Field: "Releases",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect params values",
+			json: []byte(`{
+	"kind": "k8saddons",
+	"version": "v0.0.1",
+	"params": {
+		"name": "",
+		"cluster": "gcpks",
+		"releases": []
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Cluster",
+					Field: "Cluster",
+					Tag:   "eq=azks|eq=awsks",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases",
+					Field: "Releases",
+					Tag:   "min",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Releases contains all scenarios related to validation of Release structures.
+func TestConfig_Load_Releases(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty release",
+			json: []byte(`{
+	"kind": "k8saddons",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"cluster": "azks",
+		"releases": [
+			{}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Namespace",
+					Field: "Namespace",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].CreateNamespace",
+					Field: "CreateNamespace",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Chart",
+					Field: "Chart",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Repository",
+					Field: "Repository",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Version",
+					Field: "Version",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect release values",
+			json: []byte(`{
+	"kind": "k8saddons",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"cluster": "azks",
+		"releases": [
+			{
+				"name": "Ingress_Nginx",
+				"namespace": "ingress.nginx",
+				"create_namespace": true,
+				"chart": "",
+				"repository": "kubernetes.github.io",
+				"version": "latest"
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Chart",
+					Field: "Chart",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Repository",
+					Field: "Repository",
+					Tag:   "url",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Version",
+					Field: "Version",
+					Tag:   "versionconstraint",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Name",
+					Field: "Releases[0].Name",
+					Tag:   "releasename",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[0].Namespace",
+					Field: "Releases[0].Namespace",
+					Tag:   "namespace",
+				},
+			},
+		},
+		{
+			name: "duplicated releases in namespace",
+			json: []byte(`{
+	"kind": "k8saddons",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"cluster": "azks",
+		"releases": [
+			{
+				"name": "ingress-nginx",
+				"namespace": "ingress",
+				"create_namespace": true,
+				"chart": "ingress-nginx",
+				"repository": "https://kubernetes.github.io/ingress-nginx",
+				"version": "~3.23"
+			},
+			{
+				"name": "ingress-nginx",
+				"namespace": "internal-ingress",
+				"create_namespace": true,
+				"chart": "ingress-nginx",
+				"repository": "https://kubernetes.github.io/ingress-nginx",
+				"version": "~3.23"
+			},
+			{
+				"name": "ingress-nginx",
+				"namespace": "ingress",
+				"create_namespace": false,
+				"chart": "ingress-nginx",
+				"repository": "https://kubernetes.github.io/ingress-nginx",
+				"version": "~3.24"
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Releases[2].Name",
+					Field: "Releases[2].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRelease_Key(t *testing.T) {
+	r := &Release{Name: to.StrPtr("ingress-nginx"), Namespace: to.StrPtr("ingress")}
+	o := &OutputRelease{Name: to.StrPtr("ingress-nginx"), Namespace: to.StrPtr("ingress")}
+	if r.Key() != "ingress/ingress-nginx" || o.Key() != r.Key() {
+		t.Errorf("Key() = %s and %s, want ingress/ingress-nginx", r.Key(), o.Key())
+	}
+}
@@ -0,0 +1,798 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+// GetName returns Name field of Label or nil if Label is nil.
+func (l *Label) GetName() *string {
+	if l == nil {
+		return nil
+	}
+	return l.Name
+}
+
+// GetNameV returns value of Name field of Label or zero value if either Label or field is nil.
+func (l *Label) GetNameV() string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+	return *l.Name
+}
+
+// GetNameOr returns value of Name field of Label or def if either Label or field is nil.
+func (l *Label) GetNameOr(def string) string {
+	if l == nil || l.Name == nil {
+		return def
+	}
+	return *l.Name
+}
+
+// GetValue returns Value field of Label or nil if Label is nil.
+func (l *Label) GetValue() *string {
+	if l == nil {
+		return nil
+	}
+	return l.Value
+}
+
+// GetValueV returns value of Value field of Label or zero value if either Label or field is nil.
+func (l *Label) GetValueV() string {
+	if l == nil || l.Value == nil {
+		return ""
+	}
+	return *l.Value
+}
+
+// GetValueOr returns value of Value field of Label or def if either Label or field is nil.
+func (l *Label) GetValueOr(def string) string {
+	if l == nil || l.Value == nil {
+		return def
+	}
+	return *l.Value
+}
+
+// GetHost returns Host field of Target or nil if Target is nil.
+func (t *Target) GetHost() *string {
+	if t == nil {
+		return nil
+	}
+	return t.Host
+}
+
+// GetHostV returns value of Host field of Target or zero value if either Target or field is nil.
+func (t *Target) GetHostV() string {
+	if t == nil || t.Host == nil {
+		return ""
+	}
+	return *t.Host
+}
+
+// GetHostOr returns value of Host field of Target or def if either Target or field is nil.
+func (t *Target) GetHostOr(def string) string {
+	if t == nil || t.Host == nil {
+		return def
+	}
+	return *t.Host
+}
+
+// GetPort returns Port field of Target or nil if Target is nil.
+func (t *Target) GetPort() *int {
+	if t == nil {
+		return nil
+	}
+	return t.Port
+}
+
+// GetPortV returns value of Port field of Target or zero value if either Target or field is nil.
+func (t *Target) GetPortV() int {
+	if t == nil || t.Port == nil {
+		return 0
+	}
+	return *t.Port
+}
+
+// GetPortOr returns value of Port field of Target or def if either Target or field is nil.
+func (t *Target) GetPortOr(def int) int {
+	if t == nil || t.Port == nil {
+		return def
+	}
+	return *t.Port
+}
+
+// GetLabels returns Labels field of Target, nil if Target is nil or empty slice if field is nil.
+func (t *Target) GetLabels() []Label {
+	if t == nil {
+		return nil
+	}
+	if len(t.Labels) == 0 {
+		return []Label{}
+	}
+	return t.Labels
+}
+
+// GetJobName returns JobName field of ScrapeConfig or nil if ScrapeConfig is nil.
+func (s *ScrapeConfig) GetJobName() *string {
+	if s == nil {
+		return nil
+	}
+	return s.JobName
+}
+
+// GetJobNameV returns value of JobName field of ScrapeConfig or zero value if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetJobNameV() string {
+	if s == nil || s.JobName == nil {
+		return ""
+	}
+	return *s.JobName
+}
+
+// GetJobNameOr returns value of JobName field of ScrapeConfig or def if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetJobNameOr(def string) string {
+	if s == nil || s.JobName == nil {
+		return def
+	}
+	return *s.JobName
+}
+
+// GetScrapeInterval returns ScrapeInterval field of ScrapeConfig or nil if ScrapeConfig is nil.
+func (s *ScrapeConfig) GetScrapeInterval() *string {
+	if s == nil {
+		return nil
+	}
+	return s.ScrapeInterval
+}
+
+// GetScrapeIntervalV returns value of ScrapeInterval field of ScrapeConfig or zero value if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetScrapeIntervalV() string {
+	if s == nil || s.ScrapeInterval == nil {
+		return ""
+	}
+	return *s.ScrapeInterval
+}
+
+// GetScrapeIntervalOr returns value of ScrapeInterval field of ScrapeConfig or def if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetScrapeIntervalOr(def string) string {
+	if s == nil || s.ScrapeInterval == nil {
+		return def
+	}
+	return *s.ScrapeInterval
+}
+
+// GetMetricsPath returns MetricsPath field of ScrapeConfig or nil if ScrapeConfig is nil.
+func (s *ScrapeConfig) GetMetricsPath() *string {
+	if s == nil {
+		return nil
+	}
+	return s.MetricsPath
+}
+
+// GetMetricsPathV returns value of MetricsPath field of ScrapeConfig or zero value if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetMetricsPathV() string {
+	if s == nil || s.MetricsPath == nil {
+		return ""
+	}
+	return *s.MetricsPath
+}
+
+// GetMetricsPathOr returns value of MetricsPath field of ScrapeConfig or def if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetMetricsPathOr(def string) string {
+	if s == nil || s.MetricsPath == nil {
+		return def
+	}
+	return *s.MetricsPath
+}
+
+// GetScheme returns Scheme field of ScrapeConfig or nil if ScrapeConfig is nil.
+func (s *ScrapeConfig) GetScheme() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Scheme
+}
+
+// GetSchemeV returns value of Scheme field of ScrapeConfig or zero value if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetSchemeV() string {
+	if s == nil || s.Scheme == nil {
+		return ""
+	}
+	return *s.Scheme
+}
+
+// GetSchemeOr returns value of Scheme field of ScrapeConfig or def if either ScrapeConfig or field is nil.
+func (s *ScrapeConfig) GetSchemeOr(def string) string {
+	if s == nil || s.Scheme == nil {
+		return def
+	}
+	return *s.Scheme
+}
+
+// GetTargets returns Targets field of ScrapeConfig, nil if ScrapeConfig is nil or empty slice if field is nil.
+func (s *ScrapeConfig) GetTargets() []Target {
+	if s == nil {
+		return nil
+	}
+	if len(s.Targets) == 0 {
+		return []Target{}
+	}
+	return s.Targets
+}
+
+// GetAlert returns Alert field of AlertRule or nil if AlertRule is nil.
+func (a *AlertRule) GetAlert() *string {
+	if a == nil {
+		return nil
+	}
+	return a.Alert
+}
+
+// GetAlertV returns value of Alert field of AlertRule or zero value if either AlertRule or field is nil.
+func (a *AlertRule) GetAlertV() string {
+	if a == nil || a.Alert == nil {
+		return ""
+	}
+	return *a.Alert
+}
+
+// GetAlertOr returns value of Alert field of AlertRule or def if either AlertRule or field is nil.
+func (a *AlertRule) GetAlertOr(def string) string {
+	if a == nil || a.Alert == nil {
+		return def
+	}
+	return *a.Alert
+}
+
+// GetExpr returns Expr field of AlertRule or nil if AlertRule is nil.
+func (a *AlertRule) GetExpr() *string {
+	if a == nil {
+		return nil
+	}
+	return a.Expr
+}
+
+// GetExprV returns value of Expr field of AlertRule or zero value if either AlertRule or field is nil.
+func (a *AlertRule) GetExprV() string {
+	if a == nil || a.Expr == nil {
+		return ""
+	}
+	return *a.Expr
+}
+
+// GetExprOr returns value of Expr field of AlertRule or def if either AlertRule or field is nil.
+func (a *AlertRule) GetExprOr(def string) string {
+	if a == nil || a.Expr == nil {
+		return def
+	}
+	return *a.Expr
+}
+
+// GetFor returns For field of AlertRule or nil if AlertRule is nil.
+func (a *AlertRule) GetFor() *string {
+	if a == nil {
+		return nil
+	}
+	return a.For
+}
+
+// GetForV returns value of For field of AlertRule or zero value if either AlertRule or field is nil.
+func (a *AlertRule) GetForV() string {
+	if a == nil || a.For == nil {
+		return ""
+	}
+	return *a.For
+}
+
+// GetForOr returns value of For field of AlertRule or def if either AlertRule or field is nil.
+func (a *AlertRule) GetForOr(def string) string {
+	if a == nil || a.For == nil {
+		return def
+	}
+	return *a.For
+}
+
+// GetLabels returns Labels field of AlertRule, nil if AlertRule is nil or empty slice if field is nil.
+func (a *AlertRule) GetLabels() []Label {
+	if a == nil {
+		return nil
+	}
+	if len(a.Labels) == 0 {
+		return []Label{}
+	}
+	return a.Labels
+}
+
+// GetAnnotations returns Annotations field of AlertRule, nil if AlertRule is nil or empty slice if field is nil.
+func (a *AlertRule) GetAnnotations() []Label {
+	if a == nil {
+		return nil
+	}
+	if len(a.Annotations) == 0 {
+		return []Label{}
+	}
+	return a.Annotations
+}
+
+// GetName returns Name field of AlertRuleGroup or nil if AlertRuleGroup is nil.
+func (a *AlertRuleGroup) GetName() *string {
+	if a == nil {
+		return nil
+	}
+	return a.Name
+}
+
+// GetNameV returns value of Name field of AlertRuleGroup or zero value if either AlertRuleGroup or field is nil.
+func (a *AlertRuleGroup) GetNameV() string {
+	if a == nil || a.Name == nil {
+		return ""
+	}
+	return *a.Name
+}
+
+// GetNameOr returns value of Name field of AlertRuleGroup or def if either AlertRuleGroup or field is nil.
+func (a *AlertRuleGroup) GetNameOr(def string) string {
+	if a == nil || a.Name == nil {
+		return def
+	}
+	return *a.Name
+}
+
+// GetInterval returns Interval field of AlertRuleGroup or nil if AlertRuleGroup is nil.
+func (a *AlertRuleGroup) GetInterval() *string {
+	if a == nil {
+		return nil
+	}
+	return a.Interval
+}
+
+// GetIntervalV returns value of Interval field of AlertRuleGroup or zero value if either AlertRuleGroup or field is nil.
+func (a *AlertRuleGroup) GetIntervalV() string {
+	if a == nil || a.Interval == nil {
+		return ""
+	}
+	return *a.Interval
+}
+
+// GetIntervalOr returns value of Interval field of AlertRuleGroup or def if either AlertRuleGroup or field is nil.
+func (a *AlertRuleGroup) GetIntervalOr(def string) string {
+	if a == nil || a.Interval == nil {
+		return def
+	}
+	return *a.Interval
+}
+
+// GetRules returns Rules field of AlertRuleGroup, nil if AlertRuleGroup is nil or empty slice if field is nil.
+func (a *AlertRuleGroup) GetRules() []AlertRule {
+	if a == nil {
+		return nil
+	}
+	if len(a.Rules) == 0 {
+		return []AlertRule{}
+	}
+	return a.Rules
+}
+
+// GetPort returns Port field of Prometheus or nil if Prometheus is nil.
+func (p *Prometheus) GetPort() *int {
+	if p == nil {
+		return nil
+	}
+	return p.Port
+}
+
+// GetPortV returns value of Port field of Prometheus or zero value if either Prometheus or field is nil.
+func (p *Prometheus) GetPortV() int {
+	if p == nil || p.Port == nil {
+		return 0
+	}
+	return *p.Port
+}
+
+// GetPortOr returns value of Port field of Prometheus or def if either Prometheus or field is nil.
+func (p *Prometheus) GetPortOr(def int) int {
+	if p == nil || p.Port == nil {
+		return def
+	}
+	return *p.Port
+}
+
+// GetScrapeInterval returns ScrapeInterval field of Prometheus or nil if Prometheus is nil.
+func (p *Prometheus) GetScrapeInterval() *string {
+	if p == nil {
+		return nil
+	}
+	return p.ScrapeInterval
+}
+
+// GetScrapeIntervalV returns value of ScrapeInterval field of Prometheus or zero value if either Prometheus or field is nil.
+func (p *Prometheus) GetScrapeIntervalV() string {
+	if p == nil || p.ScrapeInterval == nil {
+		return ""
+	}
+	return *p.ScrapeInterval
+}
+
+// GetScrapeIntervalOr returns value of ScrapeInterval field of Prometheus or def if either Prometheus or field is nil.
+func (p *Prometheus) GetScrapeIntervalOr(def string) string {
+	if p == nil || p.ScrapeInterval == nil {
+		return def
+	}
+	return *p.ScrapeInterval
+}
+
+// GetEvaluationInterval returns EvaluationInterval field of Prometheus or nil if Prometheus is nil.
+func (p *Prometheus) GetEvaluationInterval() *string {
+	if p == nil {
+		return nil
+	}
+	return p.EvaluationInterval
+}
+
+// GetEvaluationIntervalV returns value of EvaluationInterval field of Prometheus or zero value if either Prometheus or field is nil.
+func (p *Prometheus) GetEvaluationIntervalV() string {
+	if p == nil || p.EvaluationInterval == nil {
+		return ""
+	}
+	return *p.EvaluationInterval
+}
+
+// GetEvaluationIntervalOr returns value of EvaluationInterval field of Prometheus or def if either Prometheus or field is nil.
+func (p *Prometheus) GetEvaluationIntervalOr(def string) string {
+	if p == nil || p.EvaluationInterval == nil {
+		return def
+	}
+	return *p.EvaluationInterval
+}
+
+// GetRetentionTime returns RetentionTime field of Prometheus or nil if Prometheus is nil.
+func (p *Prometheus) GetRetentionTime() *string {
+	if p == nil {
+		return nil
+	}
+	return p.RetentionTime
+}
+
+// GetRetentionTimeV returns value of RetentionTime field of Prometheus or zero value if either Prometheus or field is nil.
+func (p *Prometheus) GetRetentionTimeV() string {
+	if p == nil || p.RetentionTime == nil {
+		return ""
+	}
+	return *p.RetentionTime
+}
+
+// GetRetentionTimeOr returns value of RetentionTime field of Prometheus or def if either Prometheus or field is nil.
+func (p *Prometheus) GetRetentionTimeOr(def string) string {
+	if p == nil || p.RetentionTime == nil {
+		return def
+	}
+	return *p.RetentionTime
+}
+
+// GetExternalLabels returns ExternalLabels field of Prometheus, nil if Prometheus is nil or empty slice if field is nil.
+func (p *Prometheus) GetExternalLabels() []Label {
+	if p == nil {
+		return nil
+	}
+	if len(p.ExternalLabels) == 0 {
+		return []Label{}
+	}
+	return p.ExternalLabels
+}
+
+// GetPort returns Port field of Grafana or nil if Grafana is nil.
+func (g *Grafana) GetPort() *int {
+	if g == nil {
+		return nil
+	}
+	return g.Port
+}
+
+// GetPortV returns value of Port field of Grafana or zero value if either Grafana or field is nil.
+func (g *Grafana) GetPortV() int {
+	if g == nil || g.Port == nil {
+		return 0
+	}
+	return *g.Port
+}
+
+// GetPortOr returns value of Port field of Grafana or def if either Grafana or field is nil.
+func (g *Grafana) GetPortOr(def int) int {
+	if g == nil || g.Port == nil {
+		return def
+	}
+	return *g.Port
+}
+
+// GetAdminUser returns AdminUser field of Grafana or nil if Grafana is nil.
+func (g *Grafana) GetAdminUser() *string {
+	if g == nil {
+		return nil
+	}
+	return g.AdminUser
+}
+
+// GetAdminUserV returns value of AdminUser field of Grafana or zero value if either Grafana or field is nil.
+func (g *Grafana) GetAdminUserV() string {
+	if g == nil || g.AdminUser == nil {
+		return ""
+	}
+	return *g.AdminUser
+}
+
+// GetAdminUserOr returns value of AdminUser field of Grafana or def if either Grafana or field is nil.
+func (g *Grafana) GetAdminUserOr(def string) string {
+	if g == nil || g.AdminUser == nil {
+		return def
+	}
+	return *g.AdminUser
+}
+
+// GetAdminPassword returns AdminPassword field of Grafana or nil if Grafana is nil.
+func (g *Grafana) GetAdminPassword() *string {
+	if g == nil {
+		return nil
+	}
+	return g.AdminPassword
+}
+
+// GetAdminPasswordV returns value of AdminPassword field of Grafana or zero value if either Grafana or field is nil.
+func (g *Grafana) GetAdminPasswordV() string {
+	if g == nil || g.AdminPassword == nil {
+		return ""
+	}
+	return *g.AdminPassword
+}
+
+// GetAdminPasswordOr returns value of AdminPassword field of Grafana or def if either Grafana or field is nil.
+func (g *Grafana) GetAdminPasswordOr(def string) string {
+	if g == nil || g.AdminPassword == nil {
+		return def
+	}
+	return *g.AdminPassword
+}
+
+// GetName returns Name field of Params or nil if Params is nil.
+func (p *Params) GetName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.Name
+}
+
+// GetNameV returns value of Name field of Params or zero value if either Params or field is nil.
+func (p *Params) GetNameV() string {
+	if p == nil || p.Name == nil {
+		return ""
+	}
+	return *p.Name
+}
+
+// GetNameOr returns value of Name field of Params or def if either Params or field is nil.
+func (p *Params) GetNameOr(def string) string {
+	if p == nil || p.Name == nil {
+		return def
+	}
+	return *p.Name
+}
+
+// GetVmGroupName returns VmGroupName field of Params or nil if Params is nil.
+func (p *Params) GetVmGroupName() *string {
+	if p == nil {
+		return nil
+	}
+	return p.VmGroupName
+}
+
+// GetVmGroupNameV returns value of VmGroupName field of Params or zero value if either Params or field is nil.
+func (p *Params) GetVmGroupNameV() string {
+	if p == nil || p.VmGroupName == nil {
+		return ""
+	}
+	return *p.VmGroupName
+}
+
+// GetVmGroupNameOr returns value of VmGroupName field of Params or def if either Params or field is nil.
+func (p *Params) GetVmGroupNameOr(def string) string {
+	if p == nil || p.VmGroupName == nil {
+		return def
+	}
+	return *p.VmGroupName
+}
+
+// GetPrometheus returns Prometheus field of Params or nil if Params is nil.
+func (p *Params) GetPrometheus() *Prometheus {
+	if p == nil {
+		return nil
+	}
+	return p.Prometheus
+}
+
+// GetPrometheusV returns value of Prometheus field of Params or zero value if either Params or field is nil.
+func (p *Params) GetPrometheusV() Prometheus {
+	if p == nil || p.Prometheus == nil {
+		return Prometheus{}
+	}
+	return *p.Prometheus
+}
+
+// GetPrometheusOr returns value of Prometheus field of Params or def if either Params or field is nil.
+func (p *Params) GetPrometheusOr(def Prometheus) Prometheus {
+	if p == nil || p.Prometheus == nil {
+		return def
+	}
+	return *p.Prometheus
+}
+
+// GetGrafana returns Grafana field of Params or nil if Params is nil.
+func (p *Params) GetGrafana() *Grafana {
+	if p == nil {
+		return nil
+	}
+	return p.Grafana
+}
+
+// GetGrafanaV returns value of Grafana field of Params or zero value if either Params or field is nil.
+func (p *Params) GetGrafanaV() Grafana {
+	if p == nil || p.Grafana == nil {
+		return Grafana{}
+	}
+	return *p.Grafana
+}
+
+// GetGrafanaOr returns value of Grafana field of Params or def if either Params or field is nil.
+func (p *Params) GetGrafanaOr(def Grafana) Grafana {
+	if p == nil || p.Grafana == nil {
+		return def
+	}
+	return *p.Grafana
+}
+
+// GetScrapeConfigs returns ScrapeConfigs field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetScrapeConfigs() []ScrapeConfig {
+	if p == nil {
+		return nil
+	}
+	if len(p.ScrapeConfigs) == 0 {
+		return []ScrapeConfig{}
+	}
+	return p.ScrapeConfigs
+}
+
+// GetAlertRuleGroups returns AlertRuleGroups field of Params, nil if Params is nil or empty slice if field is nil.
+func (p *Params) GetAlertRuleGroups() []AlertRuleGroup {
+	if p == nil {
+		return nil
+	}
+	if len(p.AlertRuleGroups) == 0 {
+		return []AlertRuleGroup{}
+	}
+	return p.AlertRuleGroups
+}
+
+// GetKind returns Kind field of Config or nil if Config is nil.
+func (c *Config) GetKind() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Kind
+}
+
+// GetKindV returns value of Kind field of Config or zero value if either Config or field is nil.
+func (c *Config) GetKindV() string {
+	if c == nil || c.Kind == nil {
+		return ""
+	}
+	return *c.Kind
+}
+
+// GetKindOr returns value of Kind field of Config or def if either Config or field is nil.
+func (c *Config) GetKindOr(def string) string {
+	if c == nil || c.Kind == nil {
+		return def
+	}
+	return *c.Kind
+}
+
+// GetVersion returns Version field of Config or nil if Config is nil.
+func (c *Config) GetVersion() *string {
+	if c == nil {
+		return nil
+	}
+	return c.Version
+}
+
+// GetVersionV returns value of Version field of Config or zero value if either Config or field is nil.
+func (c *Config) GetVersionV() string {
+	if c == nil || c.Version == nil {
+		return ""
+	}
+	return *c.Version
+}
+
+// GetVersionOr returns value of Version field of Config or def if either Config or field is nil.
+func (c *Config) GetVersionOr(def string) string {
+	if c == nil || c.Version == nil {
+		return def
+	}
+	return *c.Version
+}
+
+// GetParams returns Params field of Config or nil if Config is nil.
+func (c *Config) GetParams() *Params {
+	if c == nil {
+		return nil
+	}
+	return c.Params
+}
+
+// GetParamsV returns value of Params field of Config or zero value if either Config or field is nil.
+func (c *Config) GetParamsV() Params {
+	if c == nil || c.Params == nil {
+		return Params{}
+	}
+	return *c.Params
+}
+
+// GetParamsOr returns value of Params field of Config or def if either Config or field is nil.
+func (c *Config) GetParamsOr(def Params) Params {
+	if c == nil || c.Params == nil {
+		return def
+	}
+	return *c.Params
+}
+
+// GetUnused returns Unused field of Config, nil if Config is nil or empty slice if field is nil.
+func (c *Config) GetUnused() []string {
+	if c == nil {
+		return nil
+	}
+	if len(c.Unused) == 0 {
+		return []string{}
+	}
+	return c.Unused
+}
+
+// GetPrometheusUrl returns PrometheusUrl field of Output or nil if Output is nil.
+func (o *Output) GetPrometheusUrl() *string {
+	if o == nil {
+		return nil
+	}
+	return o.PrometheusUrl
+}
+
+// GetPrometheusUrlV returns value of PrometheusUrl field of Output or zero value if either Output or field is nil.
+func (o *Output) GetPrometheusUrlV() string {
+	if o == nil || o.PrometheusUrl == nil {
+		return ""
+	}
+	return *o.PrometheusUrl
+}
+
+// GetPrometheusUrlOr returns value of PrometheusUrl field of Output or def if either Output or field is nil.
+func (o *Output) GetPrometheusUrlOr(def string) string {
+	if o == nil || o.PrometheusUrl == nil {
+		return def
+	}
+	return *o.PrometheusUrl
+}
+
+// GetGrafanaUrl returns GrafanaUrl field of Output or nil if Output is nil.
+func (o *Output) GetGrafanaUrl() *string {
+	if o == nil {
+		return nil
+	}
+	return o.GrafanaUrl
+}
+
+// GetGrafanaUrlV returns value of GrafanaUrl field of Output or zero value if either Output or field is nil.
+func (o *Output) GetGrafanaUrlV() string {
+	if o == nil || o.GrafanaUrl == nil {
+		return ""
+	}
+	return *o.GrafanaUrl
+}
+
+// GetGrafanaUrlOr returns value of GrafanaUrl field of Output or def if either Output or field is nil.
+func (o *Output) GetGrafanaUrlOr(def string) string {
+	if o == nil || o.GrafanaUrl == nil {
+		return def
+	}
+	return *o.GrafanaUrl
+}
@@ -0,0 +1,795 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLabel_Accessors(t *testing.T) {
+	var nilStruct *Label
+	emptyStruct := &Label{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Label{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Value", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Label{Value: &v}
+		if nilStruct.GetValue() != nil || emptyStruct.GetValue() != nil {
+			t.Error("GetValue() expected to return nil")
+		}
+		if fullStruct.GetValue() != &v {
+			t.Error("GetValue() expected to return field")
+		}
+		if nilStruct.GetValueV() != "" || emptyStruct.GetValueV() != "" {
+			t.Error("GetValueV() expected to return zero value")
+		}
+		if fullStruct.GetValueV() != v {
+			t.Error("GetValueV() expected to return field value")
+		}
+		if nilStruct.GetValueOr(v) != v || emptyStruct.GetValueOr(v) != v {
+			t.Error("GetValueOr() expected to return default value")
+		}
+		if fullStruct.GetValueOr("") != v {
+			t.Error("GetValueOr() expected to return field value")
+		}
+	})
+}
+
+func TestTarget_Accessors(t *testing.T) {
+	var nilStruct *Target
+	emptyStruct := &Target{}
+	t.Run("Host", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Target{Host: &v}
+		if nilStruct.GetHost() != nil || emptyStruct.GetHost() != nil {
+			t.Error("GetHost() expected to return nil")
+		}
+		if fullStruct.GetHost() != &v {
+			t.Error("GetHost() expected to return field")
+		}
+		if nilStruct.GetHostV() != "" || emptyStruct.GetHostV() != "" {
+			t.Error("GetHostV() expected to return zero value")
+		}
+		if fullStruct.GetHostV() != v {
+			t.Error("GetHostV() expected to return field value")
+		}
+		if nilStruct.GetHostOr(v) != v || emptyStruct.GetHostOr(v) != v {
+			t.Error("GetHostOr() expected to return default value")
+		}
+		if fullStruct.GetHostOr("") != v {
+			t.Error("GetHostOr() expected to return field value")
+		}
+	})
+	t.Run("Port", func(t *testing.T) {
+		v := 1
+		fullStruct := &Target{Port: &v}
+		if nilStruct.GetPort() != nil || emptyStruct.GetPort() != nil {
+			t.Error("GetPort() expected to return nil")
+		}
+		if fullStruct.GetPort() != &v {
+			t.Error("GetPort() expected to return field")
+		}
+		if nilStruct.GetPortV() != 0 || emptyStruct.GetPortV() != 0 {
+			t.Error("GetPortV() expected to return zero value")
+		}
+		if fullStruct.GetPortV() != v {
+			t.Error("GetPortV() expected to return field value")
+		}
+		if nilStruct.GetPortOr(v) != v || emptyStruct.GetPortOr(v) != v {
+			t.Error("GetPortOr() expected to return default value")
+		}
+		if fullStruct.GetPortOr(0) != v {
+			t.Error("GetPortOr() expected to return field value")
+		}
+	})
+	t.Run("Labels", func(t *testing.T) {
+		fullStruct := &Target{Labels: make([]Label, 1)}
+		if nilStruct.GetLabels() != nil {
+			t.Error("GetLabels() expected to return nil")
+		}
+		if got := emptyStruct.GetLabels(); got == nil || len(got) != 0 {
+			t.Error("GetLabels() expected to return empty slice")
+		}
+		if got := fullStruct.GetLabels(); len(got) != 1 || &got[0] != &fullStruct.Labels[0] {
+			t.Error("GetLabels() expected to return field")
+		}
+	})
+}
+
+func TestScrapeConfig_Accessors(t *testing.T) {
+	var nilStruct *ScrapeConfig
+	emptyStruct := &ScrapeConfig{}
+	t.Run("JobName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &ScrapeConfig{JobName: &v}
+		if nilStruct.GetJobName() != nil || emptyStruct.GetJobName() != nil {
+			t.Error("GetJobName() expected to return nil")
+		}
+		if fullStruct.GetJobName() != &v {
+			t.Error("GetJobName() expected to return field")
+		}
+		if nilStruct.GetJobNameV() != "" || emptyStruct.GetJobNameV() != "" {
+			t.Error("GetJobNameV() expected to return zero value")
+		}
+		if fullStruct.GetJobNameV() != v {
+			t.Error("GetJobNameV() expected to return field value")
+		}
+		if nilStruct.GetJobNameOr(v) != v || emptyStruct.GetJobNameOr(v) != v {
+			t.Error("GetJobNameOr() expected to return default value")
+		}
+		if fullStruct.GetJobNameOr("") != v {
+			t.Error("GetJobNameOr() expected to return field value")
+		}
+	})
+	t.Run("ScrapeInterval", func(t *testing.T) {
+		v := "value"
+		fullStruct := &ScrapeConfig{ScrapeInterval: &v}
+		if nilStruct.GetScrapeInterval() != nil || emptyStruct.GetScrapeInterval() != nil {
+			t.Error("GetScrapeInterval() expected to return nil")
+		}
+		if fullStruct.GetScrapeInterval() != &v {
+			t.Error("GetScrapeInterval() expected to return field")
+		}
+		if nilStruct.GetScrapeIntervalV() != "" || emptyStruct.GetScrapeIntervalV() != "" {
+			t.Error("GetScrapeIntervalV() expected to return zero value")
+		}
+		if fullStruct.GetScrapeIntervalV() != v {
+			t.Error("GetScrapeIntervalV() expected to return field value")
+		}
+		if nilStruct.GetScrapeIntervalOr(v) != v || emptyStruct.GetScrapeIntervalOr(v) != v {
+			t.Error("GetScrapeIntervalOr() expected to return default value")
+		}
+		if fullStruct.GetScrapeIntervalOr("") != v {
+			t.Error("GetScrapeIntervalOr() expected to return field value")
+		}
+	})
+	t.Run("MetricsPath", func(t *testing.T) {
+		v := "value"
+		fullStruct := &ScrapeConfig{MetricsPath: &v}
+		if nilStruct.GetMetricsPath() != nil || emptyStruct.GetMetricsPath() != nil {
+			t.Error("GetMetricsPath() expected to return nil")
+		}
+		if fullStruct.GetMetricsPath() != &v {
+			t.Error("GetMetricsPath() expected to return field")
+		}
+		if nilStruct.GetMetricsPathV() != "" || emptyStruct.GetMetricsPathV() != "" {
+			t.Error("GetMetricsPathV() expected to return zero value")
+		}
+		if fullStruct.GetMetricsPathV() != v {
+			t.Error("GetMetricsPathV() expected to return field value")
+		}
+		if nilStruct.GetMetricsPathOr(v) != v || emptyStruct.GetMetricsPathOr(v) != v {
+			t.Error("GetMetricsPathOr() expected to return default value")
+		}
+		if fullStruct.GetMetricsPathOr("") != v {
+			t.Error("GetMetricsPathOr() expected to return field value")
+		}
+	})
+	t.Run("Scheme", func(t *testing.T) {
+		v := "value"
+		fullStruct := &ScrapeConfig{Scheme: &v}
+		if nilStruct.GetScheme() != nil || emptyStruct.GetScheme() != nil {
+			t.Error("GetScheme() expected to return nil")
+		}
+		if fullStruct.GetScheme() != &v {
+			t.Error("GetScheme() expected to return field")
+		}
+		if nilStruct.GetSchemeV() != "" || emptyStruct.GetSchemeV() != "" {
+			t.Error("GetSchemeV() expected to return zero value")
+		}
+		if fullStruct.GetSchemeV() != v {
+			t.Error("GetSchemeV() expected to return field value")
+		}
+		if nilStruct.GetSchemeOr(v) != v || emptyStruct.GetSchemeOr(v) != v {
+			t.Error("GetSchemeOr() expected to return default value")
+		}
+		if fullStruct.GetSchemeOr("") != v {
+			t.Error("GetSchemeOr() expected to return field value")
+		}
+	})
+	t.Run("Targets", func(t *testing.T) {
+		fullStruct := &ScrapeConfig{Targets: make([]Target, 1)}
+		if nilStruct.GetTargets() != nil {
+			t.Error("GetTargets() expected to return nil")
+		}
+		if got := emptyStruct.GetTargets(); got == nil || len(got) != 0 {
+			t.Error("GetTargets() expected to return empty slice")
+		}
+		if got := fullStruct.GetTargets(); len(got) != 1 || &got[0] != &fullStruct.Targets[0] {
+			t.Error("GetTargets() expected to return field")
+		}
+	})
+}
+
+func TestAlertRule_Accessors(t *testing.T) {
+	var nilStruct *AlertRule
+	emptyStruct := &AlertRule{}
+	t.Run("Alert", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AlertRule{Alert: &v}
+		if nilStruct.GetAlert() != nil || emptyStruct.GetAlert() != nil {
+			t.Error("GetAlert() expected to return nil")
+		}
+		if fullStruct.GetAlert() != &v {
+			t.Error("GetAlert() expected to return field")
+		}
+		if nilStruct.GetAlertV() != "" || emptyStruct.GetAlertV() != "" {
+			t.Error("GetAlertV() expected to return zero value")
+		}
+		if fullStruct.GetAlertV() != v {
+			t.Error("GetAlertV() expected to return field value")
+		}
+		if nilStruct.GetAlertOr(v) != v || emptyStruct.GetAlertOr(v) != v {
+			t.Error("GetAlertOr() expected to return default value")
+		}
+		if fullStruct.GetAlertOr("") != v {
+			t.Error("GetAlertOr() expected to return field value")
+		}
+	})
+	t.Run("Expr", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AlertRule{Expr: &v}
+		if nilStruct.GetExpr() != nil || emptyStruct.GetExpr() != nil {
+			t.Error("GetExpr() expected to return nil")
+		}
+		if fullStruct.GetExpr() != &v {
+			t.Error("GetExpr() expected to return field")
+		}
+		if nilStruct.GetExprV() != "" || emptyStruct.GetExprV() != "" {
+			t.Error("GetExprV() expected to return zero value")
+		}
+		if fullStruct.GetExprV() != v {
+			t.Error("GetExprV() expected to return field value")
+		}
+		if nilStruct.GetExprOr(v) != v || emptyStruct.GetExprOr(v) != v {
+			t.Error("GetExprOr() expected to return default value")
+		}
+		if fullStruct.GetExprOr("") != v {
+			t.Error("GetExprOr() expected to return field value")
+		}
+	})
+	t.Run("For", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AlertRule{For: &v}
+		if nilStruct.GetFor() != nil || emptyStruct.GetFor() != nil {
+			t.Error("GetFor() expected to return nil")
+		}
+		if fullStruct.GetFor() != &v {
+			t.Error("GetFor() expected to return field")
+		}
+		if nilStruct.GetForV() != "" || emptyStruct.GetForV() != "" {
+			t.Error("GetForV() expected to return zero value")
+		}
+		if fullStruct.GetForV() != v {
+			t.Error("GetForV() expected to return field value")
+		}
+		if nilStruct.GetForOr(v) != v || emptyStruct.GetForOr(v) != v {
+			t.Error("GetForOr() expected to return default value")
+		}
+		if fullStruct.GetForOr("") != v {
+			t.Error("GetForOr() expected to return field value")
+		}
+	})
+	t.Run("Labels", func(t *testing.T) {
+		fullStruct := &AlertRule{Labels: make([]Label, 1)}
+		if nilStruct.GetLabels() != nil {
+			t.Error("GetLabels() expected to return nil")
+		}
+		if got := emptyStruct.GetLabels(); got == nil || len(got) != 0 {
+			t.Error("GetLabels() expected to return empty slice")
+		}
+		if got := fullStruct.GetLabels(); len(got) != 1 || &got[0] != &fullStruct.Labels[0] {
+			t.Error("GetLabels() expected to return field")
+		}
+	})
+	t.Run("Annotations", func(t *testing.T) {
+		fullStruct := &AlertRule{Annotations: make([]Label, 1)}
+		if nilStruct.GetAnnotations() != nil {
+			t.Error("GetAnnotations() expected to return nil")
+		}
+		if got := emptyStruct.GetAnnotations(); got == nil || len(got) != 0 {
+			t.Error("GetAnnotations() expected to return empty slice")
+		}
+		if got := fullStruct.GetAnnotations(); len(got) != 1 || &got[0] != &fullStruct.Annotations[0] {
+			t.Error("GetAnnotations() expected to return field")
+		}
+	})
+}
+
+func TestAlertRuleGroup_Accessors(t *testing.T) {
+	var nilStruct *AlertRuleGroup
+	emptyStruct := &AlertRuleGroup{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AlertRuleGroup{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("Interval", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AlertRuleGroup{Interval: &v}
+		if nilStruct.GetInterval() != nil || emptyStruct.GetInterval() != nil {
+			t.Error("GetInterval() expected to return nil")
+		}
+		if fullStruct.GetInterval() != &v {
+			t.Error("GetInterval() expected to return field")
+		}
+		if nilStruct.GetIntervalV() != "" || emptyStruct.GetIntervalV() != "" {
+			t.Error("GetIntervalV() expected to return zero value")
+		}
+		if fullStruct.GetIntervalV() != v {
+			t.Error("GetIntervalV() expected to return field value")
+		}
+		if nilStruct.GetIntervalOr(v) != v || emptyStruct.GetIntervalOr(v) != v {
+			t.Error("GetIntervalOr() expected to return default value")
+		}
+		if fullStruct.GetIntervalOr("") != v {
+			t.Error("GetIntervalOr() expected to return field value")
+		}
+	})
+	t.Run("Rules", func(t *testing.T) {
+		fullStruct := &AlertRuleGroup{Rules: make([]AlertRule, 1)}
+		if nilStruct.GetRules() != nil {
+			t.Error("GetRules() expected to return nil")
+		}
+		if got := emptyStruct.GetRules(); got == nil || len(got) != 0 {
+			t.Error("GetRules() expected to return empty slice")
+		}
+		if got := fullStruct.GetRules(); len(got) != 1 || &got[0] != &fullStruct.Rules[0] {
+			t.Error("GetRules() expected to return field")
+		}
+	})
+}
+
+func TestPrometheus_Accessors(t *testing.T) {
+	var nilStruct *Prometheus
+	emptyStruct := &Prometheus{}
+	t.Run("Port", func(t *testing.T) {
+		v := 1
+		fullStruct := &Prometheus{Port: &v}
+		if nilStruct.GetPort() != nil || emptyStruct.GetPort() != nil {
+			t.Error("GetPort() expected to return nil")
+		}
+		if fullStruct.GetPort() != &v {
+			t.Error("GetPort() expected to return field")
+		}
+		if nilStruct.GetPortV() != 0 || emptyStruct.GetPortV() != 0 {
+			t.Error("GetPortV() expected to return zero value")
+		}
+		if fullStruct.GetPortV() != v {
+			t.Error("GetPortV() expected to return field value")
+		}
+		if nilStruct.GetPortOr(v) != v || emptyStruct.GetPortOr(v) != v {
+			t.Error("GetPortOr() expected to return default value")
+		}
+		if fullStruct.GetPortOr(0) != v {
+			t.Error("GetPortOr() expected to return field value")
+		}
+	})
+	t.Run("ScrapeInterval", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Prometheus{ScrapeInterval: &v}
+		if nilStruct.GetScrapeInterval() != nil || emptyStruct.GetScrapeInterval() != nil {
+			t.Error("GetScrapeInterval() expected to return nil")
+		}
+		if fullStruct.GetScrapeInterval() != &v {
+			t.Error("GetScrapeInterval() expected to return field")
+		}
+		if nilStruct.GetScrapeIntervalV() != "" || emptyStruct.GetScrapeIntervalV() != "" {
+			t.Error("GetScrapeIntervalV() expected to return zero value")
+		}
+		if fullStruct.GetScrapeIntervalV() != v {
+			t.Error("GetScrapeIntervalV() expected to return field value")
+		}
+		if nilStruct.GetScrapeIntervalOr(v) != v || emptyStruct.GetScrapeIntervalOr(v) != v {
+			t.Error("GetScrapeIntervalOr() expected to return default value")
+		}
+		if fullStruct.GetScrapeIntervalOr("") != v {
+			t.Error("GetScrapeIntervalOr() expected to return field value")
+		}
+	})
+	t.Run("EvaluationInterval", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Prometheus{EvaluationInterval: &v}
+		if nilStruct.GetEvaluationInterval() != nil || emptyStruct.GetEvaluationInterval() != nil {
+			t.Error("GetEvaluationInterval() expected to return nil")
+		}
+		if fullStruct.GetEvaluationInterval() != &v {
+			t.Error("GetEvaluationInterval() expected to return field")
+		}
+		if nilStruct.GetEvaluationIntervalV() != "" || emptyStruct.GetEvaluationIntervalV() != "" {
+			t.Error("GetEvaluationIntervalV() expected to return zero value")
+		}
+		if fullStruct.GetEvaluationIntervalV() != v {
+			t.Error("GetEvaluationIntervalV() expected to return field value")
+		}
+		if nilStruct.GetEvaluationIntervalOr(v) != v || emptyStruct.GetEvaluationIntervalOr(v) != v {
+			t.Error("GetEvaluationIntervalOr() expected to return default value")
+		}
+		if fullStruct.GetEvaluationIntervalOr("") != v {
+			t.Error("GetEvaluationIntervalOr() expected to return field value")
+		}
+	})
+	t.Run("RetentionTime", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Prometheus{RetentionTime: &v}
+		if nilStruct.GetRetentionTime() != nil || emptyStruct.GetRetentionTime() != nil {
+			t.Error("GetRetentionTime() expected to return nil")
+		}
+		if fullStruct.GetRetentionTime() != &v {
+			t.Error("GetRetentionTime() expected to return field")
+		}
+		if nilStruct.GetRetentionTimeV() != "" || emptyStruct.GetRetentionTimeV() != "" {
+			t.Error("GetRetentionTimeV() expected to return zero value")
+		}
+		if fullStruct.GetRetentionTimeV() != v {
+			t.Error("GetRetentionTimeV() expected to return field value")
+		}
+		if nilStruct.GetRetentionTimeOr(v) != v || emptyStruct.GetRetentionTimeOr(v) != v {
+			t.Error("GetRetentionTimeOr() expected to return default value")
+		}
+		if fullStruct.GetRetentionTimeOr("") != v {
+			t.Error("GetRetentionTimeOr() expected to return field value")
+		}
+	})
+	t.Run("ExternalLabels", func(t *testing.T) {
+		fullStruct := &Prometheus{ExternalLabels: make([]Label, 1)}
+		if nilStruct.GetExternalLabels() != nil {
+			t.Error("GetExternalLabels() expected to return nil")
+		}
+		if got := emptyStruct.GetExternalLabels(); got == nil || len(got) != 0 {
+			t.Error("GetExternalLabels() expected to return empty slice")
+		}
+		if got := fullStruct.GetExternalLabels(); len(got) != 1 || &got[0] != &fullStruct.ExternalLabels[0] {
+			t.Error("GetExternalLabels() expected to return field")
+		}
+	})
+}
+
+func TestGrafana_Accessors(t *testing.T) {
+	var nilStruct *Grafana
+	emptyStruct := &Grafana{}
+	t.Run("Port", func(t *testing.T) {
+		v := 1
+		fullStruct := &Grafana{Port: &v}
+		if nilStruct.GetPort() != nil || emptyStruct.GetPort() != nil {
+			t.Error("GetPort() expected to return nil")
+		}
+		if fullStruct.GetPort() != &v {
+			t.Error("GetPort() expected to return field")
+		}
+		if nilStruct.GetPortV() != 0 || emptyStruct.GetPortV() != 0 {
+			t.Error("GetPortV() expected to return zero value")
+		}
+		if fullStruct.GetPortV() != v {
+			t.Error("GetPortV() expected to return field value")
+		}
+		if nilStruct.GetPortOr(v) != v || emptyStruct.GetPortOr(v) != v {
+			t.Error("GetPortOr() expected to return default value")
+		}
+		if fullStruct.GetPortOr(0) != v {
+			t.Error("GetPortOr() expected to return field value")
+		}
+	})
+	t.Run("AdminUser", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Grafana{AdminUser: &v}
+		if nilStruct.GetAdminUser() != nil || emptyStruct.GetAdminUser() != nil {
+			t.Error("GetAdminUser() expected to return nil")
+		}
+		if fullStruct.GetAdminUser() != &v {
+			t.Error("GetAdminUser() expected to return field")
+		}
+		if nilStruct.GetAdminUserV() != "" || emptyStruct.GetAdminUserV() != "" {
+			t.Error("GetAdminUserV() expected to return zero value")
+		}
+		if fullStruct.GetAdminUserV() != v {
+			t.Error("GetAdminUserV() expected to return field value")
+		}
+		if nilStruct.GetAdminUserOr(v) != v || emptyStruct.GetAdminUserOr(v) != v {
+			t.Error("GetAdminUserOr() expected to return default value")
+		}
+		if fullStruct.GetAdminUserOr("") != v {
+			t.Error("GetAdminUserOr() expected to return field value")
+		}
+	})
+	t.Run("AdminPassword", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Grafana{AdminPassword: &v}
+		if nilStruct.GetAdminPassword() != nil || emptyStruct.GetAdminPassword() != nil {
+			t.Error("GetAdminPassword() expected to return nil")
+		}
+		if fullStruct.GetAdminPassword() != &v {
+			t.Error("GetAdminPassword() expected to return field")
+		}
+		if nilStruct.GetAdminPasswordV() != "" || emptyStruct.GetAdminPasswordV() != "" {
+			t.Error("GetAdminPasswordV() expected to return zero value")
+		}
+		if fullStruct.GetAdminPasswordV() != v {
+			t.Error("GetAdminPasswordV() expected to return field value")
+		}
+		if nilStruct.GetAdminPasswordOr(v) != v || emptyStruct.GetAdminPasswordOr(v) != v {
+			t.Error("GetAdminPasswordOr() expected to return default value")
+		}
+		if fullStruct.GetAdminPasswordOr("") != v {
+			t.Error("GetAdminPasswordOr() expected to return field value")
+		}
+	})
+}
+
+func TestParams_Accessors(t *testing.T) {
+	var nilStruct *Params
+	emptyStruct := &Params{}
+	t.Run("Name", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{Name: &v}
+		if nilStruct.GetName() != nil || emptyStruct.GetName() != nil {
+			t.Error("GetName() expected to return nil")
+		}
+		if fullStruct.GetName() != &v {
+			t.Error("GetName() expected to return field")
+		}
+		if nilStruct.GetNameV() != "" || emptyStruct.GetNameV() != "" {
+			t.Error("GetNameV() expected to return zero value")
+		}
+		if fullStruct.GetNameV() != v {
+			t.Error("GetNameV() expected to return field value")
+		}
+		if nilStruct.GetNameOr(v) != v || emptyStruct.GetNameOr(v) != v {
+			t.Error("GetNameOr() expected to return default value")
+		}
+		if fullStruct.GetNameOr("") != v {
+			t.Error("GetNameOr() expected to return field value")
+		}
+	})
+	t.Run("VmGroupName", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Params{VmGroupName: &v}
+		if nilStruct.GetVmGroupName() != nil || emptyStruct.GetVmGroupName() != nil {
+			t.Error("GetVmGroupName() expected to return nil")
+		}
+		if fullStruct.GetVmGroupName() != &v {
+			t.Error("GetVmGroupName() expected to return field")
+		}
+		if nilStruct.GetVmGroupNameV() != "" || emptyStruct.GetVmGroupNameV() != "" {
+			t.Error("GetVmGroupNameV() expected to return zero value")
+		}
+		if fullStruct.GetVmGroupNameV() != v {
+			t.Error("GetVmGroupNameV() expected to return field value")
+		}
+		if nilStruct.GetVmGroupNameOr(v) != v || emptyStruct.GetVmGroupNameOr(v) != v {
+			t.Error("GetVmGroupNameOr() expected to return default value")
+		}
+		if fullStruct.GetVmGroupNameOr("") != v {
+			t.Error("GetVmGroupNameOr() expected to return field value")
+		}
+	})
+	t.Run("Prometheus", func(t *testing.T) {
+		v := Prometheus{}
+		fullStruct := &Params{Prometheus: &v}
+		if nilStruct.GetPrometheus() != nil || emptyStruct.GetPrometheus() != nil {
+			t.Error("GetPrometheus() expected to return nil")
+		}
+		if fullStruct.GetPrometheus() != &v {
+			t.Error("GetPrometheus() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetPrometheusV(), Prometheus{}) || !reflect.DeepEqual(emptyStruct.GetPrometheusV(), Prometheus{}) {
+			t.Error("GetPrometheusV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetPrometheusV(), v) {
+			t.Error("GetPrometheusV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetPrometheusOr(v), v) || !reflect.DeepEqual(emptyStruct.GetPrometheusOr(v), v) {
+			t.Error("GetPrometheusOr() expected to return default value")
+		}
+	})
+	t.Run("Grafana", func(t *testing.T) {
+		v := Grafana{}
+		fullStruct := &Params{Grafana: &v}
+		if nilStruct.GetGrafana() != nil || emptyStruct.GetGrafana() != nil {
+			t.Error("GetGrafana() expected to return nil")
+		}
+		if fullStruct.GetGrafana() != &v {
+			t.Error("GetGrafana() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetGrafanaV(), Grafana{}) || !reflect.DeepEqual(emptyStruct.GetGrafanaV(), Grafana{}) {
+			t.Error("GetGrafanaV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetGrafanaV(), v) {
+			t.Error("GetGrafanaV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetGrafanaOr(v), v) || !reflect.DeepEqual(emptyStruct.GetGrafanaOr(v), v) {
+			t.Error("GetGrafanaOr() expected to return default value")
+		}
+	})
+	t.Run("ScrapeConfigs", func(t *testing.T) {
+		fullStruct := &Params{ScrapeConfigs: make([]ScrapeConfig, 1)}
+		if nilStruct.GetScrapeConfigs() != nil {
+			t.Error("GetScrapeConfigs() expected to return nil")
+		}
+		if got := emptyStruct.GetScrapeConfigs(); got == nil || len(got) != 0 {
+			t.Error("GetScrapeConfigs() expected to return empty slice")
+		}
+		if got := fullStruct.GetScrapeConfigs(); len(got) != 1 || &got[0] != &fullStruct.ScrapeConfigs[0] {
+			t.Error("GetScrapeConfigs() expected to return field")
+		}
+	})
+	t.Run("AlertRuleGroups", func(t *testing.T) {
+		fullStruct := &Params{AlertRuleGroups: make([]AlertRuleGroup, 1)}
+		if nilStruct.GetAlertRuleGroups() != nil {
+			t.Error("GetAlertRuleGroups() expected to return nil")
+		}
+		if got := emptyStruct.GetAlertRuleGroups(); got == nil || len(got) != 0 {
+			t.Error("GetAlertRuleGroups() expected to return empty slice")
+		}
+		if got := fullStruct.GetAlertRuleGroups(); len(got) != 1 || &got[0] != &fullStruct.AlertRuleGroups[0] {
+			t.Error("GetAlertRuleGroups() expected to return field")
+		}
+	})
+}
+
+func TestConfig_Accessors(t *testing.T) {
+	var nilStruct *Config
+	emptyStruct := &Config{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Config{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Params", func(t *testing.T) {
+		v := Params{}
+		fullStruct := &Config{Params: &v}
+		if nilStruct.GetParams() != nil || emptyStruct.GetParams() != nil {
+			t.Error("GetParams() expected to return nil")
+		}
+		if fullStruct.GetParams() != &v {
+			t.Error("GetParams() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsV(), Params{}) || !reflect.DeepEqual(emptyStruct.GetParamsV(), Params{}) {
+			t.Error("GetParamsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetParamsV(), v) {
+			t.Error("GetParamsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetParamsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetParamsOr(v), v) {
+			t.Error("GetParamsOr() expected to return default value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &Config{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+}
+
+func TestOutput_Accessors(t *testing.T) {
+	var nilStruct *Output
+	emptyStruct := &Output{}
+	t.Run("PrometheusUrl", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{PrometheusUrl: &v}
+		if nilStruct.GetPrometheusUrl() != nil || emptyStruct.GetPrometheusUrl() != nil {
+			t.Error("GetPrometheusUrl() expected to return nil")
+		}
+		if fullStruct.GetPrometheusUrl() != &v {
+			t.Error("GetPrometheusUrl() expected to return field")
+		}
+		if nilStruct.GetPrometheusUrlV() != "" || emptyStruct.GetPrometheusUrlV() != "" {
+			t.Error("GetPrometheusUrlV() expected to return zero value")
+		}
+		if fullStruct.GetPrometheusUrlV() != v {
+			t.Error("GetPrometheusUrlV() expected to return field value")
+		}
+		if nilStruct.GetPrometheusUrlOr(v) != v || emptyStruct.GetPrometheusUrlOr(v) != v {
+			t.Error("GetPrometheusUrlOr() expected to return default value")
+		}
+		if fullStruct.GetPrometheusUrlOr("") != v {
+			t.Error("GetPrometheusUrlOr() expected to return field value")
+		}
+	})
+	t.Run("GrafanaUrl", func(t *testing.T) {
+		v := "value"
+		fullStruct := &Output{GrafanaUrl: &v}
+		if nilStruct.GetGrafanaUrl() != nil || emptyStruct.GetGrafanaUrl() != nil {
+			t.Error("GetGrafanaUrl() expected to return nil")
+		}
+		if fullStruct.GetGrafanaUrl() != &v {
+			t.Error("GetGrafanaUrl() expected to return field")
+		}
+		if nilStruct.GetGrafanaUrlV() != "" || emptyStruct.GetGrafanaUrlV() != "" {
+			t.Error("GetGrafanaUrlV() expected to return zero value")
+		}
+		if fullStruct.GetGrafanaUrlV() != v {
+			t.Error("GetGrafanaUrlV() expected to return field value")
+		}
+		if nilStruct.GetGrafanaUrlOr(v) != v || emptyStruct.GetGrafanaUrlOr(v) != v {
+			t.Error("GetGrafanaUrlOr() expected to return default value")
+		}
+		if fullStruct.GetGrafanaUrlOr("") != v {
+			t.Error("GetGrafanaUrlOr() expected to return field value")
+		}
+	})
+}
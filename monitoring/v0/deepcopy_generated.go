@@ -0,0 +1,493 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of Label or nil if Label is nil.
+func (l *Label) DeepCopy() *Label {
+	if l == nil {
+		return nil
+	}
+	out := new(Label)
+	if l.Name != nil {
+		x := *l.Name
+		out.Name = &x
+	}
+	if l.Value != nil {
+		x := *l.Value
+		out.Value = &x
+	}
+	return out
+}
+
+// Equal reports whether Label and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (l *Label) Equal(other *Label) bool {
+	if l == nil || other == nil {
+		return l == other
+	}
+	if (l.Name == nil) != (other.Name == nil) || l.Name != nil && *l.Name != *other.Name {
+		return false
+	}
+	if (l.Value == nil) != (other.Value == nil) || l.Value != nil && *l.Value != *other.Value {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Target or nil if Target is nil.
+func (t *Target) DeepCopy() *Target {
+	if t == nil {
+		return nil
+	}
+	out := new(Target)
+	if t.Host != nil {
+		x := *t.Host
+		out.Host = &x
+	}
+	if t.Port != nil {
+		x := *t.Port
+		out.Port = &x
+	}
+	if t.Labels != nil {
+		out.Labels = make([]Label, len(t.Labels))
+		for i := range t.Labels {
+			out.Labels[i] = *t.Labels[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Target and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (t *Target) Equal(other *Target) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	if (t.Host == nil) != (other.Host == nil) || t.Host != nil && *t.Host != *other.Host {
+		return false
+	}
+	if (t.Port == nil) != (other.Port == nil) || t.Port != nil && *t.Port != *other.Port {
+		return false
+	}
+	if (t.Labels == nil) != (other.Labels == nil) || len(t.Labels) != len(other.Labels) {
+		return false
+	}
+	for i := range t.Labels {
+		if !t.Labels[i].Equal(&other.Labels[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of ScrapeConfig or nil if ScrapeConfig is nil.
+func (s *ScrapeConfig) DeepCopy() *ScrapeConfig {
+	if s == nil {
+		return nil
+	}
+	out := new(ScrapeConfig)
+	if s.JobName != nil {
+		x := *s.JobName
+		out.JobName = &x
+	}
+	if s.ScrapeInterval != nil {
+		x := *s.ScrapeInterval
+		out.ScrapeInterval = &x
+	}
+	if s.MetricsPath != nil {
+		x := *s.MetricsPath
+		out.MetricsPath = &x
+	}
+	if s.Scheme != nil {
+		x := *s.Scheme
+		out.Scheme = &x
+	}
+	if s.Targets != nil {
+		out.Targets = make([]Target, len(s.Targets))
+		for i := range s.Targets {
+			out.Targets[i] = *s.Targets[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether ScrapeConfig and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *ScrapeConfig) Equal(other *ScrapeConfig) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.JobName == nil) != (other.JobName == nil) || s.JobName != nil && *s.JobName != *other.JobName {
+		return false
+	}
+	if (s.ScrapeInterval == nil) != (other.ScrapeInterval == nil) || s.ScrapeInterval != nil && *s.ScrapeInterval != *other.ScrapeInterval {
+		return false
+	}
+	if (s.MetricsPath == nil) != (other.MetricsPath == nil) || s.MetricsPath != nil && *s.MetricsPath != *other.MetricsPath {
+		return false
+	}
+	if (s.Scheme == nil) != (other.Scheme == nil) || s.Scheme != nil && *s.Scheme != *other.Scheme {
+		return false
+	}
+	if (s.Targets == nil) != (other.Targets == nil) || len(s.Targets) != len(other.Targets) {
+		return false
+	}
+	for i := range s.Targets {
+		if !s.Targets[i].Equal(&other.Targets[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AlertRule or nil if AlertRule is nil.
+func (a *AlertRule) DeepCopy() *AlertRule {
+	if a == nil {
+		return nil
+	}
+	out := new(AlertRule)
+	if a.Alert != nil {
+		x := *a.Alert
+		out.Alert = &x
+	}
+	if a.Expr != nil {
+		x := *a.Expr
+		out.Expr = &x
+	}
+	if a.For != nil {
+		x := *a.For
+		out.For = &x
+	}
+	if a.Labels != nil {
+		out.Labels = make([]Label, len(a.Labels))
+		for i := range a.Labels {
+			out.Labels[i] = *a.Labels[i].DeepCopy()
+		}
+	}
+	if a.Annotations != nil {
+		out.Annotations = make([]Label, len(a.Annotations))
+		for i := range a.Annotations {
+			out.Annotations[i] = *a.Annotations[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether AlertRule and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AlertRule) Equal(other *AlertRule) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.Alert == nil) != (other.Alert == nil) || a.Alert != nil && *a.Alert != *other.Alert {
+		return false
+	}
+	if (a.Expr == nil) != (other.Expr == nil) || a.Expr != nil && *a.Expr != *other.Expr {
+		return false
+	}
+	if (a.For == nil) != (other.For == nil) || a.For != nil && *a.For != *other.For {
+		return false
+	}
+	if (a.Labels == nil) != (other.Labels == nil) || len(a.Labels) != len(other.Labels) {
+		return false
+	}
+	for i := range a.Labels {
+		if !a.Labels[i].Equal(&other.Labels[i]) {
+			return false
+		}
+	}
+	if (a.Annotations == nil) != (other.Annotations == nil) || len(a.Annotations) != len(other.Annotations) {
+		return false
+	}
+	for i := range a.Annotations {
+		if !a.Annotations[i].Equal(&other.Annotations[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AlertRuleGroup or nil if AlertRuleGroup is nil.
+func (a *AlertRuleGroup) DeepCopy() *AlertRuleGroup {
+	if a == nil {
+		return nil
+	}
+	out := new(AlertRuleGroup)
+	if a.Name != nil {
+		x := *a.Name
+		out.Name = &x
+	}
+	if a.Interval != nil {
+		x := *a.Interval
+		out.Interval = &x
+	}
+	if a.Rules != nil {
+		out.Rules = make([]AlertRule, len(a.Rules))
+		for i := range a.Rules {
+			out.Rules[i] = *a.Rules[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether AlertRuleGroup and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AlertRuleGroup) Equal(other *AlertRuleGroup) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if (a.Name == nil) != (other.Name == nil) || a.Name != nil && *a.Name != *other.Name {
+		return false
+	}
+	if (a.Interval == nil) != (other.Interval == nil) || a.Interval != nil && *a.Interval != *other.Interval {
+		return false
+	}
+	if (a.Rules == nil) != (other.Rules == nil) || len(a.Rules) != len(other.Rules) {
+		return false
+	}
+	for i := range a.Rules {
+		if !a.Rules[i].Equal(&other.Rules[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Prometheus or nil if Prometheus is nil.
+func (p *Prometheus) DeepCopy() *Prometheus {
+	if p == nil {
+		return nil
+	}
+	out := new(Prometheus)
+	if p.Port != nil {
+		x := *p.Port
+		out.Port = &x
+	}
+	if p.ScrapeInterval != nil {
+		x := *p.ScrapeInterval
+		out.ScrapeInterval = &x
+	}
+	if p.EvaluationInterval != nil {
+		x := *p.EvaluationInterval
+		out.EvaluationInterval = &x
+	}
+	if p.RetentionTime != nil {
+		x := *p.RetentionTime
+		out.RetentionTime = &x
+	}
+	if p.ExternalLabels != nil {
+		out.ExternalLabels = make([]Label, len(p.ExternalLabels))
+		for i := range p.ExternalLabels {
+			out.ExternalLabels[i] = *p.ExternalLabels[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Prometheus and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Prometheus) Equal(other *Prometheus) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Port == nil) != (other.Port == nil) || p.Port != nil && *p.Port != *other.Port {
+		return false
+	}
+	if (p.ScrapeInterval == nil) != (other.ScrapeInterval == nil) || p.ScrapeInterval != nil && *p.ScrapeInterval != *other.ScrapeInterval {
+		return false
+	}
+	if (p.EvaluationInterval == nil) != (other.EvaluationInterval == nil) || p.EvaluationInterval != nil && *p.EvaluationInterval != *other.EvaluationInterval {
+		return false
+	}
+	if (p.RetentionTime == nil) != (other.RetentionTime == nil) || p.RetentionTime != nil && *p.RetentionTime != *other.RetentionTime {
+		return false
+	}
+	if (p.ExternalLabels == nil) != (other.ExternalLabels == nil) || len(p.ExternalLabels) != len(other.ExternalLabels) {
+		return false
+	}
+	for i := range p.ExternalLabels {
+		if !p.ExternalLabels[i].Equal(&other.ExternalLabels[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Grafana or nil if Grafana is nil.
+func (g *Grafana) DeepCopy() *Grafana {
+	if g == nil {
+		return nil
+	}
+	out := new(Grafana)
+	if g.Port != nil {
+		x := *g.Port
+		out.Port = &x
+	}
+	if g.AdminUser != nil {
+		x := *g.AdminUser
+		out.AdminUser = &x
+	}
+	if g.AdminPassword != nil {
+		x := *g.AdminPassword
+		out.AdminPassword = &x
+	}
+	return out
+}
+
+// Equal reports whether Grafana and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (g *Grafana) Equal(other *Grafana) bool {
+	if g == nil || other == nil {
+		return g == other
+	}
+	if (g.Port == nil) != (other.Port == nil) || g.Port != nil && *g.Port != *other.Port {
+		return false
+	}
+	if (g.AdminUser == nil) != (other.AdminUser == nil) || g.AdminUser != nil && *g.AdminUser != *other.AdminUser {
+		return false
+	}
+	if (g.AdminPassword == nil) != (other.AdminPassword == nil) || g.AdminPassword != nil && *g.AdminPassword != *other.AdminPassword {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Params or nil if Params is nil.
+func (p *Params) DeepCopy() *Params {
+	if p == nil {
+		return nil
+	}
+	out := new(Params)
+	if p.Name != nil {
+		x := *p.Name
+		out.Name = &x
+	}
+	if p.VmGroupName != nil {
+		x := *p.VmGroupName
+		out.VmGroupName = &x
+	}
+	out.Prometheus = p.Prometheus.DeepCopy()
+	out.Grafana = p.Grafana.DeepCopy()
+	if p.ScrapeConfigs != nil {
+		out.ScrapeConfigs = make([]ScrapeConfig, len(p.ScrapeConfigs))
+		for i := range p.ScrapeConfigs {
+			out.ScrapeConfigs[i] = *p.ScrapeConfigs[i].DeepCopy()
+		}
+	}
+	if p.AlertRuleGroups != nil {
+		out.AlertRuleGroups = make([]AlertRuleGroup, len(p.AlertRuleGroups))
+		for i := range p.AlertRuleGroups {
+			out.AlertRuleGroups[i] = *p.AlertRuleGroups[i].DeepCopy()
+		}
+	}
+	return out
+}
+
+// Equal reports whether Params and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (p *Params) Equal(other *Params) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	if (p.Name == nil) != (other.Name == nil) || p.Name != nil && *p.Name != *other.Name {
+		return false
+	}
+	if (p.VmGroupName == nil) != (other.VmGroupName == nil) || p.VmGroupName != nil && *p.VmGroupName != *other.VmGroupName {
+		return false
+	}
+	if !p.Prometheus.Equal(other.Prometheus) {
+		return false
+	}
+	if !p.Grafana.Equal(other.Grafana) {
+		return false
+	}
+	if (p.ScrapeConfigs == nil) != (other.ScrapeConfigs == nil) || len(p.ScrapeConfigs) != len(other.ScrapeConfigs) {
+		return false
+	}
+	for i := range p.ScrapeConfigs {
+		if !p.ScrapeConfigs[i].Equal(&other.ScrapeConfigs[i]) {
+			return false
+		}
+	}
+	if (p.AlertRuleGroups == nil) != (other.AlertRuleGroups == nil) || len(p.AlertRuleGroups) != len(other.AlertRuleGroups) {
+		return false
+	}
+	for i := range p.AlertRuleGroups {
+		if !p.AlertRuleGroups[i].Equal(&other.AlertRuleGroups[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Config or nil if Config is nil.
+func (c *Config) DeepCopy() *Config {
+	if c == nil {
+		return nil
+	}
+	out := new(Config)
+	if c.Kind != nil {
+		x := *c.Kind
+		out.Kind = &x
+	}
+	if c.Version != nil {
+		x := *c.Version
+		out.Version = &x
+	}
+	out.Params = c.Params.DeepCopy()
+	if c.Unused != nil {
+		out.Unused = make([]string, len(c.Unused))
+		copy(out.Unused, c.Unused)
+	}
+	return out
+}
+
+// Equal reports whether Config and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (c *Config) Equal(other *Config) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	if (c.Kind == nil) != (other.Kind == nil) || c.Kind != nil && *c.Kind != *other.Kind {
+		return false
+	}
+	if (c.Version == nil) != (other.Version == nil) || c.Version != nil && *c.Version != *other.Version {
+		return false
+	}
+	if !c.Params.Equal(other.Params) {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of Output or nil if Output is nil.
+func (o *Output) DeepCopy() *Output {
+	if o == nil {
+		return nil
+	}
+	out := new(Output)
+	if o.PrometheusUrl != nil {
+		x := *o.PrometheusUrl
+		out.PrometheusUrl = &x
+	}
+	if o.GrafanaUrl != nil {
+		x := *o.GrafanaUrl
+		out.GrafanaUrl = &x
+	}
+	return out
+}
+
+// Equal reports whether Output and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (o *Output) Equal(other *Output) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	if (o.PrometheusUrl == nil) != (other.PrometheusUrl == nil) || o.PrometheusUrl != nil && *o.PrometheusUrl != *other.PrometheusUrl {
+		return false
+	}
+	if (o.GrafanaUrl == nil) != (other.GrafanaUrl == nil) || o.GrafanaUrl != nil && *o.GrafanaUrl != *other.GrafanaUrl {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,440 @@
+package v0
+
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	"github.com/epiphany-platform/e-structures/utils/fingerprint"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/epiphany-platform/e-structures/utils/validators"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+const (
+	kind    = "monitoring"
+	version = "v0.0.1"
+
+	// VmGroupLabel and HostnameLabel are labels added to targets created from hi and azbi
+	// structures.
+	VmGroupLabel  = "vm_group"
+	HostnameLabel = "hostname"
+)
+
+var (
+	// https://prometheus.io/docs/prometheus/latest/configuration/configuration/#duration
+	durationRegexp = regexp.MustCompile(`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`)
+	// https://prometheus.io/docs/concepts/data_model/#metric-names-and-labels
+	labelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+)
+
+// Label is Prometheus label. Names starting with "__" are reserved for internal use.
+type Label struct {
+	Name  *string `json:"name" validate:"required,labelname"`
+	Value *string `json:"value" validate:"required"`
+}
+
+// Target is single endpoint scraped by Prometheus.
+type Target struct {
+	Host   *string `json:"host" validate:"required,ip|hostname_rfc1123"`
+	Port   *int    `json:"port" validate:"required,min=1,max=65535"`
+	Labels []Label `json:"labels" validate:"omitempty,dive"`
+}
+
+// ScrapeConfig is Prometheus scrape job. ScrapeInterval overrides Prometheus.ScrapeInterval.
+type ScrapeConfig struct {
+	JobName        *string  `json:"job_name" validate:"required,min=1"`
+	ScrapeInterval *string  `json:"scrape_interval" validate:"omitempty,promduration"`
+	MetricsPath    *string  `json:"metrics_path" validate:"required,startswith=/"`
+	Scheme         *string  `json:"scheme" validate:"required,eq=http|eq=https"`
+	Targets        []Target `json:"targets" validate:"required,min=1,dive"`
+}
+
+// AlertRule fires when Expr returns results for For duration. Names of Annotations have to be
+// valid label names as well.
+type AlertRule struct {
+	Alert       *string `json:"alert" validate:"required,min=1"`
+	Expr        *string `json:"expr" validate:"required,min=1"`
+	For         *string `json:"for" validate:"omitempty,promduration"`
+	Labels      []Label `json:"labels" validate:"omitempty,dive"`
+	Annotations []Label `json:"annotations" validate:"omitempty,dive"`
+}
+
+// AlertRuleGroup is evaluated every Interval or Prometheus.EvaluationInterval if not set.
+type AlertRuleGroup struct {
+	Name     *string     `json:"name" validate:"required,min=1"`
+	Interval *string     `json:"interval" validate:"omitempty,promduration"`
+	Rules    []AlertRule `json:"rules" validate:"required,min=1,dive"`
+}
+
+type Prometheus struct {
+	Port               *int    `json:"port" validate:"required,min=1,max=65535"`
+	ScrapeInterval     *string `json:"scrape_interval" validate:"required,promduration"`
+	EvaluationInterval *string `json:"evaluation_interval" validate:"required,promduration"`
+	RetentionTime      *string `json:"retention_time" validate:"required,promduration"`
+	ExternalLabels     []Label `json:"external_labels" validate:"omitempty,dive"`
+}
+
+type Grafana struct {
+	Port          *int    `json:"port" validate:"required,min=1,max=65535"`
+	AdminUser     *string `json:"admin_user" validate:"required,min=1"`
+	AdminPassword *string `json:"admin_password" validate:"required,min=8" sensitive:"true"`
+}
+
+type Params struct {
+	Name *string `json:"name" validate:"required,min=1"`
+	// VmGroupName is name of hi VM group which hosts run Prometheus and Grafana.
+	VmGroupName     *string          `json:"vm_group_name" validate:"required,min=1"`
+	Prometheus      *Prometheus      `json:"prometheus" validate:"required"`
+	Grafana         *Grafana         `json:"grafana" validate:"required"`
+	ScrapeConfigs   []ScrapeConfig   `json:"scrape_configs" validate:"omitempty,dive"`
+	AlertRuleGroups []AlertRuleGroup `json:"alert_rule_groups" validate:"omitempty,dive"`
+}
+
+// TargetsFromHiVmGroup returns target for each host of hi VM group, labeled with VM group and
+// host names.
+func TargetsFromHiVmGroup(g *hi.VmGroup, port int) []Target {
+	if g == nil {
+		return nil
+	}
+	var result []Target
+	for _, h := range g.Hosts {
+		result = append(result, newTarget(h.GetIpV(), port, g.GetNameV(), h.GetNameV()))
+	}
+	return result
+}
+
+// TargetsFromAzBIOutput returns target for first private IP of each VM in azbi output, labeled
+// with VM group and VM names. VMs without private IPs are skipped.
+func TargetsFromAzBIOutput(o *azbi.Output, port int) []Target {
+	if o == nil {
+		return nil
+	}
+	var result []Target
+	for _, g := range o.VmGroups {
+		for _, vm := range g.Vms {
+			if len(vm.PrivateIps) == 0 {
+				continue
+			}
+			result = append(result, newTarget(vm.PrivateIps[0], port, g.GetNameV(), vm.GetNameV()))
+		}
+	}
+	return result
+}
+
+func newTarget(host string, port int, vmGroupName, hostname string) Target {
+	return Target{
+		Host: to.StrPtr(host),
+		Port: to.IntPtr(port),
+		Labels: []Label{
+			{
+				Name:  to.StrPtr(VmGroupLabel),
+				Value: to.StrPtr(vmGroupName),
+			},
+			{
+				Name:  to.StrPtr(HostnameLabel),
+				Value: to.StrPtr(hostname),
+			},
+		},
+	}
+}
+
+// SetScrapeTargets replaces targets of scrape job with jobName or adds new job with default
+// metrics path and scheme if there is no such job.
+func (p *Params) SetScrapeTargets(jobName string, targets []Target) {
+	if p == nil {
+		return
+	}
+	for i, sc := range p.ScrapeConfigs {
+		if sc.GetJobNameV() == jobName {
+			p.ScrapeConfigs[i].Targets = targets
+			return
+		}
+	}
+	p.ScrapeConfigs = append(p.ScrapeConfigs, ScrapeConfig{
+		JobName:     to.StrPtr(jobName),
+		MetricsPath: to.StrPtr("/metrics"),
+		Scheme:      to.StrPtr("http"),
+		Targets:     targets,
+	})
+}
+
+// UseHiConfig sets targets of scrape job with jobName to hosts of all VM groups of hi module
+// listening on port.
+func (p *Params) UseHiConfig(c *hi.Config, jobName string, port int) {
+	if p == nil || c == nil {
+		return
+	}
+	var targets []Target
+	for i := range c.GetParams().VmGroups {
+		targets = append(targets, TargetsFromHiVmGroup(&c.Params.VmGroups[i], port)...)
+	}
+	p.SetScrapeTargets(jobName, targets)
+}
+
+// UseAzBIOutput sets targets of scrape job with jobName to VMs created by azbi module listening
+// on port.
+func (p *Params) UseAzBIOutput(o *azbi.Output, jobName string, port int) {
+	if p == nil || o == nil {
+		return
+	}
+	p.SetScrapeTargets(jobName, TargetsFromAzBIOutput(o, port))
+}
+
+type Config struct {
+	Kind    *string  `json:"kind" validate:"required,eq=monitoring"`
+	Version *string  `json:"version" validate:"required,version=~0"`
+	Params  *Params  `json:"params" validate:"required"`
+	Unused  []string `json:"-"`
+}
+
+func NewConfig() *Config {
+	return &Config{
+		Kind:    to.StrPtr(kind),
+		Version: to.StrPtr(version),
+		Params: &Params{
+			Name:        to.StrPtr("epiphany"),
+			VmGroupName: to.StrPtr("vm-group0"),
+			Prometheus: &Prometheus{
+				Port:               to.IntPtr(9090),
+				ScrapeInterval:     to.StrPtr("30s"),
+				EvaluationInterval: to.StrPtr("30s"),
+				RetentionTime:      to.StrPtr("15d"),
+				ExternalLabels:     []Label{},
+			},
+			Grafana: &Grafana{
+				Port:          to.IntPtr(3000),
+				AdminUser:     to.StrPtr("admin"),
+				AdminPassword: to.StrPtr("env://GRAFANA_ADMIN_PASSWORD"),
+			},
+			ScrapeConfigs: []ScrapeConfig{
+				{
+					JobName:     to.StrPtr("node"),
+					MetricsPath: to.StrPtr("/metrics"),
+					Scheme:      to.StrPtr("http"),
+					Targets: []Target{
+						newTarget("10.0.1.4", 9100, "vm-group0", "epiphany-vm-group0-1"),
+					},
+				},
+			},
+			AlertRuleGroups: []AlertRuleGroup{
+				{
+					Name: to.StrPtr("node"),
+					Rules: []AlertRule{
+						{
+							Alert: to.StrPtr("InstanceDown"),
+							Expr:  to.StrPtr("up == 0"),
+							For:   to.StrPtr("5m"),
+							Labels: []Label{
+								{
+									Name:  to.StrPtr("severity"),
+									Value: to.StrPtr("critical"),
+								},
+							},
+							Annotations: []Label{
+								{
+									Name:  to.StrPtr("summary"),
+									Value: to.StrPtr("Instance {{ $labels.instance }} down"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Unused: []string{},
+	}
+}
+
+func (c *Config) Marshal() ([]byte, error) {
+	err := c.isValid()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(c, "", "\t")
+}
+
+// Fingerprint returns stable SHA-256 hash of Config content. Unused keys are ignored.
+func (c *Config) Fingerprint() (string, error) {
+	return fingerprint.Of(c)
+}
+
+// Redacted returns copy of Config with values of sensitive fields replaced, so it can be logged.
+func (c *Config) Redacted() *Config {
+	r := c.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
+func (c *Config) Unmarshal(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching Config structure.
+func (c *Config) UnmarshalStrict(b []byte) (err error) {
+	if err = c.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(c, c.Unused); err != nil {
+		return
+	}
+	err = c.isValid()
+	return
+}
+
+func (c *Config) decode(b []byte) (err error) {
+	var input map[string]interface{}
+	if err = json.Unmarshal(b, &input); err != nil {
+		return
+	}
+	var md maps.Metadata
+	d, err := maps.NewDecoder(&maps.DecoderConfig{
+		Metadata: &md,
+		TagName:  "json",
+		Result:   &c,
+	})
+	if err != nil {
+		return
+	}
+	err = d.Decode(input)
+	if err != nil {
+		return
+	}
+	c.Unused = md.Unused
+	return
+}
+
+func (c *Config) isValid() error {
+	if c == nil {
+		return errors.New("monitoring config is nil")
+	}
+	validate := validator.New()
+
+	err := validate.RegisterValidation("version", validators.HasVersion)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("promduration", IsDuration)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("labelname", IsLabelName)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(MonitoringParamsValidation, Params{})
+	err = validate.Struct(c)
+	if err != nil {
+		if _, ok := err.(*validator.InvalidValidationError); ok {
+			return err
+		}
+		return err
+	}
+	return nil
+}
+
+// IsDuration checks if field is Prometheus duration, i.e. "30s", "15d" or "1h30m".
+func IsDuration(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+	return field.String() != "" && durationRegexp.MatchString(field.String())
+}
+
+// IsLabelName checks if field is Prometheus label name not reserved for internal use.
+func IsLabelName(fl validator.FieldLevel) bool {
+	field := fl.Field()
+	if field.Kind() != reflect.String {
+		panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+	}
+	return labelNameRegexp.MatchString(field.String()) && !strings.HasPrefix(field.String(), "__")
+}
+
+// MonitoringParamsValidation checks that Grafana and Prometheus listen on different ports, that
+// names of scrape jobs and alert rule groups are unique and that targets are not repeated within
+// scrape job.
+func MonitoringParamsValidation(sl validator.StructLevel) {
+	params := sl.Current().Interface().(Params)
+	grafanaPort := params.GetGrafana().GetPort()
+	prometheusPort := params.GetPrometheus().GetPort()
+	if grafanaPort != nil && prometheusPort != nil && *grafanaPort == *prometheusPort {
+		sl.ReportError(
+			params.Grafana.Port,
+			"Grafana.Port",
+			"Port",
+			"nefield",
+			"Prometheus.Port")
+	}
+	jobs := make(map[string]bool)
+	for i, sc := range params.ScrapeConfigs {
+		if sc.JobName != nil {
+			if jobs[*sc.JobName] {
+				sl.ReportError(
+					params.ScrapeConfigs[i].JobName,
+					fmt.Sprintf("ScrapeConfigs[%d].JobName", i),
+					"JobName",
+					"unique",
+					"")
+			}
+			jobs[*sc.JobName] = true
+		}
+		targets := make(map[string]bool)
+		for j, t := range sc.Targets {
+			if t.Host == nil || t.Port == nil {
+				continue
+			}
+			endpoint := fmt.Sprintf("%s:%d", *t.Host, *t.Port)
+			if targets[endpoint] {
+				sl.ReportError(
+					params.ScrapeConfigs[i].Targets[j].Host,
+					fmt.Sprintf("ScrapeConfigs[%d].Targets[%d].Host", i, j),
+					"Host",
+					"unique",
+					"")
+			}
+			targets[endpoint] = true
+		}
+	}
+	groups := make(map[string]bool)
+	for i, g := range params.AlertRuleGroups {
+		if g.Name == nil {
+			continue
+		}
+		if groups[*g.Name] {
+			sl.ReportError(
+				params.AlertRuleGroups[i].Name,
+				fmt.Sprintf("AlertRuleGroups[%d].Name", i),
+				"Name",
+				"unique",
+				"")
+		}
+		groups[*g.Name] = true
+	}
+}
+
+type Output struct {
+	PrometheusUrl *string `json:"prometheus_url" validate:"required,url"`
+	GrafanaUrl    *string `json:"grafana_url" validate:"required,url"`
+}
+
+// Fingerprint returns stable SHA-256 hash of Output content.
+func (o *Output) Fingerprint() (string, error) {
+	return fingerprint.Of(o)
+}
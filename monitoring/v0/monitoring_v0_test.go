@@ -0,0 +1,942 @@
+package v0
+
+import (
+	"testing"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/test"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfig_Load_general(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "happy path",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "1m",
+			"retention_time": "1y2w",
+			"external_labels": [
+				{
+					"name": "environment",
+					"value": "production"
+				}
+			]
+		},
+		"grafana": {
+			"port": 3000,
+			"admin_user": "admin",
+			"admin_password": "secret123"
+		},
+		"scrape_configs": [
+			{
+				"job_name": "node",
+				"scrape_interval": "1m30s",
+				"metrics_path": "/metrics",
+				"scheme": "https",
+				"targets": [
+					{
+						"host": "10.0.1.4",
+						"port": 9100,
+						"labels": [
+							{
+								"name": "vm_group",
+								"value": "vm-group0"
+							}
+						]
+					},
+					{
+						"host": "node-1.example.com",
+						"port": 9100
+					}
+				]
+			}
+		],
+		"alert_rule_groups": [
+			{
+				"name": "node",
+				"interval": "500ms",
+				"rules": [
+					{
+						"alert": "InstanceDown",
+						"expr": "up == 0",
+						"for": "5m",
+						"labels": [
+							{
+								"name": "severity",
+								"value": "critical"
+							}
+						],
+						"annotations": [
+							{
+								"name": "summary",
+								"value": ""
+							}
+						]
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("monitoring"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:        to.StrPtr("epiphany"),
+					VmGroupName: to.StrPtr("vm-group0"),
+					Prometheus: &Prometheus{
+						Port:               to.IntPtr(9090),
+						ScrapeInterval:     to.StrPtr("30s"),
+						EvaluationInterval: to.StrPtr("1m"),
+						RetentionTime:      to.StrPtr("1y2w"),
+						ExternalLabels: []Label{
+							{
+								Name:  to.StrPtr("environment"),
+								Value: to.StrPtr("production"),
+							},
+						},
+					},
+					Grafana: &Grafana{
+						Port:          to.IntPtr(3000),
+						AdminUser:     to.StrPtr("admin"),
+						AdminPassword: to.StrPtr("secret123"),
+					},
+					ScrapeConfigs: []ScrapeConfig{
+						{
+							JobName:        to.StrPtr("node"),
+							ScrapeInterval: to.StrPtr("1m30s"),
+							MetricsPath:    to.StrPtr("/metrics"),
+							Scheme:         to.StrPtr("https"),
+							Targets: []Target{
+								{
+									Host: to.StrPtr("10.0.1.4"),
+									Port: to.IntPtr(9100),
+									Labels: []Label{
+										{
+											Name:  to.StrPtr("vm_group"),
+											Value: to.StrPtr("vm-group0"),
+										},
+									},
+								},
+								{
+									Host: to.StrPtr("node-1.example.com"),
+									Port: to.IntPtr(9100),
+								},
+							},
+						},
+					},
+					AlertRuleGroups: []AlertRuleGroup{
+						{
+							Name:     to.StrPtr("node"),
+							Interval: to.StrPtr("500ms"),
+							Rules: []AlertRule{
+								{
+									Alert: to.StrPtr("InstanceDown"),
+									Expr:  to.StrPtr("up == 0"),
+									For:   to.StrPtr("5m"),
+									Labels: []Label{
+										{
+											Name:  to.StrPtr("severity"),
+											Value: to.StrPtr("critical"),
+										},
+									},
+									Annotations: []Label{
+										{
+											Name:  to.StrPtr("summary"),
+											Value: to.StrPtr(""),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Unused: []string{},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "unknown fields in multiple places",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"extra_outer_field": "extra_outer_value",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"extra_inner_field": "extra_inner_value",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "1m",
+			"retention_time": "15d",
+			"extra_prometheus_field": "extra_prometheus_value"
+		},
+		"grafana": {
+			"port": 3000,
+			"admin_user": "admin",
+			"admin_password": "secret123"
+		}
+	}
+}`),
+			want: &Config{
+				Kind:    to.StrPtr("monitoring"),
+				Version: to.StrPtr("v0.0.1"),
+				Params: &Params{
+					Name:        to.StrPtr("epiphany"),
+					VmGroupName: to.StrPtr("vm-group0"),
+					Prometheus: &Prometheus{
+						Port:               to.IntPtr(9090),
+						ScrapeInterval:     to.StrPtr("30s"),
+						EvaluationInterval: to.StrPtr("1m"),
+						RetentionTime:      to.StrPtr("15d"),
+					},
+					Grafana: &Grafana{
+						Port:          to.IntPtr(3000),
+						AdminUser:     to.StrPtr("admin"),
+						AdminPassword: to.StrPtr("secret123"),
+					},
+				},
+				Unused: []string{"extra_outer_field", "params.extra_inner_field", "params.prometheus.extra_prometheus_field"},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect kind and version",
+			json: []byte(`{
+	"kind": "hi",
+	"version": "v1.0.0",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "1m",
+			"retention_time": "15d"
+		},
+		"grafana": {
+			"port": 3000,
+			"admin_user": "admin",
+			"admin_password": "secret123"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Kind",
+					Field: "Kind",
+					Tag:   "eq",
+				},
+				test.TestValidationError{
+					Key:   "Config.Version",
+					Field: "Version",
+					Tag:   "version",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_Params contains all scenarios related to validation of values stored directly in Params, Prometheus and Grafana structures.
+func TestConfig_Load_Params(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty params",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.VmGroupName",
+					Field: "VmGroupName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus",
+					Field: "Prometheus",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana",
+					Field: "Grafana",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "empty prometheus and grafana",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {},
+		"grafana": {}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.Port",
+					Field: "Port",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.ScrapeInterval",
+					Field: "ScrapeInterval",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.EvaluationInterval",
+					Field: "EvaluationInterval",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.RetentionTime",
+					Field: "RetentionTime",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana.Port",
+					Field: "Port",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana.AdminUser",
+					Field: "AdminUser",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana.AdminPassword",
+					Field: "AdminPassword",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect prometheus and grafana values",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 0,
+			"scrape_interval": "30",
+			"evaluation_interval": "1m30",
+			"retention_time": "",
+			"external_labels": [
+				{
+					"name": "__name__",
+					"value": "x"
+				},
+				{
+					"name": "1environment",
+					"value": "x"
+				}
+			]
+		},
+		"grafana": {
+			"port": 65536,
+			"admin_user": "",
+			"admin_password": "short"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.Port",
+					Field: "Port",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.ScrapeInterval",
+					Field: "ScrapeInterval",
+					Tag:   "promduration",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.EvaluationInterval",
+					Field: "EvaluationInterval",
+					Tag:   "promduration",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.RetentionTime",
+					Field: "RetentionTime",
+					Tag:   "promduration",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.ExternalLabels[0].Name",
+					Field: "Name",
+					Tag:   "labelname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Prometheus.ExternalLabels[1].Name",
+					Field: "Name",
+					Tag:   "labelname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana.Port",
+					Field: "Port",
+					Tag:   "max",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana.AdminUser",
+					Field: "AdminUser",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana.AdminPassword",
+					Field: "AdminPassword",
+					Tag:   "min",
+				},
+			},
+		},
+		{
+			name: "grafana and prometheus on the same port",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "30s",
+			"retention_time": "15d"
+		},
+		"grafana": {
+			"port": 9090,
+			"admin_user": "admin",
+			"admin_password": "env://GRAFANA_ADMIN_PASSWORD"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.Grafana.Port",
+					Field: "Grafana.Port",
+					Tag:   "nefield",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_ScrapeConfigs contains all scenarios related to validation of ScrapeConfig and Target structures.
+func TestConfig_Load_ScrapeConfigs(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty scrape config and target",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "1m",
+			"retention_time": "15d"
+		},
+		"grafana": {
+			"port": 3000,
+			"admin_user": "admin",
+			"admin_password": "secret123"
+		},
+		"scrape_configs": [
+			{},
+			{
+				"job_name": "node",
+				"metrics_path": "/metrics",
+				"scheme": "http",
+				"targets": [
+					{}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].JobName",
+					Field: "JobName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].MetricsPath",
+					Field: "MetricsPath",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].Scheme",
+					Field: "Scheme",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].Targets",
+					Field: "Targets",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[1].Targets[0].Host",
+					Field: "Host",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[1].Targets[0].Port",
+					Field: "Port",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect scrape config values",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "1m",
+			"retention_time": "15d"
+		},
+		"grafana": {
+			"port": 3000,
+			"admin_user": "admin",
+			"admin_password": "secret123"
+		},
+		"scrape_configs": [
+			{
+				"job_name": "node",
+				"scrape_interval": "1.5m",
+				"metrics_path": "metrics",
+				"scheme": "ftp",
+				"targets": [
+					{
+						"host": "not a host",
+						"port": 70000,
+						"labels": [
+							{
+								"name": "vm-group",
+								"value": "vm-group0"
+							}
+						]
+					}
+				]
+			},
+			{
+				"job_name": "node",
+				"metrics_path": "/metrics",
+				"scheme": "http",
+				"targets": [
+					{
+						"host": "10.0.1.4",
+						"port": 9100
+					},
+					{
+						"host": "10.0.1.4",
+						"port": 9100
+					}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].ScrapeInterval",
+					Field: "ScrapeInterval",
+					Tag:   "promduration",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].MetricsPath",
+					Field: "MetricsPath",
+					Tag:   "startswith",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].Scheme",
+					Field: "Scheme",
+					Tag:   "eq=http|eq=https",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].Targets[0].Host",
+					Field: "Host",
+					Tag:   "ip|hostname_rfc1123",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].Targets[0].Port",
+					Field: "Port",
+					Tag:   "max",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[0].Targets[0].Labels[0].Name",
+					Field: "Name",
+					Tag:   "labelname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[1].JobName",
+					Field: "ScrapeConfigs[1].JobName",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.ScrapeConfigs[1].Targets[1].Host",
+					Field: "ScrapeConfigs[1].Targets[1].Host",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+// TestConfig_Load_AlertRuleGroups contains all scenarios related to validation of AlertRuleGroup and AlertRule structures.
+func TestConfig_Load_AlertRuleGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    *Config
+		wantErr error
+	}{
+		{
+			name: "empty alert rule group and rule",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "1m",
+			"retention_time": "15d"
+		},
+		"grafana": {
+			"port": 3000,
+			"admin_user": "admin",
+			"admin_password": "secret123"
+		},
+		"alert_rule_groups": [
+			{},
+			{
+				"name": "node",
+				"rules": [
+					{}
+				]
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Name",
+					Field: "Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Rules",
+					Field: "Rules",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[1].Rules[0].Alert",
+					Field: "Alert",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[1].Rules[0].Expr",
+					Field: "Expr",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "incorrect alert rule values",
+			json: []byte(`{
+	"kind": "monitoring",
+	"version": "v0.0.1",
+	"params": {
+		"name": "epiphany",
+		"vm_group_name": "vm-group0",
+		"prometheus": {
+			"port": 9090,
+			"scrape_interval": "30s",
+			"evaluation_interval": "1m",
+			"retention_time": "15d"
+		},
+		"grafana": {
+			"port": 3000,
+			"admin_user": "admin",
+			"admin_password": "secret123"
+		},
+		"alert_rule_groups": [
+			{
+				"name": "node",
+				"interval": "1 m",
+				"rules": [
+					{
+						"alert": "InstanceDown",
+						"expr": "",
+						"for": "5min",
+						"labels": [
+							{
+								"name": "__severity"
+							}
+						],
+						"annotations": [
+							{
+								"name": "summary text",
+								"value": "down"
+							}
+						]
+					}
+				]
+			},
+			{
+				"name": "node",
+				"rules": []
+			}
+		]
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Interval",
+					Field: "Interval",
+					Tag:   "promduration",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Rules[0].Expr",
+					Field: "Expr",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Rules[0].For",
+					Field: "For",
+					Tag:   "promduration",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Rules[0].Labels[0].Name",
+					Field: "Name",
+					Tag:   "labelname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Rules[0].Labels[0].Value",
+					Field: "Value",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[0].Rules[0].Annotations[0].Name",
+					Field: "Name",
+					Tag:   "labelname",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[1].Rules",
+					Field: "Rules",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "Config.Params.AlertRuleGroups[1].Name",
+					Field: "AlertRuleGroups[1].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configLoadTestingBody(t, tt.json, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func configLoadTestingBody(t *testing.T, json []byte, want *Config, wantErr error) {
+	got := &Config{}
+	err := got.Unmarshal(json)
+
+	if wantErr != nil {
+
+		if err != nil {
+			if _, ok := err.(*validator.InvalidValidationError); ok {
+				t.Fatal(err)
+			}
+			errs := err.(validator.ValidationErrors)
+			if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+				t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+			}
+			for _, e := range errs {
+				found := false
+				for _, we := range wantErr.(test.TestValidationErrors) {
+					if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+				}
+			}
+		} else {
+			t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		}
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	b, err := NewConfig().Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() of NewConfig() unexpected error occurred: %v", err)
+	}
+	got := &Config{}
+	if err = got.UnmarshalStrict(b); err != nil {
+		t.Fatalf("UnmarshalStrict() unexpected error occurred: %v", err)
+	}
+	if diff := cmp.Diff(NewConfig(), got); diff != "" {
+		t.Errorf("NewConfig() round trip mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParams_UseOutputs(t *testing.T) {
+	p := NewConfig().Params
+	p.UseHiConfig(&hi.Config{
+		Params: &hi.Params{
+			VmGroups: []hi.VmGroup{
+				{
+					Name: to.StrPtr("vm-group0"),
+					Hosts: []hi.Host{
+						{
+							Name: to.StrPtr("vm0"),
+							Ip:   to.StrPtr("10.0.1.4"),
+						},
+						{
+							Name: to.StrPtr("vm1"),
+							Ip:   to.StrPtr("10.0.1.5"),
+						},
+					},
+				},
+			},
+		},
+	}, "node", 9100)
+	p.UseAzBIOutput(&azbi.Output{
+		VmGroups: []azbi.OutputVmGroup{
+			{
+				Name: to.StrPtr("vm-group1"),
+				Vms: []azbi.OutputVm{
+					{
+						Name:       to.StrPtr("vm2"),
+						PrivateIps: []string{"10.0.2.4", "10.0.2.5"},
+					},
+					{
+						Name: to.StrPtr("vm3"),
+					},
+				},
+			},
+		},
+	}, "app", 8080)
+	want := []ScrapeConfig{
+		{
+			JobName:     to.StrPtr("node"),
+			MetricsPath: to.StrPtr("/metrics"),
+			Scheme:      to.StrPtr("http"),
+			Targets: []Target{
+				newTarget("10.0.1.4", 9100, "vm-group0", "vm0"),
+				newTarget("10.0.1.5", 9100, "vm-group0", "vm1"),
+			},
+		},
+		{
+			JobName:     to.StrPtr("app"),
+			MetricsPath: to.StrPtr("/metrics"),
+			Scheme:      to.StrPtr("http"),
+			Targets: []Target{
+				newTarget("10.0.2.4", 8080, "vm-group1", "vm2"),
+			},
+		},
+	}
+	if diff := cmp.Diff(want, p.ScrapeConfigs); diff != "" {
+		t.Errorf("UseHiConfig() and UseAzBIOutput() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfig_Redacted(t *testing.T) {
+	c := NewConfig()
+	r := c.Redacted()
+	if got := r.Params.Grafana.GetAdminPasswordV(); got != sensitive.Placeholder {
+		t.Errorf("Redacted() admin password = %s, want %s", got, sensitive.Placeholder)
+	}
+	if got := c.Params.Grafana.GetAdminPasswordV(); got != "env://GRAFANA_ADMIN_PASSWORD" {
+		t.Errorf("Redacted() modified original config, got %s", got)
+	}
+}
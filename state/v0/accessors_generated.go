@@ -0,0 +1,1399 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	awsks "github.com/epiphany-platform/e-structures/awsks/v0"
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	azkv "github.com/epiphany-platform/e-structures/azkv/v0"
+	azlb "github.com/epiphany-platform/e-structures/azlb/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	azstorage "github.com/epiphany-platform/e-structures/azstorage/v0"
+	bastion "github.com/epiphany-platform/e-structures/bastion/v0"
+	dns "github.com/epiphany-platform/e-structures/dns/v0"
+	gcpbi "github.com/epiphany-platform/e-structures/gcpbi/v0"
+	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	k8saddons "github.com/epiphany-platform/e-structures/k8saddons/v0"
+	monitoring "github.com/epiphany-platform/e-structures/monitoring/v0"
+)
+
+// GetConfig returns Config field of AwsBIState or nil if AwsBIState is nil.
+func (a *AwsBIState) GetConfig() *awsbi.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AwsBIState or zero value if either AwsBIState or field is nil.
+func (a *AwsBIState) GetConfigV() awsbi.Config {
+	if a == nil || a.Config == nil {
+		return awsbi.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AwsBIState or def if either AwsBIState or field is nil.
+func (a *AwsBIState) GetConfigOr(def awsbi.Config) awsbi.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AwsBIState or nil if AwsBIState is nil.
+func (a *AwsBIState) GetOutput() *awsbi.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AwsBIState or zero value if either AwsBIState or field is nil.
+func (a *AwsBIState) GetOutputV() awsbi.Output {
+	if a == nil || a.Output == nil {
+		return awsbi.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AwsBIState or def if either AwsBIState or field is nil.
+func (a *AwsBIState) GetOutputOr(def awsbi.Output) awsbi.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AwsBIState or nil if AwsBIState is nil.
+func (a *AwsBIState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AwsBIState or zero value if either AwsBIState or field is nil.
+func (a *AwsBIState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AwsBIState or def if either AwsBIState or field is nil.
+func (a *AwsBIState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of HiState or nil if HiState is nil.
+func (h *HiState) GetConfig() *hi.Config {
+	if h == nil {
+		return nil
+	}
+	return h.Config
+}
+
+// GetConfigV returns value of Config field of HiState or zero value if either HiState or field is nil.
+func (h *HiState) GetConfigV() hi.Config {
+	if h == nil || h.Config == nil {
+		return hi.Config{}
+	}
+	return *h.Config
+}
+
+// GetConfigOr returns value of Config field of HiState or def if either HiState or field is nil.
+func (h *HiState) GetConfigOr(def hi.Config) hi.Config {
+	if h == nil || h.Config == nil {
+		return def
+	}
+	return *h.Config
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of HiState or nil if HiState is nil.
+func (h *HiState) GetAppliedFingerprint() *string {
+	if h == nil {
+		return nil
+	}
+	return h.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of HiState or zero value if either HiState or field is nil.
+func (h *HiState) GetAppliedFingerprintV() string {
+	if h == nil || h.AppliedFingerprint == nil {
+		return ""
+	}
+	return *h.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of HiState or def if either HiState or field is nil.
+func (h *HiState) GetAppliedFingerprintOr(def string) string {
+	if h == nil || h.AppliedFingerprint == nil {
+		return def
+	}
+	return *h.AppliedFingerprint
+}
+
+// GetConfig returns Config field of AzBIState or nil if AzBIState is nil.
+func (a *AzBIState) GetConfig() *azbi.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AzBIState or zero value if either AzBIState or field is nil.
+func (a *AzBIState) GetConfigV() azbi.Config {
+	if a == nil || a.Config == nil {
+		return azbi.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AzBIState or def if either AzBIState or field is nil.
+func (a *AzBIState) GetConfigOr(def azbi.Config) azbi.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AzBIState or nil if AzBIState is nil.
+func (a *AzBIState) GetOutput() *azbi.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AzBIState or zero value if either AzBIState or field is nil.
+func (a *AzBIState) GetOutputV() azbi.Output {
+	if a == nil || a.Output == nil {
+		return azbi.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AzBIState or def if either AzBIState or field is nil.
+func (a *AzBIState) GetOutputOr(def azbi.Output) azbi.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AzBIState or nil if AzBIState is nil.
+func (a *AzBIState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AzBIState or zero value if either AzBIState or field is nil.
+func (a *AzBIState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AzBIState or def if either AzBIState or field is nil.
+func (a *AzBIState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of AzKSState or nil if AzKSState is nil.
+func (a *AzKSState) GetConfig() *azks.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AzKSState or zero value if either AzKSState or field is nil.
+func (a *AzKSState) GetConfigV() azks.Config {
+	if a == nil || a.Config == nil {
+		return azks.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AzKSState or def if either AzKSState or field is nil.
+func (a *AzKSState) GetConfigOr(def azks.Config) azks.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AzKSState or nil if AzKSState is nil.
+func (a *AzKSState) GetOutput() *azks.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AzKSState or zero value if either AzKSState or field is nil.
+func (a *AzKSState) GetOutputV() azks.Output {
+	if a == nil || a.Output == nil {
+		return azks.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AzKSState or def if either AzKSState or field is nil.
+func (a *AzKSState) GetOutputOr(def azks.Output) azks.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AzKSState or nil if AzKSState is nil.
+func (a *AzKSState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AzKSState or zero value if either AzKSState or field is nil.
+func (a *AzKSState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AzKSState or def if either AzKSState or field is nil.
+func (a *AzKSState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of AwsKSState or nil if AwsKSState is nil.
+func (a *AwsKSState) GetConfig() *awsks.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AwsKSState or zero value if either AwsKSState or field is nil.
+func (a *AwsKSState) GetConfigV() awsks.Config {
+	if a == nil || a.Config == nil {
+		return awsks.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AwsKSState or def if either AwsKSState or field is nil.
+func (a *AwsKSState) GetConfigOr(def awsks.Config) awsks.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AwsKSState or nil if AwsKSState is nil.
+func (a *AwsKSState) GetOutput() *awsks.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AwsKSState or zero value if either AwsKSState or field is nil.
+func (a *AwsKSState) GetOutputV() awsks.Output {
+	if a == nil || a.Output == nil {
+		return awsks.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AwsKSState or def if either AwsKSState or field is nil.
+func (a *AwsKSState) GetOutputOr(def awsks.Output) awsks.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AwsKSState or nil if AwsKSState is nil.
+func (a *AwsKSState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AwsKSState or zero value if either AwsKSState or field is nil.
+func (a *AwsKSState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AwsKSState or def if either AwsKSState or field is nil.
+func (a *AwsKSState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of GcpBIState or nil if GcpBIState is nil.
+func (g *GcpBIState) GetConfig() *gcpbi.Config {
+	if g == nil {
+		return nil
+	}
+	return g.Config
+}
+
+// GetConfigV returns value of Config field of GcpBIState or zero value if either GcpBIState or field is nil.
+func (g *GcpBIState) GetConfigV() gcpbi.Config {
+	if g == nil || g.Config == nil {
+		return gcpbi.Config{}
+	}
+	return *g.Config
+}
+
+// GetConfigOr returns value of Config field of GcpBIState or def if either GcpBIState or field is nil.
+func (g *GcpBIState) GetConfigOr(def gcpbi.Config) gcpbi.Config {
+	if g == nil || g.Config == nil {
+		return def
+	}
+	return *g.Config
+}
+
+// GetOutput returns Output field of GcpBIState or nil if GcpBIState is nil.
+func (g *GcpBIState) GetOutput() *gcpbi.Output {
+	if g == nil {
+		return nil
+	}
+	return g.Output
+}
+
+// GetOutputV returns value of Output field of GcpBIState or zero value if either GcpBIState or field is nil.
+func (g *GcpBIState) GetOutputV() gcpbi.Output {
+	if g == nil || g.Output == nil {
+		return gcpbi.Output{}
+	}
+	return *g.Output
+}
+
+// GetOutputOr returns value of Output field of GcpBIState or def if either GcpBIState or field is nil.
+func (g *GcpBIState) GetOutputOr(def gcpbi.Output) gcpbi.Output {
+	if g == nil || g.Output == nil {
+		return def
+	}
+	return *g.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of GcpBIState or nil if GcpBIState is nil.
+func (g *GcpBIState) GetAppliedFingerprint() *string {
+	if g == nil {
+		return nil
+	}
+	return g.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of GcpBIState or zero value if either GcpBIState or field is nil.
+func (g *GcpBIState) GetAppliedFingerprintV() string {
+	if g == nil || g.AppliedFingerprint == nil {
+		return ""
+	}
+	return *g.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of GcpBIState or def if either GcpBIState or field is nil.
+func (g *GcpBIState) GetAppliedFingerprintOr(def string) string {
+	if g == nil || g.AppliedFingerprint == nil {
+		return def
+	}
+	return *g.AppliedFingerprint
+}
+
+// GetConfig returns Config field of AzPGState or nil if AzPGState is nil.
+func (a *AzPGState) GetConfig() *azpg.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AzPGState or zero value if either AzPGState or field is nil.
+func (a *AzPGState) GetConfigV() azpg.Config {
+	if a == nil || a.Config == nil {
+		return azpg.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AzPGState or def if either AzPGState or field is nil.
+func (a *AzPGState) GetConfigOr(def azpg.Config) azpg.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AzPGState or nil if AzPGState is nil.
+func (a *AzPGState) GetOutput() *azpg.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AzPGState or zero value if either AzPGState or field is nil.
+func (a *AzPGState) GetOutputV() azpg.Output {
+	if a == nil || a.Output == nil {
+		return azpg.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AzPGState or def if either AzPGState or field is nil.
+func (a *AzPGState) GetOutputOr(def azpg.Output) azpg.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AzPGState or nil if AzPGState is nil.
+func (a *AzPGState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AzPGState or zero value if either AzPGState or field is nil.
+func (a *AzPGState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AzPGState or def if either AzPGState or field is nil.
+func (a *AzPGState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of AzStorageState or nil if AzStorageState is nil.
+func (a *AzStorageState) GetConfig() *azstorage.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AzStorageState or zero value if either AzStorageState or field is nil.
+func (a *AzStorageState) GetConfigV() azstorage.Config {
+	if a == nil || a.Config == nil {
+		return azstorage.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AzStorageState or def if either AzStorageState or field is nil.
+func (a *AzStorageState) GetConfigOr(def azstorage.Config) azstorage.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AzStorageState or nil if AzStorageState is nil.
+func (a *AzStorageState) GetOutput() *azstorage.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AzStorageState or zero value if either AzStorageState or field is nil.
+func (a *AzStorageState) GetOutputV() azstorage.Output {
+	if a == nil || a.Output == nil {
+		return azstorage.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AzStorageState or def if either AzStorageState or field is nil.
+func (a *AzStorageState) GetOutputOr(def azstorage.Output) azstorage.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AzStorageState or nil if AzStorageState is nil.
+func (a *AzStorageState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AzStorageState or zero value if either AzStorageState or field is nil.
+func (a *AzStorageState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AzStorageState or def if either AzStorageState or field is nil.
+func (a *AzStorageState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of AzLBState or nil if AzLBState is nil.
+func (a *AzLBState) GetConfig() *azlb.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AzLBState or zero value if either AzLBState or field is nil.
+func (a *AzLBState) GetConfigV() azlb.Config {
+	if a == nil || a.Config == nil {
+		return azlb.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AzLBState or def if either AzLBState or field is nil.
+func (a *AzLBState) GetConfigOr(def azlb.Config) azlb.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AzLBState or nil if AzLBState is nil.
+func (a *AzLBState) GetOutput() *azlb.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AzLBState or zero value if either AzLBState or field is nil.
+func (a *AzLBState) GetOutputV() azlb.Output {
+	if a == nil || a.Output == nil {
+		return azlb.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AzLBState or def if either AzLBState or field is nil.
+func (a *AzLBState) GetOutputOr(def azlb.Output) azlb.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AzLBState or nil if AzLBState is nil.
+func (a *AzLBState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AzLBState or zero value if either AzLBState or field is nil.
+func (a *AzLBState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AzLBState or def if either AzLBState or field is nil.
+func (a *AzLBState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of DnsState or nil if DnsState is nil.
+func (d *DnsState) GetConfig() *dns.Config {
+	if d == nil {
+		return nil
+	}
+	return d.Config
+}
+
+// GetConfigV returns value of Config field of DnsState or zero value if either DnsState or field is nil.
+func (d *DnsState) GetConfigV() dns.Config {
+	if d == nil || d.Config == nil {
+		return dns.Config{}
+	}
+	return *d.Config
+}
+
+// GetConfigOr returns value of Config field of DnsState or def if either DnsState or field is nil.
+func (d *DnsState) GetConfigOr(def dns.Config) dns.Config {
+	if d == nil || d.Config == nil {
+		return def
+	}
+	return *d.Config
+}
+
+// GetOutput returns Output field of DnsState or nil if DnsState is nil.
+func (d *DnsState) GetOutput() *dns.Output {
+	if d == nil {
+		return nil
+	}
+	return d.Output
+}
+
+// GetOutputV returns value of Output field of DnsState or zero value if either DnsState or field is nil.
+func (d *DnsState) GetOutputV() dns.Output {
+	if d == nil || d.Output == nil {
+		return dns.Output{}
+	}
+	return *d.Output
+}
+
+// GetOutputOr returns value of Output field of DnsState or def if either DnsState or field is nil.
+func (d *DnsState) GetOutputOr(def dns.Output) dns.Output {
+	if d == nil || d.Output == nil {
+		return def
+	}
+	return *d.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of DnsState or nil if DnsState is nil.
+func (d *DnsState) GetAppliedFingerprint() *string {
+	if d == nil {
+		return nil
+	}
+	return d.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of DnsState or zero value if either DnsState or field is nil.
+func (d *DnsState) GetAppliedFingerprintV() string {
+	if d == nil || d.AppliedFingerprint == nil {
+		return ""
+	}
+	return *d.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of DnsState or def if either DnsState or field is nil.
+func (d *DnsState) GetAppliedFingerprintOr(def string) string {
+	if d == nil || d.AppliedFingerprint == nil {
+		return def
+	}
+	return *d.AppliedFingerprint
+}
+
+// GetConfig returns Config field of AzKVState or nil if AzKVState is nil.
+func (a *AzKVState) GetConfig() *azkv.Config {
+	if a == nil {
+		return nil
+	}
+	return a.Config
+}
+
+// GetConfigV returns value of Config field of AzKVState or zero value if either AzKVState or field is nil.
+func (a *AzKVState) GetConfigV() azkv.Config {
+	if a == nil || a.Config == nil {
+		return azkv.Config{}
+	}
+	return *a.Config
+}
+
+// GetConfigOr returns value of Config field of AzKVState or def if either AzKVState or field is nil.
+func (a *AzKVState) GetConfigOr(def azkv.Config) azkv.Config {
+	if a == nil || a.Config == nil {
+		return def
+	}
+	return *a.Config
+}
+
+// GetOutput returns Output field of AzKVState or nil if AzKVState is nil.
+func (a *AzKVState) GetOutput() *azkv.Output {
+	if a == nil {
+		return nil
+	}
+	return a.Output
+}
+
+// GetOutputV returns value of Output field of AzKVState or zero value if either AzKVState or field is nil.
+func (a *AzKVState) GetOutputV() azkv.Output {
+	if a == nil || a.Output == nil {
+		return azkv.Output{}
+	}
+	return *a.Output
+}
+
+// GetOutputOr returns value of Output field of AzKVState or def if either AzKVState or field is nil.
+func (a *AzKVState) GetOutputOr(def azkv.Output) azkv.Output {
+	if a == nil || a.Output == nil {
+		return def
+	}
+	return *a.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of AzKVState or nil if AzKVState is nil.
+func (a *AzKVState) GetAppliedFingerprint() *string {
+	if a == nil {
+		return nil
+	}
+	return a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of AzKVState or zero value if either AzKVState or field is nil.
+func (a *AzKVState) GetAppliedFingerprintV() string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return ""
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of AzKVState or def if either AzKVState or field is nil.
+func (a *AzKVState) GetAppliedFingerprintOr(def string) string {
+	if a == nil || a.AppliedFingerprint == nil {
+		return def
+	}
+	return *a.AppliedFingerprint
+}
+
+// GetConfig returns Config field of K8sAddonsState or nil if K8sAddonsState is nil.
+func (k *K8sAddonsState) GetConfig() *k8saddons.Config {
+	if k == nil {
+		return nil
+	}
+	return k.Config
+}
+
+// GetConfigV returns value of Config field of K8sAddonsState or zero value if either K8sAddonsState or field is nil.
+func (k *K8sAddonsState) GetConfigV() k8saddons.Config {
+	if k == nil || k.Config == nil {
+		return k8saddons.Config{}
+	}
+	return *k.Config
+}
+
+// GetConfigOr returns value of Config field of K8sAddonsState or def if either K8sAddonsState or field is nil.
+func (k *K8sAddonsState) GetConfigOr(def k8saddons.Config) k8saddons.Config {
+	if k == nil || k.Config == nil {
+		return def
+	}
+	return *k.Config
+}
+
+// GetOutput returns Output field of K8sAddonsState or nil if K8sAddonsState is nil.
+func (k *K8sAddonsState) GetOutput() *k8saddons.Output {
+	if k == nil {
+		return nil
+	}
+	return k.Output
+}
+
+// GetOutputV returns value of Output field of K8sAddonsState or zero value if either K8sAddonsState or field is nil.
+func (k *K8sAddonsState) GetOutputV() k8saddons.Output {
+	if k == nil || k.Output == nil {
+		return k8saddons.Output{}
+	}
+	return *k.Output
+}
+
+// GetOutputOr returns value of Output field of K8sAddonsState or def if either K8sAddonsState or field is nil.
+func (k *K8sAddonsState) GetOutputOr(def k8saddons.Output) k8saddons.Output {
+	if k == nil || k.Output == nil {
+		return def
+	}
+	return *k.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of K8sAddonsState or nil if K8sAddonsState is nil.
+func (k *K8sAddonsState) GetAppliedFingerprint() *string {
+	if k == nil {
+		return nil
+	}
+	return k.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of K8sAddonsState or zero value if either K8sAddonsState or field is nil.
+func (k *K8sAddonsState) GetAppliedFingerprintV() string {
+	if k == nil || k.AppliedFingerprint == nil {
+		return ""
+	}
+	return *k.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of K8sAddonsState or def if either K8sAddonsState or field is nil.
+func (k *K8sAddonsState) GetAppliedFingerprintOr(def string) string {
+	if k == nil || k.AppliedFingerprint == nil {
+		return def
+	}
+	return *k.AppliedFingerprint
+}
+
+// GetConfig returns Config field of MonitoringState or nil if MonitoringState is nil.
+func (m *MonitoringState) GetConfig() *monitoring.Config {
+	if m == nil {
+		return nil
+	}
+	return m.Config
+}
+
+// GetConfigV returns value of Config field of MonitoringState or zero value if either MonitoringState or field is nil.
+func (m *MonitoringState) GetConfigV() monitoring.Config {
+	if m == nil || m.Config == nil {
+		return monitoring.Config{}
+	}
+	return *m.Config
+}
+
+// GetConfigOr returns value of Config field of MonitoringState or def if either MonitoringState or field is nil.
+func (m *MonitoringState) GetConfigOr(def monitoring.Config) monitoring.Config {
+	if m == nil || m.Config == nil {
+		return def
+	}
+	return *m.Config
+}
+
+// GetOutput returns Output field of MonitoringState or nil if MonitoringState is nil.
+func (m *MonitoringState) GetOutput() *monitoring.Output {
+	if m == nil {
+		return nil
+	}
+	return m.Output
+}
+
+// GetOutputV returns value of Output field of MonitoringState or zero value if either MonitoringState or field is nil.
+func (m *MonitoringState) GetOutputV() monitoring.Output {
+	if m == nil || m.Output == nil {
+		return monitoring.Output{}
+	}
+	return *m.Output
+}
+
+// GetOutputOr returns value of Output field of MonitoringState or def if either MonitoringState or field is nil.
+func (m *MonitoringState) GetOutputOr(def monitoring.Output) monitoring.Output {
+	if m == nil || m.Output == nil {
+		return def
+	}
+	return *m.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of MonitoringState or nil if MonitoringState is nil.
+func (m *MonitoringState) GetAppliedFingerprint() *string {
+	if m == nil {
+		return nil
+	}
+	return m.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of MonitoringState or zero value if either MonitoringState or field is nil.
+func (m *MonitoringState) GetAppliedFingerprintV() string {
+	if m == nil || m.AppliedFingerprint == nil {
+		return ""
+	}
+	return *m.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of MonitoringState or def if either MonitoringState or field is nil.
+func (m *MonitoringState) GetAppliedFingerprintOr(def string) string {
+	if m == nil || m.AppliedFingerprint == nil {
+		return def
+	}
+	return *m.AppliedFingerprint
+}
+
+// GetConfig returns Config field of BastionState or nil if BastionState is nil.
+func (b *BastionState) GetConfig() *bastion.Config {
+	if b == nil {
+		return nil
+	}
+	return b.Config
+}
+
+// GetConfigV returns value of Config field of BastionState or zero value if either BastionState or field is nil.
+func (b *BastionState) GetConfigV() bastion.Config {
+	if b == nil || b.Config == nil {
+		return bastion.Config{}
+	}
+	return *b.Config
+}
+
+// GetConfigOr returns value of Config field of BastionState or def if either BastionState or field is nil.
+func (b *BastionState) GetConfigOr(def bastion.Config) bastion.Config {
+	if b == nil || b.Config == nil {
+		return def
+	}
+	return *b.Config
+}
+
+// GetOutput returns Output field of BastionState or nil if BastionState is nil.
+func (b *BastionState) GetOutput() *bastion.Output {
+	if b == nil {
+		return nil
+	}
+	return b.Output
+}
+
+// GetOutputV returns value of Output field of BastionState or zero value if either BastionState or field is nil.
+func (b *BastionState) GetOutputV() bastion.Output {
+	if b == nil || b.Output == nil {
+		return bastion.Output{}
+	}
+	return *b.Output
+}
+
+// GetOutputOr returns value of Output field of BastionState or def if either BastionState or field is nil.
+func (b *BastionState) GetOutputOr(def bastion.Output) bastion.Output {
+	if b == nil || b.Output == nil {
+		return def
+	}
+	return *b.Output
+}
+
+// GetAppliedFingerprint returns AppliedFingerprint field of BastionState or nil if BastionState is nil.
+func (b *BastionState) GetAppliedFingerprint() *string {
+	if b == nil {
+		return nil
+	}
+	return b.AppliedFingerprint
+}
+
+// GetAppliedFingerprintV returns value of AppliedFingerprint field of BastionState or zero value if either BastionState or field is nil.
+func (b *BastionState) GetAppliedFingerprintV() string {
+	if b == nil || b.AppliedFingerprint == nil {
+		return ""
+	}
+	return *b.AppliedFingerprint
+}
+
+// GetAppliedFingerprintOr returns value of AppliedFingerprint field of BastionState or def if either BastionState or field is nil.
+func (b *BastionState) GetAppliedFingerprintOr(def string) string {
+	if b == nil || b.AppliedFingerprint == nil {
+		return def
+	}
+	return *b.AppliedFingerprint
+}
+
+// GetKind returns Kind field of State or nil if State is nil.
+func (s *State) GetKind() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Kind
+}
+
+// GetKindV returns value of Kind field of State or zero value if either State or field is nil.
+func (s *State) GetKindV() string {
+	if s == nil || s.Kind == nil {
+		return ""
+	}
+	return *s.Kind
+}
+
+// GetKindOr returns value of Kind field of State or def if either State or field is nil.
+func (s *State) GetKindOr(def string) string {
+	if s == nil || s.Kind == nil {
+		return def
+	}
+	return *s.Kind
+}
+
+// GetVersion returns Version field of State or nil if State is nil.
+func (s *State) GetVersion() *string {
+	if s == nil {
+		return nil
+	}
+	return s.Version
+}
+
+// GetVersionV returns value of Version field of State or zero value if either State or field is nil.
+func (s *State) GetVersionV() string {
+	if s == nil || s.Version == nil {
+		return ""
+	}
+	return *s.Version
+}
+
+// GetVersionOr returns value of Version field of State or def if either State or field is nil.
+func (s *State) GetVersionOr(def string) string {
+	if s == nil || s.Version == nil {
+		return def
+	}
+	return *s.Version
+}
+
+// GetUnused returns Unused field of State, nil if State is nil or empty slice if field is nil.
+func (s *State) GetUnused() []string {
+	if s == nil {
+		return nil
+	}
+	if len(s.Unused) == 0 {
+		return []string{}
+	}
+	return s.Unused
+}
+
+// GetAzBI returns AzBI field of State or nil if State is nil.
+func (s *State) GetAzBI() *AzBIState {
+	if s == nil {
+		return nil
+	}
+	return s.AzBI
+}
+
+// GetAzBIV returns value of AzBI field of State or zero value if either State or field is nil.
+func (s *State) GetAzBIV() AzBIState {
+	if s == nil || s.AzBI == nil {
+		return AzBIState{}
+	}
+	return *s.AzBI
+}
+
+// GetAzBIOr returns value of AzBI field of State or def if either State or field is nil.
+func (s *State) GetAzBIOr(def AzBIState) AzBIState {
+	if s == nil || s.AzBI == nil {
+		return def
+	}
+	return *s.AzBI
+}
+
+// GetAzKS returns AzKS field of State or nil if State is nil.
+func (s *State) GetAzKS() *AzKSState {
+	if s == nil {
+		return nil
+	}
+	return s.AzKS
+}
+
+// GetAzKSV returns value of AzKS field of State or zero value if either State or field is nil.
+func (s *State) GetAzKSV() AzKSState {
+	if s == nil || s.AzKS == nil {
+		return AzKSState{}
+	}
+	return *s.AzKS
+}
+
+// GetAzKSOr returns value of AzKS field of State or def if either State or field is nil.
+func (s *State) GetAzKSOr(def AzKSState) AzKSState {
+	if s == nil || s.AzKS == nil {
+		return def
+	}
+	return *s.AzKS
+}
+
+// GetHi returns Hi field of State or nil if State is nil.
+func (s *State) GetHi() *HiState {
+	if s == nil {
+		return nil
+	}
+	return s.Hi
+}
+
+// GetHiV returns value of Hi field of State or zero value if either State or field is nil.
+func (s *State) GetHiV() HiState {
+	if s == nil || s.Hi == nil {
+		return HiState{}
+	}
+	return *s.Hi
+}
+
+// GetHiOr returns value of Hi field of State or def if either State or field is nil.
+func (s *State) GetHiOr(def HiState) HiState {
+	if s == nil || s.Hi == nil {
+		return def
+	}
+	return *s.Hi
+}
+
+// GetAwsBI returns AwsBI field of State or nil if State is nil.
+func (s *State) GetAwsBI() *AwsBIState {
+	if s == nil {
+		return nil
+	}
+	return s.AwsBI
+}
+
+// GetAwsBIV returns value of AwsBI field of State or zero value if either State or field is nil.
+func (s *State) GetAwsBIV() AwsBIState {
+	if s == nil || s.AwsBI == nil {
+		return AwsBIState{}
+	}
+	return *s.AwsBI
+}
+
+// GetAwsBIOr returns value of AwsBI field of State or def if either State or field is nil.
+func (s *State) GetAwsBIOr(def AwsBIState) AwsBIState {
+	if s == nil || s.AwsBI == nil {
+		return def
+	}
+	return *s.AwsBI
+}
+
+// GetAwsKS returns AwsKS field of State or nil if State is nil.
+func (s *State) GetAwsKS() *AwsKSState {
+	if s == nil {
+		return nil
+	}
+	return s.AwsKS
+}
+
+// GetAwsKSV returns value of AwsKS field of State or zero value if either State or field is nil.
+func (s *State) GetAwsKSV() AwsKSState {
+	if s == nil || s.AwsKS == nil {
+		return AwsKSState{}
+	}
+	return *s.AwsKS
+}
+
+// GetAwsKSOr returns value of AwsKS field of State or def if either State or field is nil.
+func (s *State) GetAwsKSOr(def AwsKSState) AwsKSState {
+	if s == nil || s.AwsKS == nil {
+		return def
+	}
+	return *s.AwsKS
+}
+
+// GetGcpBI returns GcpBI field of State or nil if State is nil.
+func (s *State) GetGcpBI() *GcpBIState {
+	if s == nil {
+		return nil
+	}
+	return s.GcpBI
+}
+
+// GetGcpBIV returns value of GcpBI field of State or zero value if either State or field is nil.
+func (s *State) GetGcpBIV() GcpBIState {
+	if s == nil || s.GcpBI == nil {
+		return GcpBIState{}
+	}
+	return *s.GcpBI
+}
+
+// GetGcpBIOr returns value of GcpBI field of State or def if either State or field is nil.
+func (s *State) GetGcpBIOr(def GcpBIState) GcpBIState {
+	if s == nil || s.GcpBI == nil {
+		return def
+	}
+	return *s.GcpBI
+}
+
+// GetAzPG returns AzPG field of State or nil if State is nil.
+func (s *State) GetAzPG() *AzPGState {
+	if s == nil {
+		return nil
+	}
+	return s.AzPG
+}
+
+// GetAzPGV returns value of AzPG field of State or zero value if either State or field is nil.
+func (s *State) GetAzPGV() AzPGState {
+	if s == nil || s.AzPG == nil {
+		return AzPGState{}
+	}
+	return *s.AzPG
+}
+
+// GetAzPGOr returns value of AzPG field of State or def if either State or field is nil.
+func (s *State) GetAzPGOr(def AzPGState) AzPGState {
+	if s == nil || s.AzPG == nil {
+		return def
+	}
+	return *s.AzPG
+}
+
+// GetAzStorage returns AzStorage field of State or nil if State is nil.
+func (s *State) GetAzStorage() *AzStorageState {
+	if s == nil {
+		return nil
+	}
+	return s.AzStorage
+}
+
+// GetAzStorageV returns value of AzStorage field of State or zero value if either State or field is nil.
+func (s *State) GetAzStorageV() AzStorageState {
+	if s == nil || s.AzStorage == nil {
+		return AzStorageState{}
+	}
+	return *s.AzStorage
+}
+
+// GetAzStorageOr returns value of AzStorage field of State or def if either State or field is nil.
+func (s *State) GetAzStorageOr(def AzStorageState) AzStorageState {
+	if s == nil || s.AzStorage == nil {
+		return def
+	}
+	return *s.AzStorage
+}
+
+// GetAzLB returns AzLB field of State or nil if State is nil.
+func (s *State) GetAzLB() *AzLBState {
+	if s == nil {
+		return nil
+	}
+	return s.AzLB
+}
+
+// GetAzLBV returns value of AzLB field of State or zero value if either State or field is nil.
+func (s *State) GetAzLBV() AzLBState {
+	if s == nil || s.AzLB == nil {
+		return AzLBState{}
+	}
+	return *s.AzLB
+}
+
+// GetAzLBOr returns value of AzLB field of State or def if either State or field is nil.
+func (s *State) GetAzLBOr(def AzLBState) AzLBState {
+	if s == nil || s.AzLB == nil {
+		return def
+	}
+	return *s.AzLB
+}
+
+// GetDns returns Dns field of State or nil if State is nil.
+func (s *State) GetDns() *DnsState {
+	if s == nil {
+		return nil
+	}
+	return s.Dns
+}
+
+// GetDnsV returns value of Dns field of State or zero value if either State or field is nil.
+func (s *State) GetDnsV() DnsState {
+	if s == nil || s.Dns == nil {
+		return DnsState{}
+	}
+	return *s.Dns
+}
+
+// GetDnsOr returns value of Dns field of State or def if either State or field is nil.
+func (s *State) GetDnsOr(def DnsState) DnsState {
+	if s == nil || s.Dns == nil {
+		return def
+	}
+	return *s.Dns
+}
+
+// GetAzKV returns AzKV field of State or nil if State is nil.
+func (s *State) GetAzKV() *AzKVState {
+	if s == nil {
+		return nil
+	}
+	return s.AzKV
+}
+
+// GetAzKVV returns value of AzKV field of State or zero value if either State or field is nil.
+func (s *State) GetAzKVV() AzKVState {
+	if s == nil || s.AzKV == nil {
+		return AzKVState{}
+	}
+	return *s.AzKV
+}
+
+// GetAzKVOr returns value of AzKV field of State or def if either State or field is nil.
+func (s *State) GetAzKVOr(def AzKVState) AzKVState {
+	if s == nil || s.AzKV == nil {
+		return def
+	}
+	return *s.AzKV
+}
+
+// GetK8sAddons returns K8sAddons field of State or nil if State is nil.
+func (s *State) GetK8sAddons() *K8sAddonsState {
+	if s == nil {
+		return nil
+	}
+	return s.K8sAddons
+}
+
+// GetK8sAddonsV returns value of K8sAddons field of State or zero value if either State or field is nil.
+func (s *State) GetK8sAddonsV() K8sAddonsState {
+	if s == nil || s.K8sAddons == nil {
+		return K8sAddonsState{}
+	}
+	return *s.K8sAddons
+}
+
+// GetK8sAddonsOr returns value of K8sAddons field of State or def if either State or field is nil.
+func (s *State) GetK8sAddonsOr(def K8sAddonsState) K8sAddonsState {
+	if s == nil || s.K8sAddons == nil {
+		return def
+	}
+	return *s.K8sAddons
+}
+
+// GetMonitoring returns Monitoring field of State or nil if State is nil.
+func (s *State) GetMonitoring() *MonitoringState {
+	if s == nil {
+		return nil
+	}
+	return s.Monitoring
+}
+
+// GetMonitoringV returns value of Monitoring field of State or zero value if either State or field is nil.
+func (s *State) GetMonitoringV() MonitoringState {
+	if s == nil || s.Monitoring == nil {
+		return MonitoringState{}
+	}
+	return *s.Monitoring
+}
+
+// GetMonitoringOr returns value of Monitoring field of State or def if either State or field is nil.
+func (s *State) GetMonitoringOr(def MonitoringState) MonitoringState {
+	if s == nil || s.Monitoring == nil {
+		return def
+	}
+	return *s.Monitoring
+}
+
+// GetBastion returns Bastion field of State or nil if State is nil.
+func (s *State) GetBastion() *BastionState {
+	if s == nil {
+		return nil
+	}
+	return s.Bastion
+}
+
+// GetBastionV returns value of Bastion field of State or zero value if either State or field is nil.
+func (s *State) GetBastionV() BastionState {
+	if s == nil || s.Bastion == nil {
+		return BastionState{}
+	}
+	return *s.Bastion
+}
+
+// GetBastionOr returns value of Bastion field of State or def if either State or field is nil.
+func (s *State) GetBastionOr(def BastionState) BastionState {
+	if s == nil || s.Bastion == nil {
+		return def
+	}
+	return *s.Bastion
+}
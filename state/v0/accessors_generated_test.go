@@ -0,0 +1,1241 @@
+// Code generated by utils/generators/accessors; DO NOT EDIT.
+
+package v0
+
+import (
+	"reflect"
+	"testing"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	awsks "github.com/epiphany-platform/e-structures/awsks/v0"
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	azkv "github.com/epiphany-platform/e-structures/azkv/v0"
+	azlb "github.com/epiphany-platform/e-structures/azlb/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	azstorage "github.com/epiphany-platform/e-structures/azstorage/v0"
+	bastion "github.com/epiphany-platform/e-structures/bastion/v0"
+	dns "github.com/epiphany-platform/e-structures/dns/v0"
+	gcpbi "github.com/epiphany-platform/e-structures/gcpbi/v0"
+	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	k8saddons "github.com/epiphany-platform/e-structures/k8saddons/v0"
+	monitoring "github.com/epiphany-platform/e-structures/monitoring/v0"
+)
+
+func TestAwsBIState_Accessors(t *testing.T) {
+	var nilStruct *AwsBIState
+	emptyStruct := &AwsBIState{}
+	t.Run("Config", func(t *testing.T) {
+		v := awsbi.Config{}
+		fullStruct := &AwsBIState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), awsbi.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), awsbi.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := awsbi.Output{}
+		fullStruct := &AwsBIState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), awsbi.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), awsbi.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AwsBIState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestHiState_Accessors(t *testing.T) {
+	var nilStruct *HiState
+	emptyStruct := &HiState{}
+	t.Run("Config", func(t *testing.T) {
+		v := hi.Config{}
+		fullStruct := &HiState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), hi.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), hi.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &HiState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestAzBIState_Accessors(t *testing.T) {
+	var nilStruct *AzBIState
+	emptyStruct := &AzBIState{}
+	t.Run("Config", func(t *testing.T) {
+		v := azbi.Config{}
+		fullStruct := &AzBIState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), azbi.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), azbi.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := azbi.Output{}
+		fullStruct := &AzBIState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), azbi.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), azbi.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AzBIState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestAzKSState_Accessors(t *testing.T) {
+	var nilStruct *AzKSState
+	emptyStruct := &AzKSState{}
+	t.Run("Config", func(t *testing.T) {
+		v := azks.Config{}
+		fullStruct := &AzKSState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), azks.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), azks.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := azks.Output{}
+		fullStruct := &AzKSState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), azks.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), azks.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AzKSState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestAwsKSState_Accessors(t *testing.T) {
+	var nilStruct *AwsKSState
+	emptyStruct := &AwsKSState{}
+	t.Run("Config", func(t *testing.T) {
+		v := awsks.Config{}
+		fullStruct := &AwsKSState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), awsks.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), awsks.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := awsks.Output{}
+		fullStruct := &AwsKSState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), awsks.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), awsks.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AwsKSState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestGcpBIState_Accessors(t *testing.T) {
+	var nilStruct *GcpBIState
+	emptyStruct := &GcpBIState{}
+	t.Run("Config", func(t *testing.T) {
+		v := gcpbi.Config{}
+		fullStruct := &GcpBIState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), gcpbi.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), gcpbi.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := gcpbi.Output{}
+		fullStruct := &GcpBIState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), gcpbi.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), gcpbi.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &GcpBIState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestAzPGState_Accessors(t *testing.T) {
+	var nilStruct *AzPGState
+	emptyStruct := &AzPGState{}
+	t.Run("Config", func(t *testing.T) {
+		v := azpg.Config{}
+		fullStruct := &AzPGState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), azpg.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), azpg.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := azpg.Output{}
+		fullStruct := &AzPGState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), azpg.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), azpg.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AzPGState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestAzStorageState_Accessors(t *testing.T) {
+	var nilStruct *AzStorageState
+	emptyStruct := &AzStorageState{}
+	t.Run("Config", func(t *testing.T) {
+		v := azstorage.Config{}
+		fullStruct := &AzStorageState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), azstorage.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), azstorage.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := azstorage.Output{}
+		fullStruct := &AzStorageState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), azstorage.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), azstorage.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AzStorageState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestAzLBState_Accessors(t *testing.T) {
+	var nilStruct *AzLBState
+	emptyStruct := &AzLBState{}
+	t.Run("Config", func(t *testing.T) {
+		v := azlb.Config{}
+		fullStruct := &AzLBState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), azlb.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), azlb.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := azlb.Output{}
+		fullStruct := &AzLBState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), azlb.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), azlb.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AzLBState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestDnsState_Accessors(t *testing.T) {
+	var nilStruct *DnsState
+	emptyStruct := &DnsState{}
+	t.Run("Config", func(t *testing.T) {
+		v := dns.Config{}
+		fullStruct := &DnsState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), dns.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), dns.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := dns.Output{}
+		fullStruct := &DnsState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), dns.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), dns.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &DnsState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestAzKVState_Accessors(t *testing.T) {
+	var nilStruct *AzKVState
+	emptyStruct := &AzKVState{}
+	t.Run("Config", func(t *testing.T) {
+		v := azkv.Config{}
+		fullStruct := &AzKVState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), azkv.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), azkv.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := azkv.Output{}
+		fullStruct := &AzKVState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), azkv.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), azkv.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &AzKVState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestK8sAddonsState_Accessors(t *testing.T) {
+	var nilStruct *K8sAddonsState
+	emptyStruct := &K8sAddonsState{}
+	t.Run("Config", func(t *testing.T) {
+		v := k8saddons.Config{}
+		fullStruct := &K8sAddonsState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), k8saddons.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), k8saddons.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := k8saddons.Output{}
+		fullStruct := &K8sAddonsState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), k8saddons.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), k8saddons.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &K8sAddonsState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestMonitoringState_Accessors(t *testing.T) {
+	var nilStruct *MonitoringState
+	emptyStruct := &MonitoringState{}
+	t.Run("Config", func(t *testing.T) {
+		v := monitoring.Config{}
+		fullStruct := &MonitoringState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), monitoring.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), monitoring.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := monitoring.Output{}
+		fullStruct := &MonitoringState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), monitoring.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), monitoring.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &MonitoringState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestBastionState_Accessors(t *testing.T) {
+	var nilStruct *BastionState
+	emptyStruct := &BastionState{}
+	t.Run("Config", func(t *testing.T) {
+		v := bastion.Config{}
+		fullStruct := &BastionState{Config: &v}
+		if nilStruct.GetConfig() != nil || emptyStruct.GetConfig() != nil {
+			t.Error("GetConfig() expected to return nil")
+		}
+		if fullStruct.GetConfig() != &v {
+			t.Error("GetConfig() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigV(), bastion.Config{}) || !reflect.DeepEqual(emptyStruct.GetConfigV(), bastion.Config{}) {
+			t.Error("GetConfigV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetConfigV(), v) {
+			t.Error("GetConfigV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetConfigOr(v), v) || !reflect.DeepEqual(emptyStruct.GetConfigOr(v), v) {
+			t.Error("GetConfigOr() expected to return default value")
+		}
+	})
+	t.Run("Output", func(t *testing.T) {
+		v := bastion.Output{}
+		fullStruct := &BastionState{Output: &v}
+		if nilStruct.GetOutput() != nil || emptyStruct.GetOutput() != nil {
+			t.Error("GetOutput() expected to return nil")
+		}
+		if fullStruct.GetOutput() != &v {
+			t.Error("GetOutput() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputV(), bastion.Output{}) || !reflect.DeepEqual(emptyStruct.GetOutputV(), bastion.Output{}) {
+			t.Error("GetOutputV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetOutputV(), v) {
+			t.Error("GetOutputV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetOutputOr(v), v) || !reflect.DeepEqual(emptyStruct.GetOutputOr(v), v) {
+			t.Error("GetOutputOr() expected to return default value")
+		}
+	})
+	t.Run("AppliedFingerprint", func(t *testing.T) {
+		v := "value"
+		fullStruct := &BastionState{AppliedFingerprint: &v}
+		if nilStruct.GetAppliedFingerprint() != nil || emptyStruct.GetAppliedFingerprint() != nil {
+			t.Error("GetAppliedFingerprint() expected to return nil")
+		}
+		if fullStruct.GetAppliedFingerprint() != &v {
+			t.Error("GetAppliedFingerprint() expected to return field")
+		}
+		if nilStruct.GetAppliedFingerprintV() != "" || emptyStruct.GetAppliedFingerprintV() != "" {
+			t.Error("GetAppliedFingerprintV() expected to return zero value")
+		}
+		if fullStruct.GetAppliedFingerprintV() != v {
+			t.Error("GetAppliedFingerprintV() expected to return field value")
+		}
+		if nilStruct.GetAppliedFingerprintOr(v) != v || emptyStruct.GetAppliedFingerprintOr(v) != v {
+			t.Error("GetAppliedFingerprintOr() expected to return default value")
+		}
+		if fullStruct.GetAppliedFingerprintOr("") != v {
+			t.Error("GetAppliedFingerprintOr() expected to return field value")
+		}
+	})
+}
+
+func TestState_Accessors(t *testing.T) {
+	var nilStruct *State
+	emptyStruct := &State{}
+	t.Run("Kind", func(t *testing.T) {
+		v := "value"
+		fullStruct := &State{Kind: &v}
+		if nilStruct.GetKind() != nil || emptyStruct.GetKind() != nil {
+			t.Error("GetKind() expected to return nil")
+		}
+		if fullStruct.GetKind() != &v {
+			t.Error("GetKind() expected to return field")
+		}
+		if nilStruct.GetKindV() != "" || emptyStruct.GetKindV() != "" {
+			t.Error("GetKindV() expected to return zero value")
+		}
+		if fullStruct.GetKindV() != v {
+			t.Error("GetKindV() expected to return field value")
+		}
+		if nilStruct.GetKindOr(v) != v || emptyStruct.GetKindOr(v) != v {
+			t.Error("GetKindOr() expected to return default value")
+		}
+		if fullStruct.GetKindOr("") != v {
+			t.Error("GetKindOr() expected to return field value")
+		}
+	})
+	t.Run("Version", func(t *testing.T) {
+		v := "value"
+		fullStruct := &State{Version: &v}
+		if nilStruct.GetVersion() != nil || emptyStruct.GetVersion() != nil {
+			t.Error("GetVersion() expected to return nil")
+		}
+		if fullStruct.GetVersion() != &v {
+			t.Error("GetVersion() expected to return field")
+		}
+		if nilStruct.GetVersionV() != "" || emptyStruct.GetVersionV() != "" {
+			t.Error("GetVersionV() expected to return zero value")
+		}
+		if fullStruct.GetVersionV() != v {
+			t.Error("GetVersionV() expected to return field value")
+		}
+		if nilStruct.GetVersionOr(v) != v || emptyStruct.GetVersionOr(v) != v {
+			t.Error("GetVersionOr() expected to return default value")
+		}
+		if fullStruct.GetVersionOr("") != v {
+			t.Error("GetVersionOr() expected to return field value")
+		}
+	})
+	t.Run("Unused", func(t *testing.T) {
+		fullStruct := &State{Unused: make([]string, 1)}
+		if nilStruct.GetUnused() != nil {
+			t.Error("GetUnused() expected to return nil")
+		}
+		if got := emptyStruct.GetUnused(); got == nil || len(got) != 0 {
+			t.Error("GetUnused() expected to return empty slice")
+		}
+		if got := fullStruct.GetUnused(); len(got) != 1 || &got[0] != &fullStruct.Unused[0] {
+			t.Error("GetUnused() expected to return field")
+		}
+	})
+	t.Run("AzBI", func(t *testing.T) {
+		v := AzBIState{}
+		fullStruct := &State{AzBI: &v}
+		if nilStruct.GetAzBI() != nil || emptyStruct.GetAzBI() != nil {
+			t.Error("GetAzBI() expected to return nil")
+		}
+		if fullStruct.GetAzBI() != &v {
+			t.Error("GetAzBI() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzBIV(), AzBIState{}) || !reflect.DeepEqual(emptyStruct.GetAzBIV(), AzBIState{}) {
+			t.Error("GetAzBIV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzBIV(), v) {
+			t.Error("GetAzBIV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzBIOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzBIOr(v), v) {
+			t.Error("GetAzBIOr() expected to return default value")
+		}
+	})
+	t.Run("AzKS", func(t *testing.T) {
+		v := AzKSState{}
+		fullStruct := &State{AzKS: &v}
+		if nilStruct.GetAzKS() != nil || emptyStruct.GetAzKS() != nil {
+			t.Error("GetAzKS() expected to return nil")
+		}
+		if fullStruct.GetAzKS() != &v {
+			t.Error("GetAzKS() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzKSV(), AzKSState{}) || !reflect.DeepEqual(emptyStruct.GetAzKSV(), AzKSState{}) {
+			t.Error("GetAzKSV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzKSV(), v) {
+			t.Error("GetAzKSV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzKSOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzKSOr(v), v) {
+			t.Error("GetAzKSOr() expected to return default value")
+		}
+	})
+	t.Run("Hi", func(t *testing.T) {
+		v := HiState{}
+		fullStruct := &State{Hi: &v}
+		if nilStruct.GetHi() != nil || emptyStruct.GetHi() != nil {
+			t.Error("GetHi() expected to return nil")
+		}
+		if fullStruct.GetHi() != &v {
+			t.Error("GetHi() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetHiV(), HiState{}) || !reflect.DeepEqual(emptyStruct.GetHiV(), HiState{}) {
+			t.Error("GetHiV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetHiV(), v) {
+			t.Error("GetHiV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetHiOr(v), v) || !reflect.DeepEqual(emptyStruct.GetHiOr(v), v) {
+			t.Error("GetHiOr() expected to return default value")
+		}
+	})
+	t.Run("AwsBI", func(t *testing.T) {
+		v := AwsBIState{}
+		fullStruct := &State{AwsBI: &v}
+		if nilStruct.GetAwsBI() != nil || emptyStruct.GetAwsBI() != nil {
+			t.Error("GetAwsBI() expected to return nil")
+		}
+		if fullStruct.GetAwsBI() != &v {
+			t.Error("GetAwsBI() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAwsBIV(), AwsBIState{}) || !reflect.DeepEqual(emptyStruct.GetAwsBIV(), AwsBIState{}) {
+			t.Error("GetAwsBIV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAwsBIV(), v) {
+			t.Error("GetAwsBIV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAwsBIOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAwsBIOr(v), v) {
+			t.Error("GetAwsBIOr() expected to return default value")
+		}
+	})
+	t.Run("AwsKS", func(t *testing.T) {
+		v := AwsKSState{}
+		fullStruct := &State{AwsKS: &v}
+		if nilStruct.GetAwsKS() != nil || emptyStruct.GetAwsKS() != nil {
+			t.Error("GetAwsKS() expected to return nil")
+		}
+		if fullStruct.GetAwsKS() != &v {
+			t.Error("GetAwsKS() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAwsKSV(), AwsKSState{}) || !reflect.DeepEqual(emptyStruct.GetAwsKSV(), AwsKSState{}) {
+			t.Error("GetAwsKSV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAwsKSV(), v) {
+			t.Error("GetAwsKSV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAwsKSOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAwsKSOr(v), v) {
+			t.Error("GetAwsKSOr() expected to return default value")
+		}
+	})
+	t.Run("GcpBI", func(t *testing.T) {
+		v := GcpBIState{}
+		fullStruct := &State{GcpBI: &v}
+		if nilStruct.GetGcpBI() != nil || emptyStruct.GetGcpBI() != nil {
+			t.Error("GetGcpBI() expected to return nil")
+		}
+		if fullStruct.GetGcpBI() != &v {
+			t.Error("GetGcpBI() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetGcpBIV(), GcpBIState{}) || !reflect.DeepEqual(emptyStruct.GetGcpBIV(), GcpBIState{}) {
+			t.Error("GetGcpBIV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetGcpBIV(), v) {
+			t.Error("GetGcpBIV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetGcpBIOr(v), v) || !reflect.DeepEqual(emptyStruct.GetGcpBIOr(v), v) {
+			t.Error("GetGcpBIOr() expected to return default value")
+		}
+	})
+	t.Run("AzPG", func(t *testing.T) {
+		v := AzPGState{}
+		fullStruct := &State{AzPG: &v}
+		if nilStruct.GetAzPG() != nil || emptyStruct.GetAzPG() != nil {
+			t.Error("GetAzPG() expected to return nil")
+		}
+		if fullStruct.GetAzPG() != &v {
+			t.Error("GetAzPG() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzPGV(), AzPGState{}) || !reflect.DeepEqual(emptyStruct.GetAzPGV(), AzPGState{}) {
+			t.Error("GetAzPGV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzPGV(), v) {
+			t.Error("GetAzPGV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzPGOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzPGOr(v), v) {
+			t.Error("GetAzPGOr() expected to return default value")
+		}
+	})
+	t.Run("AzStorage", func(t *testing.T) {
+		v := AzStorageState{}
+		fullStruct := &State{AzStorage: &v}
+		if nilStruct.GetAzStorage() != nil || emptyStruct.GetAzStorage() != nil {
+			t.Error("GetAzStorage() expected to return nil")
+		}
+		if fullStruct.GetAzStorage() != &v {
+			t.Error("GetAzStorage() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzStorageV(), AzStorageState{}) || !reflect.DeepEqual(emptyStruct.GetAzStorageV(), AzStorageState{}) {
+			t.Error("GetAzStorageV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzStorageV(), v) {
+			t.Error("GetAzStorageV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzStorageOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzStorageOr(v), v) {
+			t.Error("GetAzStorageOr() expected to return default value")
+		}
+	})
+	t.Run("AzLB", func(t *testing.T) {
+		v := AzLBState{}
+		fullStruct := &State{AzLB: &v}
+		if nilStruct.GetAzLB() != nil || emptyStruct.GetAzLB() != nil {
+			t.Error("GetAzLB() expected to return nil")
+		}
+		if fullStruct.GetAzLB() != &v {
+			t.Error("GetAzLB() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzLBV(), AzLBState{}) || !reflect.DeepEqual(emptyStruct.GetAzLBV(), AzLBState{}) {
+			t.Error("GetAzLBV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzLBV(), v) {
+			t.Error("GetAzLBV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzLBOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzLBOr(v), v) {
+			t.Error("GetAzLBOr() expected to return default value")
+		}
+	})
+	t.Run("Dns", func(t *testing.T) {
+		v := DnsState{}
+		fullStruct := &State{Dns: &v}
+		if nilStruct.GetDns() != nil || emptyStruct.GetDns() != nil {
+			t.Error("GetDns() expected to return nil")
+		}
+		if fullStruct.GetDns() != &v {
+			t.Error("GetDns() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetDnsV(), DnsState{}) || !reflect.DeepEqual(emptyStruct.GetDnsV(), DnsState{}) {
+			t.Error("GetDnsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetDnsV(), v) {
+			t.Error("GetDnsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetDnsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetDnsOr(v), v) {
+			t.Error("GetDnsOr() expected to return default value")
+		}
+	})
+	t.Run("AzKV", func(t *testing.T) {
+		v := AzKVState{}
+		fullStruct := &State{AzKV: &v}
+		if nilStruct.GetAzKV() != nil || emptyStruct.GetAzKV() != nil {
+			t.Error("GetAzKV() expected to return nil")
+		}
+		if fullStruct.GetAzKV() != &v {
+			t.Error("GetAzKV() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzKVV(), AzKVState{}) || !reflect.DeepEqual(emptyStruct.GetAzKVV(), AzKVState{}) {
+			t.Error("GetAzKVV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetAzKVV(), v) {
+			t.Error("GetAzKVV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetAzKVOr(v), v) || !reflect.DeepEqual(emptyStruct.GetAzKVOr(v), v) {
+			t.Error("GetAzKVOr() expected to return default value")
+		}
+	})
+	t.Run("K8sAddons", func(t *testing.T) {
+		v := K8sAddonsState{}
+		fullStruct := &State{K8sAddons: &v}
+		if nilStruct.GetK8sAddons() != nil || emptyStruct.GetK8sAddons() != nil {
+			t.Error("GetK8sAddons() expected to return nil")
+		}
+		if fullStruct.GetK8sAddons() != &v {
+			t.Error("GetK8sAddons() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetK8sAddonsV(), K8sAddonsState{}) || !reflect.DeepEqual(emptyStruct.GetK8sAddonsV(), K8sAddonsState{}) {
+			t.Error("GetK8sAddonsV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetK8sAddonsV(), v) {
+			t.Error("GetK8sAddonsV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetK8sAddonsOr(v), v) || !reflect.DeepEqual(emptyStruct.GetK8sAddonsOr(v), v) {
+			t.Error("GetK8sAddonsOr() expected to return default value")
+		}
+	})
+	t.Run("Monitoring", func(t *testing.T) {
+		v := MonitoringState{}
+		fullStruct := &State{Monitoring: &v}
+		if nilStruct.GetMonitoring() != nil || emptyStruct.GetMonitoring() != nil {
+			t.Error("GetMonitoring() expected to return nil")
+		}
+		if fullStruct.GetMonitoring() != &v {
+			t.Error("GetMonitoring() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetMonitoringV(), MonitoringState{}) || !reflect.DeepEqual(emptyStruct.GetMonitoringV(), MonitoringState{}) {
+			t.Error("GetMonitoringV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetMonitoringV(), v) {
+			t.Error("GetMonitoringV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetMonitoringOr(v), v) || !reflect.DeepEqual(emptyStruct.GetMonitoringOr(v), v) {
+			t.Error("GetMonitoringOr() expected to return default value")
+		}
+	})
+	t.Run("Bastion", func(t *testing.T) {
+		v := BastionState{}
+		fullStruct := &State{Bastion: &v}
+		if nilStruct.GetBastion() != nil || emptyStruct.GetBastion() != nil {
+			t.Error("GetBastion() expected to return nil")
+		}
+		if fullStruct.GetBastion() != &v {
+			t.Error("GetBastion() expected to return field")
+		}
+		if !reflect.DeepEqual(nilStruct.GetBastionV(), BastionState{}) || !reflect.DeepEqual(emptyStruct.GetBastionV(), BastionState{}) {
+			t.Error("GetBastionV() expected to return zero value")
+		}
+		if !reflect.DeepEqual(fullStruct.GetBastionV(), v) {
+			t.Error("GetBastionV() expected to return field value")
+		}
+		if !reflect.DeepEqual(nilStruct.GetBastionOr(v), v) || !reflect.DeepEqual(emptyStruct.GetBastionOr(v), v) {
+			t.Error("GetBastionOr() expected to return default value")
+		}
+	})
+}
@@ -0,0 +1,609 @@
+// Code generated by utils/generators/deepcopy; DO NOT EDIT.
+
+package v0
+
+// DeepCopy returns deep copy of AwsBIState or nil if AwsBIState is nil.
+func (a *AwsBIState) DeepCopy() *AwsBIState {
+	if a == nil {
+		return nil
+	}
+	out := new(AwsBIState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AwsBIState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AwsBIState) Equal(other *AwsBIState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of HiState or nil if HiState is nil.
+func (h *HiState) DeepCopy() *HiState {
+	if h == nil {
+		return nil
+	}
+	out := new(HiState)
+	out.Status = h.Status
+	out.Config = h.Config.DeepCopy()
+	if h.AppliedFingerprint != nil {
+		x := *h.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether HiState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (h *HiState) Equal(other *HiState) bool {
+	if h == nil || other == nil {
+		return h == other
+	}
+	if h.Status != other.Status {
+		return false
+	}
+	if !h.Config.Equal(other.Config) {
+		return false
+	}
+	if (h.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || h.AppliedFingerprint != nil && *h.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AzBIState or nil if AzBIState is nil.
+func (a *AzBIState) DeepCopy() *AzBIState {
+	if a == nil {
+		return nil
+	}
+	out := new(AzBIState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AzBIState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AzBIState) Equal(other *AzBIState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AzKSState or nil if AzKSState is nil.
+func (a *AzKSState) DeepCopy() *AzKSState {
+	if a == nil {
+		return nil
+	}
+	out := new(AzKSState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AzKSState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AzKSState) Equal(other *AzKSState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AwsKSState or nil if AwsKSState is nil.
+func (a *AwsKSState) DeepCopy() *AwsKSState {
+	if a == nil {
+		return nil
+	}
+	out := new(AwsKSState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AwsKSState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AwsKSState) Equal(other *AwsKSState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of GcpBIState or nil if GcpBIState is nil.
+func (g *GcpBIState) DeepCopy() *GcpBIState {
+	if g == nil {
+		return nil
+	}
+	out := new(GcpBIState)
+	out.Status = g.Status
+	out.Config = g.Config.DeepCopy()
+	out.Output = g.Output.DeepCopy()
+	if g.AppliedFingerprint != nil {
+		x := *g.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether GcpBIState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (g *GcpBIState) Equal(other *GcpBIState) bool {
+	if g == nil || other == nil {
+		return g == other
+	}
+	if g.Status != other.Status {
+		return false
+	}
+	if !g.Config.Equal(other.Config) {
+		return false
+	}
+	if !g.Output.Equal(other.Output) {
+		return false
+	}
+	if (g.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || g.AppliedFingerprint != nil && *g.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AzPGState or nil if AzPGState is nil.
+func (a *AzPGState) DeepCopy() *AzPGState {
+	if a == nil {
+		return nil
+	}
+	out := new(AzPGState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AzPGState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AzPGState) Equal(other *AzPGState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AzStorageState or nil if AzStorageState is nil.
+func (a *AzStorageState) DeepCopy() *AzStorageState {
+	if a == nil {
+		return nil
+	}
+	out := new(AzStorageState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AzStorageState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AzStorageState) Equal(other *AzStorageState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AzLBState or nil if AzLBState is nil.
+func (a *AzLBState) DeepCopy() *AzLBState {
+	if a == nil {
+		return nil
+	}
+	out := new(AzLBState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AzLBState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AzLBState) Equal(other *AzLBState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of DnsState or nil if DnsState is nil.
+func (d *DnsState) DeepCopy() *DnsState {
+	if d == nil {
+		return nil
+	}
+	out := new(DnsState)
+	out.Status = d.Status
+	out.Config = d.Config.DeepCopy()
+	out.Output = d.Output.DeepCopy()
+	if d.AppliedFingerprint != nil {
+		x := *d.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether DnsState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (d *DnsState) Equal(other *DnsState) bool {
+	if d == nil || other == nil {
+		return d == other
+	}
+	if d.Status != other.Status {
+		return false
+	}
+	if !d.Config.Equal(other.Config) {
+		return false
+	}
+	if !d.Output.Equal(other.Output) {
+		return false
+	}
+	if (d.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || d.AppliedFingerprint != nil && *d.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of AzKVState or nil if AzKVState is nil.
+func (a *AzKVState) DeepCopy() *AzKVState {
+	if a == nil {
+		return nil
+	}
+	out := new(AzKVState)
+	out.Status = a.Status
+	out.Config = a.Config.DeepCopy()
+	out.Output = a.Output.DeepCopy()
+	if a.AppliedFingerprint != nil {
+		x := *a.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether AzKVState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (a *AzKVState) Equal(other *AzKVState) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if !a.Config.Equal(other.Config) {
+		return false
+	}
+	if !a.Output.Equal(other.Output) {
+		return false
+	}
+	if (a.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || a.AppliedFingerprint != nil && *a.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of K8sAddonsState or nil if K8sAddonsState is nil.
+func (k *K8sAddonsState) DeepCopy() *K8sAddonsState {
+	if k == nil {
+		return nil
+	}
+	out := new(K8sAddonsState)
+	out.Status = k.Status
+	out.Config = k.Config.DeepCopy()
+	out.Output = k.Output.DeepCopy()
+	if k.AppliedFingerprint != nil {
+		x := *k.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether K8sAddonsState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (k *K8sAddonsState) Equal(other *K8sAddonsState) bool {
+	if k == nil || other == nil {
+		return k == other
+	}
+	if k.Status != other.Status {
+		return false
+	}
+	if !k.Config.Equal(other.Config) {
+		return false
+	}
+	if !k.Output.Equal(other.Output) {
+		return false
+	}
+	if (k.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || k.AppliedFingerprint != nil && *k.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of MonitoringState or nil if MonitoringState is nil.
+func (m *MonitoringState) DeepCopy() *MonitoringState {
+	if m == nil {
+		return nil
+	}
+	out := new(MonitoringState)
+	out.Status = m.Status
+	out.Config = m.Config.DeepCopy()
+	out.Output = m.Output.DeepCopy()
+	if m.AppliedFingerprint != nil {
+		x := *m.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether MonitoringState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (m *MonitoringState) Equal(other *MonitoringState) bool {
+	if m == nil || other == nil {
+		return m == other
+	}
+	if m.Status != other.Status {
+		return false
+	}
+	if !m.Config.Equal(other.Config) {
+		return false
+	}
+	if !m.Output.Equal(other.Output) {
+		return false
+	}
+	if (m.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || m.AppliedFingerprint != nil && *m.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of BastionState or nil if BastionState is nil.
+func (b *BastionState) DeepCopy() *BastionState {
+	if b == nil {
+		return nil
+	}
+	out := new(BastionState)
+	out.Status = b.Status
+	out.Config = b.Config.DeepCopy()
+	out.Output = b.Output.DeepCopy()
+	if b.AppliedFingerprint != nil {
+		x := *b.AppliedFingerprint
+		out.AppliedFingerprint = &x
+	}
+	return out
+}
+
+// Equal reports whether BastionState and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (b *BastionState) Equal(other *BastionState) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Status != other.Status {
+		return false
+	}
+	if !b.Config.Equal(other.Config) {
+		return false
+	}
+	if !b.Output.Equal(other.Output) {
+		return false
+	}
+	if (b.AppliedFingerprint == nil) != (other.AppliedFingerprint == nil) || b.AppliedFingerprint != nil && *b.AppliedFingerprint != *other.AppliedFingerprint {
+		return false
+	}
+	return true
+}
+
+// DeepCopy returns deep copy of State or nil if State is nil.
+func (s *State) DeepCopy() *State {
+	if s == nil {
+		return nil
+	}
+	out := new(State)
+	if s.Kind != nil {
+		x := *s.Kind
+		out.Kind = &x
+	}
+	if s.Version != nil {
+		x := *s.Version
+		out.Version = &x
+	}
+	if s.Unused != nil {
+		out.Unused = make([]string, len(s.Unused))
+		copy(out.Unused, s.Unused)
+	}
+	out.AzBI = s.AzBI.DeepCopy()
+	out.AzKS = s.AzKS.DeepCopy()
+	out.Hi = s.Hi.DeepCopy()
+	out.AwsBI = s.AwsBI.DeepCopy()
+	out.AwsKS = s.AwsKS.DeepCopy()
+	out.GcpBI = s.GcpBI.DeepCopy()
+	out.AzPG = s.AzPG.DeepCopy()
+	out.AzStorage = s.AzStorage.DeepCopy()
+	out.AzLB = s.AzLB.DeepCopy()
+	out.Dns = s.Dns.DeepCopy()
+	out.AzKV = s.AzKV.DeepCopy()
+	out.K8sAddons = s.K8sAddons.DeepCopy()
+	out.Monitoring = s.Monitoring.DeepCopy()
+	out.Bastion = s.Bastion.DeepCopy()
+	return out
+}
+
+// Equal reports whether State and other are structurally equal. Fields that are not
+// serialized are ignored.
+func (s *State) Equal(other *State) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	if (s.Kind == nil) != (other.Kind == nil) || s.Kind != nil && *s.Kind != *other.Kind {
+		return false
+	}
+	if (s.Version == nil) != (other.Version == nil) || s.Version != nil && *s.Version != *other.Version {
+		return false
+	}
+	if !s.AzBI.Equal(other.AzBI) {
+		return false
+	}
+	if !s.AzKS.Equal(other.AzKS) {
+		return false
+	}
+	if !s.Hi.Equal(other.Hi) {
+		return false
+	}
+	if !s.AwsBI.Equal(other.AwsBI) {
+		return false
+	}
+	if !s.AwsKS.Equal(other.AwsKS) {
+		return false
+	}
+	if !s.GcpBI.Equal(other.GcpBI) {
+		return false
+	}
+	if !s.AzPG.Equal(other.AzPG) {
+		return false
+	}
+	if !s.AzStorage.Equal(other.AzStorage) {
+		return false
+	}
+	if !s.AzLB.Equal(other.AzLB) {
+		return false
+	}
+	if !s.Dns.Equal(other.Dns) {
+		return false
+	}
+	if !s.AzKV.Equal(other.AzKV) {
+		return false
+	}
+	if !s.K8sAddons.Equal(other.K8sAddons) {
+		return false
+	}
+	if !s.Monitoring.Equal(other.Monitoring) {
+		return false
+	}
+	if !s.Bastion.Equal(other.Bastion) {
+		return false
+	}
+	return true
+}
@@ -1,13 +1,30 @@
 package v0
 
+//go:generate go run ../../utils/generators/accessors
+//go:generate go run ../../utils/generators/deepcopy
+
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	awsks "github.com/epiphany-platform/e-structures/awsks/v0"
 	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
 	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	azkv "github.com/epiphany-platform/e-structures/azkv/v0"
+	azlb "github.com/epiphany-platform/e-structures/azlb/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	azstorage "github.com/epiphany-platform/e-structures/azstorage/v0"
+	bastion "github.com/epiphany-platform/e-structures/bastion/v0"
+	dns "github.com/epiphany-platform/e-structures/dns/v0"
+	gcpbi "github.com/epiphany-platform/e-structures/gcpbi/v0"
 	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	k8saddons "github.com/epiphany-platform/e-structures/k8saddons/v0"
+	monitoring "github.com/epiphany-platform/e-structures/monitoring/v0"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
 	"github.com/epiphany-platform/e-structures/utils/to"
 	"github.com/epiphany-platform/e-structures/utils/validators"
 	"github.com/go-playground/validator/v10"
@@ -18,7 +35,7 @@ type Status string
 
 const (
 	kind    = "state"
-	version = "v0.0.5"
+	version = "v0.0.16"
 
 	Initialized Status = "initialized"
 	Applied     Status = "applied"
@@ -26,95 +43,185 @@ const (
 )
 
 type AwsBIState struct {
-	Status Status        `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
-	Config *awsbi.Config `json:"config" validate:"omitempty"`
-	Output *awsbi.Output `json:"output" validate:"omitempty"`
+	Status             Status        `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *awsbi.Config `json:"config" validate:"omitempty"`
+	Output             *awsbi.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string       `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
 type HiState struct {
-	Status Status     `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
-	Config *hi.Config `json:"config" validate:"omitempty"`
+	Status             Status     `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *hi.Config `json:"config" validate:"omitempty"`
+	AppliedFingerprint *string    `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
-func (s *HiState) GetConfig() *hi.Config {
-	if s == nil {
-		return nil
-	}
-	return s.Config
+type AzBIState struct {
+	Status             Status       `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *azbi.Config `json:"config" validate:"omitempty"`
+	Output             *azbi.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string      `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
-type AzBIState struct {
-	Status Status       `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
-	Config *azbi.Config `json:"config" validate:"omitempty"`
-	Output *azbi.Output `json:"output" validate:"omitempty"`
+type AzKSState struct {
+	Status             Status       `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *azks.Config `json:"config" validate:"omitempty"`
+	Output             *azks.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string      `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
-func (s *AzBIState) GetConfig() *azbi.Config {
-	if s == nil {
-		return nil
-	}
-	return s.Config
+type AwsKSState struct {
+	Status             Status        `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *awsks.Config `json:"config" validate:"omitempty"`
+	Output             *awsks.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string       `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
-func (s *AzBIState) GetOutput() *azbi.Output {
-	if s == nil {
-		return nil
-	}
-	return s.Output
+type GcpBIState struct {
+	Status             Status        `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *gcpbi.Config `json:"config" validate:"omitempty"`
+	Output             *gcpbi.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string       `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
-type AzKSState struct {
-	Status Status       `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
-	Config *azks.Config `json:"config" validate:"omitempty"`
-	Output *azks.Output `json:"output" validate:"omitempty"`
+type AzPGState struct {
+	Status             Status       `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *azpg.Config `json:"config" validate:"omitempty"`
+	Output             *azpg.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string      `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
-func (s *AzKSState) GetConfig() *azks.Config {
-	if s == nil {
-		return nil
-	}
-	return s.Config
+type AzStorageState struct {
+	Status             Status            `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *azstorage.Config `json:"config" validate:"omitempty"`
+	Output             *azstorage.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string           `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
-func (s *AzKSState) GetOutput() *azks.Output {
-	if s == nil {
-		return nil
+type AzLBState struct {
+	Status             Status       `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *azlb.Config `json:"config" validate:"omitempty"`
+	Output             *azlb.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string      `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
+}
+
+type DnsState struct {
+	Status             Status      `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *dns.Config `json:"config" validate:"omitempty"`
+	Output             *dns.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string     `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
+}
+
+type AzKVState struct {
+	Status             Status       `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *azkv.Config `json:"config" validate:"omitempty"`
+	Output             *azkv.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string      `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
+}
+
+type K8sAddonsState struct {
+	Status             Status            `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *k8saddons.Config `json:"config" validate:"omitempty"`
+	Output             *k8saddons.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string           `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
+}
+
+// OutdatedReleases returns keys (namespace/name) of releases from Config that are not installed
+// according to Output or which installed chart version does not satisfy version constraint.
+func (s *K8sAddonsState) OutdatedReleases() ([]string, error) {
+	if s == nil || s.Config == nil {
+		return nil, nil
+	}
+	installed := make(map[string]string)
+	for _, r := range s.GetOutput().Releases {
+		installed[r.Key()] = r.GetChartVersionV()
+	}
+	var result []string
+	for _, r := range s.Config.GetParams().Releases {
+		v, ok := installed[r.Key()]
+		if !ok {
+			result = append(result, r.Key())
+			continue
+		}
+		matches, err := validators.CheckVersionConstraint(v, r.GetVersionV())
+		if err != nil {
+			return nil, fmt.Errorf("release %s: %w", r.Key(), err)
+		}
+		if !matches {
+			result = append(result, r.Key())
+		}
 	}
-	return s.Output
+	return result, nil
+}
+
+type MonitoringState struct {
+	Status             Status             `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *monitoring.Config `json:"config" validate:"omitempty"`
+	Output             *monitoring.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string            `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
+}
+
+type BastionState struct {
+	Status             Status          `json:"status" validate:"required,eq=initialized|eq=applied|eq=destroyed"`
+	Config             *bastion.Config `json:"config" validate:"omitempty"`
+	Output             *bastion.Output `json:"output" validate:"-"` // validated in moduleStateValidation
+	AppliedFingerprint *string         `json:"applied_fingerprint" validate:"omitempty,startswith=sha256:,len=71"`
 }
 
 type State struct {
-	Kind    *string     `json:"kind" validate:"required,eq=state"`
-	Version *string     `json:"version" validate:"required,version=~0"`
-	Unused  []string    `json:"-"`
-	AzBI    *AzBIState  `json:"azbi" validate:"omitempty"`
-	AzKS    *AzKSState  `json:"azks" validate:"omitempty"`
-	Hi      *HiState    `json:"hi" validate:"omitempty"`
-	AwsBI   *AwsBIState `json:"awsbi" validate:"omitempty"`
+	Kind       *string          `json:"kind" validate:"required,eq=state"`
+	Version    *string          `json:"version" validate:"required,version=~0"`
+	Unused     []string         `json:"-"`
+	AzBI       *AzBIState       `json:"azbi" validate:"omitempty"`
+	AzKS       *AzKSState       `json:"azks" validate:"omitempty"`
+	Hi         *HiState         `json:"hi" validate:"omitempty"`
+	AwsBI      *AwsBIState      `json:"awsbi" validate:"omitempty"`
+	AwsKS      *AwsKSState      `json:"awsks" validate:"omitempty"`
+	GcpBI      *GcpBIState      `json:"gcpbi" validate:"omitempty"`
+	AzPG       *AzPGState       `json:"azpg" validate:"omitempty"`
+	AzStorage  *AzStorageState  `json:"azstorage" validate:"omitempty"`
+	AzLB       *AzLBState       `json:"azlb" validate:"omitempty"`
+	Dns        *DnsState        `json:"dns" validate:"omitempty"`
+	AzKV       *AzKVState       `json:"azkv" validate:"omitempty"`
+	K8sAddons  *K8sAddonsState  `json:"k8saddons" validate:"omitempty"`
+	Monitoring *MonitoringState `json:"monitoring" validate:"omitempty"`
+	Bastion    *BastionState    `json:"bastion" validate:"omitempty"`
 }
 
+// Deprecated: use GetAzBI.
 func (s *State) GetAzBIState() *AzBIState {
-	if s == nil {
-		return nil
-	}
-	return s.AzBI
+	return s.GetAzBI()
 }
 
+// Deprecated: use GetAzKS.
 func (s *State) GetAzKSState() *AzKSState {
-	if s == nil {
-		return nil
-	}
-	return s.AzKS
+	return s.GetAzKS()
 }
 
+// Deprecated: use GetHi.
 func (s *State) GetHiState() *HiState {
-	if s == nil {
-		return nil
+	return s.GetHi()
+}
+
+// K8sAddonsKubeConfig returns kubeconfig of cluster targeted by k8saddons module. It fails if
+// k8saddons module or cluster module output is missing in state.
+func (s *State) K8sAddonsKubeConfig() (string, error) {
+	cluster := s.GetK8sAddons().GetConfig().GetParams().GetClusterV()
+	switch cluster {
+	case "azks":
+		if o := s.GetAzKS().GetOutput(); o != nil && o.KubeConfig != nil {
+			return *o.KubeConfig, nil
+		}
+	case "awsks":
+		if o := s.GetAwsKS().GetOutput(); o != nil && o.KubeConfig != nil {
+			return *o.KubeConfig, nil
+		}
+	default:
+		return "", errors.New("k8saddons cluster is not set in state")
 	}
-	return s.Hi
+	return "", fmt.Errorf("%s output with kubeconfig not found in state", cluster)
 }
 
-//TODO test
+// TODO test
 func NewState() *State {
 	return &State{
 		Kind:    to.StrPtr(kind),
@@ -123,6 +230,14 @@ func NewState() *State {
 	}
 }
 
+// Redacted returns copy of State with values of sensitive fields of all modules configs and
+// outputs replaced, so it can be logged.
+func (s *State) Redacted() *State {
+	r := s.DeepCopy()
+	sensitive.Redact(r)
+	return r
+}
+
 func (s *State) Marshal() ([]byte, error) {
 	err := s.isValid()
 	if err != nil {
@@ -132,6 +247,27 @@ func (s *State) Marshal() ([]byte, error) {
 }
 
 func (s *State) Unmarshal(b []byte) (err error) {
+	if err = s.decode(b); err != nil {
+		return
+	}
+	err = s.isValid()
+	return
+}
+
+// UnmarshalStrict works like Unmarshal but fails with strict.UnknownKeysError before validation
+// if there are any keys in b not matching State structure.
+func (s *State) UnmarshalStrict(b []byte) (err error) {
+	if err = s.decode(b); err != nil {
+		return
+	}
+	if err = strict.Check(s, s.Unused); err != nil {
+		return
+	}
+	err = s.isValid()
+	return
+}
+
+func (s *State) decode(b []byte) (err error) {
 	var input map[string]interface{}
 	if err = json.Unmarshal(b, &input); err != nil {
 		return
@@ -150,7 +286,6 @@ func (s *State) Unmarshal(b []byte) (err error) {
 		return
 	}
 	s.Unused = md.Unused
-	err = s.isValid()
 	return
 }
 
@@ -163,6 +298,52 @@ func (s *State) isValid() error {
 	if err != nil {
 		return err
 	}
+	err = validate.RegisterValidation("kubeconfig", validators.IsKubeConfig)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("dnsname", dns.IsDnsName)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("dnstemplate", dns.IsDnsTemplate)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("versionconstraint", validators.IsVersionConstraint)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("semver", validators.IsSemVer)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("promduration", monitoring.IsDuration)
+	if err != nil {
+		return err
+	}
+	err = validate.RegisterValidation("labelname", monitoring.IsLabelName)
+	if err != nil {
+		return err
+	}
+	validate.RegisterStructValidation(moduleStateValidation,
+		AzBIState{}, AzKSState{}, AwsBIState{}, AwsKSState{}, GcpBIState{}, AzPGState{}, AzStorageState{},
+		AzLBState{}, DnsState{}, AzKVState{}, K8sAddonsState{}, MonitoringState{}, BastionState{})
+	validate.RegisterStructValidation(StateReferencesValidation, State{})
+	validate.RegisterStructValidation(azbi.AzBISubnetsValidation, azbi.Params{})
+	validate.RegisterStructValidation(azbi.AzBIOutputVmValidation, azbi.OutputVm{})
+	validate.RegisterStructValidation(awsbi.AwsBIParamsValidation, awsbi.Params{})
+	validate.RegisterStructValidation(gcpbi.GcpBIParamsValidation, gcpbi.Params{})
+	validate.RegisterStructValidation(azpg.AzPGParamsValidation, azpg.Params{})
+	validate.RegisterStructValidation(azstorage.AzStorageParamsValidation, azstorage.Params{})
+	validate.RegisterStructValidation(azstorage.AzStorageLifecycleRuleValidation, azstorage.LifecycleRule{})
+	validate.RegisterStructValidation(azlb.AzLBParamsValidation, azlb.Params{})
+	validate.RegisterStructValidation(azlb.AzLBFrontendValidation, azlb.Frontend{})
+	validate.RegisterStructValidation(dns.DnsRecordValidation, dns.Record{})
+	validate.RegisterStructValidation(dns.DnsZoneValidation, dns.Zone{})
+	validate.RegisterStructValidation(azkv.AzKVParamsValidation, azkv.Params{})
+	validate.RegisterStructValidation(k8saddons.K8sAddonsParamsValidation, k8saddons.Params{})
+	validate.RegisterStructValidation(monitoring.MonitoringParamsValidation, monitoring.Params{})
 	err = validate.Struct(s)
 	if err != nil {
 		if _, ok := err.(*validator.InvalidValidationError); ok {
@@ -173,29 +354,317 @@ func (s *State) isValid() error {
 	return nil
 }
 
-// DO NOT USE!!!
-// This is temporary function used to fix existing issue (https://github.com/epiphany-platform/e-structures/issues/10)
-// in some modules and will be removed shortly after issue is resolved in all modules
-func (s *State) UnmarshalDoNotUse(b []byte) error {
-	var input map[string]interface{}
-	if err := json.Unmarshal(b, &input); err != nil {
-		return err
+// StateReferencesValidation checks references between modules recorded in state. Validator keeps
+// only one struct level validation per type, so all cross module checks are called from here.
+func StateReferencesValidation(sl validator.StructLevel) {
+	AzPGSubnetsValidation(sl)
+	AzStorageSubnetsValidation(sl)
+	AzLBReferencesValidation(sl)
+	AzKVSubnetsValidation(sl)
+	MonitoringVmGroupValidation(sl)
+	BastionSubnetValidation(sl)
+}
+
+// AzPGSubnetsValidation checks that azpg VNet rules refer only to subnets defined in azbi config,
+// if both modules are present in state.
+func AzPGSubnetsValidation(sl validator.StructLevel) {
+	s := sl.Current().Interface().(State)
+	params := s.GetAzPG().GetConfig().GetParams()
+	subnets := azbiSubnetNames(s)
+	if params == nil || subnets == nil {
+		return
 	}
-	var md maps.Metadata
-	d, err := maps.NewDecoder(&maps.DecoderConfig{
-		Metadata: &md,
-		TagName:  "json",
-		Result:   &s,
-	})
+	for i, rule := range params.VnetRules {
+		if rule.SubnetName != nil && !subnets[*rule.SubnetName] {
+			sl.ReportError(
+				params.VnetRules[i].SubnetName,
+				fmt.Sprintf("AzPG.Config.Params.VnetRules[%d].SubnetName", i),
+				"SubnetName",
+				"inazbisubnets",
+				"")
+		}
+	}
+}
+
+// AzStorageSubnetsValidation checks that azstorage network rules refer only to subnets defined in
+// azbi config, if both modules are present in state.
+func AzStorageSubnetsValidation(sl validator.StructLevel) {
+	s := sl.Current().Interface().(State)
+	rules := s.GetAzStorage().GetConfig().GetParams().GetNetworkRules()
+	subnets := azbiSubnetNames(s)
+	if rules == nil || subnets == nil {
+		return
+	}
+	for i, sn := range rules.SubnetNames {
+		if !subnets[sn] {
+			sl.ReportError(
+				rules.SubnetNames[i],
+				fmt.Sprintf("AzStorage.Config.Params.NetworkRules.SubnetNames[%d]", i),
+				fmt.Sprintf("SubnetNames[%d]", i),
+				"inazbisubnets",
+				"")
+		}
+	}
+}
+
+// AzLBReferencesValidation checks that azlb backend pools refer only to VM groups and private
+// frontends only to subnets defined in azbi config, if both modules are present in state.
+func AzLBReferencesValidation(sl validator.StructLevel) {
+	s := sl.Current().Interface().(State)
+	lb := s.GetAzLB().GetConfig().GetParams()
+	params := s.GetAzBI().GetConfig().GetParams()
+	if lb == nil || params == nil {
+		return
+	}
+	vmGroups := make(map[string]bool)
+	for _, vmGroup := range params.VmGroups {
+		if vmGroup.Name != nil {
+			vmGroups[*vmGroup.Name] = true
+		}
+	}
+	subnets := azbiSubnetNames(s)
+	for i, pool := range lb.BackendPools {
+		for j, name := range pool.VmGroupNames {
+			if !vmGroups[name] {
+				sl.ReportError(
+					lb.BackendPools[i].VmGroupNames[j],
+					fmt.Sprintf("AzLB.Config.Params.BackendPools[%d].VmGroupNames[%d]", i, j),
+					fmt.Sprintf("VmGroupNames[%d]", j),
+					"inazbivmgroups",
+					"")
+			}
+		}
+	}
+	for i, frontend := range lb.Frontends {
+		if frontend.SubnetName != nil && !subnets[*frontend.SubnetName] {
+			sl.ReportError(
+				lb.Frontends[i].SubnetName,
+				fmt.Sprintf("AzLB.Config.Params.Frontends[%d].SubnetName", i),
+				"SubnetName",
+				"inazbisubnets",
+				"")
+		}
+	}
+}
+
+// AzKVSubnetsValidation checks that azkv network ACLs refer only to subnets defined in azbi
+// config, if both modules are present in state.
+func AzKVSubnetsValidation(sl validator.StructLevel) {
+	s := sl.Current().Interface().(State)
+	acls := s.GetAzKV().GetConfig().GetParams().GetNetworkAcls()
+	subnets := azbiSubnetNames(s)
+	if acls == nil || subnets == nil {
+		return
+	}
+	for i, sn := range acls.SubnetNames {
+		if !subnets[sn] {
+			sl.ReportError(
+				acls.SubnetNames[i],
+				fmt.Sprintf("AzKV.Config.Params.NetworkAcls.SubnetNames[%d]", i),
+				fmt.Sprintf("SubnetNames[%d]", i),
+				"inazbisubnets",
+				"")
+		}
+	}
+}
+
+// MonitoringVmGroupValidation checks that monitoring stack is placed on VM group defined in hi
+// config, if both modules are present in state.
+func MonitoringVmGroupValidation(sl validator.StructLevel) {
+	s := sl.Current().Interface().(State)
+	params := s.GetMonitoring().GetConfig().GetParams()
+	hiParams := s.GetHi().GetConfig().GetParams()
+	if params == nil || params.VmGroupName == nil || hiParams == nil {
+		return
+	}
+	for _, g := range hiParams.VmGroups {
+		if g.Name != nil && *g.Name == *params.VmGroupName {
+			return
+		}
+	}
+	sl.ReportError(
+		params.VmGroupName,
+		"Monitoring.Config.Params.VmGroupName",
+		"VmGroupName",
+		"inhivmgroups",
+		"")
+}
+
+// BastionSubnetValidation checks that bastion is placed in public subnet defined in awsbi config
+// or in subnet defined in azbi config, depending on provider, if both modules are present in state.
+// Bastion subnet cannot be used by VM groups of those modules, as other VMs accept SSH connections
+// from whole bastion subnet.
+func BastionSubnetValidation(sl validator.StructLevel) {
+	s := sl.Current().Interface().(State)
+	params := s.GetBastion().GetConfig().GetParams()
+	if params == nil || params.SubnetName == nil {
+		return
+	}
+	var subnets map[string]bool
+	vmGroupSubnets := make(map[string]bool)
+	var tag string
+	switch params.GetProviderV() {
+	case "aws":
+		awsbiParams := s.GetAwsBI().GetConfig().GetParams()
+		if awsbiParams == nil {
+			return
+		}
+		subnets = make(map[string]bool)
+		for _, subnet := range awsbiParams.GetSubnets().Public {
+			subnets[subnet.GetNameV()] = true
+		}
+		for _, vmGroup := range awsbiParams.VmGroups {
+			for _, n := range vmGroup.SubnetNames {
+				vmGroupSubnets[n] = true
+			}
+		}
+		tag = "inawsbipublicsubnets"
+	case "azure":
+		subnets = azbiSubnetNames(s)
+		for _, vmGroup := range s.GetAzBI().GetConfig().GetParams().GetVmGroups() {
+			for _, n := range vmGroup.SubnetNames {
+				vmGroupSubnets[n] = true
+			}
+		}
+		tag = "inazbisubnets"
+	}
+	if subnets == nil {
+		return
+	}
+	if !subnets[*params.SubnetName] {
+		sl.ReportError(
+			params.SubnetName,
+			"Bastion.Config.Params.SubnetName",
+			"SubnetName",
+			tag,
+			"")
+	} else if vmGroupSubnets[*params.SubnetName] {
+		sl.ReportError(
+			params.SubnetName,
+			"Bastion.Config.Params.SubnetName",
+			"SubnetName",
+			"notinvmgroupsubnets",
+			"")
+	}
+}
+
+// azbiSubnetNames returns set of names of subnets defined in azbi config or nil if there is no
+// azbi config in state.
+func azbiSubnetNames(s State) map[string]bool {
+	params := s.GetAzBI().GetConfig().GetParams()
+	if params == nil {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, subnet := range params.Subnets {
+		if subnet.Name != nil {
+			names[*subnet.Name] = true
+		}
+	}
+	return names
+}
+
+// moduleStateValidation requires and validates Output of module state only after module was
+// applied. It is registered for all module state types having Status and Output fields.
+func moduleStateValidation(sl validator.StructLevel) {
+	switch s := sl.Current().Interface().(type) {
+	case AwsBIState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case AzBIState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case AzKSState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case AwsKSState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case GcpBIState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case AzPGState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case AzStorageState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case AzLBState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case DnsState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case AzKVState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case K8sAddonsState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case MonitoringState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	case BastionState:
+		reportOutputErrors(sl, s.Status, s.Output == nil, s.Output)
+	}
+}
+
+func reportOutputErrors(sl validator.StructLevel, status Status, isNil bool, output interface{}) {
+	if status != Applied {
+		return
+	}
+	if isNil {
+		sl.ReportError(output, "Output", "Output", "required", "")
+		return
+	}
+	err := sl.Validator().Struct(output)
 	if err != nil {
-		return err
+		if e, ok := err.(validator.ValidationErrors); ok {
+			// namespaces of e start with name of output type, so it is replaced with name of field
+			for _, fe := range e {
+				sl.ReportError(
+					fe.Value(),
+					outputNamespace(fe.Namespace()),
+					outputNamespace(fe.StructNamespace()),
+					fe.Tag(),
+					fe.Param())
+			}
+		} else {
+			sl.ReportError(output, "Output", "Output", "fatal", "")
+		}
 	}
-	err = d.Decode(input)
+}
+
+// outputNamespace returns namespace of error found in output relative to module state.
+func outputNamespace(ns string) string {
+	if i := strings.Index(ns, "."); i >= 0 {
+		return "Output" + ns[i:]
+	}
+	return "Output"
+}
+
+// Fingerprinter is implemented by configs of all modules.
+type Fingerprinter interface {
+	Fingerprint() (string, error)
+}
+
+// ConfigChanged reports whether fingerprint of config differs from applied one, i.e. the one
+// stored in AppliedFingerprint field of module state. It returns true if no fingerprint was
+// applied yet.
+func ConfigChanged(config Fingerprinter, applied *string) (bool, error) {
+	if applied == nil {
+		return true, nil
+	}
+	fp, err := config.Fingerprint()
 	if err != nil {
-		return err
+		return false, err
 	}
-	s.Unused = md.Unused
-	return nil
+	return fp != *applied, nil
+}
+
+// AppliedFingerprint returns fingerprint of config to be stored in AppliedFingerprint field of
+// module state after module was applied.
+func AppliedFingerprint(config Fingerprinter) (*string, error) {
+	fp, err := config.Fingerprint()
+	if err != nil {
+		return nil, err
+	}
+	return &fp, nil
+}
+
+// DO NOT USE!!!
+// This is temporary function used to fix existing issue (https://github.com/epiphany-platform/e-structures/issues/10)
+// in some modules and will be removed shortly after issue is resolved in all modules
+func (s *State) UnmarshalDoNotUse(b []byte) error {
+	return s.decode(b)
 }
 
 // DO NOT USE!!!
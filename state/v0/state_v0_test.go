@@ -1,9 +1,21 @@
 package v0
 
 import (
+	"strconv"
 	"testing"
 
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	awsks "github.com/epiphany-platform/e-structures/awsks/v0"
 	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	azkv "github.com/epiphany-platform/e-structures/azkv/v0"
+	azlb "github.com/epiphany-platform/e-structures/azlb/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	azstorage "github.com/epiphany-platform/e-structures/azstorage/v0"
+	bastion "github.com/epiphany-platform/e-structures/bastion/v0"
+	gcpbi "github.com/epiphany-platform/e-structures/gcpbi/v0"
+	k8saddons "github.com/epiphany-platform/e-structures/k8saddons/v0"
+	monitoring "github.com/epiphany-platform/e-structures/monitoring/v0"
 	"github.com/epiphany-platform/e-structures/utils/test"
 	"github.com/epiphany-platform/e-structures/utils/to"
 	"github.com/go-playground/validator/v10"
@@ -232,41 +244,1941 @@ func TestState_Load(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := &State{}
-			err := got.Unmarshal(tt.args)
+			stateLoadTestingBody(t, tt.args, tt.want, tt.wantErr)
+		})
+	}
+}
 
-			if tt.wantErr != nil {
-				if err != nil {
-					if _, ok := err.(*validator.InvalidValidationError); ok {
-						t.Fatal(err)
-					}
-					errs := err.(validator.ValidationErrors)
-					if len(errs) != len(tt.wantErr.(test.TestValidationErrors)) {
-						t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), tt.wantErr.Error())
-					}
-					for _, e := range errs {
-						found := false
-						for _, we := range tt.wantErr.(test.TestValidationErrors) {
-							if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
-								found = true
-								break
-							}
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: epiphany
+  cluster:
+    server: https://epiphany-12345678.hcp.northeurope.azmk8s.io:443
+users:
+- name: clusterAdmin_epiphany-rg_epiphany
+contexts:
+- name: epiphany
+  context:
+    cluster: epiphany
+    user: clusterAdmin_epiphany-rg_epiphany
+current-context: epiphany
+`
+
+func TestState_Load_Outputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []byte
+		want    *State
+		wantErr error
+	}{
+		{
+			name: "malformed output ignored when not applied",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "initialized",
+		"output": {
+			"vm_groups": [
+				{
+					"vm_group_name": "vm-group0",
+					"vms": [
+						{
+							"vm_name": "epiphany-vm-group0-0",
+							"private_ips": ["10.0.1.400"]
 						}
-						if !found {
-							t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), tt.wantErr.Error())
+					]
+				}
+			]
+		}
+	}
+}`),
+			want: &State{
+				Kind:    to.StrPtr("state"),
+				Version: to.StrPtr("0.0.5"),
+				Unused:  []string{},
+				AzBI: &AzBIState{
+					Status: Initialized,
+					Output: &azbi.Output{
+						VmGroups: []azbi.OutputVmGroup{
+							{
+								Name: to.StrPtr("vm-group0"),
+								Vms: []azbi.OutputVm{
+									{
+										Name:       to.StrPtr("epiphany-vm-group0-0"),
+										PrivateIps: []string{"10.0.1.400"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "correct applied outputs",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "applied",
+		"output": {
+			"rg_name": "epiphany-rg",
+			"vnet_name": "epiphany-vnet",
+			"vm_groups": [
+				{
+					"vm_group_name": "vm-group0",
+					"vms": [
+						{
+							"vm_name": "epiphany-vm-group0-0",
+							"private_ips": ["10.0.1.4"],
+							"public_ip": "20.54.10.1",
+							"data_disks": [
+								{
+									"size": 10,
+									"lun": 10
+								},
+								{
+									"size": 10,
+									"lun": 11
+								}
+							]
 						}
-					}
-				} else {
-					t.Errorf("No errors got. All expected errors: \n%s", tt.wantErr.Error())
+					]
+				}
+			]
+		}
+	},
+	"azks": {
+		"status": "applied",
+		"output": {
+			"kubeconfig": ` + strconv.Quote(testKubeConfig) + `
+		}
+	},
+	"awsbi": {
+		"status": "applied",
+		"output": {
+			"vpc_id": "vpc-0123456789",
+			"private_subnet_ids": ["subnet-0123456789"],
+			"public_subnet_ids": [],
+			"private_route_table": "rtb-0123456789",
+			"vm_groups": [
+				{
+					"name": "vm-group0",
+					"vms": [
+						{
+							"name": "vm-group0-1",
+							"public_ip": "",
+							"private_ip": "10.1.1.10",
+							"data_disks": [
+								{
+									"size": 16,
+									"device_name": "/dev/sdf"
+								}
+							]
+						}
+					]
+				}
+			]
+		}
+	}
+}`),
+			want: &State{
+				Kind:    to.StrPtr("state"),
+				Version: to.StrPtr("0.0.5"),
+				Unused:  []string{},
+				AzBI: &AzBIState{
+					Status: Applied,
+					Output: &azbi.Output{
+						RgName:   to.StrPtr("epiphany-rg"),
+						VnetName: to.StrPtr("epiphany-vnet"),
+						VmGroups: []azbi.OutputVmGroup{
+							{
+								Name: to.StrPtr("vm-group0"),
+								Vms: []azbi.OutputVm{
+									{
+										Name:       to.StrPtr("epiphany-vm-group0-0"),
+										PrivateIps: []string{"10.0.1.4"},
+										PublicIp:   to.StrPtr("20.54.10.1"),
+										DataDisks: []azbi.OutputDataDisk{
+											{
+												Size: to.IntPtr(10),
+												Lun:  to.IntPtr(10),
+											},
+											{
+												Size: to.IntPtr(10),
+												Lun:  to.IntPtr(11),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				AzKS: &AzKSState{
+					Status: Applied,
+					Output: &azks.Output{
+						KubeConfig: to.StrPtr(testKubeConfig),
+					},
+				},
+				AwsBI: &AwsBIState{
+					Status: Applied,
+					Output: &awsbi.Output{
+						VpcId:             to.StrPtr("vpc-0123456789"),
+						PrivateSubnetIds:  []string{"subnet-0123456789"},
+						PublicSubnetIds:   []string{},
+						PrivateRouteTable: to.StrPtr("rtb-0123456789"),
+						VmGroups: []awsbi.OutputVmGroup{
+							{
+								Name: to.StrPtr("vm-group0"),
+								Vms: []awsbi.OutputVm{
+									{
+										Name:      to.StrPtr("vm-group0-1"),
+										PublicIp:  to.StrPtr(""),
+										PrivateIp: to.StrPtr("10.1.1.10"),
+										DataDisks: []awsbi.OutputDataDisk{
+											{
+												Size:       to.IntPtr(16),
+												DeviceName: to.StrPtr("/dev/sdf"),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "missing outputs when applied",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "applied"
+	},
+	"azks": {
+		"status": "applied"
+	},
+	"awsbi": {
+		"status": "applied"
+	},
+	"awsks": {
+		"status": "applied"
+	},
+	"gcpbi": {
+		"status": "applied"
+	},
+	"azpg": {
+		"status": "applied"
+	},
+	"azstorage": {
+		"status": "applied"
+	},
+	"azlb": {
+		"status": "applied"
+	},
+	"dns": {
+		"status": "applied"
+	},
+	"azkv": {
+		"status": "applied"
+	},
+	"k8saddons": {
+		"status": "applied"
+	},
+	"monitoring": {
+		"status": "applied"
+	},
+	"bastion": {
+		"status": "applied"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzBI.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzKS.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsBI.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsKS.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.GcpBI.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzPG.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzStorage.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzLB.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.Dns.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzKV.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.K8sAddons.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.Monitoring.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.Bastion.Output",
+					Field: "Output",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "azbi output without names",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "applied",
+		"output": {
+			"vm_groups": [
+				{
+					"vms": [
+						{
+							"private_ips": ["10.0.1.4"]
+						}
+					]
 				}
-			} else {
-				if diff := cmp.Diff(tt.want, got); diff != "" {
-					t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.RgName",
+					Field: "Output.RgName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VnetName",
+					Field: "Output.VnetName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Name",
+					Field: "Output.VmGroups[0].Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].Name",
+					Field: "Output.VmGroups[0].Vms[0].Name",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "azbi output incorrect ips",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "applied",
+		"output": {
+			"rg_name": "epiphany-rg",
+			"vnet_name": "epiphany-vnet",
+			"vm_groups": [
+				{
+					"vm_group_name": "vm-group0",
+					"vms": [
+						{
+							"vm_name": "epiphany-vm-group0-0",
+							"private_ips": ["10.0.1.4", "10.0.1.400", ""],
+							"public_ip": "123.234.345.456"
+						}
+					]
 				}
-				if err != nil {
-					t.Errorf("Unmarshal() unexpected error occured: %v", err)
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].PrivateIps[1]",
+					Field: "Output.VmGroups[0].Vms[0].PrivateIps[1]",
+					Tag:   "ip",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].PrivateIps[2]",
+					Field: "Output.VmGroups[0].Vms[0].PrivateIps[2]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].PublicIp",
+					Field: "Output.VmGroups[0].Vms[0].PublicIp",
+					Tag:   "eq=|ip",
+				},
+			},
+		},
+		{
+			name: "azbi output incorrect data disks",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "applied",
+		"output": {
+			"rg_name": "epiphany-rg",
+			"vnet_name": "epiphany-vnet",
+			"vm_groups": [
+				{
+					"vm_group_name": "vm-group0",
+					"vms": [
+						{
+							"vm_name": "epiphany-vm-group0-0",
+							"private_ips": ["10.0.1.4"],
+							"data_disks": [
+								{
+									"size": 0,
+									"lun": 10
+								},
+								{
+									"size": 10,
+									"lun": 10
+								},
+								{
+									"size": 10,
+									"lun": 64
+								},
+								{
+									"size": 10
+								}
+							]
+						}
+					]
 				}
-			}
-		})
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].DataDisks[0].Size",
+					Field: "Output.VmGroups[0].Vms[0].DataDisks[0].Size",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].DataDisks[1].Lun",
+					Field: "Output.VmGroups[0].Vms[0].DataDisks[1].Lun",
+					Tag:   "unique",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].DataDisks[2].Lun",
+					Field: "Output.VmGroups[0].Vms[0].DataDisks[2].Lun",
+					Tag:   "max",
+				},
+				test.TestValidationError{
+					Key:   "State.AzBI.Output.VmGroups[0].Vms[0].DataDisks[3].Lun",
+					Field: "Output.VmGroups[0].Vms[0].DataDisks[3].Lun",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "azks output incorrect kubeconfig",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azks": {
+		"status": "applied",
+		"output": {
+			"kubeconfig": "apiVersion: v1\nkind: Config\nclusters: ["
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzKS.Output.KubeConfig",
+					Field: "Output.KubeConfig",
+					Tag:   "kubeconfig",
+				},
+			},
+		},
+		{
+			name: "azks output kubeconfig without clusters",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azks": {
+		"status": "applied",
+		"output": {
+			"kubeconfig": "apiVersion: v1\nkind: Config\nclusters: []"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzKS.Output.KubeConfig",
+					Field: "Output.KubeConfig",
+					Tag:   "kubeconfig",
+				},
+			},
+		},
+		{
+			name: "azks output kubeconfig secret reference",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azks": {
+		"status": "applied",
+		"output": {
+			"kubeconfig": "file:///shared/kubeconfig"
+		}
+	}
+}`),
+			want: &State{
+				Kind:    to.StrPtr("state"),
+				Version: to.StrPtr("0.0.5"),
+				Unused:  []string{},
+				AzKS: &AzKSState{
+					Status: Applied,
+					Output: &azks.Output{
+						KubeConfig: to.StrPtr("file:///shared/kubeconfig"),
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "awsbi output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"awsbi": {
+		"status": "applied",
+		"output": {
+			"private_subnet_ids": [""],
+			"vm_groups": [
+				{
+					"name": "vm-group0",
+					"vms": [
+						{
+							"name": "vm-group0-1",
+							"public_ip": "1.2.3",
+							"data_disks": [
+								{
+									"size": 16
+								}
+							]
+						}
+					]
+				}
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AwsBI.Output.VpcId",
+					Field: "Output.VpcId",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsBI.Output.PrivateSubnetIds[0]",
+					Field: "Output.PrivateSubnetIds[0]",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsBI.Output.VmGroups[0].Vms[0].PublicIp",
+					Field: "Output.VmGroups[0].Vms[0].PublicIp",
+					Tag:   "eq=|ip",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsBI.Output.VmGroups[0].Vms[0].PrivateIp",
+					Field: "Output.VmGroups[0].Vms[0].PrivateIp",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsBI.Output.VmGroups[0].Vms[0].DataDisks[0].DeviceName",
+					Field: "Output.VmGroups[0].Vms[0].DataDisks[0].DeviceName",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "awsks output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"awsks": {
+		"status": "applied",
+		"output": {
+			"endpoint": "not a url",
+			"kubeconfig": "apiVersion: v1\nkind: Config\nclusters: []"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AwsKS.Output.ClusterName",
+					Field: "Output.ClusterName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsKS.Output.Endpoint",
+					Field: "Output.Endpoint",
+					Tag:   "url",
+				},
+				test.TestValidationError{
+					Key:   "State.AwsKS.Output.KubeConfig",
+					Field: "Output.KubeConfig",
+					Tag:   "kubeconfig",
+				},
+			},
+		},
+		{
+			name: "gcpbi output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"gcpbi": {
+		"status": "applied",
+		"output": {
+			"vm_groups": [
+				{
+					"vms": [
+						{
+							"name": "vm0",
+							"zone": "europe-west1-b",
+							"public_ip": "not an ip",
+							"private_ip": "10.2.1.300"
+						}
+					]
+				}
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.GcpBI.Output.NetworkName",
+					Field: "Output.NetworkName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.GcpBI.Output.VmGroups[0].Name",
+					Field: "Output.VmGroups[0].Name",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.GcpBI.Output.VmGroups[0].Vms[0].PublicIp",
+					Field: "Output.VmGroups[0].Vms[0].PublicIp",
+					Tag:   "eq=|ip",
+				},
+				test.TestValidationError{
+					Key:   "State.GcpBI.Output.VmGroups[0].Vms[0].PrivateIp",
+					Field: "Output.VmGroups[0].Vms[0].PrivateIp",
+					Tag:   "ip",
+				},
+			},
+		},
+		{
+			name: "azpg output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azpg": {
+		"status": "applied",
+		"output": {
+			"server_name": "epiphany-pg",
+			"fqdn": "not a fqdn",
+			"admin_login": "operations"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzPG.Output.Fqdn",
+					Field: "Output.Fqdn",
+					Tag:   "fqdn",
+				},
+				test.TestValidationError{
+					Key:   "State.AzPG.Output.AdminPassword",
+					Field: "Output.AdminPassword",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "azpg vnet rules with subnet unknown to azbi",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "initialized",
+		"config": {
+			"kind": "azbi",
+			"version": "v0.1.4",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"address_space": ["10.0.0.0/16"],
+				"subnets": [
+					{
+						"name": "main",
+						"address_prefixes": ["10.0.1.0/24"]
+					}
+				],
+				"vm_groups": [],
+				"rsa_pub_path": "/shared/vms_rsa.pub"
+			}
+		}
+	},
+	"azpg": {
+		"status": "initialized",
+		"config": {
+			"kind": "azpg",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"rg_name": "epiphany-rg",
+				"server_name": "epiphany-pg",
+				"postgres_version": "11",
+				"sku": {
+					"name": "GP_Gen5_2",
+					"storage_mb": 5120
+				},
+				"backup": {
+					"retention_days": 7,
+					"geo_redundant": false,
+					"auto_grow_enabled": true
+				},
+				"ssl_enforcement": true,
+				"admin_login": "operations",
+				"admin_password": "env://AZPG_ADMIN_PASSWORD",
+				"vnet_rules": [
+					{
+						"name": "epiphany-vnet-main",
+						"vnet_name": "epiphany-vnet",
+						"subnet_name": "main"
+					},
+					{
+						"name": "epiphany-vnet-other",
+						"vnet_name": "epiphany-vnet",
+						"subnet_name": "other"
+					}
+				],
+				"firewall_rules": []
+			}
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzPG.Config.Params.VnetRules[1].SubnetName",
+					Field: "AzPG.Config.Params.VnetRules[1].SubnetName",
+					Tag:   "inazbisubnets",
+				},
+			},
+		},
+		{
+			name: "azstorage output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azstorage": {
+		"status": "applied",
+		"output": {
+			"account_name": "epiphanystorage",
+			"endpoints": {
+				"blob": "not a url"
+			},
+			"containers": [
+				{
+					"name": "tfstate"
+				}
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzStorage.Output.Endpoints.Blob",
+					Field: "Output.Endpoints.Blob",
+					Tag:   "url",
+				},
+				test.TestValidationError{
+					Key:   "State.AzStorage.Output.Containers[0].Url",
+					Field: "Output.Containers[0].Url",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "azstorage network rules with subnet unknown to azbi",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "initialized",
+		"config": {
+			"kind": "azbi",
+			"version": "v0.1.4",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"address_space": ["10.0.0.0/16"],
+				"subnets": [
+					{
+						"name": "main",
+						"address_prefixes": ["10.0.1.0/24"]
+					}
+				],
+				"vm_groups": [],
+				"rsa_pub_path": "/shared/vms_rsa.pub"
+			}
+		}
+	},
+	"azstorage": {
+		"status": "initialized",
+		"config": {
+			"kind": "azstorage",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"rg_name": "epiphany-rg",
+				"account_name": "epiphanystorage",
+				"account_tier": "Standard",
+				"replication_type": "LRS",
+				"enable_https_traffic_only": true,
+				"min_tls_version": "TLS1_2",
+				"network_rules": {
+					"default_action": "Deny",
+					"vnet_name": "epiphany-vnet",
+					"subnet_names": ["main", "other"]
+				}
+			}
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzStorage.Config.Params.NetworkRules.SubnetNames[1]",
+					Field: "AzStorage.Config.Params.NetworkRules.SubnetNames[1]",
+					Tag:   "inazbisubnets",
+				},
+			},
+		},
+		{
+			name: "azlb output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azlb": {
+		"status": "applied",
+		"output": {
+			"frontends": [
+				{
+					"name": "public",
+					"ip": "10.0.1"
+				}
+			],
+			"backend_pools": [
+				{
+					"vm_names": ["vm0"]
+				}
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzLB.Output.LbName",
+					Field: "Output.LbName",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzLB.Output.Frontends[0].Ip",
+					Field: "Output.Frontends[0].Ip",
+					Tag:   "ip",
+				},
+				test.TestValidationError{
+					Key:   "State.AzLB.Output.BackendPools[0].Name",
+					Field: "Output.BackendPools[0].Name",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "azlb config referencing elements unknown to azbi",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "initialized",
+		"config": {
+			"kind": "azbi",
+			"version": "v0.1.4",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"address_space": ["10.0.0.0/16"],
+				"subnets": [
+					{
+						"name": "main",
+						"address_prefixes": ["10.0.1.0/24"]
+					}
+				],
+				"vm_groups": [
+					{
+						"name": "vm-group0",
+						"vm_count": 1,
+						"vm_size": "Standard_DS2_v2",
+						"use_public_ip": false,
+						"subnet_names": ["main"],
+						"vm_image": {
+							"publisher": "Canonical",
+							"offer": "UbuntuServer",
+							"sku": "18.04-LTS",
+							"version": "18.04.202006101"
+						},
+						"data_disks": []
+					}
+				],
+				"rsa_pub_path": "/shared/vms_rsa.pub"
+			}
+		}
+	},
+	"azlb": {
+		"status": "initialized",
+		"config": {
+			"kind": "azlb",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"rg_name": "epiphany-rg",
+				"sku": "Standard",
+				"frontends": [
+					{
+						"name": "internal",
+						"type": "private",
+						"subnet_name": "other"
+					}
+				],
+				"backend_pools": [
+					{
+						"name": "web",
+						"vm_group_names": ["vm-group0", "vm-group1"]
+					}
+				]
+			}
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzLB.Config.Params.BackendPools[0].VmGroupNames[1]",
+					Field: "AzLB.Config.Params.BackendPools[0].VmGroupNames[1]",
+					Tag:   "inazbivmgroups",
+				},
+				test.TestValidationError{
+					Key:   "State.AzLB.Config.Params.Frontends[0].SubnetName",
+					Field: "AzLB.Config.Params.Frontends[0].SubnetName",
+					Tag:   "inazbisubnets",
+				},
+			},
+		},
+		{
+			name: "dns output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"dns": {
+		"status": "applied",
+		"output": {
+			"zones": [
+				{
+					"name": "env.example.com",
+					"name_servers": ["ns1-01.azure-dns.com", "not a name"],
+					"records": [
+						{
+							"fqdn": "vm0.env.example.com",
+							"type": "AAAA",
+							"values": ["::1"]
+						}
+					]
+				}
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Dns.Output.Zones[0].NameServers[1]",
+					Field: "Output.Zones[0].NameServers[1]",
+					Tag:   "fqdn",
+				},
+				test.TestValidationError{
+					Key:   "State.Dns.Output.Zones[0].Records[0].Type",
+					Field: "Output.Zones[0].Records[0].Type",
+					Tag:   "eq=A|eq=CNAME|eq=TXT",
+				},
+			},
+		},
+		{
+			name: "dns config incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"dns": {
+		"status": "initialized",
+		"config": {
+			"kind": "dns",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"provider": "azure",
+				"azure": {
+					"rg_name": "epiphany-rg"
+				},
+				"zones": [
+					{
+						"name": "env.example.com",
+						"private": false,
+						"records": [
+							{
+								"name": "www_1",
+								"type": "A",
+								"ttl": 300,
+								"values": ["10.0.0"]
+							}
+						],
+						"vm_records": [
+							{
+								"template": "{{vm_id}}",
+								"use_public_ip": false,
+								"ttl": 60
+							}
+						]
+					}
+				]
+			}
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Dns.Config.Params.Zones[0].Records[0].Name",
+					Field: "Name",
+					Tag:   "dnsname",
+				},
+				test.TestValidationError{
+					Key:   "State.Dns.Config.Params.Zones[0].Records[0].Values[0]",
+					Field: "Values[0]",
+					Tag:   "ipv4",
+				},
+				test.TestValidationError{
+					Key:   "State.Dns.Config.Params.Zones[0].VmRecords[0].Template",
+					Field: "Template",
+					Tag:   "dnstemplate",
+				},
+			},
+		},
+		{
+			name: "azkv output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azkv": {
+		"status": "applied",
+		"output": {
+			"vault_uri": "epiphany-kv.vault.azure.net",
+			"secrets": [
+				{
+					"name": "kubeconfig",
+					"id": "not a url"
+				}
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzKV.Output.VaultId",
+					Field: "Output.VaultId",
+					Tag:   "required",
+				},
+				test.TestValidationError{
+					Key:   "State.AzKV.Output.VaultUri",
+					Field: "Output.VaultUri",
+					Tag:   "url",
+				},
+				test.TestValidationError{
+					Key:   "State.AzKV.Output.Secrets[0].Id",
+					Field: "Output.Secrets[0].Id",
+					Tag:   "url",
+				},
+			},
+		},
+		{
+			name: "azkv network acls with subnet unknown to azbi",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "initialized",
+		"config": {
+			"kind": "azbi",
+			"version": "v0.1.4",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"address_space": ["10.0.0.0/16"],
+				"subnets": [
+					{
+						"name": "main",
+						"address_prefixes": ["10.0.1.0/24"]
+					}
+				],
+				"vm_groups": [],
+				"rsa_pub_path": "/shared/vms_rsa.pub"
+			}
+		}
+	},
+	"azkv": {
+		"status": "initialized",
+		"config": {
+			"kind": "azkv",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"rg_name": "epiphany-rg",
+				"vault_name": "epiphany-kv",
+				"tenant_id": "00000000-0000-0000-0000-000000000000",
+				"sku": "standard",
+				"soft_delete_retention_days": 90,
+				"purge_protection": false,
+				"network_acls": {
+					"default_action": "Deny",
+					"bypass": "AzureServices",
+					"vnet_name": "epiphany-vnet",
+					"subnet_names": ["other", "main"]
+				}
+			}
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzKV.Config.Params.NetworkAcls.SubnetNames[0]",
+					Field: "AzKV.Config.Params.NetworkAcls.SubnetNames[0]",
+					Tag:   "inazbisubnets",
+				},
+			},
+		},
+		{
+			name: "k8saddons config and output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"k8saddons": {
+		"status": "applied",
+		"config": {
+			"kind": "k8saddons",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"cluster": "azks",
+				"releases": [
+					{
+						"name": "ingress-nginx",
+						"namespace": "ingress-nginx",
+						"create_namespace": true,
+						"chart": "ingress-nginx",
+						"repository": "https://kubernetes.github.io/ingress-nginx",
+						"version": "latest",
+						"values": {
+							"controller": {
+								"replicaCount": 2
+							}
+						}
+					}
+				]
+			}
+		},
+		"output": {
+			"releases": [
+				{
+					"name": "ingress-nginx",
+					"namespace": "ingress-nginx",
+					"chart": "ingress-nginx",
+					"chart_version": "three",
+					"revision": 0,
+					"status": "running"
+				}
+			]
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.K8sAddons.Config.Params.Releases[0].Version",
+					Field: "Version",
+					Tag:   "versionconstraint",
+				},
+				test.TestValidationError{
+					Key:   "State.K8sAddons.Output.Releases[0].ChartVersion",
+					Field: "Output.Releases[0].ChartVersion",
+					Tag:   "semver",
+				},
+				test.TestValidationError{
+					Key:   "State.K8sAddons.Output.Releases[0].Revision",
+					Field: "Output.Releases[0].Revision",
+					Tag:   "min",
+				},
+				test.TestValidationError{
+					Key:   "State.K8sAddons.Output.Releases[0].Status",
+					Field: "Output.Releases[0].Status",
+					Tag:   "eq=deployed|eq=failed|eq=pending-install|eq=pending-upgrade|eq=pending-rollback|eq=superseded|eq=uninstalling|eq=uninstalled|eq=unknown",
+				},
+			},
+		},
+		{
+			name: "monitoring config and output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"monitoring": {
+		"status": "applied",
+		"config": {
+			"kind": "monitoring",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"vm_group_name": "vm-group0",
+				"prometheus": {
+					"port": 9090,
+					"scrape_interval": "30 seconds",
+					"evaluation_interval": "30s",
+					"retention_time": "15d",
+					"external_labels": [
+						{
+							"name": "__environment",
+							"value": "production"
+						}
+					]
+				},
+				"grafana": {
+					"port": 3000,
+					"admin_user": "admin",
+					"admin_password": "env://GRAFANA_ADMIN_PASSWORD"
+				}
+			}
+		},
+		"output": {
+			"prometheus_url": "10.0.1.4:9090"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Monitoring.Config.Params.Prometheus.ScrapeInterval",
+					Field: "ScrapeInterval",
+					Tag:   "promduration",
+				},
+				test.TestValidationError{
+					Key:   "State.Monitoring.Config.Params.Prometheus.ExternalLabels[0].Name",
+					Field: "Name",
+					Tag:   "labelname",
+				},
+				test.TestValidationError{
+					Key:   "State.Monitoring.Output.PrometheusUrl",
+					Field: "Output.PrometheusUrl",
+					Tag:   "url",
+				},
+				test.TestValidationError{
+					Key:   "State.Monitoring.Output.GrafanaUrl",
+					Field: "Output.GrafanaUrl",
+					Tag:   "required",
+				},
+			},
+		},
+		{
+			name: "monitoring vm group unknown to hi",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"hi": {
+		"status": "initialized",
+		"config": {
+			"kind": "hi",
+			"version": "v0.0.1",
+			"params": {
+				"vm_groups": [
+					{
+						"name": "vm-group0",
+						"admin_user": "operations",
+						"hosts": [
+							{
+								"name": "epiphany-vm-group0-1",
+								"ip": "10.0.1.4"
+							}
+						]
+					}
+				],
+				"rsa_private_path": "/shared/vms_rsa"
+			}
+		}
+	},
+	"monitoring": {
+		"status": "initialized",
+		"config": {
+			"kind": "monitoring",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"vm_group_name": "vm-group1",
+				"prometheus": {
+					"port": 9090,
+					"scrape_interval": "30s",
+					"evaluation_interval": "30s",
+					"retention_time": "15d"
+				},
+				"grafana": {
+					"port": 3000,
+					"admin_user": "admin",
+					"admin_password": "env://GRAFANA_ADMIN_PASSWORD"
+				}
+			}
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Monitoring.Config.Params.VmGroupName",
+					Field: "Monitoring.Config.Params.VmGroupName",
+					Tag:   "inhivmgroups",
+				},
+			},
+		},
+		{
+			name: "bastion output incorrect values",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"bastion": {
+		"status": "applied",
+		"output": {
+			"vm_name": "epiphany-bastion",
+			"public_ip": "",
+			"private_ip": "10.0.0.300"
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Bastion.Output.PublicIp",
+					Field: "Output.PublicIp",
+					Tag:   "ip",
+				},
+				test.TestValidationError{
+					Key:   "State.Bastion.Output.PrivateIp",
+					Field: "Output.PrivateIp",
+					Tag:   "ip",
+				},
+			},
+		},
+		{
+			name: "bastion subnet unknown to azbi",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.5",
+	"azbi": {
+		"status": "initialized",
+		"config": {
+			"kind": "azbi",
+			"version": "v0.1.4",
+			"params": {
+				"name": "epiphany",
+				"location": "northeurope",
+				"address_space": ["10.0.0.0/16"],
+				"subnets": [
+					{
+						"name": "main",
+						"address_prefixes": ["10.0.1.0/24"]
+					}
+				],
+				"vm_groups": [],
+				"rsa_pub_path": "/shared/vms_rsa.pub"
+			}
+		}
+	},
+	"bastion": {
+		"status": "initialized",
+		"config": {
+			"kind": "bastion",
+			"version": "v0.0.1",
+			"params": {
+				"name": "epiphany",
+				"provider": "azure",
+				"subnet_name": "bastion",
+				"allowed_cidrs": ["203.0.113.0/24"],
+				"vm_size": "Standard_B1s",
+				"rsa_pub_path": "/shared/vms_rsa.pub"
+			}
+		}
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Bastion.Config.Params.SubnetName",
+					Field: "Bastion.Config.Params.SubnetName",
+					Tag:   "inazbisubnets",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateLoadTestingBody(t, tt.args, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func stateLoadTestingBody(t *testing.T, args []byte, want *State, wantErr error) {
+	got := &State{}
+	err := got.Unmarshal(args)
+
+	if wantErr != nil {
+		validationErrorsTestingBody(t, err, wantErr)
+	} else {
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+		}
+		if err != nil {
+			t.Errorf("Unmarshal() unexpected error occured: %v", err)
+		}
+	}
+}
+
+func validationErrorsTestingBody(t *testing.T, err error, wantErr error) {
+	if err == nil {
+		t.Errorf("No errors got. All expected errors: \n%s", wantErr.Error())
+		return
+	}
+	if _, ok := err.(*validator.InvalidValidationError); ok {
+		t.Fatal(err)
+	}
+	errs := err.(validator.ValidationErrors)
+	if len(errs) != len(wantErr.(test.TestValidationErrors)) {
+		t.Fatalf("incorrect length of found errors. Got: \n%s\nExpected: \n%s", errs.Error(), wantErr.Error())
+	}
+	for _, e := range errs {
+		found := false
+		for _, we := range wantErr.(test.TestValidationErrors) {
+			if we.Key == e.Namespace() && we.Tag == e.Tag() && we.Field == e.Field() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Got unknown error:\n%s\nAll expected errors: \n%s", e.Error(), wantErr.Error())
+		}
+	}
+}
+
+func TestConfigChanged(t *testing.T) {
+	c := azbi.NewConfig()
+	changed, err := ConfigChanged(c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Error("ConfigChanged() expected to return true when no fingerprint was applied")
+	}
+	applied, err := AppliedFingerprint(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed, err = ConfigChanged(c, applied)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("ConfigChanged() expected to return false for applied fingerprint")
+	}
+	c.Unused = []string{"params.unknown"}
+	changed, err = ConfigChanged(c, applied)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed {
+		t.Error("ConfigChanged() expected to ignore Unused keys")
+	}
+	c.Params.Name = to.StrPtr("other")
+	changed, err = ConfigChanged(c, applied)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed {
+		t.Error("ConfigChanged() expected to return true after config modification")
+	}
+}
+
+func TestState_Load_AppliedFingerprint(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []byte
+		want    *State
+		wantErr error
+	}{
+		{
+			name: "correct fingerprint",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.6",
+	"hi": {
+		"status": "initialized",
+		"applied_fingerprint": "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	}
+}`),
+			want: &State{
+				Kind:    to.StrPtr("state"),
+				Version: to.StrPtr("0.0.6"),
+				Unused:  []string{},
+				Hi: &HiState{
+					Status:             Initialized,
+					AppliedFingerprint: to.StrPtr("sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"),
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "incorrect fingerprint",
+			args: []byte(`{
+	"kind": "state",
+	"version": "0.0.6",
+	"hi": {
+		"status": "initialized",
+		"applied_fingerprint": "md5:d41d8cd98f00b204e9800998ecf8427e"
+	}
+}`),
+			want: nil,
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Hi.AppliedFingerprint",
+					Field: "AppliedFingerprint",
+					Tag:   "startswith",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateLoadTestingBody(t, tt.args, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestState_Redacted(t *testing.T) {
+	s := NewState()
+	s.AzKS = &AzKSState{
+		Status: Applied,
+		Output: &azks.Output{
+			KubeConfig: to.StrPtr(testKubeConfig),
+		},
+	}
+	r := s.Redacted()
+	if got := r.GetAzKS().GetOutput().GetKubeConfigV(); got != "<redacted>" {
+		t.Errorf("Redacted() kubeconfig = %s, want <redacted>", got)
+	}
+	if got := s.GetAzKS().GetOutput().GetKubeConfigV(); got != testKubeConfig {
+		t.Errorf("Redacted() modified original state, kubeconfig = %s", got)
+	}
+}
+
+func TestState_K8sAddonsKubeConfig(t *testing.T) {
+	withCluster := func(cluster string) *K8sAddonsState {
+		c := k8saddons.NewConfig()
+		c.Params.Cluster = to.StrPtr(cluster)
+		return &K8sAddonsState{Status: Initialized, Config: c}
+	}
+	tests := []struct {
+		name    string
+		state   *State
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "no k8saddons module",
+			state:   &State{},
+			wantErr: true,
+		},
+		{
+			name: "azks output missing",
+			state: &State{
+				AzKS:      &AzKSState{Status: Initialized},
+				K8sAddons: withCluster("azks"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "azks cluster",
+			state: &State{
+				AzKS:      &AzKSState{Status: Applied, Output: &azks.Output{KubeConfig: to.StrPtr("azks kubeconfig")}},
+				AwsKS:     &AwsKSState{Status: Applied, Output: &awsks.Output{KubeConfig: to.StrPtr("awsks kubeconfig")}},
+				K8sAddons: withCluster("azks"),
+			},
+			want: "azks kubeconfig",
+		},
+		{
+			name: "awsks cluster",
+			state: &State{
+				AzKS:      &AzKSState{Status: Applied, Output: &azks.Output{KubeConfig: to.StrPtr("azks kubeconfig")}},
+				AwsKS:     &AwsKSState{Status: Applied, Output: &awsks.Output{KubeConfig: to.StrPtr("awsks kubeconfig")}},
+				K8sAddons: withCluster("awsks"),
+			},
+			want: "awsks kubeconfig",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.state.K8sAddonsKubeConfig()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("K8sAddonsKubeConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("K8sAddonsKubeConfig() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestK8sAddonsState_OutdatedReleases(t *testing.T) {
+	s := &K8sAddonsState{
+		Status: Applied,
+		Config: k8saddons.NewConfig(),
+		Output: &k8saddons.Output{
+			Releases: []k8saddons.OutputRelease{
+				{
+					Name:         to.StrPtr("ingress-nginx"),
+					Namespace:    to.StrPtr("ingress-nginx"),
+					Chart:        to.StrPtr("ingress-nginx"),
+					ChartVersion: to.StrPtr("3.23.0"),
+					Revision:     to.IntPtr(1),
+					Status:       to.StrPtr("deployed"),
+				},
+				{
+					Name:         to.StrPtr("cert-manager"),
+					Namespace:    to.StrPtr("cert-manager"),
+					Chart:        to.StrPtr("cert-manager"),
+					ChartVersion: to.StrPtr("v1.1.0"),
+					Revision:     to.IntPtr(3),
+					Status:       to.StrPtr("deployed"),
+				},
+			},
+		},
+	}
+	s.Config.Params.Releases = append(s.Config.Params.Releases, k8saddons.Release{
+		Name:            to.StrPtr("prometheus"),
+		Namespace:       to.StrPtr("monitoring"),
+		CreateNamespace: to.BooPtr(true),
+		Chart:           to.StrPtr("prometheus"),
+		Repository:      to.StrPtr("https://prometheus-community.github.io/helm-charts"),
+		Version:         to.StrPtr("~14.1"),
+	})
+	got, err := s.OutdatedReleases()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"cert-manager/cert-manager", "monitoring/prometheus"}, got); diff != "" {
+		t.Errorf("OutdatedReleases() mismatch (-want +got):\n%s", diff)
+	}
+	s.Output.Releases[1].ChartVersion = to.StrPtr("1.2.3")
+	s.Config.Params.Releases = s.Config.Params.Releases[:2]
+	got, err = s.OutdatedReleases()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 0 {
+		t.Errorf("OutdatedReleases() = %v, want no releases", got)
+	}
+}
+
+func TestState_BastionSubnetValidation_AwsBI(t *testing.T) {
+	s := NewState()
+	s.AwsBI = &AwsBIState{Status: Initialized, Config: awsbi.NewConfig()}
+	s.Bastion = &BastionState{Status: Initialized, Config: bastion.NewConfig()}
+	if _, err := s.Marshal(); err != nil {
+		t.Fatalf("Marshal() unexpected error occurred: %v", err)
+	}
+	s.Bastion.Config.Params.SubnetName = to.StrPtr("first_private_subnet")
+	_, err := s.Marshal()
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Tag() != "inawsbipublicsubnets" {
+		t.Errorf("Marshal() expected to fail on inawsbipublicsubnets, got %v", err)
+	}
+	s.Bastion.Config.Params.SubnetName = to.StrPtr("first_public_subnet")
+	s.AwsBI.Config.Params.VmGroups[0].SubnetNames = []string{"first_public_subnet"}
+	_, err = s.Marshal()
+	errs, ok = err.(validator.ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Tag() != "notinvmgroupsubnets" {
+		t.Errorf("Marshal() expected to fail on notinvmgroupsubnets, got %v", err)
+	}
+}
+
+func TestState_Marshal_ParamsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		mutate  func(s *State)
+		wantErr error
+	}{
+		{
+			name: "azbi vm group in unknown subnet",
+			mutate: func(s *State) {
+				s.AzBI = &AzBIState{Status: Initialized, Config: azbi.NewConfig()}
+				s.AzBI.Config.Params.VmGroups[0].SubnetNames = []string{"unknown"}
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzBI.Config.Params.VmGroups[0].SubnetNames[0]",
+					Field: "VmGroups[0].SubnetNames[0]",
+					Tag:   "insubnets",
+				},
+			},
+		},
+		{
+			name: "awsbi vm group in unknown subnet",
+			mutate: func(s *State) {
+				s.AwsBI = &AwsBIState{Status: Initialized, Config: awsbi.NewConfig()}
+				s.AwsBI.Config.Params.VmGroups[0].SubnetNames = []string{"unknown"}
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AwsBI.Config.Params.VmGroups[0].SubnetNames[0]",
+					Field: "VmGroups[0].SubnetNames[0]",
+					Tag:   "insubnets",
+				},
+			},
+		},
+		{
+			name: "gcpbi vm group in unknown subnet",
+			mutate: func(s *State) {
+				s.GcpBI = &GcpBIState{Status: Initialized, Config: gcpbi.NewConfig()}
+				s.GcpBI.Config.Params.VmGroups[0].SubnetName = to.StrPtr("unknown")
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.GcpBI.Config.Params.VmGroups[0].SubnetName",
+					Field: "VmGroups[0].SubnetName",
+					Tag:   "insubnets",
+				},
+			},
+		},
+		{
+			name: "azpg too short admin password",
+			mutate: func(s *State) {
+				s.AzPG = &AzPGState{Status: Initialized, Config: azpg.NewConfig()}
+				s.AzPG.Config.Params.AdminPassword = to.StrPtr("short")
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzPG.Config.Params.AdminPassword",
+					Field: "AdminPassword",
+					Tag:   "min",
+				},
+			},
+		},
+		{
+			name: "azstorage duplicated container names",
+			mutate: func(s *State) {
+				s.AzStorage = &AzStorageState{Status: Initialized, Config: azstorage.NewConfig()}
+				s.AzStorage.Config.Params.Containers[1].Name = to.StrPtr("tfstate")
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzStorage.Config.Params.Containers[1].Name",
+					Field: "Containers[1].Name",
+					Tag:   "unique",
+				},
+			},
+		},
+		{
+			name: "azstorage lifecycle rule archiving before cooling",
+			mutate: func(s *State) {
+				s.AzStorage = &AzStorageState{Status: Initialized, Config: azstorage.NewConfig()}
+				s.AzStorage.Config.Params.LifecycleRules[0].TierToArchiveAfterDays = to.IntPtr(10)
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzStorage.Config.Params.LifecycleRules[0].TierToArchiveAfterDays",
+					Field: "TierToArchiveAfterDays",
+					Tag:   "gtefield",
+				},
+			},
+		},
+		{
+			name: "azlb rules with duplicated frontend port",
+			mutate: func(s *State) {
+				s.AzLB = &AzLBState{Status: Initialized, Config: azlb.NewConfig()}
+				rule := *s.AzLB.Config.Params.Rules[0].DeepCopy()
+				rule.Name = to.StrPtr("http2")
+				s.AzLB.Config.Params.Rules = append(s.AzLB.Config.Params.Rules, rule)
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzLB.Config.Params.Rules[1].FrontendPort",
+					Field: "Rules[1].FrontendPort",
+					Tag:   "unique",
+				},
+			},
+		},
+		{
+			name: "azlb public frontend with subnet",
+			mutate: func(s *State) {
+				s.AzLB = &AzLBState{Status: Initialized, Config: azlb.NewConfig()}
+				s.AzLB.Config.Params.Frontends[0].SubnetName = to.StrPtr("main")
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzLB.Config.Params.Frontends[0].SubnetName",
+					Field: "SubnetName",
+					Tag:   "excluded",
+				},
+			},
+		},
+		{
+			name: "azkv incorrect vault name",
+			mutate: func(s *State) {
+				s.AzKV = &AzKVState{Status: Initialized, Config: azkv.NewConfig()}
+				s.AzKV.Config.Params.VaultName = to.StrPtr("epiphany--kv")
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.AzKV.Config.Params.VaultName",
+					Field: "VaultName",
+					Tag:   "vaultname",
+				},
+			},
+		},
+		{
+			name: "k8saddons incorrect release name",
+			mutate: func(s *State) {
+				s.K8sAddons = &K8sAddonsState{Status: Initialized, Config: k8saddons.NewConfig()}
+				s.K8sAddons.Config.Params.Releases[0].Name = to.StrPtr("Ingress_Nginx")
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.K8sAddons.Config.Params.Releases[0].Name",
+					Field: "Releases[0].Name",
+					Tag:   "releasename",
+				},
+			},
+		},
+		{
+			name: "monitoring duplicated job names",
+			mutate: func(s *State) {
+				s.Monitoring = &MonitoringState{Status: Initialized, Config: monitoring.NewConfig()}
+				job := *s.Monitoring.Config.Params.ScrapeConfigs[0].DeepCopy()
+				s.Monitoring.Config.Params.ScrapeConfigs = append(s.Monitoring.Config.Params.ScrapeConfigs, job)
+			},
+			wantErr: test.TestValidationErrors{
+				test.TestValidationError{
+					Key:   "State.Monitoring.Config.Params.ScrapeConfigs[1].JobName",
+					Field: "ScrapeConfigs[1].JobName",
+					Tag:   "unique",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewState()
+			tt.mutate(s)
+			_, err := s.Marshal()
+			validationErrorsTestingBody(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestState_BastionSubnetValidation_AzBI(t *testing.T) {
+	s := NewState()
+	s.AzBI = &AzBIState{Status: Initialized, Config: azbi.NewConfig()}
+	s.Bastion = &BastionState{Status: Initialized, Config: bastion.NewConfig()}
+	s.Bastion.Config.Params.Provider = to.StrPtr("azure")
+	s.Bastion.Config.Params.SubnetName = to.StrPtr("main")
+	_, err := s.Marshal()
+	errs, ok := err.(validator.ValidationErrors)
+	if !ok || len(errs) != 1 || errs[0].Tag() != "notinvmgroupsubnets" {
+		t.Errorf("Marshal() expected to fail on notinvmgroupsubnets, got %v", err)
+	}
+	s.AzBI.Config.Params.Subnets = append(s.AzBI.Config.Params.Subnets, azbi.Subnet{
+		Name:            to.StrPtr("bastion"),
+		AddressPrefixes: []string{"10.0.0.0/28"},
+	})
+	s.Bastion.Config.Params.SubnetName = to.StrPtr("bastion")
+	if _, err = s.Marshal(); err != nil {
+		t.Errorf("Marshal() unexpected error occurred: %v", err)
 	}
 }
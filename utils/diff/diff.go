@@ -0,0 +1,152 @@
+// Package diff compares JSON documents structurally, so differences in formatting and key order
+// are not reported.
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+type Operation string
+
+const (
+	Added   Operation = "added"
+	Removed Operation = "removed"
+	Changed Operation = "changed"
+)
+
+// Change is single difference between documents. Path is JSON path of changed value
+// (i.e. params.vm_groups[0].name), Old is nil for added values and New is nil for removed ones.
+type Change struct {
+	Path      string      `json:"path"`
+	Operation Operation   `json:"operation"`
+	Old       interface{} `json:"old,omitempty"`
+	New       interface{} `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	switch c.Operation {
+	case Added:
+		return fmt.Sprintf("+ %s: %s", c.Path, format(c.New))
+	case Removed:
+		return fmt.Sprintf("- %s: %s", c.Path, format(c.Old))
+	default:
+		return fmt.Sprintf("~ %s: %s -> %s", c.Path, format(c.Old), format(c.New))
+	}
+}
+
+// Compare returns changes needed to turn JSON document a into JSON document b, sorted by path.
+// Objects are compared key by key and arrays element by element. Keys with null value are
+// treated the same as missing keys.
+func Compare(a, b []byte) ([]Change, error) {
+	va, err := decode(a)
+	if err != nil {
+		return nil, err
+	}
+	vb, err := decode(b)
+	if err != nil {
+		return nil, err
+	}
+	var result []Change
+	compare("", va, vb, &result)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result, nil
+}
+
+// String returns one line per change in human readable form.
+func String(changes []Change) string {
+	var lines []string
+	for _, c := range changes {
+		lines = append(lines, c.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func compare(path string, a, b interface{}, result *[]Change) {
+	switch {
+	case a == nil && b == nil:
+		return
+	case a == nil:
+		*result = append(*result, Change{Path: path, Operation: Added, New: b})
+		return
+	case b == nil:
+		*result = append(*result, Change{Path: path, Operation: Removed, Old: a})
+		return
+	}
+
+	ma, aIsMap := a.(map[string]interface{})
+	mb, bIsMap := b.(map[string]interface{})
+	if aIsMap && bIsMap {
+		keys := make(map[string]struct{})
+		for k := range ma {
+			keys[k] = struct{}{}
+		}
+		for k := range mb {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			compare(join(path, k), ma[k], mb[k], result)
+		}
+		return
+	}
+
+	sa, aIsSlice := a.([]interface{})
+	sb, bIsSlice := b.([]interface{})
+	if aIsSlice && bIsSlice {
+		for i := 0; i < len(sa) || i < len(sb); i++ {
+			var ea, eb interface{}
+			if i < len(sa) {
+				ea = sa[i]
+			}
+			if i < len(sb) {
+				eb = sb[i]
+			}
+			p := fmt.Sprintf("%s[%d]", path, i)
+			if ea == nil && i >= len(sa) {
+				*result = append(*result, Change{Path: p, Operation: Added, New: eb})
+			} else if eb == nil && i >= len(sb) {
+				*result = append(*result, Change{Path: p, Operation: Removed, Old: ea})
+			} else {
+				compare(p, ea, eb, result)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(a, b) {
+		*result = append(*result, Change{Path: path, Operation: Changed, Old: a, New: b})
+	}
+}
+
+func decode(b []byte) (interface{}, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func format(v interface{}) string {
+	var b bytes.Buffer
+	e := json.NewEncoder(&b)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
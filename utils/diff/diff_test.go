@@ -0,0 +1,118 @@
+package diff
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		want    []Change
+		wantErr bool
+	}{
+		{
+			name: "formatting and key order are ignored",
+			a:    `{"kind": "azbi", "params": {"name": "epiphany", "location": "northeurope"}}`,
+			b: `{
+	"params": {
+		"location": "northeurope",
+		"name": "epiphany"
+	},
+	"kind": "azbi"
+}`,
+			want: nil,
+		},
+		{
+			name: "changed value",
+			a:    `{"params": {"location": "northeurope"}}`,
+			b:    `{"params": {"location": "westeurope"}}`,
+			want: []Change{
+				{Path: "params.location", Operation: Changed, Old: "northeurope", New: "westeurope"},
+			},
+		},
+		{
+			name: "added and removed keys",
+			a:    `{"params": {"name": "epiphany", "rsa_pub_path": "/shared/vms_rsa.pub"}}`,
+			b:    `{"params": {"name": "epiphany", "location": "northeurope"}}`,
+			want: []Change{
+				{Path: "params.location", Operation: Added, New: "northeurope"},
+				{Path: "params.rsa_pub_path", Operation: Removed, Old: "/shared/vms_rsa.pub"},
+			},
+		},
+		{
+			name: "null is the same as missing key",
+			a:    `{"applied_fingerprint": null}`,
+			b:    `{}`,
+			want: nil,
+		},
+		{
+			name: "array elements",
+			a:    `{"address_space": ["10.0.0.0/16", "10.1.0.0/16"]}`,
+			b:    `{"address_space": ["10.0.0.0/16", "10.2.0.0/16", "10.3.0.0/16"]}`,
+			want: []Change{
+				{Path: "address_space[1]", Operation: Changed, Old: "10.1.0.0/16", New: "10.2.0.0/16"},
+				{Path: "address_space[2]", Operation: Added, New: "10.3.0.0/16"},
+			},
+		},
+		{
+			name: "nested objects in arrays",
+			a:    `{"vm_groups": [{"name": "vm-group0", "vm_count": 1}]}`,
+			b:    `{"vm_groups": [{"name": "vm-group0", "vm_count": 3}]}`,
+			want: []Change{
+				{Path: "vm_groups[0].vm_count", Operation: Changed, Old: json.Number("1"), New: json.Number("3")},
+			},
+		},
+		{
+			name: "removed object",
+			a:    `{"azbi": {"status": "applied"}, "hi": null}`,
+			b:    `{"azbi": null, "hi": null}`,
+			want: []Change{
+				{Path: "azbi", Operation: Removed, Old: map[string]interface{}{"status": "applied"}},
+			},
+		},
+		{
+			name: "changed type",
+			a:    `{"vm_count": "1"}`,
+			b:    `{"vm_count": 1}`,
+			want: []Change{
+				{Path: "vm_count", Operation: Changed, Old: "1", New: json.Number("1")},
+			},
+		},
+		{
+			name:    "incorrect json",
+			a:       `{}`,
+			b:       `{"kind": `,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compare([]byte(tt.a), []byte(tt.b))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compare() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Compare() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	changes := []Change{
+		{Path: "params.location", Operation: Changed, Old: "northeurope", New: "westeurope"},
+		{Path: "params.subnets[1]", Operation: Added, New: map[string]interface{}{"name": "second"}},
+		{Path: "params.rsa_pub_path", Operation: Removed, Old: "/shared/vms_rsa.pub"},
+	}
+	want := `~ params.location: "northeurope" -> "westeurope"
++ params.subnets[1]: {"name":"second"}
+- params.rsa_pub_path: "/shared/vms_rsa.pub"`
+	if got := String(changes); got != want {
+		t.Errorf("String() mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,61 @@
+package encryption
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+)
+
+// Encrypt encrypts values of all sensitive fields reachable from v (which has to be a pointer)
+// in place. Empty values, secret references and already encrypted values are left unchanged.
+func Encrypt(v interface{}, k *Keyring) error {
+	return sensitive.Walk(v, func(s string) (string, error) {
+		if s == "" || sensitive.IsReference(s) || IsEncrypted(s) {
+			return s, nil
+		}
+		return k.Encrypt(s)
+	})
+}
+
+// Decrypt decrypts values of all sensitive fields reachable from v (which has to be a pointer)
+// in place. Values that are not encrypted are left unchanged.
+func Decrypt(v interface{}, k *Keyring) error {
+	return sensitive.Walk(v, func(s string) (string, error) {
+		if !IsEncrypted(s) {
+			return s, nil
+		}
+		return k.Decrypt(s)
+	})
+}
+
+// Rotate rewraps data keys of all encrypted sensitive fields reachable from v (which has to be
+// a pointer) with primary key of keyring, so keys other than primary are not needed anymore.
+// Values are not decrypted.
+func Rotate(v interface{}, k *Keyring) error {
+	return sensitive.Walk(v, func(s string) (string, error) {
+		if !IsEncrypted(s) {
+			return s, nil
+		}
+		return k.Rewrap(s)
+	})
+}
+
+// DecryptJSON returns JSON document b of structure type t with encrypted values of sensitive
+// fields decrypted. If b contains no encrypted values it is returned unchanged.
+func DecryptJSON(t reflect.Type, b []byte, k *Keyring) ([]byte, error) {
+	if !bytes.Contains(b, []byte(prefix)) {
+		return b, nil
+	}
+	return sensitive.Transform(t, b, func(path, s string) (string, error) {
+		if !IsEncrypted(s) {
+			return s, nil
+		}
+		plaintext, err := k.Decrypt(s)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", path, err)
+		}
+		return plaintext, nil
+	})
+}
@@ -0,0 +1,130 @@
+package encryption
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type credentials struct {
+	User     *string  `json:"user"`
+	Password *string  `json:"password" sensitive:"true"`
+	Tokens   []string `json:"tokens" sensitive:"true"`
+}
+
+type document struct {
+	Name        *string      `json:"name"`
+	Credentials *credentials `json:"credentials"`
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestEncrypt(t *testing.T) {
+	k := newKeyring(t, 1)
+	d := &document{
+		Name: strPtr("name"),
+		Credentials: &credentials{
+			User:     strPtr("user"),
+			Password: strPtr("password"),
+			Tokens:   []string{"token", "", "env://TOKEN"},
+		},
+	}
+	if err := Encrypt(d, k); err != nil {
+		t.Fatalf("Encrypt() unexpected error occurred: %v", err)
+	}
+	if !IsEncrypted(*d.Credentials.Password) || !IsEncrypted(d.Credentials.Tokens[0]) {
+		t.Errorf("Encrypt() expected to encrypt sensitive values, got %v", d.Credentials)
+	}
+	if *d.Name != "name" || *d.Credentials.User != "user" || d.Credentials.Tokens[1] != "" || d.Credentials.Tokens[2] != "env://TOKEN" {
+		t.Errorf("Encrypt() expected to leave not sensitive, empty and reference values unchanged, got %v", d.Credentials)
+	}
+
+	encrypted := *d.Credentials.Password
+	if err := Encrypt(d, k); err != nil {
+		t.Fatal(err)
+	}
+	if *d.Credentials.Password != encrypted {
+		t.Errorf("Encrypt() expected to leave encrypted values unchanged")
+	}
+
+	if err := Decrypt(d, newKeyring(t, 1)); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt() with other key error = %v, want %v", err, ErrUnknownKey)
+	}
+	if err := Decrypt(d, k); err != nil {
+		t.Fatalf("Decrypt() unexpected error occurred: %v", err)
+	}
+	want := &document{
+		Name: strPtr("name"),
+		Credentials: &credentials{
+			User:     strPtr("user"),
+			Password: strPtr("password"),
+			Tokens:   []string{"token", "", "env://TOKEN"},
+		},
+	}
+	if diff := cmp.Diff(want, d); diff != "" {
+		t.Errorf("Decrypt() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	old := newKeyring(t, 1)
+	d := &document{Credentials: &credentials{Password: strPtr("password")}}
+	if err := Encrypt(d, old); err != nil {
+		t.Fatal(err)
+	}
+	rotated := newKeyring(t, 1)
+	rotated.keys = append(rotated.keys, old.keys[0])
+	if err := Rotate(d, rotated); err != nil {
+		t.Fatalf("Rotate() unexpected error occurred: %v", err)
+	}
+	if err := Decrypt(d, &Keyring{keys: rotated.keys[:1]}); err != nil {
+		t.Fatalf("Decrypt() with new key after Rotate() unexpected error occurred: %v", err)
+	}
+	if *d.Credentials.Password != "password" {
+		t.Errorf("Decrypt() after Rotate() password = %s, want password", *d.Credentials.Password)
+	}
+}
+
+func TestDecryptJSON(t *testing.T) {
+	k := newKeyring(t, 1)
+	typ := reflect.TypeOf(&document{})
+	d := &document{Name: strPtr("name"), Credentials: &credentials{Password: strPtr("s3cr3t")}}
+	if err := Encrypt(d, k); err != nil {
+		t.Fatal(err)
+	}
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "s3cr3t") {
+		t.Fatalf("encrypted document contains plaintext: %s", b)
+	}
+
+	got, err := DecryptJSON(typ, b, k)
+	if err != nil {
+		t.Fatalf("DecryptJSON() unexpected error occurred: %v", err)
+	}
+	decrypted := &document{}
+	if err = json.Unmarshal(got, decrypted); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&document{Name: strPtr("name"), Credentials: &credentials{Password: strPtr("s3cr3t")}}, decrypted); diff != "" {
+		t.Errorf("DecryptJSON() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = DecryptJSON(typ, b, newKeyring(t, 1))
+	if !errors.Is(err, ErrUnknownKey) || !strings.HasPrefix(err.Error(), "credentials.password: ") {
+		t.Errorf("DecryptJSON() with other key error = %v, want credentials.password: %v", err, ErrUnknownKey)
+	}
+
+	plain := []byte(`{"name": "name", "credentials": {"password": "s3cr3t"}}`)
+	if got, err = DecryptJSON(typ, plain, k); err != nil || string(got) != string(plain) {
+		t.Errorf("DecryptJSON() expected to return document without encrypted values unchanged, got %s, %v", got, err)
+	}
+}
@@ -0,0 +1,216 @@
+// Package encryption provides envelope encryption of values of sensitive fields (see package
+// sensitive) for documents stored in shared locations.
+//
+// Each value is encrypted with its own random data key using NaCl secretbox, and the data key is
+// encrypted (wrapped) with key from locally supplied key file. Encrypted value has form:
+//
+//	enc:v1:<key id>:<wrapped data key>:<ciphertext>
+//
+// Key file contains base64 encoded 32 byte keys, one per line. Empty lines and lines starting with
+// # are ignored. First key is primary and is used for encryption, all keys can be used for
+// decryption. To rotate keys, new key is put on top of key file and documents are re-encrypted
+// with Rotate, after which old key can be removed.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/crypto/nacl/secretbox"
+)
+
+const (
+	prefix    = "enc:v1:"
+	keySize   = 32
+	nonceSize = 24
+	idSize    = 8
+)
+
+var (
+	// ErrUnknownKey is returned when value was encrypted with key not present in keyring.
+	ErrUnknownKey = errors.New("value was encrypted with unknown key")
+	// ErrDecrypt is returned when value cannot be decrypted because it was modified or key is wrong.
+	ErrDecrypt = errors.New("value cannot be decrypted")
+	// ErrMalformed is returned when value has prefix of encrypted value but incorrect format.
+	ErrMalformed = errors.New("malformed encrypted value")
+)
+
+type key struct {
+	id     string
+	secret [keySize]byte
+}
+
+// Keyring is ordered set of keys. First key is primary.
+type Keyring struct {
+	keys []key
+}
+
+// IsEncrypted reports if s is value encrypted by this package.
+func IsEncrypted(s string) bool {
+	return strings.HasPrefix(s, prefix)
+}
+
+// GenerateKey returns new random key encoded to be put in key file.
+func GenerateKey() (string, error) {
+	var secret [keySize]byte
+	if _, err := io.ReadFull(rand.Reader, secret[:]); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(secret[:]), nil
+}
+
+// LoadKeyring reads keyring from key file at path.
+func LoadKeyring(path string) (*Keyring, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	k, err := ParseKeyring(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return k, nil
+}
+
+// ParseKeyring parses content of key file.
+func ParseKeyring(b []byte) (*Keyring, error) {
+	k := &Keyring{}
+	s := bufio.NewScanner(bytes.NewReader(b))
+	line := 0
+	for s.Scan() {
+		line++
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(text)
+		if err != nil || len(decoded) != keySize {
+			return nil, fmt.Errorf("line %d: key has to be base64 encoded %d bytes", line, keySize)
+		}
+		var secret [keySize]byte
+		copy(secret[:], decoded)
+		sum := sha256.Sum256(secret[:])
+		k.keys = append(k.keys, key{
+			id:     hex.EncodeToString(sum[:idSize]),
+			secret: secret,
+		})
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(k.keys) == 0 {
+		return nil, errors.New("no keys found")
+	}
+	return k, nil
+}
+
+// Encrypt encrypts plaintext with new data key wrapped with primary key.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	var dataKey [keySize]byte
+	if _, err := io.ReadFull(rand.Reader, dataKey[:]); err != nil {
+		return "", err
+	}
+	wrapped, err := seal(&k.keys[0].secret, dataKey[:])
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(&dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return format(k.keys[0].id, wrapped, ciphertext), nil
+}
+
+// Decrypt returns plaintext of encrypted value.
+func (k *Keyring) Decrypt(value string) (string, error) {
+	_, dataKey, ciphertext, err := k.unwrap(value)
+	if err != nil {
+		return "", err
+	}
+	plaintext, ok := open(&dataKey, ciphertext)
+	if !ok {
+		return "", ErrDecrypt
+	}
+	return string(plaintext), nil
+}
+
+// Rewrap returns encrypted value with its data key wrapped with primary key. Ciphertext of value
+// itself is not changed. Values already wrapped with primary key are returned unchanged.
+func (k *Keyring) Rewrap(value string) (string, error) {
+	id, dataKey, ciphertext, err := k.unwrap(value)
+	if err != nil {
+		return "", err
+	}
+	if id == k.keys[0].id {
+		return value, nil
+	}
+	wrapped, err := seal(&k.keys[0].secret, dataKey[:])
+	if err != nil {
+		return "", err
+	}
+	return format(k.keys[0].id, wrapped, ciphertext), nil
+}
+
+// unwrap parses encrypted value and returns id of key used to wrap data key, unwrapped data key
+// and ciphertext.
+func (k *Keyring) unwrap(value string) (string, [keySize]byte, []byte, error) {
+	var dataKey [keySize]byte
+	if !IsEncrypted(value) {
+		return "", dataKey, nil, ErrMalformed
+	}
+	parts := strings.Split(strings.TrimPrefix(value, prefix), ":")
+	if len(parts) != 3 {
+		return "", dataKey, nil, ErrMalformed
+	}
+	wrapped, err := base64.RawStdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", dataKey, nil, ErrMalformed
+	}
+	ciphertext, err := base64.RawStdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", dataKey, nil, ErrMalformed
+	}
+	for _, key := range k.keys {
+		if key.id != parts[0] {
+			continue
+		}
+		unwrapped, ok := open(&key.secret, wrapped)
+		if !ok || len(unwrapped) != keySize {
+			return "", dataKey, nil, ErrDecrypt
+		}
+		copy(dataKey[:], unwrapped)
+		return key.id, dataKey, ciphertext, nil
+	}
+	return "", dataKey, nil, ErrUnknownKey
+}
+
+func format(id string, wrapped, ciphertext []byte) string {
+	return prefix + id + ":" + base64.RawStdEncoding.EncodeToString(wrapped) + ":" + base64.RawStdEncoding.EncodeToString(ciphertext)
+}
+
+// seal encrypts message with random nonce, which is prepended to result.
+func seal(secret *[keySize]byte, message []byte) ([]byte, error) {
+	var nonce [nonceSize]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		return nil, err
+	}
+	return secretbox.Seal(nonce[:], message, &nonce, secret), nil
+}
+
+func open(secret *[keySize]byte, box []byte) ([]byte, bool) {
+	if len(box) < nonceSize+secretbox.Overhead {
+		return nil, false
+	}
+	var nonce [nonceSize]byte
+	copy(nonce[:], box[:nonceSize])
+	return secretbox.Open(nil, box[nonceSize:], &nonce, secret)
+}
@@ -0,0 +1,195 @@
+package encryption
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newKeyring(t *testing.T, n int) *Keyring {
+	t.Helper()
+	var lines []string
+	for i := 0; i < n; i++ {
+		k, err := GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, k)
+	}
+	k, err := ParseKeyring([]byte(strings.Join(lines, "\n")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k
+}
+
+func TestParseKeyring(t *testing.T) {
+	key, err := GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		content  string
+		wantKeys int
+		wantErr  string
+	}{
+		{
+			name:     "comments and empty lines",
+			content:  "# primary\n" + key + "\n\n  # old\n" + key + "\n",
+			wantKeys: 2,
+		},
+		{
+			name:    "no keys",
+			content: "# nothing here\n",
+			wantErr: "no keys found",
+		},
+		{
+			name:    "not base64",
+			content: key + "\nnot a key\n",
+			wantErr: "line 2: key has to be base64 encoded 32 bytes",
+		},
+		{
+			name:    "too short",
+			content: "c2hvcnQ=\n",
+			wantErr: "line 1: key has to be base64 encoded 32 bytes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := ParseKeyring([]byte(tt.content))
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("ParseKeyring() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseKeyring() unexpected error occurred: %v", err)
+			}
+			if len(k.keys) != tt.wantKeys {
+				t.Errorf("ParseKeyring() got %d keys, want %d", len(k.keys), tt.wantKeys)
+			}
+		})
+	}
+}
+
+func TestKeyring_EncryptDecrypt(t *testing.T) {
+	k := newKeyring(t, 1)
+	value, err := k.Encrypt("secret value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !IsEncrypted(value) || strings.Contains(value, "secret value") {
+		t.Fatalf("Encrypt() returned incorrect value %s", value)
+	}
+	again, err := k.Encrypt("secret value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again == value {
+		t.Errorf("Encrypt() expected to return different values for the same plaintext")
+	}
+	got, err := k.Decrypt(value)
+	if err != nil {
+		t.Fatalf("Decrypt() unexpected error occurred: %v", err)
+	}
+	if got != "secret value" {
+		t.Errorf("Decrypt() = %s, want secret value", got)
+	}
+}
+
+func TestKeyring_Decrypt_Errors(t *testing.T) {
+	k := newKeyring(t, 1)
+	value, err := k.Encrypt("secret value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(value, ":")
+
+	other := newKeyring(t, 1)
+	// keyring with different key but the same id, as if key file was corrupted
+	forged := newKeyring(t, 1)
+	forged.keys[0].id = k.keys[0].id
+	// replace first character of ciphertext
+	flipped := "A"
+	if parts[4][0] == 'A' {
+		flipped = "B"
+	}
+	tampered := strings.Join(parts[:4], ":") + ":" + flipped + parts[4][1:]
+
+	tests := []struct {
+		name    string
+		keyring *Keyring
+		value   string
+		wantErr error
+	}{
+		{
+			name:    "unknown key",
+			keyring: other,
+			value:   value,
+			wantErr: ErrUnknownKey,
+		},
+		{
+			name:    "wrong key",
+			keyring: forged,
+			value:   value,
+			wantErr: ErrDecrypt,
+		},
+		{
+			name:    "tampered ciphertext",
+			keyring: k,
+			value:   tampered,
+			wantErr: ErrDecrypt,
+		},
+		{
+			name:    "missing part",
+			keyring: k,
+			value:   strings.Join(parts[:4], ":"),
+			wantErr: ErrMalformed,
+		},
+		{
+			name:    "not encrypted",
+			keyring: k,
+			value:   "secret value",
+			wantErr: ErrMalformed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.keyring.Decrypt(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Decrypt() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyring_Rewrap(t *testing.T) {
+	old := newKeyring(t, 1)
+	value, err := old.Encrypt("secret value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotated := newKeyring(t, 1)
+	rotated.keys = append(rotated.keys, old.keys[0])
+
+	rewrapped, err := rotated.Rewrap(value)
+	if err != nil {
+		t.Fatalf("Rewrap() unexpected error occurred: %v", err)
+	}
+	if unchanged, _ := rotated.Rewrap(rewrapped); unchanged != rewrapped {
+		t.Errorf("Rewrap() expected to return value wrapped with primary key unchanged")
+	}
+	if _, err = old.Decrypt(rewrapped); !errors.Is(err, ErrUnknownKey) {
+		t.Errorf("Decrypt() with old key after Rewrap() error = %v, want %v", err, ErrUnknownKey)
+	}
+	primary := &Keyring{keys: rotated.keys[:1]}
+	got, err := primary.Decrypt(rewrapped)
+	if err != nil {
+		t.Fatalf("Decrypt() with new key after Rewrap() unexpected error occurred: %v", err)
+	}
+	if got != "secret value" {
+		t.Errorf("Decrypt() after Rewrap() = %s, want secret value", got)
+	}
+}
@@ -0,0 +1,53 @@
+package fingerprint
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+)
+
+const prefix = "sha256:"
+
+// Of calculates stable SHA-256 fingerprint of JSON representation of v. Before hashing v is
+// converted to canonical form with object keys sorted and no insignificant whitespace, so
+// fingerprint does not depend on fields order. Fields not serialized to JSON (like Unused)
+// are not part of fingerprint. Non-empty values of sensitive fields are replaced with
+// sensitive.Placeholder, so fingerprint cannot be used to guess them and does not depend on
+// whether they are stored as references, encrypted or plain values.
+func Of(v interface{}) (string, error) {
+	b, err := Canonical(v)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s%x", prefix, sha256.Sum256(b)), nil
+}
+
+// Canonical returns normalized JSON form of v used to calculate fingerprint.
+func Canonical(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if v != nil {
+		b, err = sensitive.Transform(reflect.TypeOf(v), b, func(_, s string) (string, error) {
+			if s == "" {
+				return s, nil
+			}
+			return sensitive.Placeholder, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	var generic interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err = d.Decode(&generic); err != nil {
+		return nil, err
+	}
+	return json.Marshal(generic)
+}
@@ -0,0 +1,117 @@
+package fingerprint
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestOf(t *testing.T) {
+	type inner struct {
+		B string `json:"b"`
+		A int    `json:"a"`
+	}
+	type outer struct {
+		Z      *inner   `json:"z"`
+		Y      []string `json:"y"`
+		Unused []string `json:"-"`
+	}
+	type secret struct {
+		User     string  `json:"user"`
+		Password *string `json:"password" sensitive:"true"`
+	}
+	str := func(s string) *string {
+		return &s
+	}
+	tests := []struct {
+		name      string
+		a         interface{}
+		b         interface{}
+		wantEqual bool
+	}{
+		{
+			name:      "same structures",
+			a:         outer{Z: &inner{B: "b", A: 1}, Y: []string{"1", "2"}},
+			b:         outer{Z: &inner{B: "b", A: 1}, Y: []string{"1", "2"}},
+			wantEqual: true,
+		},
+		{
+			name:      "ignored fields",
+			a:         outer{Z: &inner{B: "b", A: 1}, Unused: []string{"a"}},
+			b:         outer{Z: &inner{B: "b", A: 1}, Unused: []string{"b"}},
+			wantEqual: true,
+		},
+		{
+			name:      "structure and map with different keys order",
+			a:         inner{B: "b", A: 1},
+			b:         map[string]interface{}{"a": 1, "b": "b"},
+			wantEqual: true,
+		},
+		{
+			name:      "raw json with different keys order and whitespace",
+			a:         json.RawMessage(`{"b": "b", "a": 1}`),
+			b:         json.RawMessage(`{"a":1,"b":"b"}`),
+			wantEqual: true,
+		},
+		{
+			name:      "different values",
+			a:         inner{B: "b", A: 1},
+			b:         inner{B: "b", A: 2},
+			wantEqual: false,
+		},
+		{
+			name:      "different slices order",
+			a:         outer{Y: []string{"1", "2"}},
+			b:         outer{Y: []string{"2", "1"}},
+			wantEqual: false,
+		},
+		{
+			name:      "different sensitive values",
+			a:         &secret{User: "admin", Password: str("password1")},
+			b:         &secret{User: "admin", Password: str("password2")},
+			wantEqual: true,
+		},
+		{
+			name:      "sensitive value and reference",
+			a:         secret{User: "admin", Password: str("password1")},
+			b:         secret{User: "admin", Password: str("env://PASSWORD")},
+			wantEqual: true,
+		},
+		{
+			name:      "missing and present sensitive value",
+			a:         secret{User: "admin"},
+			b:         secret{User: "admin", Password: str("password1")},
+			wantEqual: false,
+		},
+		{
+			name:      "different values next to sensitive value",
+			a:         secret{User: "admin", Password: str("password1")},
+			b:         secret{User: "operator", Password: str("password1")},
+			wantEqual: false,
+		},
+		{
+			name:      "nil and empty slice",
+			a:         outer{Y: nil},
+			b:         outer{Y: []string{}},
+			wantEqual: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := Of(tt.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := Of(tt.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(a, "sha256:") || len(a) != 71 {
+				t.Errorf("Of() returned incorrectly formatted fingerprint %s", a)
+			}
+			if (a == b) != tt.wantEqual {
+				t.Errorf("Of() fingerprints equality = %t, want %t (%s, %s)", a == b, tt.wantEqual, a, b)
+			}
+		})
+	}
+}
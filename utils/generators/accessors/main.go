@@ -0,0 +1,170 @@
+// Command accessors generates nil-safe accessors for pointer and slice fields of all structures
+// declared in package it is run in. It is intended to be called with go:generate directive:
+//
+//	//go:generate go run ../../utils/generators/accessors
+//
+// For each pointer field X of type *T following methods are generated:
+//
+//	GetX() *T        returns field value or nil if receiver is nil
+//	GetXV() T        returns dereferenced field value or zero value of T
+//	GetXOr(def T) T  returns dereferenced field value or def
+//
+// For each slice field X of type []T GetX() []T method is generated. It returns nil if receiver
+// is nil and empty slice if field is nil or empty.
+//
+// Accessors are written to accessors_generated.go file and tests for them are written to
+// accessors_generated_test.go file.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/epiphany-platform/e-structures/utils/generators/internal/gen"
+)
+
+const (
+	outputFile     = "accessors_generated.go"
+	outputTestFile = "accessors_generated_test.go"
+	header         = "// Code generated by utils/generators/accessors; DO NOT EDIT.\n\n"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("accessors: ")
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, err := gen.Parse(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	structures := accessible(pkg.Structs)
+	if len(structures) == 0 {
+		log.Fatalf("no structures with pointer or slice fields found in %s", dir)
+	}
+	err = gen.Write(filepath.Join(dir, outputFile), generate(pkg, structures))
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = gen.Write(filepath.Join(dir, outputTestFile), generateTests(pkg, structures))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// accessible returns structures limited to pointer and slice fields, skipping structures
+// without such fields.
+func accessible(structs []gen.Struct) []gen.Struct {
+	var result []gen.Struct
+	for _, s := range structs {
+		var fields []gen.Field
+		for _, f := range s.Fields {
+			if f.Kind == gen.Pointer || f.Kind == gen.Slice {
+				fields = append(fields, f)
+			}
+		}
+		if len(fields) > 0 {
+			s.Fields = fields
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func hasNonBuiltinPointer(structures []gen.Struct) bool {
+	for _, s := range structures {
+		for _, f := range s.Fields {
+			if f.Kind == gen.Pointer && !f.Builtin {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func usedImports(pkg *gen.Package, structures []gen.Struct) []string {
+	var fields []gen.Field
+	for _, s := range structures {
+		fields = append(fields, s.Fields...)
+	}
+	return pkg.UsedImports(fields)
+}
+
+func generate(pkg *gen.Package, structures []gen.Struct) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg.Name)
+	if specs := usedImports(pkg, structures); len(specs) > 0 {
+		fmt.Fprintf(&b, "import (\n%s\n)\n\n", strings.Join(specs, "\n"))
+	}
+	for _, s := range structures {
+		r := s.Receiver
+		for _, f := range s.Fields {
+			switch f.Kind {
+			case gen.Pointer:
+				fmt.Fprintf(&b, "// Get%[3]s returns %[3]s field of %[1]s or nil if %[1]s is nil.\n", s.Name, r, f.Name)
+				fmt.Fprintf(&b, "func (%[2]s *%[1]s) Get%[3]s() *%[4]s {\n\tif %[2]s == nil {\n\t\treturn nil\n\t}\n\treturn %[2]s.%[3]s\n}\n\n", s.Name, r, f.Name, f.Elem)
+				fmt.Fprintf(&b, "// Get%[3]sV returns value of %[3]s field of %[1]s or zero value if either %[1]s or field is nil.\n", s.Name, r, f.Name)
+				fmt.Fprintf(&b, "func (%[2]s *%[1]s) Get%[3]sV() %[4]s {\n\tif %[2]s == nil || %[2]s.%[3]s == nil {\n\t\treturn %[5]s\n\t}\n\treturn *%[2]s.%[3]s\n}\n\n", s.Name, r, f.Name, f.Elem, f.Zero())
+				fmt.Fprintf(&b, "// Get%[3]sOr returns value of %[3]s field of %[1]s or def if either %[1]s or field is nil.\n", s.Name, r, f.Name)
+				fmt.Fprintf(&b, "func (%[2]s *%[1]s) Get%[3]sOr(def %[4]s) %[4]s {\n\tif %[2]s == nil || %[2]s.%[3]s == nil {\n\t\treturn def\n\t}\n\treturn *%[2]s.%[3]s\n}\n\n", s.Name, r, f.Name, f.Elem)
+			case gen.Slice:
+				fmt.Fprintf(&b, "// Get%[3]s returns %[3]s field of %[1]s, nil if %[1]s is nil or empty slice if field is nil.\n", s.Name, r, f.Name)
+				fmt.Fprintf(&b, "func (%[2]s *%[1]s) Get%[3]s() []%[4]s {\n\tif %[2]s == nil {\n\t\treturn nil\n\t}\n\tif len(%[2]s.%[3]s) == 0 {\n\t\treturn []%[4]s{}\n\t}\n\treturn %[2]s.%[3]s\n}\n\n", s.Name, r, f.Name, f.Elem)
+			}
+		}
+	}
+	return b.Bytes()
+}
+
+func generateTests(pkg *gen.Package, structures []gen.Struct) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg.Name)
+	specs := []string{`"testing"`}
+	if hasNonBuiltinPointer(structures) {
+		specs = append(specs, `"reflect"`)
+	}
+	sort.Strings(specs)
+	specs = append(append(specs, ""), usedImports(pkg, structures)...)
+	fmt.Fprintf(&b, "import (\n%s\n)\n\n", strings.Join(specs, "\n"))
+	for _, s := range structures {
+		fmt.Fprintf(&b, "func Test%s_Accessors(t *testing.T) {\n", s.Name)
+		fmt.Fprintf(&b, "\tvar nilStruct *%s\n\temptyStruct := &%s{}\n", s.Name, s.Name)
+		for _, f := range s.Fields {
+			fmt.Fprintf(&b, "\tt.Run(%q, func(t *testing.T) {\n", f.Name)
+			switch f.Kind {
+			case gen.Pointer:
+				fmt.Fprintf(&b, "\t\tv := %s\n\t\tfullStruct := &%s{%s: &v}\n", f.Sample(), s.Name, f.Name)
+				fmt.Fprintf(&b, "\t\tif nilStruct.Get%[1]s() != nil || emptyStruct.Get%[1]s() != nil {\n\t\t\tt.Error(\"Get%[1]s() expected to return nil\")\n\t\t}\n", f.Name)
+				fmt.Fprintf(&b, "\t\tif fullStruct.Get%[1]s() != &v {\n\t\t\tt.Error(\"Get%[1]s() expected to return field\")\n\t\t}\n", f.Name)
+				if f.Builtin {
+					fmt.Fprintf(&b, "\t\tif nilStruct.Get%[1]sV() != %[2]s || emptyStruct.Get%[1]sV() != %[2]s {\n\t\t\tt.Error(\"Get%[1]sV() expected to return zero value\")\n\t\t}\n", f.Name, f.Zero())
+					fmt.Fprintf(&b, "\t\tif fullStruct.Get%[1]sV() != v {\n\t\t\tt.Error(\"Get%[1]sV() expected to return field value\")\n\t\t}\n", f.Name)
+					fmt.Fprintf(&b, "\t\tif nilStruct.Get%[1]sOr(v) != v || emptyStruct.Get%[1]sOr(v) != v {\n\t\t\tt.Error(\"Get%[1]sOr() expected to return default value\")\n\t\t}\n", f.Name)
+					fmt.Fprintf(&b, "\t\tif fullStruct.Get%[1]sOr(%[2]s) != v {\n\t\t\tt.Error(\"Get%[1]sOr() expected to return field value\")\n\t\t}\n", f.Name, f.Zero())
+				} else {
+					fmt.Fprintf(&b, "\t\tif !reflect.DeepEqual(nilStruct.Get%[1]sV(), %[2]s) || !reflect.DeepEqual(emptyStruct.Get%[1]sV(), %[2]s) {\n\t\t\tt.Error(\"Get%[1]sV() expected to return zero value\")\n\t\t}\n", f.Name, f.Zero())
+					fmt.Fprintf(&b, "\t\tif !reflect.DeepEqual(fullStruct.Get%[1]sV(), v) {\n\t\t\tt.Error(\"Get%[1]sV() expected to return field value\")\n\t\t}\n", f.Name)
+					fmt.Fprintf(&b, "\t\tif !reflect.DeepEqual(nilStruct.Get%[1]sOr(v), v) || !reflect.DeepEqual(emptyStruct.Get%[1]sOr(v), v) {\n\t\t\tt.Error(\"Get%[1]sOr() expected to return default value\")\n\t\t}\n", f.Name)
+				}
+			case gen.Slice:
+				fmt.Fprintf(&b, "\t\tfullStruct := &%s{%s: make([]%s, 1)}\n", s.Name, f.Name, f.Elem)
+				fmt.Fprintf(&b, "\t\tif nilStruct.Get%[1]s() != nil {\n\t\t\tt.Error(\"Get%[1]s() expected to return nil\")\n\t\t}\n", f.Name)
+				fmt.Fprintf(&b, "\t\tif got := emptyStruct.Get%[1]s(); got == nil || len(got) != 0 {\n\t\t\tt.Error(\"Get%[1]s() expected to return empty slice\")\n\t\t}\n", f.Name)
+				fmt.Fprintf(&b, "\t\tif got := fullStruct.Get%[1]s(); len(got) != 1 || &got[0] != &fullStruct.%[1]s[0] {\n\t\t\tt.Error(\"Get%[1]s() expected to return field\")\n\t\t}\n", f.Name)
+			}
+			b.WriteString("\t})\n")
+		}
+		b.WriteString("}\n\n")
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,183 @@
+// Command deepcopy generates DeepCopy and Equal methods for all structures declared in package
+// it is run in. It is intended to be called with go:generate directive:
+//
+//	//go:generate go run ../../utils/generators/deepcopy
+//
+// DeepCopy returns copy of structure that shares no pointers or slices with original. Equal
+// reports if two structures are structurally equal, ignoring fields with json:"-" tag (like Unused)
+// as those are not part of serialized document. Structures from other packages used as fields are
+// expected to have those methods generated as well.
+//
+// Methods are written to deepcopy_generated.go file and tests for them are written to
+// deepcopy_generated_test.go file.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/epiphany-platform/e-structures/utils/generators/internal/gen"
+)
+
+const (
+	outputFile     = "deepcopy_generated.go"
+	outputTestFile = "deepcopy_generated_test.go"
+	header         = "// Code generated by utils/generators/deepcopy; DO NOT EDIT.\n\n"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("deepcopy: ")
+
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	pkg, err := gen.Parse(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkg.Structs) == 0 {
+		log.Fatalf("no structures found in %s", dir)
+	}
+	for _, s := range pkg.Structs {
+		for _, f := range s.Fields {
+			if f.Kind == gen.Other {
+				log.Fatalf("unsupported type of field %s.%s", s.Name, f.Name)
+			}
+		}
+	}
+	err = gen.Write(filepath.Join(dir, outputFile), generate(pkg))
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = gen.Write(filepath.Join(dir, outputTestFile), generateTests(pkg))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(pkg *gen.Package) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg.Name)
+	// only element types of slices and pointers to non-structures are spelled out in generated code
+	var typed []gen.Field
+	for _, f := range pkg.Fields() {
+		if f.Kind == gen.Slice || f.Kind == gen.Pointer && !f.Struct {
+			typed = append(typed, f)
+		}
+	}
+	if specs := pkg.UsedImports(typed); len(specs) > 0 {
+		fmt.Fprintf(&b, "import (\n%s\n)\n\n", strings.Join(specs, "\n"))
+	}
+	for _, s := range pkg.Structs {
+		r := s.Receiver
+		// name of temporary variable has to differ from receiver, so it doesn't shadow it
+		tmp := "x"
+		if r == tmp {
+			tmp = "y"
+		}
+		fmt.Fprintf(&b, "// DeepCopy returns deep copy of %[1]s or nil if %[1]s is nil.\n", s.Name)
+		fmt.Fprintf(&b, "func (%[2]s *%[1]s) DeepCopy() *%[1]s {\n\tif %[2]s == nil {\n\t\treturn nil\n\t}\n\tout := new(%[1]s)\n", s.Name, r)
+		for _, f := range s.Fields {
+			switch {
+			case f.Kind == gen.Value && !f.Struct:
+				fmt.Fprintf(&b, "\tout.%[2]s = %[1]s.%[2]s\n", r, f.Name)
+			case f.Kind == gen.Value:
+				fmt.Fprintf(&b, "\tout.%[2]s = *%[1]s.%[2]s.DeepCopy()\n", r, f.Name)
+			case f.Kind == gen.Pointer && !f.Struct:
+				fmt.Fprintf(&b, "\tif %[1]s.%[2]s != nil {\n\t\t%[3]s := *%[1]s.%[2]s\n\t\tout.%[2]s = &%[3]s\n\t}\n", r, f.Name, tmp)
+			case f.Kind == gen.Pointer:
+				fmt.Fprintf(&b, "\tout.%[2]s = %[1]s.%[2]s.DeepCopy()\n", r, f.Name)
+			case f.Kind == gen.Slice && !f.Struct:
+				fmt.Fprintf(&b, "\tif %[1]s.%[2]s != nil {\n\t\tout.%[2]s = make([]%[3]s, len(%[1]s.%[2]s))\n\t\tcopy(out.%[2]s, %[1]s.%[2]s)\n\t}\n", r, f.Name, f.Elem)
+			case f.Kind == gen.Slice:
+				fmt.Fprintf(&b, "\tif %[1]s.%[2]s != nil {\n\t\tout.%[2]s = make([]%[3]s, len(%[1]s.%[2]s))\n\t\tfor i := range %[1]s.%[2]s {\n\t\t\tout.%[2]s[i] = *%[1]s.%[2]s[i].DeepCopy()\n\t\t}\n\t}\n", r, f.Name, f.Elem)
+			}
+		}
+		b.WriteString("\treturn out\n}\n\n")
+
+		fmt.Fprintf(&b, "// Equal reports whether %[1]s and other are structurally equal. Fields that are not\n// serialized are ignored.\n", s.Name)
+		fmt.Fprintf(&b, "func (%[2]s *%[1]s) Equal(other *%[1]s) bool {\n\tif %[2]s == nil || other == nil {\n\t\treturn %[2]s == other\n\t}\n", s.Name, r)
+		for _, f := range s.Fields {
+			if f.Ignored {
+				continue
+			}
+			switch {
+			case f.Kind == gen.Value && !f.Struct:
+				fmt.Fprintf(&b, "\tif %[1]s.%[2]s != other.%[2]s {\n\t\treturn false\n\t}\n", r, f.Name)
+			case f.Kind == gen.Value:
+				fmt.Fprintf(&b, "\tif !%[1]s.%[2]s.Equal(&other.%[2]s) {\n\t\treturn false\n\t}\n", r, f.Name)
+			case f.Kind == gen.Pointer && !f.Struct:
+				fmt.Fprintf(&b, "\tif (%[1]s.%[2]s == nil) != (other.%[2]s == nil) || %[1]s.%[2]s != nil && *%[1]s.%[2]s != *other.%[2]s {\n\t\treturn false\n\t}\n", r, f.Name)
+			case f.Kind == gen.Pointer:
+				fmt.Fprintf(&b, "\tif !%[1]s.%[2]s.Equal(other.%[2]s) {\n\t\treturn false\n\t}\n", r, f.Name)
+			case f.Kind == gen.Slice:
+				fmt.Fprintf(&b, "\tif (%[1]s.%[2]s == nil) != (other.%[2]s == nil) || len(%[1]s.%[2]s) != len(other.%[2]s) {\n\t\treturn false\n\t}\n", r, f.Name)
+				if f.Struct {
+					fmt.Fprintf(&b, "\tfor i := range %[1]s.%[2]s {\n\t\tif !%[1]s.%[2]s[i].Equal(&other.%[2]s[i]) {\n\t\t\treturn false\n\t\t}\n\t}\n", r, f.Name)
+				} else {
+					fmt.Fprintf(&b, "\tfor i := range %[1]s.%[2]s {\n\t\tif %[1]s.%[2]s[i] != other.%[2]s[i] {\n\t\t\treturn false\n\t\t}\n\t}\n", r, f.Name)
+				}
+			}
+		}
+		b.WriteString("\treturn true\n}\n\n")
+	}
+	return b.Bytes()
+}
+
+func generateTests(pkg *gen.Package) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	fmt.Fprintf(&b, "package %s\n\n", pkg.Name)
+	b.WriteString("import (\n\t\"testing\"\n\n\t\"github.com/epiphany-platform/e-structures/utils/test\"\n\t\"github.com/google/go-cmp/cmp\"\n)\n\n")
+	for _, s := range pkg.Structs {
+		fmt.Fprintf(&b, `func Test%[1]s_DeepCopy(t *testing.T) {
+	var nilStruct *%[1]s
+	if nilStruct.DeepCopy() != nil {
+		t.Error("DeepCopy() of nil expected to return nil")
+	}
+	original := &%[1]s{}
+	test.Fill(original)
+	copied := original.DeepCopy()
+	if diff := cmp.Diff(original, copied); diff != "" {
+		t.Errorf("DeepCopy() mismatch (-original +copied):\n%%s", diff)
+	}
+	for _, path := range test.SharedMemory(original, copied) {
+		t.Errorf("DeepCopy() shares memory with original at %%s", path)
+	}
+}
+
+func Test%[1]s_Equal(t *testing.T) {
+	var nilStruct *%[1]s
+	original := &%[1]s{}
+	test.Fill(original)
+	if !nilStruct.Equal(nil) {
+		t.Error("Equal() expected to report nil structures as equal")
+	}
+	if nilStruct.Equal(original) || original.Equal(nil) {
+		t.Error("Equal() expected to report nil and non-nil structures as different")
+	}
+	if !(&%[1]s{}).Equal(&%[1]s{}) {
+		t.Error("Equal() expected to report empty structures as equal")
+	}
+	copied := original.DeepCopy()
+	if !original.Equal(copied) {
+		t.Error("Equal() expected to report copy as equal")
+	}
+	test.MutateLeaves(copied, func(path string) {
+		if original.Equal(copied) || copied.Equal(original) {
+			t.Errorf("Equal() did not detect change at %%s", path)
+		}
+	})
+}
+
+`, s.Name)
+	}
+	return b.Bytes()
+}
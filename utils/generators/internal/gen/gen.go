@@ -0,0 +1,274 @@
+// Package gen contains code shared by code generators used in this repository. It parses
+// structures declared in a package directory into simple model used to render generated code.
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// builtinZeros contains zero value literals of builtin types that can be used in generated code.
+var builtinZeros = map[string]string{
+	"string":  `""`,
+	"bool":    "false",
+	"int":     "0",
+	"int8":    "0",
+	"int16":   "0",
+	"int32":   "0",
+	"int64":   "0",
+	"uint":    "0",
+	"uint8":   "0",
+	"uint16":  "0",
+	"uint32":  "0",
+	"uint64":  "0",
+	"float32": "0",
+	"float64": "0",
+}
+
+// builtinSamples contains non-zero values of builtin types used in generated tests.
+var builtinSamples = map[string]string{
+	"string":  `"value"`,
+	"bool":    "true",
+	"int":     "1",
+	"int8":    "1",
+	"int16":   "1",
+	"int32":   "1",
+	"int64":   "1",
+	"uint":    "1",
+	"uint8":   "1",
+	"uint16":  "1",
+	"uint32":  "1",
+	"uint64":  "1",
+	"float32": "1",
+	"float64": "1",
+}
+
+type Kind int
+
+const (
+	// Value is field of non-pointer and non-slice type.
+	Value Kind = iota
+	// Pointer is field of *T type.
+	Pointer
+	// Slice is field of []T type.
+	Slice
+	// Other is field of any other type (maps, arrays, channels, ...).
+	Other
+)
+
+type Field struct {
+	Name string
+	Kind Kind
+	// Elem is printed type of field for Value kind, pointed to type for Pointer kind
+	// and element type for Slice kind.
+	Elem string
+	// Builtin is true if Elem is builtin type.
+	Builtin bool
+	// Struct is true if Elem is structure type. Types from other packages are always
+	// considered to be structures.
+	Struct bool
+	// Ignored is true if field is not serialized (has json:"-" tag).
+	Ignored bool
+}
+
+type Struct struct {
+	Name     string
+	Receiver string
+	Fields   []Field
+}
+
+type Package struct {
+	Name    string
+	Structs []Struct
+	// Imports maps package name used in code to import spec.
+	Imports map[string]string
+}
+
+// Parse reads all non-test and non-generated go files in dir and returns model of exported
+// structures declared in them in order of declaration.
+func Parse(dir string) (*Package, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return !strings.HasSuffix(name, "_test.go") && !strings.HasSuffix(name, "_generated.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("expected exactly one package in %s, found %d", dir, len(pkgs))
+	}
+	var pkg *ast.Package
+	for _, p := range pkgs {
+		pkg = p
+	}
+
+	fileNames := make([]string, 0, len(pkg.Files))
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	result := &Package{
+		Name:    pkg.Name,
+		Imports: make(map[string]string),
+	}
+	var specs []*ast.TypeSpec
+	localStructs := make(map[string]bool)
+	for _, name := range fileNames {
+		f := pkg.Files[name]
+		for _, is := range f.Imports {
+			path, _ := strconv.Unquote(is.Path.Value)
+			alias := filepath.Base(path)
+			spec := is.Path.Value
+			if is.Name != nil {
+				alias = is.Name.Name
+				spec = is.Name.Name + " " + is.Path.Value
+			}
+			result.Imports[alias] = spec
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gd.Specs {
+				ts := spec.(*ast.TypeSpec)
+				if _, ok := ts.Type.(*ast.StructType); ok {
+					localStructs[ts.Name.Name] = true
+				}
+				specs = append(specs, ts)
+			}
+		}
+	}
+
+	for _, ts := range specs {
+		st, ok := ts.Type.(*ast.StructType)
+		if !ok || !ts.Name.IsExported() {
+			continue
+		}
+		s := Struct{
+			Name:     ts.Name.Name,
+			Receiver: strings.ToLower(ts.Name.Name[:1]),
+		}
+		for _, f := range st.Fields.List {
+			ignored := false
+			if f.Tag != nil {
+				tag, _ := strconv.Unquote(f.Tag.Value)
+				ignored = reflect.StructTag(tag).Get("json") == "-"
+			}
+			for _, n := range f.Names {
+				if !n.IsExported() {
+					continue
+				}
+				field := Field{
+					Name:    n.Name,
+					Ignored: ignored,
+				}
+				elem := f.Type
+				switch t := f.Type.(type) {
+				case *ast.StarExpr:
+					field.Kind = Pointer
+					elem = t.X
+				case *ast.ArrayType:
+					if t.Len == nil {
+						field.Kind = Slice
+						elem = t.Elt
+					} else {
+						field.Kind = Other
+					}
+				case *ast.Ident, *ast.SelectorExpr:
+					field.Kind = Value
+				default:
+					field.Kind = Other
+				}
+				field.Elem = print(elem)
+				switch t := elem.(type) {
+				case *ast.Ident:
+					_, field.Builtin = builtinZeros[t.Name]
+					field.Struct = localStructs[t.Name]
+				case *ast.SelectorExpr:
+					field.Struct = true
+				}
+				s.Fields = append(s.Fields, field)
+			}
+		}
+		result.Structs = append(result.Structs, s)
+	}
+	return result, nil
+}
+
+func print(e ast.Expr) string {
+	var b bytes.Buffer
+	_ = format.Node(&b, token.NewFileSet(), e)
+	return b.String()
+}
+
+// Zero returns expression evaluating to zero value of Elem type.
+func (f Field) Zero() string {
+	switch {
+	case f.Builtin:
+		return builtinZeros[f.Elem]
+	case f.Struct:
+		return f.Elem + "{}"
+	default:
+		return "*new(" + f.Elem + ")"
+	}
+}
+
+// Sample returns expression evaluating to non-zero value of Elem type if it is builtin type
+// and to zero value otherwise.
+func (f Field) Sample() string {
+	if f.Builtin {
+		return builtinSamples[f.Elem]
+	}
+	return f.Zero()
+}
+
+// UsedImports returns sorted import specs required by element types of provided fields.
+func (p *Package) UsedImports(fields []Field) []string {
+	used := make(map[string]bool)
+	for _, f := range fields {
+		if i := strings.Index(f.Elem, "."); i > 0 {
+			alias := strings.TrimLeft(f.Elem[:i], "*[]")
+			if spec, ok := p.Imports[alias]; ok {
+				used[spec] = true
+			}
+		}
+	}
+	result := make([]string, 0, len(used))
+	for spec := range used {
+		result = append(result, spec)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Fields returns all fields of all structures in package.
+func (p *Package) Fields() []Field {
+	var result []Field
+	for _, s := range p.Structs {
+		result = append(result, s.Fields...)
+	}
+	return result
+}
+
+// Write formats src and writes it to path.
+func Write(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("failed to format generated code for %s: %v\n%s", path, err, src)
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
@@ -0,0 +1,185 @@
+// Package interpolate expands references to environment variables and user supplied variables
+// in string values of JSON documents.
+//
+// Supported syntax is:
+//
+//	${NAME}            value of environment variable NAME
+//	${var.name}        value of variable name from supplied variables map
+//	${NAME:-default}   default is used when variable is not defined or is empty
+//	$${NAME}           escaped reference, expanded to literal ${NAME}
+//
+// Only string values are expanded, keys are left unchanged.
+package interpolate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const varPrefix = "var."
+
+var nameRegexp = regexp.MustCompile(`^(var\.)?[A-Za-z_][A-Za-z0-9_]*$`)
+
+// LookupFunc returns value of environment variable and reports if it is defined.
+type LookupFunc func(name string) (string, bool)
+
+// ReferenceError describes single reference that could not be expanded.
+type ReferenceError struct {
+	// Key is path of string value containing reference, i.e. params.address_space[0]
+	Key string
+	// Reference is reference as found in value, i.e. ${var.cidr}
+	Reference string
+	// Variable is name of referenced variable if it is not defined, i.e. var.cidr. It is empty
+	// if reference is incorrect.
+	Variable string
+}
+
+func (e ReferenceError) Error() string {
+	if e.Variable != "" {
+		return fmt.Sprintf("undefined variable '%s' at '%s'", e.Variable, e.Key)
+	}
+	return fmt.Sprintf("incorrect reference '%s' at '%s'", e.Reference, e.Key)
+}
+
+type ReferencesError []ReferenceError
+
+func (e ReferencesError) Error() string {
+	buff := bytes.NewBufferString("")
+
+	for _, re := range e {
+		buff.WriteString(re.Error())
+		buff.WriteString("\n")
+	}
+
+	return strings.TrimSpace(buff.String())
+}
+
+// Expand returns JSON document b with references in string values expanded. Variables referenced
+// with var. prefix are taken from vars, other are looked up with env or os.LookupEnv if env is nil.
+// If b contains no references it is returned unchanged. If any reference cannot be expanded,
+// ReferencesError listing all of them is returned.
+func Expand(b []byte, vars map[string]string, env LookupFunc) ([]byte, error) {
+	if !bytes.Contains(b, []byte("${")) {
+		return b, nil
+	}
+	if env == nil {
+		env = os.LookupEnv
+	}
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return nil, err
+	}
+	e := &expander{
+		vars: vars,
+		env:  env,
+	}
+	doc = e.walk("", doc)
+	if len(e.errors) > 0 {
+		sort.SliceStable(e.errors, func(i, j int) bool {
+			return e.errors[i].Key < e.errors[j].Key
+		})
+		return nil, e.errors
+	}
+	if !e.changed {
+		return b, nil
+	}
+	return json.Marshal(doc)
+}
+
+type expander struct {
+	vars    map[string]string
+	env     LookupFunc
+	changed bool
+	errors  ReferencesError
+}
+
+func (e *expander) walk(path string, v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, value := range t {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			t[k] = e.walk(p, value)
+		}
+		return t
+	case []interface{}:
+		for i, value := range t {
+			t[i] = e.walk(fmt.Sprintf("%s[%d]", path, i), value)
+		}
+		return t
+	case string:
+		return e.expand(path, t)
+	default:
+		return v
+	}
+}
+
+// expand expands all references found in string s being value at path.
+func (e *expander) expand(path, s string) string {
+	if !strings.Contains(s, "${") {
+		return s
+	}
+	e.changed = true
+	var out strings.Builder
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], "$${"):
+			out.WriteString("${")
+			i += 3
+		case strings.HasPrefix(s[i:], "${"):
+			end := strings.IndexByte(s[i:], '}')
+			if end < 0 {
+				e.errors = append(e.errors, ReferenceError{Key: path, Reference: s[i:]})
+				return s
+			}
+			reference := s[i : i+end+1]
+			value, err := e.resolve(path, reference)
+			if err != nil {
+				e.errors = append(e.errors, *err)
+			}
+			out.WriteString(value)
+			i += end + 1
+		default:
+			out.WriteByte(s[i])
+			i++
+		}
+	}
+	return out.String()
+}
+
+// resolve returns value of reference in ${name} or ${name:-default} form.
+func (e *expander) resolve(path, reference string) (string, *ReferenceError) {
+	name := reference[2 : len(reference)-1]
+	def, hasDefault := "", false
+	if i := strings.Index(name, ":-"); i >= 0 {
+		name, def, hasDefault = name[:i], name[i+2:], true
+	}
+	if !nameRegexp.MatchString(name) {
+		return "", &ReferenceError{Key: path, Reference: reference}
+	}
+	var (
+		value   string
+		defined bool
+	)
+	if strings.HasPrefix(name, varPrefix) {
+		value, defined = e.vars[strings.TrimPrefix(name, varPrefix)]
+	} else {
+		value, defined = e.env(name)
+	}
+	if hasDefault && (!defined || value == "") {
+		return def, nil
+	}
+	if !defined {
+		return "", &ReferenceError{Key: path, Reference: reference, Variable: name}
+	}
+	return value, nil
+}
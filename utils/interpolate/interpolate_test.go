@@ -0,0 +1,131 @@
+package interpolate
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpand(t *testing.T) {
+	vars := map[string]string{
+		"name":  "prod",
+		"cidr":  "10.1.0.0/16",
+		"empty": "",
+	}
+	env := func(name string) (string, bool) {
+		switch name {
+		case "LOCATION":
+			return "westeurope", true
+		case "EMPTY":
+			return "", true
+		}
+		return "", false
+	}
+	tests := []struct {
+		name    string
+		json    string
+		want    string
+		wantErr error
+	}{
+		{
+			name: "variables and environment",
+			json: `{"params": {"name": "${var.name}-cluster", "location": "${LOCATION}", "address_space": ["${var.cidr}"]}}`,
+			want: `{"params": {"name": "prod-cluster", "location": "westeurope", "address_space": ["10.1.0.0/16"]}}`,
+		},
+		{
+			name: "multiple references in single value",
+			json: `{"name": "${var.name}-${LOCATION}"}`,
+			want: `{"name": "prod-westeurope"}`,
+		},
+		{
+			name: "defaults",
+			json: `{"a": "${MISSING:-northeurope}", "b": "${EMPTY:-default}", "c": "${var.missing:-10.0.0.0/16}", "d": "${var.empty:-}", "e": "${LOCATION:-northeurope}"}`,
+			want: `{"a": "northeurope", "b": "default", "c": "10.0.0.0/16", "d": "", "e": "westeurope"}`,
+		},
+		{
+			name: "escaping",
+			json: `{"script": "echo $${HOME} ${var.name}", "price": "$5"}`,
+			want: `{"script": "echo ${HOME} prod", "price": "$5"}`,
+		},
+		{
+			name: "non string values and keys are not changed",
+			json: `{"${var.name}": 1, "enabled": true, "nothing": null}`,
+			want: `{"${var.name}": 1, "enabled": true, "nothing": null}`,
+		},
+		{
+			name: "undefined variables",
+			json: `{"params": {"name": "${var.nme}", "location": "${LOCATON}", "subnets": [{"name": "ok"}, {"name": "${var.subnet}"}]}}`,
+			wantErr: ReferencesError{
+				{Key: "params.location", Reference: "${LOCATON}", Variable: "LOCATON"},
+				{Key: "params.name", Reference: "${var.nme}", Variable: "var.nme"},
+				{Key: "params.subnets[1].name", Reference: "${var.subnet}", Variable: "var.subnet"},
+			},
+		},
+		{
+			name: "incorrect references",
+			json: `{"a": "${}", "b": "${var.}", "c": "${NAME", "d": "${with space}"}`,
+			wantErr: ReferencesError{
+				{Key: "a", Reference: "${}"},
+				{Key: "b", Reference: "${var.}"},
+				{Key: "c", Reference: "${NAME"},
+				{Key: "d", Reference: "${with space}"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Expand([]byte(tt.json), vars, env)
+			if tt.wantErr != nil {
+				var refsErr ReferencesError
+				if !errors.As(err, &refsErr) {
+					t.Fatalf("Expand() expected to return ReferencesError, got %v", err)
+				}
+				if diff := cmp.Diff(tt.wantErr, refsErr); diff != "" {
+					t.Errorf("Expand() error mismatch (-want +got):\n%s", diff)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expand() unexpected error occurred: %v", err)
+			}
+			var gotDoc, wantDoc interface{}
+			if err = json.Unmarshal(got, &gotDoc); err != nil {
+				t.Fatal(err)
+			}
+			if err = json.Unmarshal([]byte(tt.want), &wantDoc); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(wantDoc, gotDoc); diff != "" {
+				t.Errorf("Expand() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExpand_Unchanged(t *testing.T) {
+	b := []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4"
+}`)
+	got, err := Expand(b, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(b) {
+		t.Errorf("Expand() expected to return document without references unchanged, got %s", got)
+	}
+}
+
+func TestReferencesError_Error(t *testing.T) {
+	err := ReferencesError{
+		{Key: "params.name", Reference: "${var.name}", Variable: "var.name"},
+		{Key: "params.location", Reference: "${LOCATION"},
+	}
+	want := `undefined variable 'var.name' at 'params.name'
+incorrect reference '${LOCATION' at 'params.location'`
+	if got := err.Error(); got != want {
+		t.Errorf("Error() mismatch (-want +got):\n%s", cmp.Diff(want, got))
+	}
+}
@@ -0,0 +1,180 @@
+// Package kinds contains registry of all document kinds defined in this repository, so tools
+// can work with documents without knowing their kind upfront.
+package kinds
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	awsks "github.com/epiphany-platform/e-structures/awsks/v0"
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	azkv "github.com/epiphany-platform/e-structures/azkv/v0"
+	azlb "github.com/epiphany-platform/e-structures/azlb/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	azstorage "github.com/epiphany-platform/e-structures/azstorage/v0"
+	bastion "github.com/epiphany-platform/e-structures/bastion/v0"
+	dns "github.com/epiphany-platform/e-structures/dns/v0"
+	gcpbi "github.com/epiphany-platform/e-structures/gcpbi/v0"
+	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	k8saddons "github.com/epiphany-platform/e-structures/k8saddons/v0"
+	monitoring "github.com/epiphany-platform/e-structures/monitoring/v0"
+	st "github.com/epiphany-platform/e-structures/state/v0"
+)
+
+// Document is implemented by all top level structures (configs and state).
+type Document interface {
+	Marshal() ([]byte, error)
+	Unmarshal(b []byte) error
+	UnmarshalStrict(b []byte) error
+}
+
+type Kind struct {
+	// Name is value of kind field of document.
+	Name string
+	// Version is current version of document of this kind.
+	Version string
+	// New returns empty document ready to be unmarshalled into.
+	New func() Document
+	// Default returns document with default values.
+	Default func() Document
+}
+
+var registry = map[string]Kind{}
+
+// Register adds kind to registry. It panics if kind with the same name is already registered.
+func Register(k Kind) {
+	if _, ok := registry[k.Name]; ok {
+		panic(fmt.Sprintf("kind %s already registered", k.Name))
+	}
+	registry[k.Name] = k
+}
+
+func init() {
+	Register(Kind{
+		Name:    "azbi",
+		Version: *azbi.NewConfig().Version,
+		New:     func() Document { return &azbi.Config{} },
+		Default: func() Document { return azbi.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "azks",
+		Version: *azks.NewConfig().Version,
+		New:     func() Document { return &azks.Config{} },
+		Default: func() Document { return azks.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "awsbi",
+		Version: *awsbi.NewConfig().Version,
+		New:     func() Document { return &awsbi.Config{} },
+		Default: func() Document { return awsbi.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "awsks",
+		Version: *awsks.NewConfig().Version,
+		New:     func() Document { return &awsks.Config{} },
+		Default: func() Document { return awsks.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "gcpbi",
+		Version: *gcpbi.NewConfig().Version,
+		New:     func() Document { return &gcpbi.Config{} },
+		Default: func() Document { return gcpbi.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "azpg",
+		Version: *azpg.NewConfig().Version,
+		New:     func() Document { return &azpg.Config{} },
+		Default: func() Document { return azpg.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "azstorage",
+		Version: *azstorage.NewConfig().Version,
+		New:     func() Document { return &azstorage.Config{} },
+		Default: func() Document { return azstorage.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "azlb",
+		Version: *azlb.NewConfig().Version,
+		New:     func() Document { return &azlb.Config{} },
+		Default: func() Document { return azlb.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "dns",
+		Version: *dns.NewConfig().Version,
+		New:     func() Document { return &dns.Config{} },
+		Default: func() Document { return dns.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "azkv",
+		Version: *azkv.NewConfig().Version,
+		New:     func() Document { return &azkv.Config{} },
+		Default: func() Document { return azkv.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "k8saddons",
+		Version: *k8saddons.NewConfig().Version,
+		New:     func() Document { return &k8saddons.Config{} },
+		Default: func() Document { return k8saddons.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "monitoring",
+		Version: *monitoring.NewConfig().Version,
+		New:     func() Document { return &monitoring.Config{} },
+		Default: func() Document { return monitoring.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "bastion",
+		Version: *bastion.NewConfig().Version,
+		New:     func() Document { return &bastion.Config{} },
+		Default: func() Document { return bastion.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "hi",
+		Version: *hi.NewConfig().Version,
+		New:     func() Document { return &hi.Config{} },
+		Default: func() Document { return hi.NewConfig() },
+	})
+	Register(Kind{
+		Name:    "state",
+		Version: *st.NewState().Version,
+		New:     func() Document { return &st.State{} },
+		Default: func() Document { return st.NewState() },
+	})
+}
+
+// Get returns registered kind with provided name.
+func Get(name string) (Kind, error) {
+	k, ok := registry[name]
+	if !ok {
+		return Kind{}, fmt.Errorf("unknown kind '%s'", name)
+	}
+	return k, nil
+}
+
+// Names returns sorted names of all registered kinds.
+func Names() []string {
+	result := make([]string, 0, len(registry))
+	for name := range registry {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Detect returns registered kind of JSON document b based on its kind field.
+func Detect(b []byte) (Kind, error) {
+	var header struct {
+		Kind *string `json:"kind"`
+	}
+	if err := json.Unmarshal(b, &header); err != nil {
+		return Kind{}, err
+	}
+	if header.Kind == nil {
+		return Kind{}, errors.New("document has no kind field")
+	}
+	return Get(*header.Kind)
+}
@@ -0,0 +1,86 @@
+package kinds
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "azbi config",
+			json: []byte(`{"kind": "azbi", "version": "v0.1.4"}`),
+			want: "azbi",
+		},
+		{
+			name: "state",
+			json: []byte(`{"kind": "state", "version": "v0.0.6"}`),
+			want: "state",
+		},
+		{
+			name:    "unknown kind",
+			json:    []byte(`{"kind": "unknown"}`),
+			wantErr: true,
+		},
+		{
+			name:    "missing kind",
+			json:    []byte(`{"version": "v0.1.4"}`),
+			wantErr: true,
+		},
+		{
+			name:    "incorrect json",
+			json:    []byte(`{"kind": `),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Detect(tt.json)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Detect() error = %v, wantErr %t", err, tt.wantErr)
+			}
+			if got.Name != tt.want {
+				t.Errorf("Detect() = %s, want %s", got.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestKinds_Default(t *testing.T) {
+	for _, name := range Names() {
+		t.Run(name, func(t *testing.T) {
+			k, err := Get(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := k.Default().Marshal()
+			if err != nil {
+				t.Fatalf("Default() document is incorrect: %v", err)
+			}
+			detected, err := Detect(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if detected.Name != name {
+				t.Errorf("Detect() of default document = %s, want %s", detected.Name, name)
+			}
+			d := k.New()
+			if err = d.UnmarshalStrict(b); err != nil {
+				t.Errorf("UnmarshalStrict() of default document failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	want := []string{"awsbi", "awsks", "azbi", "azks", "azkv", "azlb", "azpg", "azstorage", "bastion", "dns", "gcpbi", "hi", "k8saddons", "monitoring", "state"}
+	if diff := cmp.Diff(want, Names()); diff != "" {
+		t.Errorf("Names() mismatch (-want +got):\n%s", diff)
+	}
+}
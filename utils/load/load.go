@@ -1,116 +1,581 @@
 package load
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"io/ioutil"
 	"os"
 
 	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	awsks "github.com/epiphany-platform/e-structures/awsks/v0"
 	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
 	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	azkv "github.com/epiphany-platform/e-structures/azkv/v0"
+	azlb "github.com/epiphany-platform/e-structures/azlb/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	azstorage "github.com/epiphany-platform/e-structures/azstorage/v0"
+	bastion "github.com/epiphany-platform/e-structures/bastion/v0"
+	dns "github.com/epiphany-platform/e-structures/dns/v0"
+	gcpbi "github.com/epiphany-platform/e-structures/gcpbi/v0"
 	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	k8saddons "github.com/epiphany-platform/e-structures/k8saddons/v0"
+	monitoring "github.com/epiphany-platform/e-structures/monitoring/v0"
 	st "github.com/epiphany-platform/e-structures/state/v0"
+	"github.com/epiphany-platform/e-structures/utils/position"
+	"github.com/epiphany-platform/e-structures/utils/store"
+	"github.com/epiphany-platform/e-structures/utils/strict"
 )
 
-func State(path string) (*st.State, error) {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
+// osFS opens files of operating system by their paths. It is used to implement path based
+// functions with FS based ones, so it doesn't restrict names the way fs.FS implementations should.
+type osFS struct{}
+
+func (osFS) Open(name string) (fs.File, error) {
+	return os.Open(name)
+}
+
+func State(path string, opts ...Option) (*st.State, error) {
+	return StateFromFS(osFS{}, path, opts...)
+}
+
+// StateFromFS loads state from file name of fsys. If file doesn't exist, new state is returned
+// unless MustExist option is set.
+func StateFromFS(fsys fs.FS, name string, opts ...Option) (*st.State, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
+			return nil, err
+		}
 		return st.NewState(), nil
-	} else {
-		state := &st.State{}
-		bytes, err := ioutil.ReadFile(path)
-		if err != nil {
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return StateFromReader(f, named(name, opts)...)
+}
+
+// StateFromReader loads state from r.
+func StateFromReader(r io.Reader, opts ...Option) (*st.State, error) {
+	bytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	o := newOptions(opts)
+	return state(o.name, bytes, o)
+}
+
+// StateFromStore loads latest version of state from s. If s contains no state yet, new state is
+// returned unless MustExist option is set.
+func StateFromStore(ctx context.Context, s store.StateStore, opts ...Option) (*st.State, error) {
+	o := newOptions(named(s.String(), opts))
+	bytes, err := s.Get(ctx, "")
+	if errors.Is(err, store.ErrNotFound) {
+		if err = o.missing(fmt.Errorf("%s: %w", s, err)); err != nil {
 			return nil, err
 		}
-		// TODO after issue https://github.com/epiphany-platform/e-structures/issues/10 is solved
-		// TODO this should be changed back to err = state.Unmarshal(bytes)
-		err = state.UnmarshalDoNotUse(bytes)
+		return st.NewState(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return state(o.name, bytes, o)
+}
+
+func state(path string, bytes []byte, o *options) (*st.State, error) {
+	state := &st.State{}
+	input, err := o.preprocess(bytes, state)
+	if err != nil {
+		return nil, position.Annotate(path, bytes, state, err)
+	}
+	// TODO after issue https://github.com/epiphany-platform/e-structures/issues/10 is solved
+	// TODO this should be changed back to err = state.Unmarshal(bytes)
+	err = state.UnmarshalDoNotUse(input)
+	if err != nil {
+		return nil, position.AnnotateDecoded(path, bytes, input, state, err)
+	}
+	if o.strict {
+		err = strict.Check(state, state.Unused)
 		if err != nil {
+			return nil, position.AnnotateDecoded(path, bytes, input, state, err)
+		}
+	}
+
+	// TODO temporary code because of before mentioned issue
+	if state.GetAzBI() != nil && state.GetAzBI().Status == "" {
+		state.AzBI = nil
+	}
+	if state.GetAzKS() != nil && state.GetAzKS().Status == "" {
+		state.AzKS = nil
+	}
+	if state.GetHi() != nil && state.GetHi().Status == "" {
+		state.Hi = nil
+	}
+	err = state.IsValidDoNotUse()
+	if err != nil {
+		return nil, position.AnnotateDecoded(path, bytes, input, state, err)
+	}
+	// TODO end of temporary code
+
+	return state, nil
+}
+
+func AzBIConfig(path string, opts ...Option) (*azbi.Config, error) {
+	return AzBIConfigFromFS(osFS{}, path, opts...)
+}
+
+// AzBIConfigFromFS loads AzBI config from file name of fsys. If file doesn't exist, new config is
+// returned unless MustExist option is set.
+func AzBIConfigFromFS(fsys fs.FS, name string, opts ...Option) (*azbi.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
+		return azbi.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return AzBIConfigFromReader(f, named(name, opts)...)
+}
+
+// AzBIConfigFromReader loads AzBI config from r.
+func AzBIConfigFromReader(r io.Reader, opts ...Option) (*azbi.Config, error) {
+	config := &azbi.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func AzKSConfig(path string, opts ...Option) (*azks.Config, error) {
+	return AzKSConfigFromFS(osFS{}, path, opts...)
+}
 
-		// TODO temporary code because of before mentioned issue
-		if state.GetAzBIState() != nil && state.GetAzBIState().Status == "" {
-			state.AzBI = nil
+// AzKSConfigFromFS loads AzKS config from file name of fsys. If file doesn't exist, new config is
+// returned unless MustExist option is set.
+func AzKSConfigFromFS(fsys fs.FS, name string, opts ...Option) (*azks.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
+			return nil, err
 		}
-		if state.GetAzKSState() != nil && state.GetAzKSState().Status == "" {
-			state.AzKS = nil
+		return azks.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return AzKSConfigFromReader(f, named(name, opts)...)
+}
+
+// AzKSConfigFromReader loads AzKS config from r.
+func AzKSConfigFromReader(r io.Reader, opts ...Option) (*azks.Config, error) {
+	config := &azks.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func HiConfig(path string, opts ...Option) (*hi.Config, error) {
+	return HiConfigFromFS(osFS{}, path, opts...)
+}
+
+// HiConfigFromFS loads Hi config from file name of fsys. If file doesn't exist, new config is
+// returned unless MustExist option is set.
+func HiConfigFromFS(fsys fs.FS, name string, opts ...Option) (*hi.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
+			return nil, err
 		}
-		if state.GetHiState() != nil && state.GetHiState().Status == "" {
-			state.Hi = nil
+		return hi.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return HiConfigFromReader(f, named(name, opts)...)
+}
+
+// HiConfigFromReader loads Hi config from r.
+func HiConfigFromReader(r io.Reader, opts ...Option) (*hi.Config, error) {
+	config := &hi.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func AwsBIConfig(path string, opts ...Option) (*awsbi.Config, error) {
+	return AwsBIConfigFromFS(osFS{}, path, opts...)
+}
+
+// AwsBIConfigFromFS loads AwsBI config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func AwsBIConfigFromFS(fsys fs.FS, name string, opts ...Option) (*awsbi.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
+			return nil, err
 		}
-		err = state.IsValidDoNotUse()
-		if err != nil {
+		return awsbi.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return AwsBIConfigFromReader(f, named(name, opts)...)
+}
+
+// AwsBIConfigFromReader loads AwsBI config from r.
+func AwsBIConfigFromReader(r io.Reader, opts ...Option) (*awsbi.Config, error) {
+	config := &awsbi.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func AwsKSConfig(path string, opts ...Option) (*awsks.Config, error) {
+	return AwsKSConfigFromFS(osFS{}, path, opts...)
+}
+
+// AwsKSConfigFromFS loads AwsKS config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func AwsKSConfigFromFS(fsys fs.FS, name string, opts ...Option) (*awsks.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		// TODO end of temporary code
+		return awsks.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return AwsKSConfigFromReader(f, named(name, opts)...)
+}
 
-		return state, nil
+// AwsKSConfigFromReader loads AwsKS config from r.
+func AwsKSConfigFromReader(r io.Reader, opts ...Option) (*awsks.Config, error) {
+	config := &awsks.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
 	}
+	return config, nil
 }
 
-func AzBIConfig(path string) (*azbi.Config, error) {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return azbi.NewConfig(), nil
-	} else {
-		config := &azbi.Config{}
-		bytes, err := ioutil.ReadFile(path)
-		if err != nil {
+func GcpBIConfig(path string, opts ...Option) (*gcpbi.Config, error) {
+	return GcpBIConfigFromFS(osFS{}, path, opts...)
+}
+
+// GcpBIConfigFromFS loads GcpBI config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func GcpBIConfigFromFS(fsys fs.FS, name string, opts ...Option) (*gcpbi.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		err = config.Unmarshal(bytes)
-		if err != nil {
+		return gcpbi.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return GcpBIConfigFromReader(f, named(name, opts)...)
+}
+
+// GcpBIConfigFromReader loads GcpBI config from r.
+func GcpBIConfigFromReader(r io.Reader, opts ...Option) (*gcpbi.Config, error) {
+	config := &gcpbi.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func AzPGConfig(path string, opts ...Option) (*azpg.Config, error) {
+	return AzPGConfigFromFS(osFS{}, path, opts...)
+}
+
+// AzPGConfigFromFS loads AzPG config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func AzPGConfigFromFS(fsys fs.FS, name string, opts ...Option) (*azpg.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		return config, nil
+		return azpg.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	defer f.Close()
+	return AzPGConfigFromReader(f, named(name, opts)...)
 }
 
-func AzKSConfig(path string) (*azks.Config, error) {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return azks.NewConfig(), nil
-	} else {
-		config := &azks.Config{}
-		bytes, err := ioutil.ReadFile(path)
-		if err != nil {
+// AzPGConfigFromReader loads AzPG config from r.
+func AzPGConfigFromReader(r io.Reader, opts ...Option) (*azpg.Config, error) {
+	config := &azpg.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func AzStorageConfig(path string, opts ...Option) (*azstorage.Config, error) {
+	return AzStorageConfigFromFS(osFS{}, path, opts...)
+}
+
+// AzStorageConfigFromFS loads AzStorage config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func AzStorageConfigFromFS(fsys fs.FS, name string, opts ...Option) (*azstorage.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		err = config.Unmarshal(bytes)
-		if err != nil {
+		return azstorage.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return AzStorageConfigFromReader(f, named(name, opts)...)
+}
+
+// AzStorageConfigFromReader loads AzStorage config from r.
+func AzStorageConfigFromReader(r io.Reader, opts ...Option) (*azstorage.Config, error) {
+	config := &azstorage.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func AzLBConfig(path string, opts ...Option) (*azlb.Config, error) {
+	return AzLBConfigFromFS(osFS{}, path, opts...)
+}
+
+// AzLBConfigFromFS loads AzLB config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func AzLBConfigFromFS(fsys fs.FS, name string, opts ...Option) (*azlb.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		return config, nil
+		return azlb.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	defer f.Close()
+	return AzLBConfigFromReader(f, named(name, opts)...)
 }
 
-func HiConfig(path string) (*hi.Config, error) {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return hi.NewConfig(), nil
-	} else {
-		config := &hi.Config{}
-		bytes, err := ioutil.ReadFile(path)
-		if err != nil {
+// AzLBConfigFromReader loads AzLB config from r.
+func AzLBConfigFromReader(r io.Reader, opts ...Option) (*azlb.Config, error) {
+	config := &azlb.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func DnsConfig(path string, opts ...Option) (*dns.Config, error) {
+	return DnsConfigFromFS(osFS{}, path, opts...)
+}
+
+// DnsConfigFromFS loads Dns config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func DnsConfigFromFS(fsys fs.FS, name string, opts ...Option) (*dns.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		err = config.Unmarshal(bytes)
-		if err != nil {
+		return dns.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return DnsConfigFromReader(f, named(name, opts)...)
+}
+
+// DnsConfigFromReader loads Dns config from r.
+func DnsConfigFromReader(r io.Reader, opts ...Option) (*dns.Config, error) {
+	config := &dns.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func AzKVConfig(path string, opts ...Option) (*azkv.Config, error) {
+	return AzKVConfigFromFS(osFS{}, path, opts...)
+}
+
+// AzKVConfigFromFS loads AzKV config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func AzKVConfigFromFS(fsys fs.FS, name string, opts ...Option) (*azkv.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		return config, nil
+		return azkv.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	defer f.Close()
+	return AzKVConfigFromReader(f, named(name, opts)...)
 }
 
-func AwsBIConfig(path string) (*awsbi.Config, error) {
-	if _, err := os.Stat(path); os.IsNotExist(err) {
-		return awsbi.NewConfig(), nil
-	} else {
-		config := &awsbi.Config{}
-		bytes, err := ioutil.ReadFile(path)
-		if err != nil {
+// AzKVConfigFromReader loads AzKV config from r.
+func AzKVConfigFromReader(r io.Reader, opts ...Option) (*azkv.Config, error) {
+	config := &azkv.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func K8sAddonsConfig(path string, opts ...Option) (*k8saddons.Config, error) {
+	return K8sAddonsConfigFromFS(osFS{}, path, opts...)
+}
+
+// K8sAddonsConfigFromFS loads K8sAddons config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func K8sAddonsConfigFromFS(fsys fs.FS, name string, opts ...Option) (*k8saddons.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		err = config.Unmarshal(bytes)
-		if err != nil {
+		return k8saddons.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return K8sAddonsConfigFromReader(f, named(name, opts)...)
+}
+
+// K8sAddonsConfigFromReader loads K8sAddons config from r.
+func K8sAddonsConfigFromReader(r io.Reader, opts ...Option) (*k8saddons.Config, error) {
+	config := &k8saddons.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func MonitoringConfig(path string, opts ...Option) (*monitoring.Config, error) {
+	return MonitoringConfigFromFS(osFS{}, path, opts...)
+}
+
+// MonitoringConfigFromFS loads Monitoring config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func MonitoringConfigFromFS(fsys fs.FS, name string, opts ...Option) (*monitoring.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
 			return nil, err
 		}
-		return config, nil
+		return monitoring.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return MonitoringConfigFromReader(f, named(name, opts)...)
+}
+
+// MonitoringConfigFromReader loads Monitoring config from r.
+func MonitoringConfigFromReader(r io.Reader, opts ...Option) (*monitoring.Config, error) {
+	config := &monitoring.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func BastionConfig(path string, opts ...Option) (*bastion.Config, error) {
+	return BastionConfigFromFS(osFS{}, path, opts...)
+}
+
+// BastionConfigFromFS loads Bastion config from file name of fsys. If file doesn't exist, new config
+// is returned unless MustExist option is set.
+func BastionConfigFromFS(fsys fs.FS, name string, opts ...Option) (*bastion.Config, error) {
+	f, err := fsys.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		if err = newOptions(opts).missing(err); err != nil {
+			return nil, err
+		}
+		return bastion.NewConfig(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return BastionConfigFromReader(f, named(name, opts)...)
+}
+
+// BastionConfigFromReader loads Bastion config from r.
+func BastionConfigFromReader(r io.Reader, opts ...Option) (*bastion.Config, error) {
+	config := &bastion.Config{}
+	err := decode(r, config, newOptions(opts), config.Unmarshal, config.UnmarshalStrict)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// decode reads document from r and decodes it into config with unmarshal, or unmarshalStrict if
+// Strict option is set. Errors are annotated with positions in document as read from r, not
+// preprocessed one.
+func decode(r io.Reader, config interface{}, o *options, unmarshal, unmarshalStrict func([]byte) error) error {
+	bytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	input, err := o.preprocess(bytes, config)
+	if err != nil {
+		return position.Annotate(o.name, bytes, config, err)
+	}
+	if o.strict {
+		err = unmarshalStrict(input)
+	} else {
+		err = unmarshal(input)
+	}
+	if err != nil {
+		return position.AnnotateDecoded(o.name, bytes, input, config, err)
 	}
+	return nil
 }
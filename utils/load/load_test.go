@@ -0,0 +1,517 @@
+package load
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	st "github.com/epiphany-platform/e-structures/state/v0"
+	"github.com/epiphany-platform/e-structures/utils/encryption"
+	"github.com/epiphany-platform/e-structures/utils/interpolate"
+	"github.com/epiphany-platform/e-structures/utils/save"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/store"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/epiphany-platform/e-structures/utils/to"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHiConfig_Strict(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := ioutil.WriteFile(path, []byte(`{
+	"kind": "hi",
+	"version": "v0.0.1",
+	"params": {
+		"vm_groups": [],
+		"rsa_private_path": "/shared/vms_rsa",
+		"extra_key": "value"
+	}
+}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := HiConfig(path)
+	if err != nil {
+		t.Fatalf("HiConfig() unexpected error occured: %v", err)
+	}
+	if diff := cmp.Diff([]string{"params.extra_key"}, config.Unused); diff != "" {
+		t.Errorf("HiConfig() Unused mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = HiConfig(path, Strict())
+	want := strict.UnknownKeysError{
+		{
+			Key: "params.extra_key",
+		},
+	}
+	var got strict.UnknownKeysError
+	if !errors.As(err, &got) {
+		t.Fatalf("HiConfig() with Strict() expected to return strict.UnknownKeysError, got %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("HiConfig() with Strict() error mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestState_Strict(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	err := ioutil.WriteFile(path, []byte(`{
+	"kind": "state",
+	"version": "v0.0.6",
+	"azbi": {
+		"staus": "applied"
+	}
+}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = State(path, Strict())
+	want := strict.UnknownKeysError{
+		{
+			Key:        "azbi.staus",
+			Suggestion: "status",
+		},
+	}
+	var got strict.UnknownKeysError
+	if !errors.As(err, &got) {
+		t.Fatalf("State() with Strict() expected to return strict.UnknownKeysError, got %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("State() with Strict() error mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAzKSConfig_Position(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := ioutil.WriteFile(path, []byte(`{
+	"kind": "azks",
+	"version": "v0.0.3",
+	"params": {
+		"name": 12
+	}
+}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = AzKSConfig(path)
+	want := path + ":5:3: 'params.name' expected type 'string', got unconvertible type 'float64'"
+	if err == nil || err.Error() != want {
+		t.Errorf("AzKSConfig() error = %v, want %s", err, want)
+	}
+}
+
+func TestAzKSConfig_InterpolatePosition(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := ioutil.WriteFile(path, []byte(`{
+	"kind": "azks",
+	"version": "v0.0.3",
+	"params": {
+		"name": "${var.name}",
+		"location": 12
+	}
+}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = AzKSConfig(path, Interpolate(map[string]string{"name": "name-longer-than-reference"}))
+	want := path + ":6:3: 'params.location' expected type 'string', got unconvertible type 'float64'"
+	if err == nil || err.Error() != want {
+		t.Errorf("AzKSConfig() with Interpolate() error = %v, want %s", err, want)
+	}
+}
+
+func TestAzBIConfig_Interpolate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := ioutil.WriteFile(path, []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "${var.name}",
+		"location": "${E_STRUCTURES_TEST_LOCATION:-northeurope}",
+		"address_space": [
+			"${var.cidr}"
+		],
+		"subnets": [
+			{
+				"name": "main",
+				"address_prefixes": [
+					"${var.subnet_cidr}"
+				]
+			}
+		],
+		"vm_groups": [],
+		"rsa_pub_path": "${E_STRUCTURES_TEST_HOME}/vms_rsa.pub"
+	}
+}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Setenv("E_STRUCTURES_TEST_HOME", "/shared"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("E_STRUCTURES_TEST_HOME")
+
+	config, err := AzBIConfig(path, Interpolate(map[string]string{
+		"name":        "prod",
+		"cidr":        "10.1.0.0/16",
+		"subnet_cidr": "10.1.1.0/24",
+	}))
+	if err != nil {
+		t.Fatalf("AzBIConfig() with Interpolate() unexpected error occured: %v", err)
+	}
+	got := []string{
+		config.GetParams().GetNameV(),
+		config.GetParams().GetLocationV(),
+		config.GetParams().GetAddressSpace()[0],
+		config.GetParams().GetSubnets()[0].GetAddressPrefixes()[0],
+		config.GetParams().GetRsaPublicKeyPathV(),
+	}
+	want := []string{"prod", "northeurope", "10.1.0.0/16", "10.1.1.0/24", "/shared/vms_rsa.pub"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("AzBIConfig() with Interpolate() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = AzBIConfig(path, Interpolate(map[string]string{"name": "prod"}))
+	wantErr := path + ":8:4: undefined variable 'var.cidr' at 'params.address_space[0]'\n" +
+		path + ":14:6: undefined variable 'var.subnet_cidr' at 'params.subnets[0].address_prefixes[0]'"
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("AzBIConfig() with Interpolate() error = %v, want %s", err, wantErr)
+	}
+	var refsErr interpolate.ReferencesError
+	if !errors.As(err, &refsErr) {
+		t.Errorf("AzBIConfig() with Interpolate() expected to return interpolate.ReferencesError, got %v", err)
+	}
+
+	if _, err = AzBIConfig(path); err == nil {
+		t.Error("AzBIConfig() without Interpolate() expected to fail on not expanded references")
+	}
+}
+
+func TestAzKSConfig_ResolveSecrets(t *testing.T) {
+	c := azks.NewConfig()
+	c.Params.AzureAd = &azks.AzureAd{
+		Managed:             to.BooPtr(true),
+		TenantId:            to.StrPtr("env://E_STRUCTURES_TEST_TENANT_ID"),
+		AdminGroupObjectIds: []string{"123123123123"},
+	}
+	b, err := c.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err = ioutil.WriteFile(path, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = AzKSConfig(path, ResolveSecrets())
+	var resolveErrs sensitive.ResolveErrors
+	if !errors.As(err, &resolveErrs) {
+		t.Fatalf("AzKSConfig() with ResolveSecrets() expected to return sensitive.ResolveErrors, got %v", err)
+	}
+	if resolveErrs[0].Key != "params.azure_ad.tenant_id" {
+		t.Errorf("AzKSConfig() with ResolveSecrets() error key = %s, want params.azure_ad.tenant_id", resolveErrs[0].Key)
+	}
+
+	if err = os.Setenv("E_STRUCTURES_TEST_TENANT_ID", "123123123123"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("E_STRUCTURES_TEST_TENANT_ID")
+	config, err := AzKSConfig(path, ResolveSecrets())
+	if err != nil {
+		t.Fatalf("AzKSConfig() with ResolveSecrets() unexpected error occured: %v", err)
+	}
+	if got := config.GetParams().GetAzureAd().GetTenantIdV(); got != "123123123123" {
+		t.Errorf("AzKSConfig() with ResolveSecrets() tenant_id = %s, want 123123123123", got)
+	}
+
+	config, err = AzKSConfig(path)
+	if err != nil {
+		t.Fatalf("AzKSConfig() unexpected error occured: %v", err)
+	}
+	if got := config.GetParams().GetAzureAd().GetTenantIdV(); got != "env://E_STRUCTURES_TEST_TENANT_ID" {
+		t.Errorf("AzKSConfig() without ResolveSecrets() tenant_id = %s, want reference", got)
+	}
+}
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: epiphany
+  cluster:
+    server: https://epiphany-12345678.hcp.northeurope.azmk8s.io:443
+users:
+- name: clusterAdmin_epiphany-rg_epiphany
+contexts:
+- name: epiphany
+  context:
+    cluster: epiphany
+    user: clusterAdmin_epiphany-rg_epiphany
+current-context: epiphany
+`
+
+func TestState_Decrypt(t *testing.T) {
+	key, err := encryption.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyring, err := encryption.ParseKeyring([]byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := st.NewState()
+	s.AzKS = &st.AzKSState{
+		Status: st.Applied,
+		Output: &azks.Output{
+			KubeConfig: to.StrPtr(testKubeConfig),
+		},
+	}
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err = save.State(path, s, save.Encrypt(keyring)); err != nil {
+		t.Fatalf("save.State() with Encrypt() unexpected error occured: %v", err)
+	}
+	if got := s.GetAzKS().GetOutput().GetKubeConfigV(); got != testKubeConfig {
+		t.Errorf("save.State() with Encrypt() modified saved state, kubeconfig = %s", got)
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "clusterAdmin_epiphany-rg_epiphany") {
+		t.Errorf("save.State() with Encrypt() written plaintext kubeconfig:\n%s", b)
+	}
+
+	state, err := State(path)
+	if err != nil {
+		t.Fatalf("State() without Decrypt() unexpected error occured: %v", err)
+	}
+	if got := state.GetAzKS().GetOutput().GetKubeConfigV(); !encryption.IsEncrypted(got) {
+		t.Errorf("State() without Decrypt() kubeconfig = %s, want encrypted value", got)
+	}
+
+	otherKey, err := encryption.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := encryption.ParseKeyring([]byte(otherKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = State(path, Decrypt(other)); !errors.Is(err, encryption.ErrUnknownKey) {
+		t.Errorf("State() with Decrypt() of other key error = %v, want %v", err, encryption.ErrUnknownKey)
+	}
+
+	state, err = State(path, Decrypt(keyring))
+	if err != nil {
+		t.Fatalf("State() with Decrypt() unexpected error occured: %v", err)
+	}
+	if got := state.GetAzKS().GetOutput().GetKubeConfigV(); got != testKubeConfig {
+		t.Errorf("State() with Decrypt() kubeconfig = %s, want %s", got, testKubeConfig)
+	}
+}
+
+func TestStateFromStore(t *testing.T) {
+	ctx := context.Background()
+	s := store.NewFileStore(filepath.Join(t.TempDir(), "state.json"))
+	state, err := StateFromStore(ctx, s)
+	if err != nil {
+		t.Fatalf("StateFromStore() of empty store unexpected error occured: %v", err)
+	}
+	if diff := cmp.Diff(st.NewState(), state); diff != "" {
+		t.Errorf("StateFromStore() of empty store mismatch (-want +got):\n%s", diff)
+	}
+
+	state.AzKS = &st.AzKSState{
+		Status: st.Applied,
+		Output: &azks.Output{
+			KubeConfig: to.StrPtr(testKubeConfig),
+		},
+	}
+	if _, err = save.StateToStore(ctx, s, state); err != nil {
+		t.Fatalf("save.StateToStore() unexpected error occured: %v", err)
+	}
+	got, err := StateFromStore(ctx, s)
+	if err != nil {
+		t.Fatalf("StateFromStore() unexpected error occured: %v", err)
+	}
+	if diff := cmp.Diff(state, got); diff != "" {
+		t.Errorf("StateFromStore() mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err = s.Put(ctx, []byte(`{"kind": "state", "version": "v0.0.5", "azks": {"status": "unknown"}}`)); err != nil {
+		t.Fatal(err)
+	}
+	_, err = StateFromStore(ctx, s)
+	if err == nil || !strings.HasPrefix(err.Error(), s.String()+":") {
+		t.Errorf("StateFromStore() of incorrect state error = %v, want error annotated with %s", err, s)
+	}
+}
+
+func TestAzBIConfigFromFS(t *testing.T) {
+	valid, err := azbi.NewConfig().Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	fsys := fstest.MapFS{
+		"configs/valid.json":   {Data: valid},
+		"configs/invalid.json": {Data: []byte(`{"kind": "azbi", "version": "v0.1.2", "params": {"name": ""}}`)},
+	}
+
+	config, err := AzBIConfigFromFS(fsys, "configs/missing.json")
+	if err != nil {
+		t.Fatalf("AzBIConfigFromFS() of missing file unexpected error occured: %v", err)
+	}
+	if diff := cmp.Diff(azbi.NewConfig(), config); diff != "" {
+		t.Errorf("AzBIConfigFromFS() of missing file mismatch (-want +got):\n%s", diff)
+	}
+
+	config, err = AzBIConfigFromFS(fsys, "configs/valid.json")
+	if err != nil {
+		t.Fatalf("AzBIConfigFromFS() unexpected error occured: %v", err)
+	}
+	if diff := cmp.Diff(azbi.NewConfig(), config); diff != "" {
+		t.Errorf("AzBIConfigFromFS() mismatch (-want +got):\n%s", diff)
+	}
+
+	_, err = AzBIConfigFromFS(fsys, "configs/invalid.json")
+	if err == nil || !strings.HasPrefix(err.Error(), "configs/invalid.json:") {
+		t.Errorf("AzBIConfigFromFS() of invalid file error = %v, want error annotated with file name", err)
+	}
+}
+
+func TestHiConfigFromReader(t *testing.T) {
+	document := `{
+	"kind": "hi",
+	"version": "v0.0.1",
+	"params": {
+		"vm_groups": [],
+		"rsa_private_path": "/shared/vms_rsa",
+		"extra_key": "value"
+	}
+}`
+	config, err := HiConfigFromReader(strings.NewReader(document))
+	if err != nil {
+		t.Fatalf("HiConfigFromReader() unexpected error occured: %v", err)
+	}
+	if got := config.GetParams().GetRsaPrivateKeyPathV(); got != "/shared/vms_rsa" {
+		t.Errorf("HiConfigFromReader() rsa_private_path = %s, want /shared/vms_rsa", got)
+	}
+
+	_, err = HiConfigFromReader(strings.NewReader(document), Name("stdin"), Strict())
+	if err == nil || !strings.HasPrefix(err.Error(), "stdin:7:3:") {
+		t.Errorf("HiConfigFromReader() with Strict() error = %v, want error at stdin:7:3", err)
+	}
+}
+
+func TestMissing(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "state.json")
+	if err := save.State(existing, st.NewState()); err != nil {
+		t.Fatal(err)
+	}
+	loaders := []struct {
+		name string
+		load func(path string, opts ...Option) (interface{}, error)
+	}{
+		{
+			name: "State",
+			load: func(path string, opts ...Option) (interface{}, error) { return State(path, opts...) },
+		},
+		{
+			name: "AzBIConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AzBIConfig(path, opts...) },
+		},
+		{
+			name: "AzKSConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AzKSConfig(path, opts...) },
+		},
+		{
+			name: "HiConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return HiConfig(path, opts...) },
+		},
+		{
+			name: "AwsBIConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AwsBIConfig(path, opts...) },
+		},
+		{
+			name: "AwsKSConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AwsKSConfig(path, opts...) },
+		},
+		{
+			name: "GcpBIConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return GcpBIConfig(path, opts...) },
+		},
+		{
+			name: "AzPGConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AzPGConfig(path, opts...) },
+		},
+		{
+			name: "AzStorageConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AzStorageConfig(path, opts...) },
+		},
+		{
+			name: "AzLBConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AzLBConfig(path, opts...) },
+		},
+		{
+			name: "DnsConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return DnsConfig(path, opts...) },
+		},
+		{
+			name: "AzKVConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return AzKVConfig(path, opts...) },
+		},
+		{
+			name: "K8sAddonsConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return K8sAddonsConfig(path, opts...) },
+		},
+		{
+			name: "MonitoringConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return MonitoringConfig(path, opts...) },
+		},
+		{
+			name: "BastionConfig",
+			load: func(path string, opts ...Option) (interface{}, error) { return BastionConfig(path, opts...) },
+		},
+	}
+	missing := filepath.Join(dir, "missing.json")
+	for _, l := range loaders {
+		t.Run(l.name, func(t *testing.T) {
+			defaulted := false
+			v, err := l.load(missing, Defaulted(&defaulted))
+			if err != nil || v == nil || !defaulted {
+				t.Errorf("%s() of missing file = %v, %v, defaulted %t, want defaults", l.name, v, err, defaulted)
+			}
+			v, err = l.load(missing, MustExist(), DefaultIfMissing())
+			if err != nil || v == nil {
+				t.Errorf("%s() with DefaultIfMissing() after MustExist() = %v, %v, want defaults", l.name, v, err)
+			}
+			_, err = l.load(missing, MustExist(), Defaulted(&defaulted))
+			if !errors.Is(err, os.ErrNotExist) || defaulted {
+				t.Errorf("%s() with MustExist() error = %v, defaulted %t, want error wrapping os.ErrNotExist", l.name, err, defaulted)
+			}
+		})
+	}
+
+	defaulted := true
+	if _, err := State(existing, MustExist(), Defaulted(&defaulted)); err != nil || defaulted {
+		t.Errorf("State() of existing file error = %v, defaulted %t, want no defaults", err, defaulted)
+	}
+	if _, err := State(dir, MustExist()); err == nil {
+		t.Errorf("State() of directory expected to fail")
+	}
+
+	s := store.NewFileStore(missing)
+	_, err := StateFromStore(context.Background(), s, MustExist())
+	if !errors.Is(err, store.ErrNotFound) {
+		t.Errorf("StateFromStore() with MustExist() error = %v, want %v", err, store.ErrNotFound)
+	}
+}
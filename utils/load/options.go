@@ -0,0 +1,144 @@
+package load
+
+import (
+	"reflect"
+
+	"github.com/epiphany-platform/e-structures/utils/encryption"
+	"github.com/epiphany-platform/e-structures/utils/interpolate"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+)
+
+type options struct {
+	name           string
+	mustExist      bool
+	defaulted      *bool
+	strict         bool
+	interpolate    bool
+	variables      map[string]string
+	resolveSecrets bool
+	keyring        *encryption.Keyring
+}
+
+// Option modifies behaviour of load functions.
+type Option func(*options)
+
+// Name sets name of loaded document used in positions of errors. Path and FS based functions use
+// name of loaded file by default, reader based ones have no name unless this option is set.
+func Name(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+// MustExist makes load functions fail with error wrapping fs.ErrNotExist (store.ErrNotFound for
+// StateFromStore) if loaded document doesn't exist, instead of returning new structure with
+// default values.
+func MustExist() Option {
+	return func(o *options) {
+		o.mustExist = true
+	}
+}
+
+// DefaultIfMissing makes load functions return new structure with default values if loaded
+// document doesn't exist. It is default behaviour, option exists to make it explicit and to
+// override MustExist passed earlier.
+func DefaultIfMissing() Option {
+	return func(o *options) {
+		o.mustExist = false
+	}
+}
+
+// Defaulted makes load functions set used to true if document didn't exist and new structure with
+// default values was returned, and to false otherwise.
+func Defaulted(used *bool) Option {
+	return func(o *options) {
+		o.defaulted = used
+	}
+}
+
+// Strict makes load functions fail with strict.UnknownKeysError if loaded document contains keys
+// not matching loaded structure, instead of only collecting them in Unused field.
+func Strict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// Interpolate makes load functions expand references in string values of loaded document before
+// it is decoded. ${var.name} references are taken from vars and ${NAME} references from environment
+// variables. See package interpolate for full syntax. If any reference cannot be expanded,
+// interpolate.ReferencesError listing all of them is returned.
+func Interpolate(vars map[string]string) Option {
+	return func(o *options) {
+		o.interpolate = true
+		o.variables = vars
+	}
+}
+
+// ResolveSecrets makes load functions replace secret references (file://, env://) found in
+// sensitive fields with values they point to, before document is decoded. Loaded structure
+// contains secret values inline, so it shouldn't be saved back in place of document with
+// references. If any reference cannot be resolved, sensitive.ResolveErrors is returned.
+func ResolveSecrets() Option {
+	return func(o *options) {
+		o.resolveSecrets = true
+	}
+}
+
+// Decrypt makes load functions decrypt values of sensitive fields encrypted with one of keys from
+// keyring, before document is decoded. Without this option encrypted values are kept in loaded
+// structure as they are.
+func Decrypt(k *encryption.Keyring) Option {
+	return func(o *options) {
+		o.keyring = k
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.defaulted != nil {
+		*o.defaulted = false
+	}
+	return o
+}
+
+// missing is called when loaded document doesn't exist and err describes it. It returns err if
+// MustExist option is set, otherwise it records that defaults are used and returns nil.
+func (o *options) missing(err error) error {
+	if o.mustExist {
+		return err
+	}
+	if o.defaulted != nil {
+		*o.defaulted = true
+	}
+	return nil
+}
+
+// named returns opts preceded by Name option, so name can still be overridden by opts.
+func named(name string, opts []Option) []Option {
+	return append([]Option{Name(name)}, opts...)
+}
+
+// preprocess returns document b ready to be decoded into v.
+func (o *options) preprocess(b []byte, v interface{}) (result []byte, err error) {
+	result = b
+	if o.interpolate {
+		if result, err = interpolate.Expand(result, o.variables, nil); err != nil {
+			return nil, err
+		}
+	}
+	if o.keyring != nil {
+		if result, err = encryption.DecryptJSON(reflect.TypeOf(v), result, o.keyring); err != nil {
+			return nil, err
+		}
+	}
+	if o.resolveSecrets {
+		if result, err = sensitive.Resolve(reflect.TypeOf(v), result); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
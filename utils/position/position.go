@@ -0,0 +1,322 @@
+// Package position maps errors returned while decoding and validating documents to file, line
+// and column of offending key in source document. Only JSON documents are supported.
+package position
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/epiphany-platform/e-structures/utils/interpolate"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/epiphany-platform/e-structures/utils/strict"
+	"github.com/go-playground/validator/v10"
+	maps "github.com/mitchellh/mapstructure"
+)
+
+var (
+	mapstructureKeyRegexp = regexp.MustCompile(`^'([^']*)'`)
+	segmentRegexp         = regexp.MustCompile(`^([^\[]*)((?:\[\d+\])*)$`)
+)
+
+type Position struct {
+	File   string
+	Line   int
+	Column int
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// Entry is single problem found in document with its position. Key is JSON path of offending
+// key (i.e. params.vm_groups[0].name), Position points to the closest existing key on that path.
+type Entry struct {
+	Position
+	Key     string
+	Message string
+}
+
+func (e Entry) Error() string {
+	return fmt.Sprintf("%s: %s", e.Position, e.Message)
+}
+
+// Error wraps error returned by Unmarshal or UnmarshalStrict with positions of offending keys.
+// Original error is available with errors.Unwrap or errors.As.
+type Error struct {
+	Err     error
+	Entries []Entry
+}
+
+func (e *Error) Error() string {
+	buff := bytes.NewBufferString("")
+
+	for _, entry := range e.Entries {
+		buff.WriteString(entry.Error())
+		buff.WriteString("\n")
+	}
+
+	return strings.TrimSpace(buff.String())
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Annotate converts err returned when b read from file was unmarshalled into v to *Error
+// containing positions of problems. JSON syntax and type errors, mapstructure decoding errors,
+// validation errors, strict.UnknownKeysError, interpolate.ReferencesError and
+// sensitive.ResolveErrors are supported, other errors are returned unchanged.
+func Annotate(file string, b []byte, v interface{}, err error) error {
+	return AnnotateDecoded(file, b, b, v, err)
+}
+
+// AnnotateDecoded works like Annotate for err returned when decoded, produced from b by
+// interpolation, decryption or resolving of secrets, was unmarshalled into v. Offsets of syntax
+// and type errors in decoded are mapped to position of the closest preceding key in b.
+func AnnotateDecoded(file string, b, decoded []byte, v interface{}, err error) error {
+	if err == nil {
+		return nil
+	}
+	offsets, _ := Index(b)
+	locate := func(key string) Position {
+		for {
+			if offset, ok := offsets[key]; ok {
+				return position(file, b, offset)
+			}
+			if key == "" {
+				return position(file, b, 0)
+			}
+			key = parent(key)
+		}
+	}
+	at := func(offset int) Position {
+		if bytes.Equal(b, decoded) {
+			return position(file, b, offset)
+		}
+		return locate(keyAt(decoded, offset))
+	}
+
+	result := &Error{Err: err}
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+		mapsErr   *maps.Error
+		validErrs validator.ValidationErrors
+		keysErr   strict.UnknownKeysError
+		refsErr   interpolate.ReferencesError
+		secErrs   sensitive.ResolveErrors
+	)
+	switch {
+	case errors.As(err, &syntaxErr):
+		result.Entries = append(result.Entries, Entry{
+			// Offset of syntax error points right after offending character
+			Position: at(int(syntaxErr.Offset) - 1),
+			Message:  syntaxErr.Error(),
+		})
+	case errors.As(err, &typeErr):
+		result.Entries = append(result.Entries, Entry{
+			Position: at(int(typeErr.Offset)),
+			Key:      typeErr.Field,
+			Message:  typeErr.Error(),
+		})
+	case errors.As(err, &mapsErr):
+		for _, e := range mapsErr.Errors {
+			key := ""
+			if m := mapstructureKeyRegexp.FindStringSubmatch(e); m != nil {
+				key = m[1]
+			}
+			result.Entries = append(result.Entries, Entry{
+				Position: locate(key),
+				Key:      key,
+				Message:  e,
+			})
+		}
+	case errors.As(err, &validErrs):
+		for _, e := range validErrs {
+			key := JSONPath(reflect.TypeOf(v), e.Namespace())
+			result.Entries = append(result.Entries, Entry{
+				Position: locate(key),
+				Key:      key,
+				Message:  e.Error(),
+			})
+		}
+	case errors.As(err, &keysErr):
+		for _, e := range keysErr {
+			result.Entries = append(result.Entries, Entry{
+				Position: locate(e.Key),
+				Key:      e.Key,
+				Message:  e.Error(),
+			})
+		}
+	case errors.As(err, &refsErr):
+		for _, e := range refsErr {
+			result.Entries = append(result.Entries, Entry{
+				Position: locate(e.Key),
+				Key:      e.Key,
+				Message:  e.Error(),
+			})
+		}
+	case errors.As(err, &secErrs):
+		for _, e := range secErrs {
+			result.Entries = append(result.Entries, Entry{
+				Position: locate(e.Key),
+				Key:      e.Key,
+				Message:  e.Error(),
+			})
+		}
+	default:
+		return err
+	}
+	return result
+}
+
+// Index returns offsets of all keys and array elements found in JSON document b, indexed by
+// their JSON path (i.e. params.vm_groups[0].name). Root value has empty path. In case of
+// syntax error offsets found before error are returned together with error.
+func Index(b []byte) (map[string]int, error) {
+	type frame struct {
+		object    bool
+		path      string
+		key       string
+		index     int
+		expectKey bool
+	}
+	offsets := make(map[string]int)
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var stack []*frame
+	for {
+		start := skip(b, int(d.InputOffset()))
+		token, err := d.Token()
+		if err == io.EOF {
+			return offsets, nil
+		}
+		if err != nil {
+			return offsets, err
+		}
+		if delim, ok := token.(json.Delim); ok && (delim == '}' || delim == ']') {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		path := ""
+		if len(stack) > 0 {
+			top := stack[len(stack)-1]
+			switch {
+			case top.object && top.expectKey:
+				top.key = token.(string)
+				top.expectKey = false
+				offsets[join(top.path, top.key)] = start
+				continue
+			case top.object:
+				path = join(top.path, top.key)
+				top.expectKey = true
+			default:
+				path = fmt.Sprintf("%s[%d]", top.path, top.index)
+				top.index++
+				offsets[path] = start
+			}
+		} else {
+			offsets[path] = start
+		}
+		if delim, ok := token.(json.Delim); ok {
+			stack = append(stack, &frame{
+				object:    delim == '{',
+				path:      path,
+				expectKey: delim == '{',
+			})
+		}
+	}
+}
+
+// keyAt returns JSON path of the last key or array element of b starting at or before offset.
+func keyAt(b []byte, offset int) string {
+	offsets, _ := Index(b)
+	key, start := "", -1
+	for k, o := range offsets {
+		if o <= offset && o > start {
+			key, start = k, o
+		}
+	}
+	return key
+}
+
+// JSONPath converts validator namespace (i.e. Config.Params.VmGroups[0].Name) of structure of
+// type t to JSON path (i.e. params.vm_groups[0].name). Conversion stops at first element of
+// namespace that is not a field of structure.
+func JSONPath(t reflect.Type, namespace string) string {
+	segments := strings.Split(namespace, ".")
+	var result []string
+	for _, segment := range segments[1:] {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+			t = t.Elem()
+		}
+		m := segmentRegexp.FindStringSubmatch(segment)
+		if t.Kind() != reflect.Struct || m == nil {
+			break
+		}
+		f, ok := t.FieldByName(m[1])
+		if !ok {
+			break
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = f.Name
+		}
+		result = append(result, name+m[2])
+		t = f.Type
+	}
+	return strings.Join(result, ".")
+}
+
+func position(file string, b []byte, offset int) Position {
+	if offset > len(b) {
+		offset = len(b)
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	line := bytes.Count(b[:offset], []byte("\n")) + 1
+	column := offset - bytes.LastIndexByte(b[:offset], '\n')
+	return Position{
+		File:   file,
+		Line:   line,
+		Column: column,
+	}
+}
+
+// skip returns offset of first byte at or after offset that is not whitespace or separator.
+func skip(b []byte, offset int) int {
+	for offset < len(b) {
+		switch b[offset] {
+		case ' ', '\t', '\r', '\n', ',', ':':
+			offset++
+		default:
+			return offset
+		}
+	}
+	return offset
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// parent removes last element (key or index) from path.
+func parent(path string) string {
+	if strings.HasSuffix(path, "]") {
+		return path[:strings.LastIndex(path, "[")]
+	}
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
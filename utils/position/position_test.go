@@ -0,0 +1,276 @@
+package position
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	"github.com/epiphany-platform/e-structures/utils/interpolate"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIndex(t *testing.T) {
+	b := []byte(`{
+	"kind": "azbi",
+	"params": {
+		"subnets": [
+			{"name": "main"},
+			{"name": "second"}
+		]
+	}
+}`)
+	// each path is mapped to beginning of text found at its offset
+	want := map[string]string{
+		"":                       `{`,
+		"kind":                   `"kind"`,
+		"params":                 `"params"`,
+		"params.subnets":         `"subnets"`,
+		"params.subnets[0]":      `{"name": "main"}`,
+		"params.subnets[0].name": `"name": "main"`,
+		"params.subnets[1]":      `{"name": "second"}`,
+		"params.subnets[1].name": `"name": "second"`,
+	}
+	offsets, err := Index(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]string)
+	for path, offset := range offsets {
+		got[path] = string(b[offset : offset+len(want[path])])
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Index() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAnnotate(t *testing.T) {
+	tests := []struct {
+		name    string
+		json    []byte
+		strict  bool
+		want    []Entry
+		wantErr bool
+	}{
+		{
+			name: "syntax error",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "epiphany",,
+	}
+}`),
+			want: []Entry{
+				{
+					Position: Position{File: "azbi.json", Line: 5, Column: 22},
+					Message:  "invalid character ',' looking for beginning of object key string",
+				},
+			},
+		},
+		{
+			name: "mapstructure type error",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": [
+			{
+				"name": "vm-group0",
+				"vm_count": "one"
+			}
+		]
+	}
+}`),
+			want: []Entry{
+				{
+					Position: Position{File: "azbi.json", Line: 11, Column: 5},
+					Key:      "params.vm_groups[0].vm_count",
+					Message:  "'params.vm_groups[0].vm_count' expected type 'int', got unconvertible type 'string'",
+				},
+			},
+		},
+		{
+			name: "validation errors",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "",
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": []
+	}
+}`),
+			want: []Entry{
+				{
+					Position: Position{File: "azbi.json", Line: 5, Column: 3},
+					Key:      "params.name",
+					Message:  "Key: 'Config.Params.Name' Error:Field validation for 'Name' failed on the 'min' tag",
+				},
+			},
+		},
+		{
+			name: "missing key reported at parent",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": []
+	}
+}`),
+			want: []Entry{
+				{
+					Position: Position{File: "azbi.json", Line: 4, Column: 2},
+					Key:      "params.name",
+					Message:  "Key: 'Config.Params.Name' Error:Field validation for 'Name' failed on the 'required' tag",
+				},
+			},
+		},
+		{
+			name: "unknown keys",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": [],
+		"nmae": "epiphany"
+	}
+}`),
+			strict: true,
+			want: []Entry{
+				{
+					Position: Position{File: "azbi.json", Line: 9, Column: 3},
+					Key:      "params.nmae",
+					Message:  "unknown key 'params.nmae', did you mean 'name'?",
+				},
+			},
+		},
+		{
+			name: "no error",
+			json: []byte(`{
+	"kind": "azbi",
+	"version": "v0.1.4",
+	"params": {
+		"name": "epiphany",
+		"location": "northeurope",
+		"rsa_pub_path": "/shared/vms_rsa.pub",
+		"vm_groups": []
+	}
+}`),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &azbi.Config{}
+			var err error
+			if tt.strict {
+				err = c.UnmarshalStrict(tt.json)
+			} else {
+				err = c.Unmarshal(tt.json)
+			}
+			err = Annotate("azbi.json", tt.json, c, err)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Annotate() unexpected error occured: %v", err)
+				}
+				return
+			}
+			var got *Error
+			if !errors.As(err, &got) {
+				t.Fatalf("Annotate() expected to return *Error, got %T: %v", err, err)
+			}
+			if diff := cmp.Diff(tt.want, got.Entries); diff != "" {
+				t.Errorf("Annotate() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnnotate_References(t *testing.T) {
+	b := []byte(`{
+	"kind": "azbi",
+	"params": {
+		"name": "${var.name}",
+		"address_space": [
+			"${var.cidr"
+		]
+	}
+}`)
+	_, err := interpolate.Expand(b, nil, nil)
+	err = Annotate("azbi.json", b, &azbi.Config{}, err)
+	var got *Error
+	if !errors.As(err, &got) {
+		t.Fatalf("Annotate() expected to return *Error, got %T: %v", err, err)
+	}
+	want := []Entry{
+		{
+			Position: Position{File: "azbi.json", Line: 6, Column: 4},
+			Key:      "params.address_space[0]",
+			Message:  "incorrect reference '${var.cidr' at 'params.address_space[0]'",
+		},
+		{
+			Position: Position{File: "azbi.json", Line: 4, Column: 3},
+			Key:      "params.name",
+			Message:  "undefined variable 'var.name' at 'params.name'",
+		},
+	}
+	if diff := cmp.Diff(want, got.Entries); diff != "" {
+		t.Errorf("Annotate() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAnnotate_Unwrap(t *testing.T) {
+	b := []byte(`{"kind": "azbi"}`)
+	c := &azbi.Config{}
+	err := Annotate("azbi.json", b, c, c.Unmarshal(b))
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Annotate() result expected to wrap validator.ValidationErrors, got %T", err)
+	}
+	other := errors.New("other")
+	if Annotate("azbi.json", b, c, other) != other {
+		t.Error("Annotate() expected to return unsupported errors unchanged")
+	}
+}
+
+func TestAnnotateDecoded(t *testing.T) {
+	b := []byte(`{
+	"params": {
+		"name": "${var.name}",
+		"size": true
+	}
+}`)
+	decoded, err := interpolate.Expand(b, map[string]string{"name": "prod"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var v struct {
+		Params struct {
+			Name string `json:"name"`
+			Size int    `json:"size"`
+		} `json:"params"`
+	}
+	err = AnnotateDecoded("config.json", b, decoded, &v, json.Unmarshal(decoded, &v))
+	var got *Error
+	if !errors.As(err, &got) {
+		t.Fatalf("AnnotateDecoded() expected to return *Error, got %T: %v", err, err)
+	}
+	if len(got.Entries) != 1 {
+		t.Fatalf("AnnotateDecoded() returned %d entries, want 1", len(got.Entries))
+	}
+	want := Position{File: "config.json", Line: 4, Column: 3}
+	if got.Entries[0].Position != want {
+		t.Errorf("AnnotateDecoded() position = %s, want %s", got.Entries[0].Position, want)
+	}
+}
@@ -0,0 +1,27 @@
+package save
+
+import "github.com/epiphany-platform/e-structures/utils/encryption"
+
+type options struct {
+	keyring *encryption.Keyring
+}
+
+// Option modifies behaviour of save functions.
+type Option func(*options)
+
+// Encrypt makes save functions encrypt values of sensitive fields with primary key of keyring
+// before document is written. Values already encrypted and secret references are left unchanged.
+// Saved structure itself is not modified.
+func Encrypt(k *encryption.Keyring) Option {
+	return func(o *options) {
+		o.keyring = k
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
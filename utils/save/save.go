@@ -1,70 +1,342 @@
 package save
 
 import (
+	"bytes"
+	"context"
+	"io"
 	"io/ioutil"
 
 	awsbi "github.com/epiphany-platform/e-structures/awsbi/v0"
+	awsks "github.com/epiphany-platform/e-structures/awsks/v0"
 	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
 	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	azkv "github.com/epiphany-platform/e-structures/azkv/v0"
+	azlb "github.com/epiphany-platform/e-structures/azlb/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	azstorage "github.com/epiphany-platform/e-structures/azstorage/v0"
+	bastion "github.com/epiphany-platform/e-structures/bastion/v0"
+	dns "github.com/epiphany-platform/e-structures/dns/v0"
+	gcpbi "github.com/epiphany-platform/e-structures/gcpbi/v0"
 	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	k8saddons "github.com/epiphany-platform/e-structures/k8saddons/v0"
+	monitoring "github.com/epiphany-platform/e-structures/monitoring/v0"
 	st "github.com/epiphany-platform/e-structures/state/v0"
+	"github.com/epiphany-platform/e-structures/utils/encryption"
+	"github.com/epiphany-platform/e-structures/utils/store"
 )
 
-func State(path string, state *st.State) error {
-	bytes, err := state.Marshal()
+func State(path string, state *st.State, opts ...Option) error {
+	buff := &bytes.Buffer{}
+	err := StateToWriter(buff, state, opts...)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(path, bytes, 0644)
+	return writeFile(path, buff)
+}
+
+// StateToWriter writes state to w. Nothing is written if state is not valid.
+func StateToWriter(w io.Writer, state *st.State, opts ...Option) error {
+	bytes, err := marshalState(state, newOptions(opts))
 	if err != nil {
 		return err
 	}
-	return nil
+	_, err = w.Write(bytes)
+	return err
+}
+
+// StateToStore saves state as new version in s.
+func StateToStore(ctx context.Context, s store.StateStore, state *st.State, opts ...Option) (store.Version, error) {
+	bytes, err := marshalState(state, newOptions(opts))
+	if err != nil {
+		return store.Version{}, err
+	}
+	return s.Put(ctx, bytes)
+}
+
+func marshalState(state *st.State, o *options) ([]byte, error) {
+	bytes, err := state.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if o.keyring != nil {
+		encrypted := state.DeepCopy()
+		err = encryption.Encrypt(encrypted, o.keyring)
+		if err != nil {
+			return nil, err
+		}
+		return encrypted.Marshal()
+	}
+	return bytes, nil
 }
 
 func AzBIConfig(path string, config *azbi.Config) error {
-	bytes, err := config.Marshal()
+	buff := &bytes.Buffer{}
+	err := AzBIConfigToWriter(buff, config)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(path, bytes, 0644)
+	return writeFile(path, buff)
+}
+
+// AzBIConfigToWriter writes AzBI config to w. Nothing is written if config is not valid.
+func AzBIConfigToWriter(w io.Writer, config *azbi.Config) error {
+	bytes, err := config.Marshal()
 	if err != nil {
 		return err
 	}
-	return nil
+	_, err = w.Write(bytes)
+	return err
 }
 
 func AzKSConfig(path string, config *azks.Config) error {
-	bytes, err := config.Marshal()
+	buff := &bytes.Buffer{}
+	err := AzKSConfigToWriter(buff, config)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(path, bytes, 0644)
+	return writeFile(path, buff)
+}
+
+// AzKSConfigToWriter writes AzKS config to w. Nothing is written if config is not valid.
+func AzKSConfigToWriter(w io.Writer, config *azks.Config) error {
+	bytes, err := config.Marshal()
 	if err != nil {
 		return err
 	}
-	return nil
+	_, err = w.Write(bytes)
+	return err
 }
 
 func HiConfig(path string, config *hi.Config) error {
-	bytes, err := config.Marshal()
+	buff := &bytes.Buffer{}
+	err := HiConfigToWriter(buff, config)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(path, bytes, 0644)
+	return writeFile(path, buff)
+}
+
+// HiConfigToWriter writes Hi config to w. Nothing is written if config is not valid.
+func HiConfigToWriter(w io.Writer, config *hi.Config) error {
+	bytes, err := config.Marshal()
 	if err != nil {
 		return err
 	}
-	return nil
+	_, err = w.Write(bytes)
+	return err
 }
+
 func AwsBIConfig(path string, config *awsbi.Config) error {
+	buff := &bytes.Buffer{}
+	err := AwsBIConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// AwsBIConfigToWriter writes AwsBI config to w. Nothing is written if config is not valid.
+func AwsBIConfigToWriter(w io.Writer, config *awsbi.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func AwsKSConfig(path string, config *awsks.Config) error {
+	buff := &bytes.Buffer{}
+	err := AwsKSConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// AwsKSConfigToWriter writes AwsKS config to w. Nothing is written if config is not valid.
+func AwsKSConfigToWriter(w io.Writer, config *awsks.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func GcpBIConfig(path string, config *gcpbi.Config) error {
+	buff := &bytes.Buffer{}
+	err := GcpBIConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// GcpBIConfigToWriter writes GcpBI config to w. Nothing is written if config is not valid.
+func GcpBIConfigToWriter(w io.Writer, config *gcpbi.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func AzPGConfig(path string, config *azpg.Config) error {
+	buff := &bytes.Buffer{}
+	err := AzPGConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// AzPGConfigToWriter writes AzPG config to w. Nothing is written if config is not valid.
+func AzPGConfigToWriter(w io.Writer, config *azpg.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func AzStorageConfig(path string, config *azstorage.Config) error {
+	buff := &bytes.Buffer{}
+	err := AzStorageConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// AzStorageConfigToWriter writes AzStorage config to w. Nothing is written if config is not valid.
+func AzStorageConfigToWriter(w io.Writer, config *azstorage.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func AzLBConfig(path string, config *azlb.Config) error {
+	buff := &bytes.Buffer{}
+	err := AzLBConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// AzLBConfigToWriter writes AzLB config to w. Nothing is written if config is not valid.
+func AzLBConfigToWriter(w io.Writer, config *azlb.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func DnsConfig(path string, config *dns.Config) error {
+	buff := &bytes.Buffer{}
+	err := DnsConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// DnsConfigToWriter writes Dns config to w. Nothing is written if config is not valid.
+func DnsConfigToWriter(w io.Writer, config *dns.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func AzKVConfig(path string, config *azkv.Config) error {
+	buff := &bytes.Buffer{}
+	err := AzKVConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// AzKVConfigToWriter writes AzKV config to w. Nothing is written if config is not valid.
+func AzKVConfigToWriter(w io.Writer, config *azkv.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func K8sAddonsConfig(path string, config *k8saddons.Config) error {
+	buff := &bytes.Buffer{}
+	err := K8sAddonsConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// K8sAddonsConfigToWriter writes K8sAddons config to w. Nothing is written if config is not valid.
+func K8sAddonsConfigToWriter(w io.Writer, config *k8saddons.Config) error {
 	bytes, err := config.Marshal()
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(path, bytes, 0644)
+	_, err = w.Write(bytes)
+	return err
+}
+
+func MonitoringConfig(path string, config *monitoring.Config) error {
+	buff := &bytes.Buffer{}
+	err := MonitoringConfigToWriter(buff, config)
 	if err != nil {
 		return err
 	}
-	return nil
+	return writeFile(path, buff)
+}
+
+// MonitoringConfigToWriter writes Monitoring config to w. Nothing is written if config is not valid.
+func MonitoringConfigToWriter(w io.Writer, config *monitoring.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+func BastionConfig(path string, config *bastion.Config) error {
+	buff := &bytes.Buffer{}
+	err := BastionConfigToWriter(buff, config)
+	if err != nil {
+		return err
+	}
+	return writeFile(path, buff)
+}
+
+// BastionConfigToWriter writes Bastion config to w. Nothing is written if config is not valid.
+func BastionConfigToWriter(w io.Writer, config *bastion.Config) error {
+	bytes, err := config.Marshal()
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bytes)
+	return err
+}
+
+// writeFile writes content of buff to file at path, which is created only after document was
+// successfully marshalled, so invalid documents don't truncate existing files.
+func writeFile(path string, buff *bytes.Buffer) error {
+	return ioutil.WriteFile(path, buff.Bytes(), 0644)
 }
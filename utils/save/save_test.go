@@ -0,0 +1,82 @@
+package save
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azpg "github.com/epiphany-platform/e-structures/azpg/v0"
+	st "github.com/epiphany-platform/e-structures/state/v0"
+	"github.com/epiphany-platform/e-structures/utils/encryption"
+	"github.com/epiphany-platform/e-structures/utils/to"
+)
+
+func TestAzBIConfigToWriter(t *testing.T) {
+	want, err := azbi.NewConfig().Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buff := &bytes.Buffer{}
+	if err = AzBIConfigToWriter(buff, azbi.NewConfig()); err != nil {
+		t.Fatalf("AzBIConfigToWriter() unexpected error occurred: %v", err)
+	}
+	if !bytes.Equal(want, buff.Bytes()) {
+		t.Errorf("AzBIConfigToWriter() wrote %s, want %s", buff.Bytes(), want)
+	}
+
+	invalid := azbi.NewConfig()
+	invalid.Params.Name = to.StrPtr("")
+	buff.Reset()
+	if err = AzBIConfigToWriter(buff, invalid); err == nil {
+		t.Errorf("AzBIConfigToWriter() of invalid config expected to fail")
+	}
+	if buff.Len() != 0 {
+		t.Errorf("AzBIConfigToWriter() of invalid config wrote %s", buff.Bytes())
+	}
+}
+
+func TestAzBIConfig_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := ioutil.WriteFile(path, []byte("existing"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	invalid := azbi.NewConfig()
+	invalid.Params.Name = to.StrPtr("")
+	if err := AzBIConfig(path, invalid); err == nil {
+		t.Errorf("AzBIConfig() of invalid config expected to fail")
+	}
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "existing" {
+		t.Errorf("AzBIConfig() of invalid config modified existing file: %s", b)
+	}
+}
+
+func TestStateToWriter_EncryptAzPG(t *testing.T) {
+	key, err := encryption.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyring, err := encryption.ParseKeyring([]byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := st.NewState()
+	s.AzPG = &st.AzPGState{
+		Status: st.Initialized,
+		Config: azpg.NewConfig(),
+	}
+	s.AzPG.Config.Params.AdminPassword = to.StrPtr(strings.Repeat("p", 128))
+	buff := &bytes.Buffer{}
+	if err = StateToWriter(buff, s, Encrypt(keyring)); err != nil {
+		t.Fatalf("StateToWriter() with Encrypt() unexpected error occurred: %v", err)
+	}
+	if strings.Contains(buff.String(), strings.Repeat("p", 128)) {
+		t.Errorf("StateToWriter() with Encrypt() written plaintext admin password:\n%s", buff.Bytes())
+	}
+}
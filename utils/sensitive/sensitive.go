@@ -0,0 +1,328 @@
+// Package sensitive handles structure fields marked with sensitive:"true" tag. Values of such
+// fields are hidden by Redact functions and can be stored in documents as secret references
+// instead of inline values:
+//
+//	file:///path/to/file   content of file, without single trailing newline
+//	env://NAME             value of environment variable NAME
+package sensitive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Placeholder replaces values of sensitive fields in redacted documents.
+	Placeholder = "<redacted>"
+
+	tag        = "sensitive"
+	fileScheme = "file://"
+	envScheme  = "env://"
+)
+
+var segmentRegexp = regexp.MustCompile(`^([^\[]*)((?:\[\d+\])*)$`)
+
+// ResolveError describes secret reference that could not be resolved.
+type ResolveError struct {
+	// Key is path of sensitive value containing reference, i.e. azks.output.kubeconfig
+	Key string
+	// Reference is unresolved reference, i.e. env://TENANT_ID
+	Reference string
+	Err       error
+}
+
+func (e ResolveError) Error() string {
+	return fmt.Sprintf("cannot resolve '%s' at '%s': %v", e.Reference, e.Key, e.Err)
+}
+
+func (e ResolveError) Unwrap() error {
+	return e.Err
+}
+
+type ResolveErrors []ResolveError
+
+func (e ResolveErrors) Error() string {
+	buff := bytes.NewBufferString("")
+
+	for _, re := range e {
+		buff.WriteString(re.Error())
+		buff.WriteString("\n")
+	}
+
+	return strings.TrimSpace(buff.String())
+}
+
+// IsSensitive reports if structure field is marked as sensitive.
+func IsSensitive(f reflect.StructField) bool {
+	b, _ := strconv.ParseBool(f.Tag.Get(tag))
+	return b
+}
+
+// IsReference reports if s is secret reference.
+func IsReference(s string) bool {
+	return strings.HasPrefix(s, fileScheme) || strings.HasPrefix(s, envScheme)
+}
+
+// ResolveReference returns secret value referenced by ref.
+func ResolveReference(ref string) (string, error) {
+	switch {
+	case strings.HasPrefix(ref, fileScheme):
+		b, err := ioutil.ReadFile(strings.TrimPrefix(ref, fileScheme))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSuffix(string(b), "\n"), nil
+	case strings.HasPrefix(ref, envScheme):
+		name := strings.TrimPrefix(ref, envScheme)
+		v, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %s is not defined", name)
+		}
+		return v, nil
+	}
+	return "", fmt.Errorf("unsupported secret reference")
+}
+
+// Redact replaces values of all sensitive string fields reachable from v (which has to be
+// a pointer) with Placeholder. Nil and empty values are left unchanged, so it is still visible
+// that value is missing.
+func Redact(v interface{}) {
+	_ = Walk(v, func(s string) (string, error) {
+		if s == "" {
+			return s, nil
+		}
+		return Placeholder, nil
+	})
+}
+
+// Walk calls fn for value of each sensitive string field reachable from v (which has to be
+// a pointer) and replaces value with result of fn. Nil pointers are skipped. First error returned
+// by fn stops the walk and is returned.
+func Walk(v interface{}, fn func(s string) (string, error)) error {
+	return walkValue(reflect.ValueOf(v), false, fn)
+}
+
+func walkValue(v reflect.Value, sensitive bool, fn func(s string) (string, error)) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return walkValue(v.Elem(), sensitive, fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := walkValue(v.Index(i), sensitive, fn); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Type().Field(i); f.PkgPath == "" {
+				if err := walkValue(v.Field(i), IsSensitive(f), fn); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.String:
+		if sensitive {
+			s, err := fn(v.String())
+			if err != nil {
+				return err
+			}
+			v.SetString(s)
+		}
+	}
+	return nil
+}
+
+// RedactJSON returns copy of value v decoded from JSON, found at path (i.e. azks.output) of
+// document of type t, with sensitive string values replaced with Placeholder.
+func RedactJSON(t reflect.Type, path string, v interface{}) interface{} {
+	t, sensitive, ok := locate(t, path)
+	if !ok {
+		return v
+	}
+	return walk(t, sensitive, path, copyJSON(v), func(_, s string) string {
+		if s == "" {
+			return s
+		}
+		return Placeholder
+	})
+}
+
+// Resolve returns JSON document b of structure type t with secret references found in sensitive
+// fields replaced with values they point to. If b contains no references it is returned unchanged.
+// If any reference cannot be resolved, ResolveErrors listing all of them is returned.
+func Resolve(t reflect.Type, b []byte) ([]byte, error) {
+	if !bytes.Contains(b, []byte(fileScheme)) && !bytes.Contains(b, []byte(envScheme)) {
+		return b, nil
+	}
+	var errs ResolveErrors
+	result, err := Transform(t, b, func(path, s string) (string, error) {
+		if !IsReference(s) {
+			return s, nil
+		}
+		v, err := ResolveReference(s)
+		if err != nil {
+			errs = append(errs, ResolveError{Key: path, Reference: s, Err: err})
+			return s, nil
+		}
+		return v, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(errs) > 0 {
+		sort.SliceStable(errs, func(i, j int) bool {
+			return errs[i].Key < errs[j].Key
+		})
+		return nil, errs
+	}
+	return result, nil
+}
+
+// Transform calls fn for each value of sensitive string field found in JSON document b of structure
+// type t and replaces value with result of fn. Path passed to fn is JSON path of value, i.e.
+// azks.output.kubeconfig. If fn doesn't change any value, b is returned unchanged. First error
+// returned by fn is returned.
+func Transform(t reflect.Type, b []byte, fn func(path, s string) (string, error)) ([]byte, error) {
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return nil, err
+	}
+	var (
+		changed  bool
+		firstErr error
+	)
+	doc = walk(t, false, "", doc, func(path, s string) string {
+		if firstErr != nil {
+			return s
+		}
+		v, err := fn(path, s)
+		if err != nil {
+			firstErr = err
+			return s
+		}
+		if v != s {
+			changed = true
+		}
+		return v
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if !changed {
+		return b, nil
+	}
+	return json.Marshal(doc)
+}
+
+// walk calls fn for each sensitive string in value v decoded from JSON, matching structure type t,
+// and replaces string with result of fn. Sensitive is true if v itself is value of sensitive field.
+func walk(t reflect.Type, sensitive bool, path string, v interface{}, fn func(path, s string) string) interface{} {
+	t = underlying(t)
+	switch value := v.(type) {
+	case map[string]interface{}:
+		if t.Kind() != reflect.Struct {
+			return v
+		}
+		for k, e := range value {
+			f, ok := fieldByTag(t, k)
+			if !ok {
+				continue
+			}
+			value[k] = walk(f.Type, IsSensitive(f), join(path, k), e, fn)
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice {
+			return v
+		}
+		for i, e := range value {
+			value[i] = walk(t.Elem(), sensitive, fmt.Sprintf("%s[%d]", path, i), e, fn)
+		}
+	case string:
+		if sensitive {
+			return fn(path, value)
+		}
+	}
+	return v
+}
+
+// locate returns type of value at path of structure type t and reports if it is value of sensitive field.
+func locate(t reflect.Type, path string) (reflect.Type, bool, bool) {
+	if path == "" {
+		return t, false, true
+	}
+	sensitive := false
+	for _, segment := range strings.Split(path, ".") {
+		m := segmentRegexp.FindStringSubmatch(segment)
+		t = underlying(t)
+		if m == nil || t.Kind() != reflect.Struct {
+			return nil, false, false
+		}
+		f, ok := fieldByTag(t, m[1])
+		if !ok {
+			return nil, false, false
+		}
+		t, sensitive = f.Type, IsSensitive(f)
+		for i := strings.Count(m[2], "["); i > 0; i-- {
+			t = underlying(t)
+			if t.Kind() != reflect.Slice {
+				return nil, false, false
+			}
+			t = t.Elem()
+		}
+	}
+	return t, sensitive, true
+}
+
+func fieldByTag(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if strings.Split(f.Tag.Get("json"), ",")[0] == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func underlying(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+func copyJSON(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for k, e := range value {
+			result[k] = copyJSON(e)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, e := range value {
+			result[i] = copyJSON(e)
+		}
+		return result
+	}
+	return v
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
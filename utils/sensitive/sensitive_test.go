@@ -0,0 +1,198 @@
+package sensitive
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type credentials struct {
+	User     *string  `json:"user"`
+	Password *string  `json:"password" sensitive:"true"`
+	Tokens   []string `json:"tokens" sensitive:"true"`
+}
+
+type document struct {
+	Name        *string       `json:"name"`
+	Credentials *credentials  `json:"credentials"`
+	Backups     []credentials `json:"backups"`
+	Secret      string        `json:"secret" sensitive:"true"`
+	Unused      []string      `json:"-"`
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestRedact(t *testing.T) {
+	d := &document{
+		Name: strPtr("name"),
+		Credentials: &credentials{
+			User:     strPtr("user"),
+			Password: strPtr("password"),
+			Tokens:   []string{"token1", ""},
+		},
+		Backups: []credentials{
+			{User: strPtr("backup")},
+			{User: strPtr("backup"), Password: strPtr("password")},
+		},
+		Secret: "secret",
+	}
+	want := &document{
+		Name: strPtr("name"),
+		Credentials: &credentials{
+			User:     strPtr("user"),
+			Password: strPtr(Placeholder),
+			Tokens:   []string{Placeholder, ""},
+		},
+		Backups: []credentials{
+			{User: strPtr("backup")},
+			{User: strPtr("backup"), Password: strPtr(Placeholder)},
+		},
+		Secret: Placeholder,
+	}
+	Redact(d)
+	if diff := cmp.Diff(want, d); diff != "" {
+		t.Errorf("Redact() mismatch (-want +got):\n%s", diff)
+	}
+
+	var nilDocument *document
+	Redact(nilDocument)
+}
+
+func TestRedactJSON(t *testing.T) {
+	typ := reflect.TypeOf(&document{})
+	tests := []struct {
+		name string
+		path string
+		json string
+		want string
+	}{
+		{
+			name: "whole document",
+			path: "",
+			json: `{"name": "name", "secret": "secret", "credentials": {"user": "user", "password": "password", "tokens": ["a", "b"]}}`,
+			want: `{"name": "name", "secret": "<redacted>", "credentials": {"user": "user", "password": "<redacted>", "tokens": ["<redacted>", "<redacted>"]}}`,
+		},
+		{
+			name: "sensitive value",
+			path: "backups[1].password",
+			json: `"password"`,
+			want: `"<redacted>"`,
+		},
+		{
+			name: "element of sensitive list",
+			path: "credentials.tokens[0]",
+			json: `"token"`,
+			want: `"<redacted>"`,
+		},
+		{
+			name: "not sensitive value",
+			path: "credentials.user",
+			json: `"user"`,
+			want: `"user"`,
+		},
+		{
+			name: "nested structure",
+			path: "backups[0]",
+			json: `{"user": "backup", "password": "password", "unknown": "value"}`,
+			want: `{"user": "backup", "password": "<redacted>", "unknown": "value"}`,
+		},
+		{
+			name: "unknown path",
+			path: "unknown.password",
+			json: `"password"`,
+			want: `"password"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v, want interface{}
+			if err := json.Unmarshal([]byte(tt.json), &v); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			original := copyJSON(v)
+			got := RedactJSON(typ, tt.path, v)
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("RedactJSON() mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(original, v); diff != "" {
+				t.Errorf("RedactJSON() modified its argument (-original +modified):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "password")
+	if err := ioutil.WriteFile(path, []byte("file password\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Setenv("E_STRUCTURES_TEST_SECRET", "env secret"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("E_STRUCTURES_TEST_SECRET")
+	typ := reflect.TypeOf(&document{})
+
+	got, err := Resolve(typ, []byte(`{
+	"name": "env://E_STRUCTURES_TEST_SECRET",
+	"secret": "env://E_STRUCTURES_TEST_SECRET",
+	"credentials": {
+		"user": "user",
+		"password": "file://`+path+`"
+	}
+}`))
+	if err != nil {
+		t.Fatalf("Resolve() unexpected error occurred: %v", err)
+	}
+	d := &document{}
+	if err = json.Unmarshal(got, d); err != nil {
+		t.Fatal(err)
+	}
+	want := &document{
+		Name: strPtr("env://E_STRUCTURES_TEST_SECRET"),
+		Credentials: &credentials{
+			User:     strPtr("user"),
+			Password: strPtr("file password"),
+		},
+		Secret: "env secret",
+	}
+	if diff := cmp.Diff(want, d); diff != "" {
+		t.Errorf("Resolve() mismatch (-want +got):\n%s", diff)
+	}
+
+	b := []byte(`{"name": "name", "secret": "inline"}`)
+	got, err = Resolve(typ, b)
+	if err != nil || string(got) != string(b) {
+		t.Errorf("Resolve() expected to return document without references unchanged, got %s, %v", got, err)
+	}
+
+	_, err = Resolve(typ, []byte(`{
+	"secret": "env://E_STRUCTURES_TEST_MISSING",
+	"credentials": {"password": "file://`+filepath.Join(dir, "missing")+`"}
+}`))
+	var errs ResolveErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Resolve() expected to return ResolveErrors, got %v", err)
+	}
+	var keys []string
+	for _, e := range errs {
+		keys = append(keys, e.Key)
+	}
+	if diff := cmp.Diff([]string{"credentials.password", "secret"}, keys); diff != "" {
+		t.Errorf("Resolve() error keys mismatch (-want +got):\n%s", diff)
+	}
+	if !errors.Is(errs[0], os.ErrNotExist) {
+		t.Errorf("Resolve() expected to wrap os.ErrNotExist for missing file, got %v", errs[0])
+	}
+}
@@ -0,0 +1,204 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// FileStore stores state document in local file system. Latest version is kept at path, so it can
+// still be read with load.State, previous versions in directory path.versions and lock in file
+// path.lock. It can be shared by machines using common network file system that supports
+// exclusive file creation.
+type FileStore struct {
+	path string
+}
+
+// NewFileStore returns FileStore keeping state document at path.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (s *FileStore) String() string {
+	return s.path
+}
+
+func (s *FileStore) Get(_ context.Context, version string) ([]byte, error) {
+	path := s.path
+	if version != "" {
+		if _, err := strconv.ParseUint(version, 10, 64); err != nil {
+			return nil, fmt.Errorf("%w: incorrect version %s", ErrNotFound, version)
+		}
+		path = filepath.Join(s.versionsDir(), version+".json")
+	}
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	return b, err
+}
+
+// Put stores b as new version and replaces document at path atomically.
+func (s *FileStore) Put(ctx context.Context, b []byte) (Version, error) {
+	if err := os.MkdirAll(s.versionsDir(), 0755); err != nil {
+		return Version{}, err
+	}
+	versions, err := s.Versions(ctx)
+	if err != nil {
+		return Version{}, err
+	}
+	next := uint64(1)
+	if len(versions) > 0 {
+		last, _ := strconv.ParseUint(versions[len(versions)-1].ID, 10, 64)
+		next = last + 1
+	}
+	var f *os.File
+	for {
+		f, err = os.OpenFile(filepath.Join(s.versionsDir(), versionID(next)+".json"), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if !os.IsExist(err) {
+			break
+		}
+		next++
+	}
+	if err != nil {
+		return Version{}, err
+	}
+	if _, err = f.Write(b); err != nil {
+		_ = f.Close()
+		return Version{}, err
+	}
+	if err = f.Close(); err != nil {
+		return Version{}, err
+	}
+	if err = writeFile(s.path, b); err != nil {
+		return Version{}, err
+	}
+	info, err := os.Stat(f.Name())
+	if err != nil {
+		return Version{}, err
+	}
+	return Version{ID: versionID(next), Modified: info.ModTime().UTC(), Size: info.Size()}, nil
+}
+
+func (s *FileStore) Lock(_ context.Context, owner string) (LockInfo, error) {
+	info, err := newLockInfo(owner)
+	if err != nil {
+		return LockInfo{}, err
+	}
+	b, err := json.Marshal(info)
+	if err != nil {
+		return LockInfo{}, err
+	}
+	f, err := os.OpenFile(s.lockPath(), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if os.IsExist(err) {
+		holder, err := s.holder()
+		if err != nil {
+			return LockInfo{}, err
+		}
+		return LockInfo{}, &LockedError{Holder: holder}
+	}
+	if err != nil {
+		return LockInfo{}, err
+	}
+	if _, err = f.Write(b); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return LockInfo{}, err
+	}
+	return info, f.Close()
+}
+
+func (s *FileStore) Unlock(_ context.Context, id string) error {
+	holder, err := s.holder()
+	if err != nil {
+		return err
+	}
+	if holder.ID != id {
+		return unlockError(id, holder)
+	}
+	return os.Remove(s.lockPath())
+}
+
+func (s *FileStore) Versions(_ context.Context) ([]Version, error) {
+	infos, err := ioutil.ReadDir(s.versionsDir())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var result []Version
+	for _, info := range infos {
+		name := info.Name()
+		if info.IsDir() || filepath.Ext(name) != ".json" {
+			continue
+		}
+		id := name[:len(name)-len(".json")]
+		if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+			continue
+		}
+		result = append(result, Version{ID: id, Modified: info.ModTime().UTC(), Size: info.Size()})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, _ := strconv.ParseUint(result[i].ID, 10, 64)
+		b, _ := strconv.ParseUint(result[j].ID, 10, 64)
+		return a < b
+	})
+	return result, nil
+}
+
+func (s *FileStore) holder() (LockInfo, error) {
+	var holder LockInfo
+	b, err := ioutil.ReadFile(s.lockPath())
+	if os.IsNotExist(err) {
+		return holder, fmt.Errorf("%s is not locked", s.path)
+	}
+	if err != nil {
+		return holder, err
+	}
+	if err = json.Unmarshal(b, &holder); err != nil {
+		return holder, fmt.Errorf("%s: %w", s.lockPath(), err)
+	}
+	return holder, nil
+}
+
+func (s *FileStore) versionsDir() string {
+	return s.path + ".versions"
+}
+
+func (s *FileStore) lockPath() string {
+	return s.path + ".lock"
+}
+
+func versionID(n uint64) string {
+	return fmt.Sprintf("%06d", n)
+}
+
+// writeFile replaces file at path with b atomically, so readers never see partially written file.
+func writeFile(path string, b []byte) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+	if err = os.Chmod(f.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
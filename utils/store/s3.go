@@ -0,0 +1,357 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Config configures S3Store.
+type S3Config struct {
+	// Endpoint is base URL of S3 compatible service, i.e. https://s3.eu-west-1.amazonaws.com or
+	// http://localhost:9000. Buckets are addressed path style (endpoint/bucket/key).
+	Endpoint string
+	Region   string
+	Bucket   string
+	// Key is object key of state document. Lock is kept in object Key.lock.
+	Key             string
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is needed only for temporary credentials.
+	SessionToken string
+	// HTTPClient is used for requests, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// S3Store stores state document in bucket of S3 compatible service. Bucket should have versioning
+// enabled, otherwise only latest version is kept. Locking uses conditional writes
+// (If-None-Match), which have to be supported by the service.
+type S3Store struct {
+	config   S3Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+// NewS3Store returns S3Store for config.
+func NewS3Store(config S3Config) (*S3Store, error) {
+	if config.Endpoint == "" || config.Region == "" || config.Bucket == "" || config.Key == "" {
+		return nil, errors.New("endpoint, region, bucket and key are required")
+	}
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint %s has to be http or https url", config.Endpoint)
+	}
+	client := config.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &S3Store{config: config, endpoint: endpoint, client: client}, nil
+}
+
+func (s *S3Store) String() string {
+	return fmt.Sprintf("s3://%s/%s", s.config.Bucket, s.config.Key)
+}
+
+func (s *S3Store) Get(ctx context.Context, version string) ([]byte, error) {
+	query := url.Values{}
+	if version != "" {
+		query.Set("versionId", version)
+	}
+	return s.get(ctx, s.config.Key, query)
+}
+
+func (s *S3Store) Put(ctx context.Context, b []byte) (Version, error) {
+	resp, err := s.do(ctx, http.MethodPut, s.config.Key, nil, nil, b)
+	if err != nil {
+		return Version{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Version{}, responseError(resp)
+	}
+	modified := time.Now().UTC()
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		modified = t.UTC()
+	}
+	return Version{ID: resp.Header.Get("x-amz-version-id"), Modified: modified, Size: int64(len(b))}, nil
+}
+
+func (s *S3Store) Lock(ctx context.Context, owner string) (LockInfo, error) {
+	info, err := newLockInfo(owner)
+	if err != nil {
+		return LockInfo{}, err
+	}
+	b, err := json.Marshal(info)
+	if err != nil {
+		return LockInfo{}, err
+	}
+	resp, err := s.do(ctx, http.MethodPut, s.lockKey(), nil, map[string]string{"If-None-Match": "*"}, b)
+	if err != nil {
+		return LockInfo{}, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return info, nil
+	case http.StatusPreconditionFailed, http.StatusConflict:
+		holder, err := s.holder(ctx)
+		if err != nil {
+			return LockInfo{}, err
+		}
+		return LockInfo{}, &LockedError{Holder: holder}
+	}
+	return LockInfo{}, responseError(resp)
+}
+
+func (s *S3Store) Unlock(ctx context.Context, id string) error {
+	holder, err := s.holder(ctx)
+	if err != nil {
+		return err
+	}
+	if holder.ID != id {
+		return unlockError(id, holder)
+	}
+	resp, err := s.do(ctx, http.MethodDelete, s.lockKey(), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+	return nil
+}
+
+type listVersionsResult struct {
+	IsTruncated         bool   `xml:"IsTruncated"`
+	NextKeyMarker       string `xml:"NextKeyMarker"`
+	NextVersionIdMarker string `xml:"NextVersionIdMarker"`
+	Versions            []struct {
+		Key          string    `xml:"Key"`
+		VersionId    string    `xml:"VersionId"`
+		LastModified time.Time `xml:"LastModified"`
+		Size         int64     `xml:"Size"`
+	} `xml:"Version"`
+}
+
+func (s *S3Store) Versions(ctx context.Context) ([]Version, error) {
+	var result []Version
+	query := url.Values{}
+	query.Set("versions", "")
+	query.Set("prefix", s.config.Key)
+	for {
+		b, err := s.get(ctx, "", query)
+		if err != nil {
+			return nil, err
+		}
+		var list listVersionsResult
+		if err = xml.Unmarshal(b, &list); err != nil {
+			return nil, err
+		}
+		for _, v := range list.Versions {
+			if v.Key == s.config.Key {
+				result = append(result, Version{ID: v.VersionId, Modified: v.LastModified.UTC(), Size: v.Size})
+			}
+		}
+		if !list.IsTruncated {
+			break
+		}
+		query.Set("key-marker", list.NextKeyMarker)
+		query.Set("version-id-marker", list.NextVersionIdMarker)
+	}
+	// versions of key are listed from newest to oldest
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result, nil
+}
+
+func (s *S3Store) holder(ctx context.Context) (LockInfo, error) {
+	var holder LockInfo
+	b, err := s.get(ctx, s.lockKey(), nil)
+	if errors.Is(err, ErrNotFound) {
+		return holder, fmt.Errorf("%s is not locked", s)
+	}
+	if err != nil {
+		return holder, err
+	}
+	if err = json.Unmarshal(b, &holder); err != nil {
+		return holder, fmt.Errorf("%s.lock: %w", s, err)
+	}
+	return holder, nil
+}
+
+func (s *S3Store) get(ctx context.Context, key string, query url.Values) ([]byte, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, query, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err = responseError(resp)
+		// missing bucket or incorrect endpoint also result in 404, but only missing object (or its
+		// version) means that state was not stored yet
+		var e *s3Error
+		if errors.As(err, &e) && (e.Code == "NoSuchKey" || e.Code == "NoSuchVersion") {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func (s *S3Store) lockKey() string {
+	return s.config.Key + ".lock"
+}
+
+// do sends request signed with AWS Signature Version 4 for object key (bucket itself if key is
+// empty).
+func (s *S3Store) do(ctx context.Context, method, key string, query url.Values, headers map[string]string, body []byte) (*http.Response, error) {
+	path := strings.TrimSuffix(s.endpoint.Path, "/") + "/" + s.config.Bucket
+	if key != "" {
+		path += "/" + key
+	}
+	u := *s.endpoint
+	u.Path = path
+	u.RawPath = uriEncode(path, false)
+	u.RawQuery = canonicalQuery(query)
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	s.sign(req, body, time.Now().UTC())
+	return s.client.Do(req)
+}
+
+func (s *S3Store) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256.Sum256(body)
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", hex.EncodeToString(payloadHash[:]))
+	if s.config.SessionToken != "" {
+		req.Header.Set("x-amz-security-token", s.config.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k := range req.Header {
+		lower := strings.ToLower(k)
+		if strings.HasPrefix(lower, "x-amz-") || lower == "if-none-match" {
+			headers[lower] = strings.TrimSpace(req.Header.Get(k))
+		}
+	}
+	var names []string
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery encodes query sorted by key, as required by signature.
+func canonicalQuery(query url.Values) string {
+	var keys []string
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		for _, v := range query[k] {
+			parts = append(parts, uriEncode(k, true)+"="+uriEncode(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes all characters except unreserved ones and, unless encodeSlash is set,
+// slash.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func (e *s3Error) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+func responseError(resp *http.Response) error {
+	b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 4096))
+	e := &s3Error{}
+	if err := xml.Unmarshal(b, e); err == nil && e.Code != "" {
+		return fmt.Errorf("%s %s: %w", resp.Request.Method, resp.Request.URL.Path, e)
+	}
+	return fmt.Errorf("%s %s: unexpected status %s", resp.Request.Method, resp.Request.URL.Path, resp.Status)
+}
@@ -0,0 +1,221 @@
+package store
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 is in memory stand-in of S3 compatible service with single versioned bucket, supporting
+// requests used by S3Store.
+type fakeS3 struct {
+	mu       sync.Mutex
+	bucket   string
+	objects  map[string][]*fakeVersion // newest last, nil content is delete marker
+	counter  int
+	pageSize int
+}
+
+type fakeVersion struct {
+	id       string
+	content  []byte
+	modified time.Time
+}
+
+func newTestS3Store(t *testing.T) (*S3Store, *fakeS3) {
+	t.Helper()
+	fake := &fakeS3{bucket: "states", objects: map[string][]*fakeVersion{}, pageSize: 2}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	s, err := NewS3Store(S3Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		Bucket:          "states",
+		Key:             "environments/dev/state.json",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio123",
+		HTTPClient:      server.Client(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, fake
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") || r.Header.Get("x-amz-date") == "" {
+		f.error(w, http.StatusForbidden, "AccessDenied")
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if path == f.bucket && r.Method == http.MethodGet {
+		if _, ok := r.URL.Query()["versions"]; ok {
+			f.list(w, r)
+			return
+		}
+	}
+	if !strings.HasPrefix(path, f.bucket+"/") {
+		f.error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	key := strings.TrimPrefix(path, f.bucket+"/")
+	versions := f.objects[key]
+	current := len(versions) > 0 && versions[len(versions)-1].content != nil
+	switch r.Method {
+	case http.MethodGet:
+		if id := r.URL.Query().Get("versionId"); id != "" {
+			for _, v := range versions {
+				if v.id == id && v.content != nil {
+					_, _ = w.Write(v.content)
+					return
+				}
+			}
+			f.error(w, http.StatusNotFound, "NoSuchVersion")
+			return
+		}
+		if !current {
+			f.error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		_, _ = w.Write(versions[len(versions)-1].content)
+	case http.MethodPut:
+		if r.Header.Get("If-None-Match") == "*" && current {
+			f.error(w, http.StatusPreconditionFailed, "PreconditionFailed")
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		v := f.add(key, b)
+		w.Header().Set("x-amz-version-id", v.id)
+	case http.MethodDelete:
+		f.add(key, nil)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+func (f *fakeS3) add(key string, content []byte) *fakeVersion {
+	f.counter++
+	v := &fakeVersion{id: "v" + strconv.Itoa(f.counter), content: content, modified: time.Now().UTC()}
+	f.objects[key] = append(f.objects[key], v)
+	return v
+}
+
+type fakeListVersion struct {
+	Key          string    `xml:"Key"`
+	VersionId    string    `xml:"VersionId"`
+	LastModified time.Time `xml:"LastModified"`
+	Size         int64     `xml:"Size"`
+}
+
+type fakeListResult struct {
+	XMLName             xml.Name          `xml:"ListVersionsResult"`
+	IsTruncated         bool              `xml:"IsTruncated"`
+	NextKeyMarker       string            `xml:"NextKeyMarker,omitempty"`
+	NextVersionIdMarker string            `xml:"NextVersionIdMarker,omitempty"`
+	Versions            []fakeListVersion `xml:"Version"`
+}
+
+// list returns versions of keys with prefix, sorted by key and from newest to oldest, skipping
+// delete markers and paging by pageSize.
+func (f *fakeS3) list(w http.ResponseWriter, r *http.Request) {
+	prefix := r.URL.Query().Get("prefix")
+	var keys []string
+	for k := range f.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var all []fakeListVersion
+	for _, k := range keys {
+		versions := f.objects[k]
+		for i := len(versions) - 1; i >= 0; i-- {
+			if versions[i].content != nil {
+				all = append(all, fakeListVersion{Key: k, VersionId: versions[i].id, LastModified: versions[i].modified, Size: int64(len(versions[i].content))})
+			}
+		}
+	}
+	start := 0
+	if marker := r.URL.Query().Get("version-id-marker"); marker != "" {
+		for i, v := range all {
+			if v.VersionId == marker {
+				start = i + 1
+			}
+		}
+	}
+	result := fakeListResult{}
+	end := start + f.pageSize
+	if end < len(all) {
+		result.IsTruncated = true
+		result.NextKeyMarker = all[end-1].Key
+		result.NextVersionIdMarker = all[end-1].VersionId
+	} else {
+		end = len(all)
+	}
+	result.Versions = all[start:end]
+	b, _ := xml.Marshal(result)
+	_, _ = w.Write(b)
+}
+
+func (f *fakeS3) error(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, http.StatusText(status))
+}
+
+func TestNewS3Store(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  S3Config
+		wantErr string
+	}{
+		{
+			name:    "missing bucket",
+			config:  S3Config{Endpoint: "http://localhost:9000", Region: "us-east-1", Key: "state.json"},
+			wantErr: "endpoint, region, bucket and key are required",
+		},
+		{
+			name:    "incorrect scheme",
+			config:  S3Config{Endpoint: "s3://localhost", Region: "us-east-1", Bucket: "states", Key: "state.json"},
+			wantErr: "endpoint s3://localhost has to be http or https url",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewS3Store(tt.config)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("NewS3Store() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestS3Store_Errors(t *testing.T) {
+	s, _ := newTestS3Store(t)
+	s.config.AccessKeyID = "unknown"
+	_, err := s.Put(context.Background(), []byte("{}"))
+	if err == nil || !strings.Contains(err.Error(), "AccessDenied") {
+		t.Errorf("Put() with incorrect credentials error = %v, want AccessDenied", err)
+	}
+}
+
+func TestS3Store_Get_NoSuchBucket(t *testing.T) {
+	s, _ := newTestS3Store(t)
+	s.config.Bucket = "unknown"
+	_, err := s.Get(context.Background(), "")
+	if err == nil || errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "NoSuchBucket") {
+		t.Errorf("Get() from missing bucket error = %v, want NoSuchBucket", err)
+	}
+}
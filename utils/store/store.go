@@ -0,0 +1,81 @@
+// Package store provides storage backends for state documents, so state of one environment can be
+// shared by many machines. Each backend keeps history of stored documents and supports advisory
+// locking, which callers should hold for the whole load, modify and save cycle.
+package store
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned by Get when no document (or no requested version of it) is stored.
+	ErrNotFound = errors.New("state not found")
+	// ErrLocked is wrapped by LockedError returned by Lock when lock is already held.
+	ErrLocked = errors.New("state is locked")
+)
+
+// Version describes single stored version of state document.
+type Version struct {
+	ID       string    `json:"id"`
+	Modified time.Time `json:"modified"`
+	Size     int64     `json:"size"`
+}
+
+// LockInfo describes holder of state lock.
+type LockInfo struct {
+	// ID identifies lock and is needed to release it.
+	ID string `json:"id"`
+	// Owner is free form description of lock holder, i.e. CI job name.
+	Owner   string    `json:"owner"`
+	Created time.Time `json:"created"`
+}
+
+// LockedError is returned by Lock when lock is already held by Holder.
+type LockedError struct {
+	Holder LockInfo
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("%v by '%s' since %s (lock id %s)", ErrLocked, e.Holder.Owner, e.Holder.Created.Format(time.RFC3339), e.Holder.ID)
+}
+
+func (e *LockedError) Unwrap() error {
+	return ErrLocked
+}
+
+// StateStore stores versions of state document.
+type StateStore interface {
+	// Get returns content of stored document version. Empty version means latest one.
+	Get(ctx context.Context, version string) ([]byte, error)
+	// Put stores b as new latest version of document.
+	Put(ctx context.Context, b []byte) (Version, error)
+	// Lock acquires lock for owner. If lock is already held, *LockedError is returned.
+	Lock(ctx context.Context, owner string) (LockInfo, error)
+	// Unlock releases lock with id returned by Lock.
+	Unlock(ctx context.Context, id string) error
+	// Versions lists stored versions of document from oldest to newest.
+	Versions(ctx context.Context) ([]Version, error)
+	// String returns location of document, used in error messages.
+	String() string
+}
+
+func newLockInfo(owner string) (LockInfo, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return LockInfo{}, err
+	}
+	return LockInfo{
+		ID:      hex.EncodeToString(b),
+		Owner:   owner,
+		Created: time.Now().UTC().Truncate(time.Second),
+	}, nil
+}
+
+func unlockError(id string, holder LockInfo) error {
+	return fmt.Errorf("cannot unlock with id %s, lock is held by '%s' with id %s", id, holder.Owner, holder.ID)
+}
@@ -0,0 +1,97 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestFileStore(t *testing.T) {
+	testStateStore(t, NewFileStore(filepath.Join(t.TempDir(), "state.json")))
+}
+
+func TestS3Store(t *testing.T) {
+	s, _ := newTestS3Store(t)
+	testStateStore(t, s)
+}
+
+// testStateStore checks behaviour common for all StateStore implementations.
+func testStateStore(t *testing.T, s StateStore) {
+	ctx := context.Background()
+
+	if _, err := s.Get(ctx, ""); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of empty store error = %v, want %v", err, ErrNotFound)
+	}
+	versions, err := s.Versions(ctx)
+	if err != nil || len(versions) != 0 {
+		t.Errorf("Versions() of empty store = %v, %v, want no versions", versions, err)
+	}
+
+	lock, err := s.Lock(ctx, "agent 1")
+	if err != nil {
+		t.Fatalf("Lock() unexpected error occurred: %v", err)
+	}
+	_, err = s.Lock(ctx, "agent 2")
+	var lockedErr *LockedError
+	if !errors.As(err, &lockedErr) || !errors.Is(err, ErrLocked) {
+		t.Fatalf("second Lock() error = %v, want LockedError", err)
+	}
+	if lockedErr.Holder != lock {
+		t.Errorf("second Lock() holder = %v, want %v", lockedErr.Holder, lock)
+	}
+	if err = s.Unlock(ctx, "other"); err == nil {
+		t.Errorf("Unlock() with other id expected to fail")
+	}
+
+	var written []Version
+	for _, content := range []string{`{"version": 1}`, `{"version": 2}`, `{"version": 3}`} {
+		v, err := s.Put(ctx, []byte(content))
+		if err != nil {
+			t.Fatalf("Put() unexpected error occurred: %v", err)
+		}
+		if v.ID == "" || v.Size != int64(len(content)) {
+			t.Errorf("Put() returned incorrect version %v", v)
+		}
+		written = append(written, v)
+	}
+
+	if err = s.Unlock(ctx, lock.ID); err != nil {
+		t.Fatalf("Unlock() unexpected error occurred: %v", err)
+	}
+	if err = s.Unlock(ctx, lock.ID); err == nil {
+		t.Errorf("Unlock() of not locked store expected to fail")
+	}
+	lock, err = s.Lock(ctx, "agent 2")
+	if err != nil {
+		t.Fatalf("Lock() after Unlock() unexpected error occurred: %v", err)
+	}
+	if err = s.Unlock(ctx, lock.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := s.Get(ctx, "")
+	if err != nil || string(b) != `{"version": 3}` {
+		t.Errorf("Get() of latest version = %s, %v, want version 3", b, err)
+	}
+	b, err = s.Get(ctx, written[0].ID)
+	if err != nil || string(b) != `{"version": 1}` {
+		t.Errorf("Get() of first version = %s, %v, want version 1", b, err)
+	}
+	if _, err = s.Get(ctx, "999999"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() of unknown version error = %v, want %v", err, ErrNotFound)
+	}
+
+	versions, err = s.Versions(ctx)
+	if err != nil {
+		t.Fatalf("Versions() unexpected error occurred: %v", err)
+	}
+	if len(versions) != len(written) {
+		t.Fatalf("Versions() = %v, want %d versions", versions, len(written))
+	}
+	for i := range versions {
+		if versions[i].ID != written[i].ID {
+			t.Errorf("Versions()[%d] id = %s, want %s", i, versions[i].ID, written[i].ID)
+		}
+	}
+}
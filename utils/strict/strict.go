@@ -0,0 +1,155 @@
+package strict
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var indexRegexp = regexp.MustCompile(`\[\d+\]$`)
+
+// UnknownKeyError describes single key found in document that does not match any field of structure.
+type UnknownKeyError struct {
+	// Key is full path of unknown key as reported in Unused field, i.e. params.vm_groups[0].vm_cont
+	Key string
+	// Suggestion is the closest known key on the same level or empty string if there is no similar key.
+	Suggestion string
+}
+
+func (e UnknownKeyError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("unknown key '%s', did you mean '%s'?", e.Key, e.Suggestion)
+	}
+	return fmt.Sprintf("unknown key '%s'", e.Key)
+}
+
+type UnknownKeysError []UnknownKeyError
+
+func (e UnknownKeysError) Error() string {
+	buff := bytes.NewBufferString("")
+
+	for _, ue := range e {
+		buff.WriteString(ue.Error())
+		buff.WriteString("\n")
+	}
+
+	return strings.TrimSpace(buff.String())
+}
+
+// Check returns UnknownKeysError with entry for each of unused keys reported for v, or nil if
+// there are no unused keys. For each key the closest json tag of structure field on the same
+// level is suggested.
+func Check(v interface{}, unused []string) error {
+	if len(unused) == 0 {
+		return nil
+	}
+	result := make(UnknownKeysError, 0, len(unused))
+	for _, key := range unused {
+		result = append(result, UnknownKeyError{
+			Key:        key,
+			Suggestion: suggest(reflect.TypeOf(v), key),
+		})
+	}
+	return result
+}
+
+// suggest follows path of key through json tags of structure t and returns json tag closest to
+// the last element of the path.
+func suggest(t reflect.Type, key string) string {
+	parts := strings.Split(key, ".")
+	for _, part := range parts[:len(parts)-1] {
+		t = underlying(t)
+		if t.Kind() != reflect.Struct {
+			return ""
+		}
+		f, ok := fieldByTag(t, indexRegexp.ReplaceAllString(part, ""))
+		if !ok {
+			return ""
+		}
+		t = f.Type
+	}
+	t = underlying(t)
+	if t.Kind() != reflect.Struct {
+		return ""
+	}
+	last := parts[len(parts)-1]
+	best, bestDistance := "", len(last)/3+2
+	for _, tag := range tags(t) {
+		if d := distance(last, tag); d < bestDistance {
+			best, bestDistance = tag, d
+		}
+	}
+	return best
+}
+
+// underlying removes pointers and slices from t.
+func underlying(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	return t
+}
+
+func fieldByTag(t reflect.Type, tag string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == tag {
+			return t.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+func tags(t reflect.Type) []string {
+	var result []string
+	for i := 0; i < t.NumField(); i++ {
+		if name := jsonName(t.Field(i)); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "-" || f.PkgPath != "" {
+		return ""
+	}
+	if name == "" {
+		return f.Name
+	}
+	return name
+}
+
+// distance calculates Levenshtein distance between a and b.
+func distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minimum(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func minimum(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,118 @@
+package strict
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type vmGroup struct {
+	Name    *string `json:"name"`
+	VmCount *int    `json:"vm_count"`
+	VmSize  *string `json:"vm_size"`
+}
+
+type params struct {
+	Name     *string   `json:"name"`
+	Location *string   `json:"location"`
+	VmGroups []vmGroup `json:"vm_groups"`
+}
+
+type config struct {
+	Kind    *string  `json:"kind"`
+	Version *string  `json:"version"`
+	Params  *params  `json:"params"`
+	Unused  []string `json:"-"`
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		unused []string
+		want   error
+	}{
+		{
+			name:   "no unused keys",
+			unused: []string{},
+			want:   nil,
+		},
+		{
+			name:   "typo in nested slice element",
+			unused: []string{"params.vm_groups[0].vm_cont"},
+			want: UnknownKeysError{
+				{
+					Key:        "params.vm_groups[0].vm_cont",
+					Suggestion: "vm_count",
+				},
+			},
+		},
+		{
+			name:   "typos on multiple levels",
+			unused: []string{"params.locaton", "verison"},
+			want: UnknownKeysError{
+				{
+					Key:        "params.locaton",
+					Suggestion: "location",
+				},
+				{
+					Key:        "verison",
+					Suggestion: "version",
+				},
+			},
+		},
+		{
+			name:   "no similar key",
+			unused: []string{"params.something_completely_different"},
+			want: UnknownKeysError{
+				{
+					Key:        "params.something_completely_different",
+					Suggestion: "",
+				},
+			},
+		},
+		{
+			name:   "ignored fields are not suggested",
+			unused: []string{"unuse"},
+			want: UnknownKeysError{
+				{
+					Key:        "unuse",
+					Suggestion: "",
+				},
+			},
+		},
+		{
+			name:   "unknown parent key",
+			unused: []string{"parameters.name"},
+			want: UnknownKeysError{
+				{
+					Key:        "parameters.name",
+					Suggestion: "",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Check(&config{}, tt.unused)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Check() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnknownKeysError_Error(t *testing.T) {
+	err := UnknownKeysError{
+		{
+			Key:        "params.vm_groups[0].vm_cont",
+			Suggestion: "vm_count",
+		},
+		{
+			Key: "extra",
+		},
+	}
+	want := "unknown key 'params.vm_groups[0].vm_cont', did you mean 'vm_count'?\nunknown key 'extra'"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
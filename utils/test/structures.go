@@ -0,0 +1,173 @@
+package test
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Fill sets every exported field reachable from v (which has to be a pointer) to non-zero value.
+// Pointers are allocated, slices get two elements, maps get two string keyed entries and basic
+// values get first non-zero value. Interface values in maps are set to strings.
+func Fill(v interface{}) {
+	fill(reflect.ValueOf(v).Elem())
+}
+
+func fill(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fill(v.Elem())
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			fill(v.Index(i))
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		for i := 0; i < 2; i++ {
+			e := reflect.New(v.Type().Elem()).Elem()
+			if e.Kind() == reflect.Interface {
+				s := reflect.New(reflect.TypeOf("")).Elem()
+				mutate(s)
+				e.Set(s)
+			} else {
+				fill(e)
+			}
+			v.SetMapIndex(reflect.ValueOf(fmt.Sprintf("key%d", i)).Convert(v.Type().Key()), e)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).PkgPath == "" {
+				fill(v.Field(i))
+			}
+		}
+	default:
+		mutate(v)
+	}
+}
+
+// SharedMemory walks a and b in parallel and returns paths of pointers, slices and maps that point
+// to the same memory in both values.
+func SharedMemory(a, b interface{}) []string {
+	var result []string
+	sharedMemory(reflect.ValueOf(a), reflect.ValueOf(b), "", &result)
+	return result
+}
+
+func sharedMemory(a, b reflect.Value, path string, result *[]string) {
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			*result = append(*result, path)
+			return
+		}
+		sharedMemory(a.Elem(), b.Elem(), path, result)
+	case reflect.Slice:
+		if a.Len() == 0 || b.Len() == 0 {
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			*result = append(*result, path)
+			return
+		}
+		for i := 0; i < a.Len() && i < b.Len(); i++ {
+			sharedMemory(a.Index(i), b.Index(i), fmt.Sprintf("%s[%d]", path, i), result)
+		}
+	case reflect.Map:
+		if a.Len() == 0 || b.Len() == 0 {
+			return
+		}
+		if a.Pointer() == b.Pointer() {
+			*result = append(*result, path)
+			return
+		}
+		for _, k := range a.MapKeys() {
+			if e := b.MapIndex(k); e.IsValid() {
+				sharedMemory(a.MapIndex(k), e, fmt.Sprintf("%s[%v]", path, k), result)
+			}
+		}
+	case reflect.Interface:
+		if !a.IsNil() && !b.IsNil() {
+			sharedMemory(a.Elem(), b.Elem(), path, result)
+		}
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			if f := a.Type().Field(i); f.PkgPath == "" {
+				sharedMemory(a.Field(i), b.Field(i), path+"."+f.Name, result)
+			}
+		}
+	}
+}
+
+// MutateLeaves changes, one by one, every basic value reachable from v (which has to be a pointer)
+// and calls fn with path of changed value. Value is restored after fn returns. Fields with
+// json:"-" tag are skipped as they are not part of serialized structure.
+func MutateLeaves(v interface{}, fn func(path string)) {
+	mutateLeaves(reflect.ValueOf(v).Elem(), "", fn)
+}
+
+func mutateLeaves(v reflect.Value, path string, fn func(path string)) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			mutateLeaves(v.Elem(), path, fn)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			mutateLeaves(v.Index(i), fmt.Sprintf("%s[%d]", path, i), fn)
+		}
+	case reflect.Map:
+		// map elements are not addressable, so each one is mutated as a copy which is stored in
+		// the map only for the time of fn call
+		for _, k := range v.MapKeys() {
+			old := v.MapIndex(k)
+			e := old
+			if e.Kind() == reflect.Interface {
+				if e.IsNil() {
+					continue
+				}
+				e = e.Elem()
+			}
+			c := reflect.New(e.Type()).Elem()
+			c.Set(e)
+			mutateLeaves(c, fmt.Sprintf("%s[%v]", path, k), func(path string) {
+				v.SetMapIndex(k, c)
+				fn(path)
+				v.SetMapIndex(k, old)
+			})
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.PkgPath == "" && f.Tag.Get("json") != "-" {
+				mutateLeaves(v.Field(i), path+"."+f.Name, fn)
+			}
+		}
+	default:
+		old := reflect.New(v.Type()).Elem()
+		old.Set(v)
+		mutate(v)
+		fn(path)
+		v.Set(old)
+	}
+}
+
+func mutate(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(v.String() + "value")
+	case reflect.Bool:
+		v.SetBool(!v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(v.Int() + 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(v.Uint() + 1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(v.Float() + 1)
+	default:
+		panic(fmt.Sprintf("unsupported kind %s", v.Kind()))
+	}
+}
@@ -0,0 +1,207 @@
+// Package upgrade migrates documents of registered kinds from older versions to newer ones.
+//
+// Upgrade works on raw JSON so it can read documents that do not validate against current
+// structures. Migrations that change shape of document are registered as Steps. Versions
+// without registered step are considered compatible, so only version field is changed.
+// Upgraded document is validated with current structures before it is returned.
+package upgrade
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/semver"
+	"github.com/epiphany-platform/e-structures/utils/kinds"
+)
+
+// Step migrates document of Kind from version From to version To. Apply gets document decoded
+// into map and modifies it in place. It doesn't have to change version field.
+type Step struct {
+	Kind        string
+	From        string
+	To          string
+	Description string
+	Apply       func(doc map[string]interface{}) error
+}
+
+// Result describes performed upgrade.
+type Result struct {
+	Kind string
+	From string
+	To   string
+	// Steps contains descriptions of applied steps, including steps applied to nested documents.
+	Steps []string
+	// Document is upgraded and validated document.
+	Document kinds.Document
+}
+
+// Upgraded reports if document or any of its nested documents was upgraded.
+func (r *Result) Upgraded() bool {
+	return r.From != r.To || len(r.Steps) > 0
+}
+
+var (
+	steps = map[string][]Step{}
+	// nested contains keys of sections containing nested config documents for each kind
+	nested = map[string][]string{}
+)
+
+func init() {
+	RegisterNested("state", "azbi", "azks", "awsbi", "awsks", "gcpbi", "azpg", "azstorage", "azlb", "dns", "azkv", "k8saddons", "monitoring", "bastion", "hi")
+}
+
+// Register adds migration step. It panics if step with the same kind and source version is
+// already registered or versions are incorrect.
+func Register(s Step) {
+	from := semver.MustParse(s.From)
+	to := semver.MustParse(s.To)
+	if !from.LessThan(to) {
+		panic(fmt.Sprintf("step of %s from %s to %s is not an upgrade", s.Kind, s.From, s.To))
+	}
+	for _, existing := range steps[s.Kind] {
+		if semver.MustParse(existing.From).Equal(from) {
+			panic(fmt.Sprintf("step of %s from %s already registered", s.Kind, s.From))
+		}
+	}
+	steps[s.Kind] = append(steps[s.Kind], s)
+}
+
+// RegisterNested marks that kind contains sections under provided keys, that have "config" field
+// containing document which should be upgraded together with parent document.
+func RegisterNested(kind string, keys ...string) {
+	nested[kind] = append(nested[kind], keys...)
+}
+
+// Upgrade upgrades JSON document b to version to. If to is empty, document is upgraded to current
+// version of its kind. Downgrades are not supported.
+func Upgrade(b []byte, to string) (*Result, error) {
+	doc, err := decode(b)
+	if err != nil {
+		return nil, err
+	}
+	result, err := upgrade(doc, to)
+	if err != nil {
+		return nil, err
+	}
+	k, _ := kinds.Get(result.Kind)
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	result.Document = k.New()
+	// strict mode is used so keys not known to current version are not silently dropped
+	if err = result.Document.UnmarshalStrict(out); err != nil {
+		return nil, fmt.Errorf("upgraded document is incorrect: %w", err)
+	}
+	return result, nil
+}
+
+func upgrade(doc map[string]interface{}, to string) (*Result, error) {
+	kind, ok := doc["kind"].(string)
+	if !ok {
+		return nil, errors.New("document has no kind field")
+	}
+	k, err := kinds.Get(kind)
+	if err != nil {
+		return nil, err
+	}
+	version, ok := doc["version"].(string)
+	if !ok {
+		return nil, fmt.Errorf("document of kind %s has no version field", kind)
+	}
+	from, err := semver.NewVersion(version)
+	if err != nil {
+		return nil, fmt.Errorf("incorrect version '%s': %w", version, err)
+	}
+	latest := semver.MustParse(k.Version)
+	target := latest
+	if to != "" {
+		if target, err = semver.NewVersion(to); err != nil {
+			return nil, fmt.Errorf("incorrect target version '%s': %w", to, err)
+		}
+	}
+	if target.GreaterThan(latest) {
+		return nil, fmt.Errorf("version %s of %s is not known, latest version is %s", to, kind, k.Version)
+	}
+	if from.GreaterThan(target) {
+		return nil, fmt.Errorf("cannot downgrade %s from %s to %s", kind, version, target.Original())
+	}
+
+	result := &Result{
+		Kind: kind,
+		From: version,
+		To:   version,
+	}
+	current := from
+	for current.LessThan(target) {
+		s, ok := nextStep(kind, current, target)
+		if !ok {
+			current = target
+			break
+		}
+		if semver.MustParse(s.To).GreaterThan(target) {
+			return nil, fmt.Errorf("no upgrade path of %s to %s, step from %s goes to %s", kind, target.Original(), s.From, s.To)
+		}
+		if err = s.Apply(doc); err != nil {
+			return nil, fmt.Errorf("upgrade of %s from %s to %s failed: %w", kind, s.From, s.To, err)
+		}
+		result.Steps = append(result.Steps, fmt.Sprintf("%s %s -> %s: %s", kind, s.From, s.To, s.Description))
+		current = semver.MustParse(s.To)
+	}
+	if !current.Equal(from) {
+		result.To = current.Original()
+		doc["version"] = result.To
+	}
+
+	for _, key := range nested[kind] {
+		section, ok := doc[key].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		config, ok := section["config"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		r, err := upgrade(config, "")
+		if err != nil {
+			return nil, fmt.Errorf("%s.config: %w", key, err)
+		}
+		if r.Upgraded() {
+			result.Steps = append(result.Steps, r.Steps...)
+			result.Steps = append(result.Steps, fmt.Sprintf("%s.config upgraded from %s to %s", key, r.From, r.To))
+		}
+	}
+	return result, nil
+}
+
+// nextStep returns step registered for kind with the lowest source version not lower than v and
+// lower than target. Versions between v and source version of returned step are compatible.
+func nextStep(kind string, v, target *semver.Version) (Step, bool) {
+	var result Step
+	var resultFrom *semver.Version
+	for _, s := range steps[kind] {
+		from := semver.MustParse(s.From)
+		if from.LessThan(v) || !from.LessThan(target) {
+			continue
+		}
+		if resultFrom == nil || from.LessThan(resultFrom) {
+			result, resultFrom = s, from
+		}
+	}
+	return result, resultFrom != nil
+}
+
+func decode(b []byte) (map[string]interface{}, error) {
+	var doc map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, errors.New("document is not JSON object")
+	}
+	return doc, nil
+}
@@ -0,0 +1,255 @@
+package upgrade
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	azbi "github.com/epiphany-platform/e-structures/azbi/v0"
+	azks "github.com/epiphany-platform/e-structures/azks/v0"
+	hi "github.com/epiphany-platform/e-structures/hi/v0"
+	st "github.com/epiphany-platform/e-structures/state/v0"
+	"github.com/epiphany-platform/e-structures/utils/kinds"
+	"github.com/google/go-cmp/cmp"
+)
+
+func init() {
+	// hi documents older than v0.0.1 don't exist, so this step is used only in tests
+	Register(Step{
+		Kind:        "hi",
+		From:        "v0.0.0",
+		To:          "v0.0.1",
+		Description: "rename groups to vm_groups",
+		Apply: func(doc map[string]interface{}) error {
+			params, ok := doc["params"].(map[string]interface{})
+			if !ok {
+				return errors.New("params missing")
+			}
+			if groups, ok := params["groups"]; ok {
+				params["vm_groups"] = groups
+				delete(params, "groups")
+			}
+			return nil
+		},
+	})
+	// azbi step spans several versions, so no version between them can be upgrade target
+	Register(Step{
+		Kind:        "azbi",
+		From:        "v0.0.1",
+		To:          "v0.1.0",
+		Description: "no changes",
+		Apply: func(doc map[string]interface{}) error {
+			return nil
+		},
+	})
+	// azks step doesn't start at the oldest version, so v0.0.1 documents have to skip to it
+	Register(Step{
+		Kind:        "azks",
+		From:        "v0.0.2",
+		To:          "v0.0.3",
+		Description: "no changes",
+		Apply: func(doc map[string]interface{}) error {
+			return nil
+		},
+	})
+}
+
+func TestUpgrade(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      []byte
+		to        string
+		wantFrom  string
+		wantTo    string
+		wantSteps []string
+		wantErr   string
+	}{
+		{
+			name:     "compatible versions",
+			json:     document(t, azbi.NewConfig(), "v0.1.0", nil),
+			wantFrom: "v0.1.0",
+			wantTo:   "v0.1.4",
+		},
+		{
+			name:     "explicit target version",
+			json:     document(t, azbi.NewConfig(), "v0.1.0", nil),
+			to:       "v0.1.2",
+			wantFrom: "v0.1.0",
+			wantTo:   "v0.1.2",
+		},
+		{
+			name:     "already current",
+			json:     document(t, azbi.NewConfig(), "v0.1.4", nil),
+			wantFrom: "v0.1.4",
+			wantTo:   "v0.1.4",
+		},
+		{
+			name:      "registered step",
+			json:      document(t, hi.NewConfig(), "v0.0.0", renameVmGroups),
+			wantFrom:  "v0.0.0",
+			wantTo:    "v0.0.1",
+			wantSteps: []string{"hi v0.0.0 -> v0.0.1: rename groups to vm_groups"},
+		},
+		{
+			name:      "step after compatible versions",
+			json:      document(t, azks.NewConfig(), "v0.0.1", nil),
+			wantFrom:  "v0.0.1",
+			wantTo:    "v0.0.3",
+			wantSteps: []string{"azks v0.0.2 -> v0.0.3: no changes"},
+		},
+		{
+			name:     "target before step",
+			json:     document(t, azks.NewConfig(), "v0.0.1", nil),
+			to:       "v0.0.2",
+			wantFrom: "v0.0.1",
+			wantTo:   "v0.0.2",
+		},
+		{
+			name:    "target inside step",
+			json:    []byte(`{"kind": "azbi", "version": "v0.0.1"}`),
+			to:      "v0.0.5",
+			wantErr: "no upgrade path of azbi to v0.0.5, step from v0.0.1 goes to v0.1.0",
+		},
+		{
+			name: "nested configs",
+			json: stateDocument(t, map[string][]byte{
+				"azbi": document(t, azbi.NewConfig(), "v0.1.3", nil),
+			}),
+			wantFrom:  "v0.0.5",
+			wantTo:    "v0.0.16",
+			wantSteps: []string{"azbi.config upgraded from v0.1.3 to v0.1.4"},
+		},
+		{
+			name:    "downgrade",
+			json:    []byte(`{"kind": "azbi", "version": "v0.1.4"}`),
+			to:      "v0.1.0",
+			wantErr: "cannot downgrade azbi from v0.1.4 to v0.1.0",
+		},
+		{
+			name:    "unknown target version",
+			json:    []byte(`{"kind": "azbi", "version": "v0.1.4"}`),
+			to:      "v0.2.0",
+			wantErr: "version v0.2.0 of azbi is not known, latest version is v0.1.4",
+		},
+		{
+			name:    "unknown kind",
+			json:    []byte(`{"kind": "unknown", "version": "v0.1.4"}`),
+			wantErr: "unknown kind 'unknown'",
+		},
+		{
+			name:    "missing version",
+			json:    []byte(`{"kind": "azbi"}`),
+			wantErr: "document of kind azbi has no version field",
+		},
+		{
+			name:    "incorrect upgraded document",
+			json:    []byte(`{"kind": "azbi", "version": "v0.1.0", "params": {}}`),
+			wantErr: "upgraded document is incorrect",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Upgrade(tt.json, tt.to)
+			if tt.wantErr != "" {
+				if err == nil {
+					t.Fatalf("Upgrade() expected error %s", tt.wantErr)
+				}
+				if !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Fatalf("Upgrade() error = %v, want %s", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Upgrade() error = %v", err)
+			}
+			if got.From != tt.wantFrom || got.To != tt.wantTo {
+				t.Errorf("Upgrade() upgraded from %s to %s, want from %s to %s", got.From, got.To, tt.wantFrom, tt.wantTo)
+			}
+			if diff := cmp.Diff(tt.wantSteps, got.Steps); diff != "" {
+				t.Errorf("Upgrade() steps mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgrade_Document(t *testing.T) {
+	got, err := Upgrade(stateDocument(t, map[string][]byte{
+		"azbi": document(t, azbi.NewConfig(), "v0.1.3", nil),
+		"hi":   document(t, hi.NewConfig(), "v0.0.0", renameVmGroups),
+	}), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, ok := got.Document.(*st.State)
+	if !ok {
+		t.Fatalf("Upgrade() returned document of type %T", got.Document)
+	}
+	if v := s.GetVersionV(); v != "v0.0.16" {
+		t.Errorf("state version = %s, want v0.0.16", v)
+	}
+	if v := s.GetAzBI().GetConfig().GetVersionV(); v != *azbi.NewConfig().Version {
+		t.Errorf("azbi config version = %s, want %s", v, *azbi.NewConfig().Version)
+	}
+	if v := s.GetHi().GetConfig().GetVersionV(); v != *hi.NewConfig().Version {
+		t.Errorf("hi config version = %s, want %s", v, *hi.NewConfig().Version)
+	}
+	if n := len(s.GetHi().GetConfig().GetParams().GetVmGroups()); n != 1 {
+		t.Errorf("hi config has %d vm groups after upgrade, want 1", n)
+	}
+	want := []string{
+		"azbi.config upgraded from v0.1.3 to v0.1.4",
+		"hi v0.0.0 -> v0.0.1: rename groups to vm_groups",
+		"hi.config upgraded from v0.0.0 to v0.0.1",
+	}
+	if diff := cmp.Diff(want, got.Steps); diff != "" {
+		t.Errorf("Upgrade() steps mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// document returns JSON of d with version changed to version and modified by fn.
+func document(t *testing.T, d kinds.Document, version string, fn func(doc map[string]interface{})) []byte {
+	b, err := d.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc map[string]interface{}
+	if err = json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	doc["version"] = version
+	if fn != nil {
+		fn(doc)
+	}
+	b, err = json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// stateDocument returns JSON of v0.0.5 state with initialized modules using provided configs.
+func stateDocument(t *testing.T, configs map[string][]byte) []byte {
+	doc := map[string]interface{}{
+		"kind":    "state",
+		"version": "v0.0.5",
+	}
+	for module, config := range configs {
+		doc[module] = map[string]interface{}{
+			"status": "initialized",
+			"config": json.RawMessage(config),
+		}
+	}
+	b, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// renameVmGroups reverts change done by test step registered for hi documents.
+func renameVmGroups(doc map[string]interface{}) {
+	params := doc["params"].(map[string]interface{})
+	params["groups"] = params["vm_groups"]
+	delete(params, "vm_groups")
+}
@@ -0,0 +1,57 @@
+package validators
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/epiphany-platform/e-structures/utils/encryption"
+	"github.com/epiphany-platform/e-structures/utils/sensitive"
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+type kubeConfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server string `yaml:"server"`
+	} `yaml:"cluster"`
+}
+
+type kubeConfig struct {
+	ApiVersion string              `yaml:"apiVersion"`
+	Kind       string              `yaml:"kind"`
+	Clusters   []kubeConfigCluster `yaml:"clusters"`
+}
+
+// IsKubeConfig checks if field is string containing parseable kubeconfig document with at least one cluster.
+// Secret references and encrypted values are accepted, as their value is not known until they are
+// resolved or decrypted.
+func IsKubeConfig(fl validator.FieldLevel) bool {
+	field := fl.Field()
+
+	switch field.Kind() {
+	case reflect.String:
+		if sensitive.IsReference(field.String()) || encryption.IsEncrypted(field.String()) {
+			return true
+		}
+		return isKubeConfig(field.String())
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+func isKubeConfig(s string) bool {
+	var kc kubeConfig
+	if err := yaml.Unmarshal([]byte(s), &kc); err != nil {
+		return false
+	}
+	if kc.Kind != "Config" || len(kc.Clusters) == 0 {
+		return false
+	}
+	for _, c := range kc.Clusters {
+		if c.Name == "" || c.Cluster.Server == "" {
+			return false
+		}
+	}
+	return true
+}
@@ -14,7 +14,7 @@ func HasVersion(fl validator.FieldLevel) bool {
 	switch field.Kind() {
 	case reflect.String:
 		f := field.String()
-		r, err := checkVersionConstraint(f, fl.Param())
+		r, err := CheckVersionConstraint(f, fl.Param())
 		if err != nil {
 			panic(err)
 		}
@@ -24,7 +24,36 @@ func HasVersion(fl validator.FieldLevel) bool {
 	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
 }
 
-func checkVersionConstraint(version, pattern string) (bool, error) {
+// IsVersionConstraint checks if field is string containing semantic version constraint accepted
+// by HasVersion, i.e. "~1.2" or ">= 1.2.0, < 2.0.0".
+func IsVersionConstraint(fl validator.FieldLevel) bool {
+	field := fl.Field()
+
+	switch field.Kind() {
+	case reflect.String:
+		_, err := semver.NewConstraint(field.String())
+		return err == nil
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// IsSemVer checks if field is string containing semantic version, i.e. "1.2.3" or "v1.2.3-rc.1".
+func IsSemVer(fl validator.FieldLevel) bool {
+	field := fl.Field()
+
+	switch field.Kind() {
+	case reflect.String:
+		_, err := semver.NewVersion(field.String())
+		return err == nil
+	}
+
+	panic(fmt.Sprintf("Bad field type %T", field.Interface()))
+}
+
+// CheckVersionConstraint reports whether version satisfies pattern constraint. It fails if either
+// of them cannot be parsed.
+func CheckVersionConstraint(version, pattern string) (bool, error) {
 	c, err := semver.NewConstraint(pattern)
 	if err != nil {
 		return false, err
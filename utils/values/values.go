@@ -0,0 +1,57 @@
+// Package values provides type for free form JSON objects embedded in configurations, like Helm
+// chart values, which structure is not known to e-structures.
+package values
+
+import (
+	"reflect"
+)
+
+// Object is free form JSON object. Its values are expected to be JSON compatible, that is
+// nil, bool, float64, string, []interface{} or map[string]interface{}, as produced by
+// encoding/json.
+type Object map[string]interface{}
+
+// DeepCopy returns deep copy of Object or nil if Object is nil.
+func (o *Object) DeepCopy() *Object {
+	if o == nil {
+		return nil
+	}
+	var out Object
+	if *o != nil {
+		out = copyMap(*o)
+	}
+	return &out
+}
+
+// Equal reports whether Object and other are both nil or contain equal values.
+func (o *Object) Equal(other *Object) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	return reflect.DeepEqual(*o, *other)
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = copyValue(v)
+	}
+	return out
+}
+
+func copyValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return copyMap(t)
+	case Object:
+		return Object(copyMap(t))
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i := range t {
+			out[i] = copyValue(t[i])
+		}
+		return out
+	default:
+		return v
+	}
+}
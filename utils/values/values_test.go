@@ -0,0 +1,108 @@
+package values
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestObject_DeepCopy(t *testing.T) {
+	tests := []struct {
+		name     string
+		original *Object
+	}{
+		{
+			name:     "nil",
+			original: nil,
+		},
+		{
+			name:     "empty",
+			original: &Object{},
+		},
+		{
+			name: "nested values",
+			original: &Object{
+				"controller": map[string]interface{}{
+					"replicaCount": float64(2),
+					"service": map[string]interface{}{
+						"annotations": map[string]interface{}{"a": "b"},
+					},
+					"extraArgs": []interface{}{"--v=2", map[string]interface{}{"c": true}},
+				},
+				"enabled": true,
+				"empty":   nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			copied := tt.original.DeepCopy()
+			if diff := cmp.Diff(tt.original, copied); diff != "" {
+				t.Errorf("DeepCopy() mismatch (-original +copied):\n%s", diff)
+			}
+			if tt.original == nil {
+				return
+			}
+			if copied == tt.original {
+				t.Error("DeepCopy() returned original pointer")
+			}
+			if c, ok := (*copied)["controller"].(map[string]interface{}); ok {
+				c["replicaCount"] = float64(3)
+				c["extraArgs"].([]interface{})[1].(map[string]interface{})["c"] = false
+				if diff := cmp.Diff(tt.original, copied); diff == "" {
+					t.Error("DeepCopy() shares memory with original")
+				}
+			}
+		})
+	}
+}
+
+func TestObject_Equal(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *Object
+		b    *Object
+		want bool
+	}{
+		{
+			name: "both nil",
+			a:    nil,
+			b:    nil,
+			want: true,
+		},
+		{
+			name: "nil and empty",
+			a:    nil,
+			b:    &Object{},
+			want: false,
+		},
+		{
+			name: "equal nested values",
+			a:    &Object{"a": map[string]interface{}{"b": []interface{}{"c"}}},
+			b:    &Object{"a": map[string]interface{}{"b": []interface{}{"c"}}},
+			want: true,
+		},
+		{
+			name: "different nested values",
+			a:    &Object{"a": map[string]interface{}{"b": []interface{}{"c"}}},
+			b:    &Object{"a": map[string]interface{}{"b": []interface{}{"d"}}},
+			want: false,
+		},
+		{
+			name: "different keys",
+			a:    &Object{"a": "b"},
+			b:    &Object{"c": "b"},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Equal(tt.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := tt.b.Equal(tt.a); got != tt.want {
+				t.Errorf("Equal() reversed = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}